@@ -0,0 +1,167 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// seriesID identifies a value reported by a single instance.
+type seriesID struct {
+	application string
+	instance    string
+	key         string
+}
+
+// sample is the latest value of a series.
+type sample struct {
+	value    float64
+	received time.Time
+}
+
+// Filter selects the series returned by the aggregator.
+type Filter struct {
+	// Application matches the application exactly. Empty matches all applications.
+	Application string
+	// Prefix matches the beginning of the key. Empty matches all keys.
+	Prefix string
+}
+
+// Match returns true if the series belongs to the filter.
+func (filter Filter) Match(application, key string) bool {
+	if filter.Application != "" && filter.Application != application {
+		return false
+	}
+	return strings.HasPrefix(key, filter.Prefix)
+}
+
+// Series contains the values of a key aggregated across the instances of an application.
+type Series struct {
+	Application string             `json:"application"`
+	Key         string             `json:"key"`
+	Count       int                `json:"count"`
+	Sum         float64            `json:"sum"`
+	Avg         float64            `json:"avg"`
+	Min         float64            `json:"min"`
+	Max         float64            `json:"max"`
+	Instances   map[string]float64 `json:"instances,omitempty"`
+}
+
+// Aggregator keeps the latest value of every (application, instance, key) and
+// aggregates them across instances.
+type Aggregator struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu     sync.Mutex
+	values map[seriesID]sample
+}
+
+// NewAggregator creates a new aggregator, which forgets values not updated for ttl.
+func NewAggregator(ttl time.Duration) *Aggregator {
+	return &Aggregator{
+		ttl:    ttl,
+		now:    time.Now,
+		values: map[seriesID]sample{},
+	}
+}
+
+// Metric implements telemetry.Handler.
+func (aggregator *Aggregator) Metric(application, instance string, key []byte, value float64) {
+	if math.IsNaN(value) {
+		return
+	}
+	now := aggregator.now()
+
+	aggregator.mu.Lock()
+	defer aggregator.mu.Unlock()
+
+	aggregator.values[seriesID{
+		application: application,
+		instance:    instance,
+		key:         string(key),
+	}] = sample{value: value, received: now}
+}
+
+// Len returns the number of stored values.
+func (aggregator *Aggregator) Len() int {
+	aggregator.mu.Lock()
+	defer aggregator.mu.Unlock()
+	return len(aggregator.values)
+}
+
+// Expire removes the values which were not updated within the ttl.
+func (aggregator *Aggregator) Expire(ctx context.Context) error {
+	deadline := aggregator.now().Add(-aggregator.ttl)
+
+	aggregator.mu.Lock()
+	defer aggregator.mu.Unlock()
+
+	for id, sample := range aggregator.values {
+		if sample.received.Before(deadline) {
+			delete(aggregator.values, id)
+		}
+	}
+	return nil
+}
+
+// Query returns the matching series aggregated across instances, sorted by application and key.
+// Values of the individual instances are included when instances is true.
+func (aggregator *Aggregator) Query(filter Filter, instances bool) []Series {
+	deadline := aggregator.now().Add(-aggregator.ttl)
+
+	type aggregateID struct {
+		application string
+		key         string
+	}
+	aggregates := map[aggregateID]*Series{}
+
+	aggregator.mu.Lock()
+	for id, sample := range aggregator.values {
+		if sample.received.Before(deadline) || !filter.Match(id.application, id.key) {
+			continue
+		}
+
+		series, ok := aggregates[aggregateID{id.application, id.key}]
+		if !ok {
+			series = &Series{
+				Application: id.application,
+				Key:         id.key,
+				Min:         sample.value,
+				Max:         sample.value,
+			}
+			if instances {
+				series.Instances = map[string]float64{}
+			}
+			aggregates[aggregateID{id.application, id.key}] = series
+		}
+
+		series.Count++
+		series.Sum += sample.value
+		series.Min = math.Min(series.Min, sample.value)
+		series.Max = math.Max(series.Max, sample.value)
+		if instances {
+			series.Instances[id.instance] = sample.value
+		}
+	}
+	aggregator.mu.Unlock()
+
+	result := make([]Series, 0, len(aggregates))
+	for _, series := range aggregates {
+		series.Avg = series.Sum / float64(series.Count)
+		result = append(result, *series)
+	}
+	sort.Slice(result, func(i, k int) bool {
+		if result[i].Application != result[k].Application {
+			return result[i].Application < result[k].Application
+		}
+		return result[i].Key < result[k].Key
+	})
+	return result
+}
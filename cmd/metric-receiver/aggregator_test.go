@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregator(t *testing.T) {
+	now := time.Now()
+	aggregator := NewAggregator(time.Minute)
+	aggregator.now = func() time.Time { return now }
+
+	aggregator.Metric("satellite", "a", []byte("requests,scope=api total"), 1)
+	aggregator.Metric("satellite", "b", []byte("requests,scope=api total"), 2)
+	aggregator.Metric("satellite", "b", []byte("requests,scope=api total"), 5)
+	aggregator.Metric("satellite", "a", []byte("uptime"), 10)
+	aggregator.Metric("storagenode", "c", []byte("requests,scope=api total"), 7)
+
+	series := aggregator.Query(Filter{Application: "satellite", Prefix: "requests"}, true)
+	require.Equal(t, []Series{{
+		Application: "satellite",
+		Key:         "requests,scope=api total",
+		Count:       2,
+		Sum:         6,
+		Avg:         3,
+		Min:         1,
+		Max:         5,
+		Instances:   map[string]float64{"a": 1, "b": 5},
+	}}, series)
+
+	series = aggregator.Query(Filter{}, false)
+	require.Len(t, series, 3)
+	require.Equal(t, "satellite", series[0].Application)
+	require.Equal(t, "uptime", series[1].Key)
+	require.Equal(t, "storagenode", series[2].Application)
+	require.Nil(t, series[0].Instances)
+
+	// expired values are not returned and removed.
+	now = now.Add(30 * time.Second)
+	aggregator.Metric("satellite", "a", []byte("uptime"), 11)
+	now = now.Add(45 * time.Second)
+
+	series = aggregator.Query(Filter{}, false)
+	require.Len(t, series, 1)
+	require.Equal(t, "uptime", series[0].Key)
+	require.EqualValues(t, 11, series[0].Sum)
+
+	require.NoError(t, aggregator.Expire(context.Background()))
+	require.Equal(t, 1, aggregator.Len())
+}
+
+func TestParseKey(t *testing.T) {
+	name, labels := parseKey(`function,name=storj.io/storj/satellite.(*Endpoint).Begin,scope=storj.io/storj successes`)
+	require.Equal(t, "function_successes", name)
+	require.Equal(t, []label{
+		{"name", "storj.io/storj/satellite.(*Endpoint).Begin"},
+		{"scope", "storj.io/storj"},
+	}, labels)
+
+	name, labels = parseKey(`my\ metric,b=x\,y,a=1`)
+	require.Equal(t, "my_metric", name)
+	require.Equal(t, []label{{"a", "1"}, {"b", "x,y"}}, labels)
+
+	name, _ = parseKey(`9lives`)
+	require.Equal(t, "_9lives", name)
+
+	// the tags colliding with the labels of the receiver or with each other are renamed.
+	_, labels = parseKey(`up,monkit_instance=a,a-b=1,a_b=2,__name__=x`)
+	require.Equal(t, []label{
+		{"a_b", "1"},
+		{"exported___name__", "x"},
+		{"exported_a_b", "2"},
+		{"exported_monkit_instance", "a"},
+	}, labels)
+}
+
+func TestHandler(t *testing.T) {
+	aggregator := NewAggregator(time.Minute)
+	aggregator.Metric("satellite", "a", []byte("requests,scope=api total"), 1)
+	aggregator.Metric("satellite", "b", []byte("requests,scope=api total"), 3)
+	aggregator.Metric("storagenode", "c", []byte("uptime"), 7)
+
+	server := httptest.NewServer(newHandler(aggregator))
+	defer server.Close()
+
+	get := func(path string) []byte {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, resp.Body.Close()) }()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(resp.Body)
+		require.NoError(t, err)
+		return buf.Bytes()
+	}
+
+	require.Equal(t, ""+
+		"# TYPE requests_total gauge\n"+
+		`requests_total{monkit_application="satellite",scope="api",monkit_aggregate="count"} 2`+"\n"+
+		`requests_total{monkit_application="satellite",scope="api",monkit_aggregate="sum"} 4`+"\n"+
+		`requests_total{monkit_application="satellite",scope="api",monkit_aggregate="avg"} 2`+"\n"+
+		`requests_total{monkit_application="satellite",scope="api",monkit_aggregate="min"} 1`+"\n"+
+		`requests_total{monkit_application="satellite",scope="api",monkit_aggregate="max"} 3`+"\n"+
+		`requests_total{monkit_application="satellite",scope="api",monkit_instance="a"} 1`+"\n"+
+		`requests_total{monkit_application="satellite",scope="api",monkit_instance="b"} 3`+"\n",
+		string(get("/metrics?application=satellite&instances=true")))
+
+	var series []Series
+	require.NoError(t, json.Unmarshal(get("/api/v1/query?prefix=up"), &series))
+	require.Len(t, series, 1)
+	require.Equal(t, "storagenode", series[0].Application)
+	require.EqualValues(t, 7, series[0].Max)
+
+	resp, err := http.Get(server.URL + "/metrics?instances=maybe")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
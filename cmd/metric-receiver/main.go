@@ -4,28 +4,42 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"net"
+	"net/http"
+	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 
+	"storj.io/common/sync2"
 	"storj.io/common/telemetry"
 	"storj.io/storj/shared/process"
 )
 
 var (
-	addr = flag.String("addr", ":9000", "address to listen for metrics on")
+	addr     = flag.String("addr", ":9000", "address to listen for metrics on")
+	httpAddr = flag.String("http-addr", ":9001", "address to serve the prometheus endpoint and the query API on")
+	ttl      = flag.Duration("ttl", 10*time.Minute, "how long to keep a value, which is not updated")
+	verbose  = flag.Bool("verbose", false, "print every received metric to stdout")
 )
 
 func main() {
 	process.Exec(&cobra.Command{
 		Use:   "metric-receiver",
-		Short: "receive metrics",
+		Short: "receive, aggregate and export metrics",
 		RunE:  run,
 	})
 }
 
 func run(cmd *cobra.Command, args []string) (err error) {
+	if *ttl <= 0 {
+		return fmt.Errorf("--ttl must be positive, got %v", *ttl)
+	}
+
 	ctx, _ := process.Ctx(cmd)
 	s, err := telemetry.Listen(*addr)
 	if err != nil {
@@ -33,8 +47,46 @@ func run(cmd *cobra.Command, args []string) (err error) {
 	}
 	defer printError(s.Close)
 
-	fmt.Printf("listening on %s\n", s.Addr())
-	return s.Serve(ctx, telemetry.HandlerFunc(handle))
+	listener, err := net.Listen("tcp", *httpAddr)
+	if err != nil {
+		return err
+	}
+
+	aggregator := NewAggregator(*ttl)
+	var handler telemetry.Handler = aggregator
+	if *verbose {
+		handler = telemetry.HandlerFunc(func(application, instance string, key []byte, val float64) {
+			handle(application, instance, key, val)
+			aggregator.Metric(application, instance, key, val)
+		})
+	}
+
+	server := &http.Server{
+		Handler:           newHandler(aggregator),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	fmt.Printf("listening on %s, serving metrics on http://%s/metrics\n", s.Addr(), listener.Addr())
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		return s.Serve(ctx, handler)
+	})
+	group.Go(func() error {
+		err := server.Serve(listener)
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	})
+	group.Go(func() error {
+		<-ctx.Done()
+		return server.Shutdown(context.Background())
+	})
+	group.Go(func() error {
+		return sync2.NewCycle(*ttl/2).Run(ctx, aggregator.Expire)
+	})
+	return group.Wait()
 }
 
 func handle(application, instance string, key []byte, val float64) {
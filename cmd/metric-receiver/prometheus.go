@@ -0,0 +1,215 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The labels added by the receiver are prefixed, so that they don't collide
+// with the monkit tags or with the "instance" and "job" labels, which
+// prometheus attaches to every scraped target.
+const (
+	applicationLabel = "monkit_application"
+	aggregateLabel   = "monkit_aggregate"
+	instanceLabel    = "monkit_instance"
+)
+
+// label is a prometheus label.
+type label struct {
+	name  string
+	value string
+}
+
+// parseKey splits a monkit series key (`measurement,tag=value,... field`) into
+// a prometheus metric name and labels. Tags, which collide with the labels of
+// the receiver, with the reserved prometheus labels or with each other, are
+// renamed with an "exported_" prefix.
+func parseKey(key string) (name string, labels []label) {
+	measurement, field := key, ""
+	if i := lastUnescaped(key, ' '); i >= 0 {
+		measurement, field = key[:i], key[i+1:]
+	}
+
+	parts := splitUnescaped(measurement, ',')
+	name = sanitizeName(unescape(parts[0]))
+	if field != "" {
+		name += "_" + sanitizeName(unescape(field))
+	}
+
+	for _, part := range parts[1:] {
+		tag := splitUnescaped(part, '=')
+		if len(tag) != 2 {
+			continue
+		}
+		labels = append(labels, label{
+			name:  sanitizeName(unescape(tag[0])),
+			value: unescape(tag[1]),
+		})
+	}
+	sort.SliceStable(labels, func(i, k int) bool { return labels[i].name < labels[k].name })
+
+	used := map[string]bool{applicationLabel: true, aggregateLabel: true, instanceLabel: true}
+	renamed := false
+	for i := range labels {
+		for used[labels[i].name] || strings.HasPrefix(labels[i].name, "__") {
+			labels[i].name = "exported_" + labels[i].name
+			renamed = true
+		}
+		used[labels[i].name] = true
+	}
+	if renamed {
+		sort.Slice(labels, func(i, k int) bool { return labels[i].name < labels[k].name })
+	}
+	return name, labels
+}
+
+// writePrometheus writes the aggregated series in the prometheus text exposition format.
+// Every aggregate is a separate sample with a "monkit_aggregate" label, instances
+// are exported with a "monkit_instance" label.
+func writePrometheus(w io.Writer, series []Series) error {
+	type sampleLine struct {
+		labels []label
+		value  float64
+	}
+	families := map[string][]sampleLine{}
+
+	for _, s := range series {
+		name, labels := parseKey(s.Key)
+		labels = append([]label{{applicationLabel, s.Application}}, labels...)
+
+		withLabel := func(extra label) []label {
+			return append(append([]label{}, labels...), extra)
+		}
+
+		families[name] = append(families[name],
+			sampleLine{withLabel(label{aggregateLabel, "count"}), float64(s.Count)},
+			sampleLine{withLabel(label{aggregateLabel, "sum"}), s.Sum},
+			sampleLine{withLabel(label{aggregateLabel, "avg"}), s.Avg},
+			sampleLine{withLabel(label{aggregateLabel, "min"}), s.Min},
+			sampleLine{withLabel(label{aggregateLabel, "max"}), s.Max},
+		)
+
+		instances := make([]string, 0, len(s.Instances))
+		for instance := range s.Instances {
+			instances = append(instances, instance)
+		}
+		sort.Strings(instances)
+		for _, instance := range instances {
+			families[name] = append(families[name],
+				sampleLine{withLabel(label{instanceLabel, instance}), s.Instances[instance]})
+		}
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := bufio.NewWriter(w)
+	for _, name := range names {
+		_, _ = buf.WriteString("# TYPE " + name + " gauge\n")
+		for _, line := range families[name] {
+			_, _ = buf.WriteString(name)
+			_ = buf.WriteByte('{')
+			for i, l := range line.labels {
+				if i > 0 {
+					_ = buf.WriteByte(',')
+				}
+				_, _ = buf.WriteString(l.name + `="` + escapeLabelValue(l.value) + `"`)
+			}
+			_, _ = buf.WriteString("} " + formatValue(line.value) + "\n")
+		}
+	}
+	return buf.Flush()
+}
+
+// sanitizeName replaces the characters, which are not allowed in prometheus names.
+func sanitizeName(name string) string {
+	if name == "" {
+		return "_"
+	}
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_', r == ':':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// lastUnescaped returns the index of the last sep, which is not escaped with a backslash.
+func lastUnescaped(s string, sep byte) int {
+	last := -1
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			last = i
+		}
+	}
+	return last
+}
+
+// splitUnescaped splits s at every sep, which is not escaped with a backslash.
+func splitUnescaped(s string, sep byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unescape removes the backslash escaping of monkit keys.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// newHandler returns the HTTP handler for the prometheus endpoint and the JSON query API.
+//
+// Both endpoints accept the "application" and "prefix" query parameters for filtering
+// and "instances" to include the values of the individual instances.
+func newHandler(aggregator *Aggregator) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		filter, instances, err := parseQuery(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_ = writePrometheus(w, aggregator.Query(filter, instances))
+	})
+	mux.HandleFunc("/api/v1/query", func(w http.ResponseWriter, r *http.Request) {
+		filter, instances, err := parseQuery(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(aggregator.Query(filter, instances))
+	})
+	return mux
+}
+
+func parseQuery(r *http.Request) (filter Filter, instances bool, err error) {
+	query := r.URL.Query()
+	filter = Filter{
+		Application: query.Get("application"),
+		Prefix:      query.Get("prefix"),
+	}
+	if value := query.Get("instances"); value != "" {
+		instances, err = strconv.ParseBool(value)
+		if err != nil {
+			return Filter{}, false, err
+		}
+	}
+	return filter, instances, nil
+}
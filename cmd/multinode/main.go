@@ -48,6 +48,11 @@ var (
 		Short: "Run the multinode dashboard",
 		RunE:  cmdRun,
 	}
+	migrationCmd = &cobra.Command{
+		Use:   "migration",
+		Short: "Run the multinode database migration",
+		RunE:  cmdMigration,
+	}
 	setupCmd = &cobra.Command{
 		Use:         "setup",
 		Short:       "Create config files",
@@ -82,6 +87,12 @@ $ cat nodes.json | multinode add -
 
 		Config
 	}
+	migrationCfg struct {
+		DryRun        bool `help:"print the SQL statements of the migration without running them" default:"false"`
+		TargetVersion int  `help:"migrate or roll back the database to the specified version instead of the latest one" default:"-1"`
+
+		Config
+	}
 	confDir     string
 	identityDir string
 )
@@ -105,9 +116,11 @@ func init() {
 	rootCmd.AddCommand(setupCmd)
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(addCmd)
+	runCmd.AddCommand(migrationCmd)
 
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
+	process.Bind(migrationCmd, &migrationCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(addCmd, &addCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
@@ -161,6 +174,28 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 	return errs.Combine(runError, closeError)
 }
 
+func cmdMigration(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	db, err := multinodedb.Open(ctx, log.Named("db"), migrationCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on multinode: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	switch {
+	case migrationCfg.DryRun:
+		return db.DryRunMigration(ctx, cmd.OutOrStdout(), migrationCfg.TargetVersion)
+	case migrationCfg.TargetVersion >= 0:
+		return db.MigrateToVersion(ctx, migrationCfg.TargetVersion)
+	default:
+		return db.MigrateToLatest(ctx)
+	}
+}
+
 func cmdAdd(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 	log := zap.L()
@@ -106,7 +106,7 @@ func cmdAPIRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, revocationDB.Close())
 	}()
 
-	accountingCache, err := live.OpenCache(ctx, log.Named("live-accounting"), runCfg.LiveAccounting)
+	accountingCache, err := live.OpenCache(ctx, log.Named("live-accounting"), runCfg.LiveAccounting, db.LiveAccounting())
 	if err != nil {
 		if !accounting.ErrSystemOrNetError.Has(err) || accountingCache == nil {
 			return errs.New("Error instantiating live accounting cache: %w", err)
@@ -14,6 +14,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/payments/offline"
 	"storj.io/storj/satellite/payments/stripe"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/shared/process"
@@ -64,6 +65,11 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripe.Service, error) {
 		return nil, err
 	}
 
+	placementPriceOverrides, err := pc.PlacementPriceOverrides.ToModels()
+	if err != nil {
+		return nil, err
+	}
+
 	return stripe.NewService(
 		log.Named("payments.stripe:service"),
 		stripeClient,
@@ -76,12 +82,39 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripe.Service, error) {
 		db.ProjectAccounting(),
 		prices,
 		priceOverrides,
+		placementPriceOverrides,
 		pc.PackagePlans.Packages,
 		pc.BonusRate,
 		analytics.NewService(log.Named("analytics:service"), runCfg.Analytics, runCfg.Console.SatelliteName),
 	)
 }
 
+func runOfflineBillingCmd(ctx context.Context, cmdFunc func(context.Context, *offline.Service, satellite.DB) error) error {
+	if runCfg.Payments.Provider != "offline" {
+		return errs.New("payments provider is %q, not \"offline\"", runCfg.Payments.Provider)
+	}
+
+	logger := zap.L()
+	db, err := satellitedb.Open(ctx, logger.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-billing"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	payments, err := setupOfflinePayments(logger, db)
+	if err != nil {
+		return err
+	}
+
+	return cmdFunc(ctx, payments, db)
+}
+
+func setupOfflinePayments(log *zap.Logger, db satellite.DB) (*offline.Service, error) {
+	return satellite.NewOfflinePayments(log, db, runCfg.Payments)
+}
+
 // parseYearMonth parses year and month from the provided string and returns a corresponding time.Time for the first day
 // of the month. The input year and month should be iso8601 format (yyyy-mm).
 func parseYearMonth(yearMonth string) (time.Time, error) {
@@ -37,6 +37,7 @@ import (
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/payments/offline"
 	"storj.io/storj/satellite/payments/stripe"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/shared/cfgstruct"
@@ -93,6 +94,7 @@ var (
 	runMigrationCmd = &cobra.Command{
 		Use:   "migration",
 		Short: "Run the satellite database migration",
+		Long:  "Migrate the satellite database and the metabase to the latest version. With --target-version only the satellite database is migrated or rolled back to the specified version, the metabase is still migrated to the latest version, because it doesn't support migrating to a specific version. With --dry-run the SQL statements of both migrations are printed without running them.",
 		RunE:  cmdMigrationRun,
 	}
 	runAPICmd = &cobra.Command{
@@ -229,6 +231,11 @@ var (
 
 	aggregate = false
 
+	offlineInvoiceFormat string
+
+	migrationDryRun        = false
+	migrationTargetVersion = -1
+
 	prepareCustomerInvoiceRecordsCmd = &cobra.Command{
 		Use:   "prepare-invoice-records [period]",
 		Short: "Prepares invoice project records",
@@ -304,6 +311,20 @@ var (
 		Long:  "Ensures that we have a stripe customer for every satellite user.",
 		RunE:  cmdStripeCustomer,
 	}
+	generateOfflineInvoicesCmd = &cobra.Command{
+		Use:   "generate-offline-invoices [period]",
+		Short: "Generates invoices of the offline payments provider",
+		Long:  "Generates and stores invoices for the project usage of all users for a pay period, when the satellite uses the offline payments provider. Period is a UTC date formatted like YYYY-MM.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdGenerateOfflineInvoices,
+	}
+	renderOfflineInvoiceCmd = &cobra.Command{
+		Use:   "render-offline-invoice [invoice-id]",
+		Short: "Writes an invoice of the offline payments provider to stdout",
+		Long:  "Writes an invoice of the offline payments provider to stdout formatted as pdf, csv or json.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdRenderOfflineInvoice,
+	}
 	consistencyCmd = &cobra.Command{
 		Use:   "consistency",
 		Short: "Readdress DB consistency issues",
@@ -402,6 +423,8 @@ func init() {
 	defaults := cfgstruct.DefaultsFlag(rootCmd)
 	rootCmd.AddCommand(runCmd)
 	runCmd.AddCommand(runMigrationCmd)
+	runMigrationCmd.Flags().BoolVar(&migrationDryRun, "dry-run", false, "Print the SQL statements of the migration without running them.")
+	runMigrationCmd.Flags().IntVar(&migrationTargetVersion, "target-version", -1, "Migrate or roll back the satellite database to the specified version instead of the latest one. The metabase is always migrated to the latest version.")
 	runCmd.AddCommand(runAPICmd)
 	runCmd.AddCommand(runUICmd)
 	runCmd.AddCommand(runAdminCmd)
@@ -444,6 +467,9 @@ func init() {
 	billingCmd.AddCommand(failPendingInvoiceTokenPaymentCmd)
 	billingCmd.AddCommand(completePendingInvoiceTokenPaymentCmd)
 	billingCmd.AddCommand(stripeCustomerCmd)
+	billingCmd.AddCommand(generateOfflineInvoicesCmd)
+	billingCmd.AddCommand(renderOfflineInvoiceCmd)
+	renderOfflineInvoiceCmd.Flags().StringVar(&offlineInvoiceFormat, "format", "pdf", "Format of the rendered invoice, one of pdf, csv or json.")
 	consistencyCmd.AddCommand(consistencyGECleanupCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runMigrationCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -482,6 +508,8 @@ func init() {
 	process.Bind(failPendingInvoiceTokenPaymentCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(completePendingInvoiceTokenPaymentCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(stripeCustomerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(generateOfflineInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(renderOfflineInvoiceCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(consistencyGECleanupCmd, &consistencyGECleanupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(fixLastNetsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
@@ -531,7 +559,7 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, revocationDB.Close())
 	}()
 
-	liveAccounting, err := live.OpenCache(ctx, log.Named("live-accounting"), runCfg.LiveAccounting)
+	liveAccounting, err := live.OpenCache(ctx, log.Named("live-accounting"), runCfg.LiveAccounting, db.LiveAccounting())
 	if err != nil {
 		if !accounting.ErrSystemOrNetError.Has(err) || liveAccounting == nil {
 			return errs.New("Error instantiating live accounting cache: %w", err)
@@ -589,9 +617,22 @@ func cmdMigrationRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, db.Close())
 	}()
 
-	err = db.MigrateToLatest(ctx)
-	if err != nil {
-		return errs.New("Error creating tables for master database on satellite: %+v", err)
+	switch {
+	case migrationDryRun:
+		err = db.DryRunMigration(ctx, cmd.OutOrStdout(), migrationTargetVersion)
+		if err != nil {
+			return errs.New("Error previewing migration of master database on satellite: %+v", err)
+		}
+	case migrationTargetVersion >= 0:
+		err = db.MigrateToVersion(ctx, migrationTargetVersion)
+		if err != nil {
+			return errs.New("Error migrating master database on satellite to version %d: %+v", migrationTargetVersion, err)
+		}
+	default:
+		err = db.MigrateToLatest(ctx)
+		if err != nil {
+			return errs.New("Error creating tables for master database on satellite: %+v", err)
+		}
 	}
 
 	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL,
@@ -602,6 +643,15 @@ func cmdMigrationRun(cmd *cobra.Command, args []string) (err error) {
 	defer func() {
 		err = errs.Combine(err, metabaseDB.Close())
 	}()
+
+	if migrationDryRun {
+		err = metabaseDB.PostgresMigration().DryRun(ctx, cmd.OutOrStdout())
+		if err != nil {
+			return errs.New("Error previewing metabase migration: %+v", err)
+		}
+		return nil
+	}
+
 	err = metabaseDB.MigrateToLatest(ctx)
 	if err != nil {
 		return errs.New("Error creating metabase tables: %+v", err)
@@ -967,6 +1017,32 @@ func cmdStripeCustomer(cmd *cobra.Command, args []string) (err error) {
 	return generateStripeCustomers(ctx)
 }
 
+func cmdGenerateOfflineInvoices(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	periodStart, err := parseYearMonth(args[0])
+	if err != nil {
+		return err
+	}
+
+	return runOfflineBillingCmd(ctx, func(ctx context.Context, payments *offline.Service, _ satellite.DB) error {
+		return payments.GenerateInvoices(ctx, periodStart)
+	})
+}
+
+func cmdRenderOfflineInvoice(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	format, err := offline.ParseFormat(offlineInvoiceFormat)
+	if err != nil {
+		return err
+	}
+
+	return runOfflineBillingCmd(ctx, func(ctx context.Context, payments *offline.Service, _ satellite.DB) error {
+		return payments.Invoices().Render(ctx, os.Stdout, args[0], format)
+	})
+}
+
 func cmdConsistencyGECleanup(cmd *cobra.Command, args []string) error {
 	ctx, _ := process.Ctx(cmd)
 
@@ -0,0 +1,212 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/shared/cfgstruct"
+	"storj.io/storj/shared/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+// dbCfg defines configuration for the db commands.
+type dbCfg struct {
+	storagenode.Config
+}
+
+func newDBCmd(f *Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "db",
+		Short:       "Check, repair and convert the storagenode databases",
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	cmd.AddCommand(
+		newDBCheckCmd(f),
+		newDBRepairCmd(f),
+		newDBConsolidateCmd(f),
+		newDBSplitCmd(f),
+	)
+
+	return cmd
+}
+
+func newDBCheckCmd(f *Factory) *cobra.Command {
+	var cfg dbCfg
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check the integrity of the storagenode databases",
+		Long: "Check the integrity of the storagenode databases.\n" +
+			"The command fails when a database is damaged or missing.",
+		Example: `
+$ storagenode db check --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return cmdDBCheck(ctx, zap.L(), cmd.OutOrStdout(), &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func newDBRepairCmd(f *Factory) *cobra.Command {
+	var cfg dbCfg
+	cmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Recreate the damaged storagenode databases",
+		Long: "Check the integrity of the storagenode databases and recreate the damaged or missing ones.\n" +
+			"The damaged databases are moved aside as backups and recreated empty with the current schema. " +
+			"The piece expirations and the space used are derived again from the stored pieces; " +
+			"the rest of the data of a recreated database is lost, and it's reported.\n" +
+			"The storagenode must not be running.",
+		Example: `
+$ storagenode db repair --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return cmdDBRepair(ctx, zap.L(), cmd.OutOrStdout(), &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func newDBConsolidateCmd(f *Factory) *cobra.Command {
+	var cfg dbCfg
+	cmd := &cobra.Command{
+		Use:   "consolidate",
+		Short: "Move the storagenode databases into a single file",
+		Long: "Move the storagenode databases, stored in separate files, into a single file.\n" +
+			"The databases are migrated to the latest version before being moved. " +
+			"Afterwards, the storagenode has to run with storage2.database-consolidated enabled.\n" +
+			"The storagenode must not be running.",
+		Example: `
+$ storagenode db consolidate --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return storagenodedb.Consolidate(ctx, zap.L().Named("db"), cfg.DatabaseConfig())
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func newDBSplitCmd(f *Factory) *cobra.Command {
+	var cfg dbCfg
+	cmd := &cobra.Command{
+		Use:   "split",
+		Short: "Move the storagenode databases into separate files",
+		Long: "Move the storagenode databases, stored in a single file, into one file per database.\n" +
+			"The databases are migrated to the latest version before being moved. " +
+			"Afterwards, the storagenode has to run with storage2.database-consolidated disabled.\n" +
+			"The storagenode must not be running.",
+		Example: `
+$ storagenode db split --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return storagenodedb.Split(ctx, zap.L().Named("db"), cfg.DatabaseConfig())
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdDBCheck(ctx context.Context, log *zap.Logger, w io.Writer, cfg *dbCfg) (err error) {
+	// damaged databases may fail to open, so they are opened by the check.
+	db, err := storagenodedb.OpenNew(ctx, log.Named("db"), cfg.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error opening storagenode databases: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	checks, err := db.CheckIntegrity(ctx)
+	if err != nil {
+		return err
+	}
+
+	damaged := printIntegrityChecks(w, checks)
+	if damaged > 0 {
+		return errs.New("%d damaged databases, run 'storagenode db repair' for recreating them", damaged)
+	}
+
+	return nil
+}
+
+func cmdDBRepair(ctx context.Context, log *zap.Logger, w io.Writer, cfg *dbCfg) (err error) {
+	checks, reports, err := repairDatabases(ctx, log, cfg.DatabaseConfig())
+
+	printIntegrityChecks(w, checks)
+	if len(reports) > 0 {
+		_, _ = fmt.Fprintln(w)
+	}
+	for _, report := range reports {
+		_, _ = fmt.Fprintf(w, "%s: recreated\n", report.Name)
+		if report.BackupPath != "" {
+			_, _ = fmt.Fprintf(w, "\tbackup: %s\n", report.BackupPath)
+		}
+		if report.Rederived != "" {
+			_, _ = fmt.Fprintf(w, "\trecovered: %s\n", report.Rederived)
+		}
+		_, _ = fmt.Fprintf(w, "\tlost: %s\n", report.Lost)
+	}
+
+	return err
+}
+
+// repairDatabases checks the integrity of the databases and recreates the
+// damaged ones.
+func repairDatabases(ctx context.Context, log *zap.Logger, config storagenodedb.Config) (checks []storagenodedb.IntegrityCheck, reports []storagenodedb.RecoveryReport, err error) {
+	db, err := storagenodedb.OpenNew(ctx, log.Named("db"), config)
+	if err != nil {
+		return nil, nil, errs.New("Error opening storagenode databases: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	checks, err = db.CheckIntegrity(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reports, err = db.Recover(ctx, checks)
+	return checks, reports, err
+}
+
+// printIntegrityChecks prints the result of the checks and returns the number
+// of damaged databases.
+func printIntegrityChecks(w io.Writer, checks []storagenodedb.IntegrityCheck) (damaged int) {
+	for _, check := range checks {
+		if check.OK() {
+			_, _ = fmt.Fprintf(w, "%s: ok\n", check.Name)
+			continue
+		}
+
+		damaged++
+		_, _ = fmt.Fprintf(w, "%s: %s\n", check.Name, strings.Join(check.Problems, "; "))
+	}
+	return damaged
+}
@@ -14,6 +14,7 @@ import (
 	"storj.io/storj/shared/cfgstruct"
 	"storj.io/storj/shared/process"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/satellites"
 	"storj.io/storj/storagenode/storagenodedb"
@@ -152,7 +153,7 @@ func cmdForgetSatellite(ctx context.Context, log *zap.Logger, cfg *forgetSatelli
 
 		hasUntrusted := false
 		for _, satellite := range sats {
-			if satellite.Status != satellites.Untrusted {
+			if satellite.Status != satellites.Untrusted && satellite.Status != satellites.Forgetting {
 				continue
 			}
 			hasUntrusted = true
@@ -187,7 +188,7 @@ func cmdForgetSatellite(ctx context.Context, log *zap.Logger, cfg *forgetSatelli
 }
 
 func cleanupSatellite(ctx context.Context, log *zap.Logger, cfg *forgetSatelliteCfg, db *storagenodedb.DB, satellite satellites.Satellite) error {
-	if satellite.Status != satellites.Untrusted && !cfg.Force {
+	if satellite.Status != satellites.Untrusted && satellite.Status != satellites.Forgetting && !cfg.Force {
 		log.Error("Satellite is not untrusted. Skipping", zap.Stringer("satelliteID", satellite.SatelliteID))
 		return nil
 	}
@@ -206,38 +207,7 @@ func cleanupSatellite(ctx context.Context, log *zap.Logger, cfg *forgetSatellite
 		log.Info("Satellite removed from trust cache.", zap.Stringer("satelliteID", satellite.SatelliteID))
 	}
 
-	log.Info("Cleaning up satellite data.", zap.Stringer("satelliteID", satellite.SatelliteID))
 	blobs := pieces.NewBlobsUsageCache(log.Named("blobscache"), db.Pieces())
-	if err := blobs.DeleteNamespace(ctx, satellite.SatelliteID.Bytes()); err != nil {
-		return err
-	}
-
-	log.Info("Cleaning up the trash.", zap.Stringer("satelliteID", satellite.SatelliteID))
-	err = blobs.DeleteTrashNamespace(ctx, satellite.SatelliteID.Bytes())
-	if err != nil {
-		return err
-	}
-
-	log.Info("Removing satellite info from reputation DB.", zap.Stringer("satelliteID", satellite.SatelliteID))
-	err = db.Reputation().Delete(ctx, satellite.SatelliteID)
-	if err != nil {
-		return err
-	}
-
-	// delete v0 pieces for the satellite, if any.
-	log.Info("Removing satellite v0 pieces if any.", zap.Stringer("satelliteID", satellite.SatelliteID))
-	err = db.V0PieceInfo().WalkSatelliteV0Pieces(ctx, db.Pieces(), satellite.SatelliteID, func(access pieces.StoredPieceAccess) error {
-		return db.Pieces().Delete(ctx, access.BlobRef())
-	})
-	if err != nil {
-		return err
-	}
-
-	log.Info("Removing satellite from satellites DB.", zap.Stringer("satelliteID", satellite.SatelliteID))
-	err = db.Satellites().DeleteSatellite(ctx, satellite.SatelliteID)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	cleaner := forgetsatellite.NewCleaner(log, blobs, db.V0PieceInfo(), db.Reputation(), db.Satellites())
+	return cleaner.Cleanup(ctx, satellite.SatelliteID)
 }
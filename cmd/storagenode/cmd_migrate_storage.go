@@ -0,0 +1,111 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/storj/shared/cfgstruct"
+	"storj.io/storj/shared/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/iopriority"
+	"storj.io/storj/storagenode/storagemigration"
+)
+
+// migrateStorageCfg defines configuration for the migrate-storage command.
+type migrateStorageCfg struct {
+	storagenode.Config
+
+	To     string `help:"the new directory where the data is moved to" default:""`
+	Passes int    `help:"maximum number of passes copying the changes while the storagenode runs" default:"3"`
+}
+
+func newMigrateStorageCmd(f *Factory) *cobra.Command {
+	var cfg migrateStorageCfg
+	cmd := &cobra.Command{
+		Use:   "migrate-storage",
+		Short: "Move the storagenode data to a new directory, e.g. a new disk",
+		Long: "Move the storagenode data to a new directory, e.g. a new disk, while the storagenode runs.\n" +
+			"The pieces and the trash are copied and verified with passes, which copy the changes since the previous one. " +
+			"The command can be interrupted and run again for resuming the migration.\n" +
+			"Once it finishes, the storagenode has to be restarted: the last changes and the databases are copied " +
+			"and the configuration is switched to the new directory. The data in the old directory is left untouched.",
+		Example: `
+$ storagenode migrate-storage --to /mnt/new-disk/storage --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.To == "" {
+				return errs.New("must specify the new directory with --to")
+			}
+
+			ctx, _ := process.Ctx(cmd)
+			return cmdMigrateStorage(ctx, zap.L().Named("storage-migration"), cmd.OutOrStdout(), f.ConfDir, &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdMigrateStorage(ctx context.Context, log *zap.Logger, w io.Writer, confDir string, cfg *migrateStorageCfg) (err error) {
+	// the copy competes with the storagenode for the disk.
+	if err := iopriority.SetLowIOPriority(); err != nil {
+		log.Warn("Failed to lower the IO priority.", zap.Error(err))
+	}
+
+	migration, err := storagemigration.New(log, storagemigration.Config{
+		From:   cfg.Storage.Path,
+		To:     cfg.To,
+		Passes: cfg.Passes,
+	}, filepath.Join(confDir, storagemigration.StateFilename))
+	if err != nil {
+		return err
+	}
+
+	state, err := migration.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(w, "copied: %d files, %s\n", state.Stats.Copied, memory.Size(state.Stats.BytesCopied))
+	_, _ = fmt.Fprintf(w, "deleted: %d files\n", state.Stats.Deleted)
+	_, _ = fmt.Fprintf(w, "verified: %d pieces\n", state.Stats.Verified)
+	if state.Stats.Corrupted > 0 {
+		_, _ = fmt.Fprintf(w, "corrupted: %d pieces, already corrupted in %s\n", state.Stats.Corrupted, state.From)
+	}
+	_, _ = fmt.Fprintf(w, "\nRestart the storagenode for finishing the migration to %s.\n", state.To)
+
+	return nil
+}
+
+// finishStorageMigration finishes the migration of the data to a new storage
+// directory, when there is one ready to be finished, and switches the
+// configuration to the new directory.
+func finishStorageMigration(ctx context.Context, log *zap.Logger, cmd *cobra.Command, confDir string, cfg *runCfg) error {
+	to, err := storagemigration.Finish(ctx, log, filepath.Join(confDir, storagemigration.StateFilename), cfg.Storage.Path)
+	if err != nil || to == "" {
+		return err
+	}
+
+	err = process.SaveConfig(cmd, filepath.Join(confDir, "config.yaml"), process.SaveConfigWithOverride("storage.path", to))
+	if err != nil {
+		return err
+	}
+
+	log.Info("Storage directory switched, the data in the old directory can be removed.",
+		zap.String("old", cfg.Storage.Path), zap.String("new", to))
+	cfg.Storage.Path = to
+
+	return nil
+}
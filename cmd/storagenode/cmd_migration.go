@@ -0,0 +1,70 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/shared/cfgstruct"
+	"storj.io/storj/shared/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+// migrationCfg defines configuration for migration command.
+type migrationCfg struct {
+	storagenode.Config
+
+	DryRun        bool `help:"print the SQL statements of the migration without running them" default:"false"`
+	TargetVersion int  `help:"migrate or roll back the databases to the specified version instead of the latest one" default:"-1"`
+}
+
+func newMigrationCmd(f *Factory) *cobra.Command {
+	var cfg migrationCfg
+	cmd := &cobra.Command{
+		Use:   "migration",
+		Short: "Run the storagenode database migration",
+		Long: "Migrate the storagenode databases to the latest or the specified version.\n" +
+			"Migrating to an older version rolls back the newer migration steps, " +
+			"which is only possible when all of them can be reverted.",
+		Example: `
+# Print the SQL statements, which would migrate the databases to the latest version
+$ storagenode migration --dry-run --config-dir /path/to/configDir
+
+# Roll back the databases to version 53
+$ storagenode migration --target-version 53 --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return cmdMigration(ctx, zap.L(), cmd.OutOrStdout(), &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdMigration(ctx context.Context, log *zap.Logger, w io.Writer, cfg *migrationCfg) (err error) {
+	db, err := storagenodedb.OpenExisting(ctx, log.Named("db"), cfg.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	switch {
+	case cfg.DryRun:
+		return db.DryRunMigration(ctx, w, cfg.TargetVersion)
+	case cfg.TargetVersion >= 0:
+		return db.MigrateToVersion(ctx, cfg.TargetVersion)
+	default:
+		return db.MigrateToLatest(ctx)
+	}
+}
@@ -29,7 +29,7 @@ func newRunCmd(f *Factory) *cobra.Command {
 		Use:   "run",
 		Short: "Run the storagenode",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return cmdRun(cmd, &runCfg)
+			return cmdRun(cmd, f.ConfDir, &runCfg)
 		},
 	}
 
@@ -38,7 +38,7 @@ func newRunCmd(f *Factory) *cobra.Command {
 	return cmd
 }
 
-func cmdRun(cmd *cobra.Command, cfg *runCfg) (err error) {
+func cmdRun(cmd *cobra.Command, confDir string, cfg *runCfg) (err error) {
 	// inert constructors only ====
 
 	ctx, _ := process.Ctx(cmd)
@@ -57,6 +57,16 @@ func cmdRun(cmd *cobra.Command, cfg *runCfg) (err error) {
 		return err
 	}
 
+	if err := finishStorageMigration(ctx, log.Named("storage-migration"), cmd, confDir, cfg); err != nil {
+		return errs.New("Error finishing the storage migration: %+v", err)
+	}
+
+	if cfg.Preflight.DatabaseRepair {
+		if _, _, err := repairDatabases(ctx, log, cfg.DatabaseConfig()); err != nil {
+			return errs.New("Error repairing storagenode databases: %+v", err)
+		}
+	}
+
 	db, err := storagenodedb.OpenExisting(ctx, log.Named("db"), cfg.DatabaseConfig())
 	if err != nil {
 		return errs.New("Error starting master database on storagenode: %+v", err)
@@ -0,0 +1,205 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/shared/cfgstruct"
+	"storj.io/storj/shared/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/iopriority"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+// verifyPiecesCfg defines configuration for the verify-pieces command.
+type verifyPiecesCfg struct {
+	storagenode.Config
+
+	Satellite      string      `help:"ID of the satellite whose pieces are verified, all of them when empty" default:""`
+	Sample         string      `help:"percentage of the pieces which are verified" default:"100%"`
+	Output         string      `help:"path of the CSV file with the pieces which failed the verification, stdout when empty" default:""`
+	BytesPerSecond memory.Size `help:"maximum read throughput, 0 means unlimited" default:"0"`
+}
+
+func newVerifyPiecesCmd(f *Factory) *cobra.Command {
+	var cfg verifyPiecesCfg
+	cmd := &cobra.Command{
+		Use:   "verify-pieces",
+		Short: "Verify the content and the metadata of the stored pieces",
+		Long: "Verify the content of the stored pieces against the hash in their piece header and " +
+			"check that their expiration is consistent with the expiration database.\n" +
+			"The command prints a summary and a CSV of the corrupted, missing and unreadable pieces, " +
+			"and the pieces with an inconsistent expiration. It fails when any piece fails the verification.",
+		Example: `
+# Verify all the pieces
+$ storagenode verify-pieces --config-dir /path/to/configDir
+
+# Verify 10% of the pieces of a satellite and write the failures into a file
+$ storagenode verify-pieces --satellite satellite_ID --sample 10% --output failures.csv --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return cmdVerifyPieces(ctx, zap.L(), cmd.OutOrStdout(), &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdVerifyPieces(ctx context.Context, log *zap.Logger, w io.Writer, cfg *verifyPiecesCfg) (err error) {
+	sample, err := parseSample(cfg.Sample)
+	if err != nil {
+		return err
+	}
+
+	var satelliteIDs []storj.NodeID
+	if cfg.Satellite != "" {
+		satelliteID, err := storj.NodeIDFromString(cfg.Satellite)
+		if err != nil {
+			return errs.New("invalid satellite ID %q: %+v", cfg.Satellite, err)
+		}
+		satelliteIDs = append(satelliteIDs, satelliteID)
+	}
+
+	// the verification competes with the storagenode for the disk.
+	if err := iopriority.SetLowIOPriority(); err != nil {
+		log.Warn("Failed to lower the IO priority.", zap.Error(err))
+	}
+
+	db, err := storagenodedb.OpenExisting(ctx, log.Named("db"), cfg.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	if len(satelliteIDs) == 0 {
+		namespaces, err := db.Pieces().ListNamespaces(ctx)
+		if err != nil {
+			return err
+		}
+		for _, namespace := range namespaces {
+			satelliteID, err := storj.NodeIDFromBytes(namespace)
+			if err != nil {
+				return err
+			}
+			satelliteIDs = append(satelliteIDs, satelliteID)
+		}
+	}
+
+	failuresOutput := w
+	if cfg.Output != "" {
+		file, err := os.Create(cfg.Output)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, file.Close()) }()
+		failuresOutput = file
+	}
+
+	// the failures are written after the summary when they are written to stdout.
+	var failures []pieces.VerifyFailure
+	writeFailure := func(failure pieces.VerifyFailure) error {
+		failures = append(failures, failure)
+		return nil
+	}
+	failuresCSV := csv.NewWriter(failuresOutput)
+	if cfg.Output != "" {
+		if err := failuresCSV.Write(verifyFailureHeader); err != nil {
+			return err
+		}
+		writeFailure = func(failure pieces.VerifyFailure) error {
+			return failuresCSV.Write(verifyFailureRecord(failure))
+		}
+	}
+
+	store := pieces.NewStore(log.Named("pieces"),
+		pieces.NewFileWalker(log.Named("filewalker"), db.Pieces(), db.V0PieceInfo()),
+		nil,
+		db.Pieces(),
+		db.V0PieceInfo(),
+		db.PieceExpirationDB(),
+		nil,
+		cfg.Pieces,
+	)
+
+	var total pieces.VerifyStats
+	for _, satelliteID := range satelliteIDs {
+		log.Info("Verifying pieces.", zap.Stringer("Satellite ID", satelliteID), zap.Float64("Sample", sample))
+
+		stats, err := store.VerifySatellitePieces(ctx, satelliteID, pieces.VerifyOptions{
+			Sample:         sample,
+			BytesPerSecond: cfg.BytesPerSecond,
+		}, writeFailure)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(w, "%s: verified %d, corrupted %d, missing %d, unreadable %d, expiration mismatch %d, read %s\n",
+			satelliteID, stats.Verified, stats.Corrupted, stats.Missing, stats.Unreadable, stats.ExpirationMismatch, memory.Size(stats.BytesRead))
+
+		total.Verified += stats.Verified
+		total.Corrupted += stats.Corrupted
+		total.Missing += stats.Missing
+		total.Unreadable += stats.Unreadable
+		total.ExpirationMismatch += stats.ExpirationMismatch
+		total.BytesRead += stats.BytesRead
+	}
+
+	_, _ = fmt.Fprintf(w, "total: verified %d, corrupted %d, missing %d, unreadable %d, expiration mismatch %d, read %s\n",
+		total.Verified, total.Corrupted, total.Missing, total.Unreadable, total.ExpirationMismatch, memory.Size(total.BytesRead))
+
+	if cfg.Output == "" && len(failures) > 0 {
+		_, _ = fmt.Fprintln(w)
+		if err := failuresCSV.Write(verifyFailureHeader); err != nil {
+			return err
+		}
+		for _, failure := range failures {
+			if err := failuresCSV.Write(verifyFailureRecord(failure)); err != nil {
+				return err
+			}
+		}
+	}
+
+	failuresCSV.Flush()
+	if err := failuresCSV.Error(); err != nil {
+		return err
+	}
+
+	if total.Failures() > 0 {
+		return errs.New("%d pieces failed the verification", total.Failures())
+	}
+	return nil
+}
+
+var verifyFailureHeader = []string{"satellite_id", "piece_id", "problem", "details"}
+
+func verifyFailureRecord(failure pieces.VerifyFailure) []string {
+	return []string{failure.Satellite.String(), failure.PieceID.String(), string(failure.Problem), failure.Details}
+}
+
+// parseSample parses a percentage, e.g. "10%", and returns it as a fraction.
+func parseSample(value string) (float64, error) {
+	percentage, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(value), "%"), 64)
+	if err != nil || percentage <= 0 || percentage > 100 {
+		return 0, errs.New("invalid sample %q, it must be a percentage between 0%% and 100%%", value)
+	}
+	return percentage / 100, nil
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSample(t *testing.T) {
+	for _, tt := range []struct {
+		value    string
+		expected float64
+	}{
+		{"100%", 1},
+		{"10%", 0.1},
+		{"0.5%", 0.005},
+		{"25", 0.25},
+	} {
+		sample, err := parseSample(tt.value)
+		require.NoError(t, err, tt.value)
+		require.InDelta(t, tt.expected, sample, 1e-9, tt.value)
+	}
+
+	for _, value := range []string{"", "0%", "-10%", "101%", "ten"} {
+		_, err := parseSample(value)
+		require.Error(t, err, value)
+	}
+}
@@ -34,6 +34,8 @@ func (config *FilewalkerCfg) DatabaseConfig() storagenodedb.Config {
 		Pieces:    config.Pieces,
 		Filestore: config.Filestore,
 		Driver:    config.Driver,
+
+		Consolidated: config.Consolidated,
 	}
 }
 
@@ -60,6 +60,10 @@ func newRootCmd(setDefaults bool) (*cobra.Command, *Factory) {
 		newGracefulExitInitCmd(factory),
 		newGracefulExitStatusCmd(factory),
 		newForgetSatelliteCmd(factory),
+		newMigrationCmd(factory),
+		newDBCmd(factory),
+		newMigrateStorageCmd(factory),
+		newVerifyPiecesCmd(factory),
 		// internal hidden commands
 		internalcmd.NewUsedSpaceFilewalkerCmd().Command,
 		internalcmd.NewGCFilewalkerCmd().Command,
@@ -0,0 +1,480 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// Chaos actions.
+const (
+	chaosStop    = "stop"
+	chaosStart   = "start"
+	chaosRestart = "restart"
+	chaosPause   = "pause"
+	chaosSlow    = "slow"
+	chaosCorrupt = "corrupt"
+)
+
+// ChaosScenario describes the failures injected into the storage nodes of the network.
+type ChaosScenario struct {
+	// Seed initializes the random node and piece selection. Zero uses the current time.
+	Seed   int64        `yaml:"seed"`
+	Events []ChaosEvent `yaml:"events"`
+}
+
+// ChaosEvent is a single failure injected at the given time after the network started.
+//
+// Actions:
+//
+//	stop:    kills the storage nodes.
+//	start:   starts the stopped storage nodes.
+//	restart: kills the storage nodes and starts them again after duration.
+//	pause:   freezes the storage nodes for duration, they keep their connections but don't respond.
+//	slow:    repeatedly freezes the storage nodes for latency in every interval during duration,
+//	         which adds latency and caps their bandwidth.
+//	corrupt: flips bytes in the content of random pieces of the storage nodes.
+type ChaosEvent struct {
+	At     time.Duration `yaml:"at"`
+	Action string        `yaml:"action"`
+
+	// Nodes are the indexes of the affected storage nodes.
+	Nodes []int `yaml:"nodes"`
+	// Random selects the given number of random storage nodes in addition to Nodes.
+	Random int `yaml:"random"`
+
+	Duration time.Duration `yaml:"duration"`
+	Latency  time.Duration `yaml:"latency"`
+	Interval time.Duration `yaml:"interval"`
+	Pieces   int           `yaml:"pieces"`
+}
+
+// LoadChaosScenario loads the scenario from a YAML file.
+func LoadChaosScenario(path string) (*ChaosScenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var scenario ChaosScenario
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&scenario); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid scenario %q: %w", path, err)
+	}
+	return &scenario, nil
+}
+
+// Verify checks whether the scenario can be executed on the given number of storage nodes.
+func (scenario *ChaosScenario) Verify(storageNodeCount int) error {
+	var group errs.Group
+	for i, event := range scenario.Events {
+		eventError := func(format string, args ...interface{}) {
+			group.Add(fmt.Errorf("event %d (%s at %s): %s", i, event.Action, event.At, fmt.Sprintf(format, args...)))
+		}
+
+		switch event.Action {
+		case chaosStop, chaosStart:
+		case chaosRestart, chaosPause:
+			if event.Duration <= 0 {
+				eventError("duration is required")
+			}
+		case chaosSlow:
+			if event.Duration <= 0 || event.Interval <= 0 || event.Latency <= 0 {
+				eventError("duration, interval and latency are required")
+			}
+			if event.Latency >= event.Interval {
+				eventError("latency must be less than the interval")
+			}
+		case chaosCorrupt:
+			if event.Pieces <= 0 {
+				eventError("pieces is required")
+			}
+		default:
+			eventError("unknown action")
+		}
+
+		if event.At < 0 {
+			eventError("negative time")
+		}
+		if len(event.Nodes) == 0 && event.Random <= 0 {
+			eventError("no nodes selected")
+		}
+		if len(event.Nodes)+event.Random > storageNodeCount {
+			eventError("selects more than %d storage nodes", storageNodeCount)
+		}
+		for _, index := range event.Nodes {
+			if index < 0 || index >= storageNodeCount {
+				eventError("invalid storage node index %d", index)
+			}
+		}
+	}
+	return group.Err()
+}
+
+// selectNodes returns the indexes of the nodes affected by the event.
+func (event ChaosEvent) selectNodes(rng *rand.Rand, storageNodeCount int) []int {
+	selected := map[int]bool{}
+	for _, index := range event.Nodes {
+		selected[index] = true
+	}
+
+	var candidates []int
+	for index := 0; index < storageNodeCount; index++ {
+		if !selected[index] {
+			candidates = append(candidates, index)
+		}
+	}
+	rng.Shuffle(len(candidates), func(i, k int) { candidates[i], candidates[k] = candidates[k], candidates[i] })
+	for i := 0; i < event.Random && i < len(candidates); i++ {
+		selected[candidates[i]] = true
+	}
+
+	indexes := make([]int, 0, len(selected))
+	for index := range selected {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+func networkChaos(flags *Flags, scenarioPath string) (err error) {
+	scenario, err := LoadChaosScenario(scenarioPath)
+	if err != nil {
+		return err
+	}
+	if err := scenario.Verify(flags.StorageNodeCount); err != nil {
+		return err
+	}
+
+	processes, err := newNetwork(flags)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = processes.Output.Flush() }()
+
+	timeline, err := newChaosTimeline(filepath.Join(processes.Directory, "chaos-timeline.log"), processes.Output.Prefixed("chaos"))
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, timeline.Close()) }()
+
+	ctx, cancel := NewCLIContext(context.Background())
+	defer cancel()
+
+	var group *errgroup.Group
+	if processes.FailFast {
+		group, ctx = errgroup.WithContext(ctx)
+	} else {
+		group = &errgroup.Group{}
+	}
+
+	// storage nodes are supervised separately, so that killing them doesn't stop the network.
+	var nodes []*chaosNode
+	others := &Processes{
+		Output:         processes.Output,
+		Directory:      processes.Directory,
+		FailFast:       processes.FailFast,
+		MaxStartupWait: processes.MaxStartupWait,
+	}
+	for _, process := range processes.List {
+		if process.Executable == "storagenode" {
+			nodes = append(nodes, &chaosNode{process: process, timeline: timeline})
+			continue
+		}
+		others.List = append(others.List, process)
+	}
+
+	others.Start(ctx, group, "run")
+	for _, node := range nodes {
+		node.start(ctx)
+	}
+	defer func() {
+		for _, node := range nodes {
+			node.stop()
+		}
+	}()
+
+	for _, node := range nodes {
+		node.process.Status.Started.Wait(ctx)
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("network canceled: %w", group.Wait())
+	}
+
+	seed := scenario.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	timeline.Log("scenario", "", fmt.Sprintf("path=%s seed=%d events=%d", scenarioPath, seed, len(scenario.Events)))
+
+	group.Go(func() error {
+		return runChaosScenario(ctx, scenario, rand.New(rand.NewSource(seed)), nodes, timeline, time.Now())
+	})
+
+	err = group.Wait()
+	return errs.Combine(err, processes.Close())
+}
+
+// runChaosScenario executes the events of the scenario at their scheduled time after start.
+func runChaosScenario(ctx context.Context, scenario *ChaosScenario, rng *rand.Rand, nodes []*chaosNode, timeline *chaosTimeline, start time.Time) error {
+	events := append([]ChaosEvent{}, scenario.Events...)
+	sort.SliceStable(events, func(i, k int) bool { return events[i].At < events[k].At })
+
+	var active sync.WaitGroup
+	defer active.Wait()
+
+	for _, event := range events {
+		if !sync2.Sleep(ctx, time.Until(start.Add(event.At))) {
+			return nil
+		}
+
+		for _, index := range event.selectNodes(rng, len(nodes)) {
+			node := nodes[index]
+			event := event
+
+			switch event.Action {
+			case chaosStop:
+				node.stop()
+			case chaosStart:
+				node.start(ctx)
+			case chaosCorrupt:
+				corrupted, err := corruptPieces(rng, node.storageDir(), event.Pieces)
+				for _, path := range corrupted {
+					timeline.Log("corrupt", node.process.Name, path)
+				}
+				if err != nil {
+					timeline.Log("error", node.process.Name, err.Error())
+				}
+			default:
+				// actions lasting for a duration run in the background.
+				active.Add(1)
+				go func() {
+					defer active.Done()
+					node.run(ctx, event)
+				}()
+			}
+		}
+	}
+
+	timeline.Log("scenario", "", "all events scheduled")
+	return nil
+}
+
+// chaosNode supervises a storage node, which is stopped and started by the chaos scenario.
+type chaosNode struct {
+	process  *Process
+	timeline *chaosTimeline
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// start starts the storage node, if it's not running.
+func (node *chaosNode) start(ctx context.Context) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	node.cancel, node.done = cancel, done
+
+	node.timeline.Log("start", node.process.Name, node.process.Address)
+	go func() {
+		defer close(done)
+		err := node.process.Exec(ctx, "run")
+		if err != nil && ctx.Err() == nil {
+			node.timeline.Log("exited", node.process.Name, err.Error())
+		}
+	}()
+}
+
+// stop kills the storage node and waits until it exits.
+func (node *chaosNode) stop() {
+	node.mu.Lock()
+	cancel, done := node.cancel, node.done
+	node.cancel, node.done = nil, nil
+	node.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	node.timeline.Log("stop", node.process.Name, node.process.Address)
+	cancel()
+	<-done
+}
+
+// run executes the actions, which last for the duration of the event.
+func (node *chaosNode) run(ctx context.Context, event ChaosEvent) {
+	switch event.Action {
+	case chaosRestart:
+		node.stop()
+		if sync2.Sleep(ctx, event.Duration) {
+			node.start(ctx)
+		}
+
+	case chaosPause:
+		node.timeline.Log("pause", node.process.Name, "duration="+event.Duration.String())
+		node.freeze(ctx, event.Duration)
+		node.timeline.Log("resume", node.process.Name, "")
+
+	case chaosSlow:
+		node.timeline.Log("slow", node.process.Name, fmt.Sprintf("duration=%s latency=%s interval=%s", event.Duration, event.Latency, event.Interval))
+		end := time.Now().Add(event.Duration)
+		for time.Now().Before(end) && ctx.Err() == nil {
+			node.freeze(ctx, event.Latency)
+			if !sync2.Sleep(ctx, event.Interval-event.Latency) {
+				break
+			}
+		}
+		node.timeline.Log("fast", node.process.Name, "")
+	}
+}
+
+// freeze suspends the storage node process for the given duration.
+func (node *chaosNode) freeze(ctx context.Context, duration time.Duration) {
+	if err := node.process.Signal(pauseSignal); err != nil {
+		node.timeline.Log("error", node.process.Name, err.Error())
+		return
+	}
+	sync2.Sleep(ctx, duration)
+	if err := node.process.Signal(resumeSignal); err != nil {
+		node.timeline.Log("error", node.process.Name, err.Error())
+	}
+}
+
+// storageDir returns the directory where the storage node keeps the pieces.
+func (node *chaosNode) storageDir() string {
+	dir := filepath.Join(node.process.Directory, "storage")
+	if err := readConfigString(&dir, node.process.Directory, "storage.path"); err != nil {
+		node.timeline.Log("error", node.process.Name, err.Error())
+	}
+	return dir
+}
+
+// corruptPieces flips a byte in the content of count random piece files in the storage directory.
+func corruptPieces(rng *rand.Rand, storageDir string, count int) (corrupted []string, err error) {
+	var pieces []string
+	err = filepath.WalkDir(filepath.Join(storageDir, "blobs"), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() {
+			pieces = append(pieces, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(pieces)
+
+	rng.Shuffle(len(pieces), func(i, k int) { pieces[i], pieces[k] = pieces[k], pieces[i] })
+	for _, path := range pieces {
+		if len(corrupted) >= count {
+			break
+		}
+
+		ok, err := corruptFile(rng, path)
+		if err != nil {
+			return corrupted, err
+		}
+		if ok {
+			corrupted = append(corrupted, path)
+		}
+	}
+	return corrupted, nil
+}
+
+// corruptFile flips a random byte after the piece header. It returns false, when the file has no content.
+func corruptFile(rng *rand.Rand, path string) (_ bool, err error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return false, err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+	if stat.Size() <= pieces.V1PieceHeaderReservedArea {
+		return false, nil
+	}
+
+	offset := pieces.V1PieceHeaderReservedArea + rng.Int63n(stat.Size()-pieces.V1PieceHeaderReservedArea)
+	var b [1]byte
+	if _, err := file.ReadAt(b[:], offset); err != nil {
+		return false, err
+	}
+	b[0] ^= 0xFF
+	if _, err := file.WriteAt(b[:], offset); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// chaosTimeline logs the injected failures with their time, so they can be
+// correlated with the metrics of the satellite.
+type chaosTimeline struct {
+	start time.Time
+
+	mu     sync.Mutex
+	file   *os.File
+	output io.Writer
+}
+
+func newChaosTimeline(path string, output io.Writer) (*chaosTimeline, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &chaosTimeline{
+		start:  time.Now(),
+		file:   file,
+		output: output,
+	}, nil
+}
+
+// Log writes an entry to the timeline.
+func (timeline *chaosTimeline) Log(action, node, details string) {
+	now := time.Now()
+	line := fmt.Sprintf("%s +%s %s %s %s\n",
+		now.UTC().Format(time.RFC3339Nano), now.Sub(timeline.start).Truncate(time.Millisecond), action, node, details)
+
+	timeline.mu.Lock()
+	defer timeline.mu.Unlock()
+	_, _ = io.WriteString(timeline.file, line)
+	_, _ = io.WriteString(timeline.output, line)
+}
+
+// Close closes the timeline file.
+func (timeline *chaosTimeline) Close() error {
+	timeline.mu.Lock()
+	defer timeline.mu.Unlock()
+	return timeline.file.Close()
+}
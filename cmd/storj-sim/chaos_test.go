@@ -0,0 +1,108 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/storagenode/pieces"
+)
+
+func TestChaosScenario(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "scenario.yaml")
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+seed: 5
+events:
+  - at: 1m
+    action: stop
+    nodes: [0, 1]
+  - at: 2m
+    action: slow
+    random: 2
+    duration: 1m
+    latency: 200ms
+    interval: 1s
+  - at: 3m
+    action: corrupt
+    nodes: [3]
+    pieces: 10
+`), 0644))
+
+	scenario, err := LoadChaosScenario(path)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, scenario.Seed)
+	require.Len(t, scenario.Events, 3)
+	require.Equal(t, ChaosEvent{
+		At:       2 * time.Minute,
+		Action:   chaosSlow,
+		Random:   2,
+		Duration: time.Minute,
+		Latency:  200 * time.Millisecond,
+		Interval: time.Second,
+	}, scenario.Events[1])
+
+	require.NoError(t, scenario.Verify(4))
+	require.Error(t, scenario.Verify(3))
+
+	for _, invalid := range []ChaosEvent{
+		{Action: "explode", Nodes: []int{0}},
+		{Action: chaosStop},
+		{Action: chaosPause, Nodes: []int{0}},
+		{Action: chaosSlow, Nodes: []int{0}, Duration: time.Minute, Latency: time.Second, Interval: time.Second},
+		{Action: chaosCorrupt, Nodes: []int{0}},
+		{Action: chaosStart, Nodes: []int{-1}},
+	} {
+		require.Error(t, (&ChaosScenario{Events: []ChaosEvent{invalid}}).Verify(4), invalid.Action)
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte("events:\n  - at: 1m\n    unknown: 1\n"), 0644))
+	_, err = LoadChaosScenario(path)
+	require.Error(t, err)
+}
+
+func TestChaosSelectNodes(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	require.Equal(t, []int{1, 3}, ChaosEvent{Nodes: []int{3, 1}}.selectNodes(rng, 5))
+
+	selected := ChaosEvent{Nodes: []int{2}, Random: 2}.selectNodes(rng, 5)
+	require.Len(t, selected, 3)
+	require.Contains(t, selected, 2)
+
+	require.Equal(t, []int{0, 1, 2}, ChaosEvent{Random: 10}.selectNodes(rng, 3))
+}
+
+func TestCorruptPieces(t *testing.T) {
+	storageDir := t.TempDir()
+	blobsDir := filepath.Join(storageDir, "blobs", "satellite", "aa")
+	require.NoError(t, os.MkdirAll(blobsDir, 0755))
+
+	content := bytes.Repeat([]byte{1}, pieces.V1PieceHeaderReservedArea+100)
+	for _, name := range []string{"a.sj1", "b.sj1", "c.sj1"} {
+		require.NoError(t, os.WriteFile(filepath.Join(blobsDir, name), content, 0644))
+	}
+	// pieces without content are skipped.
+	require.NoError(t, os.WriteFile(filepath.Join(blobsDir, "empty.sj1"), content[:pieces.V1PieceHeaderReservedArea], 0644))
+
+	corrupted, err := corruptPieces(rand.New(rand.NewSource(1)), storageDir, 5)
+	require.NoError(t, err)
+	require.Len(t, corrupted, 3)
+
+	for _, path := range corrupted {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Len(t, data, len(content))
+		require.Equal(t, content[:pieces.V1PieceHeaderReservedArea], data[:pieces.V1PieceHeaderReservedArea])
+		require.NotEqual(t, content, data)
+	}
+}
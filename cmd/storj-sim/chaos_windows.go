@@ -0,0 +1,13 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import "syscall"
+
+// Windows doesn't support suspending processes with signals, sending an
+// invalid signal makes the pause and slow actions fail with an error.
+var (
+	pauseSignal  = syscall.Signal(-1)
+	resumeSignal = syscall.Signal(-1)
+)
@@ -0,0 +1,669 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
+
+	"storj.io/common/memory"
+	"storj.io/uplink"
+)
+
+// Load operations.
+const (
+	loadUpload          = "upload"
+	loadMultipartUpload = "multipart-upload"
+	loadDownload        = "download"
+	loadList            = "list"
+	loadDelete          = "delete"
+)
+
+// LoadProfile describes the workload generated against the network.
+type LoadProfile struct {
+	// Seed initializes the random object sizes and operations. Zero uses the current time.
+	Seed        int64         `yaml:"seed" json:"seed"`
+	Duration    time.Duration `yaml:"duration" json:"duration"`
+	Concurrency int           `yaml:"concurrency" json:"concurrency"`
+	Bucket      string        `yaml:"bucket" json:"bucket"`
+	// Objects is the number of objects uploaded before the measurement starts.
+	Objects int `yaml:"objects" json:"objects"`
+
+	Sizes      []LoadSize     `yaml:"sizes" json:"sizes"`
+	Operations LoadOperations `yaml:"operations" json:"operations"`
+	Multipart  LoadMultipart  `yaml:"multipart" json:"multipart"`
+}
+
+// LoadSize is an object size with the relative weight of its occurrence.
+type LoadSize struct {
+	Size   memory.Size `yaml:"size" json:"size"`
+	Weight int         `yaml:"weight" json:"weight"`
+}
+
+// LoadOperations contains the relative weights of the operations.
+type LoadOperations struct {
+	Upload   int `yaml:"upload" json:"upload"`
+	Download int `yaml:"download" json:"download"`
+	List     int `yaml:"list" json:"list"`
+	Delete   int `yaml:"delete" json:"delete"`
+}
+
+// LoadMultipart configures when objects are uploaded with multipart upload.
+type LoadMultipart struct {
+	// Threshold is the minimum object size uploaded with multipart upload. Zero disables multipart uploads.
+	Threshold memory.Size `yaml:"threshold" json:"threshold"`
+	PartSize  memory.Size `yaml:"part-size" json:"partSize"`
+}
+
+// DefaultLoadProfile returns the profile used for the values missing from the loaded profile.
+func DefaultLoadProfile() LoadProfile {
+	return LoadProfile{
+		Duration:    time.Minute,
+		Concurrency: 4,
+		Bucket:      "load",
+		Objects:     10,
+		Sizes:       []LoadSize{{Size: 4 * memory.KiB, Weight: 5}, {Size: memory.MiB, Weight: 1}},
+		Operations:  LoadOperations{Upload: 4, Download: 4, List: 1, Delete: 1},
+		Multipart:   LoadMultipart{Threshold: 0, PartSize: 5 * memory.MiB},
+	}
+}
+
+// LoadLoadProfile loads the profile from a YAML file. An empty path returns the default profile.
+func LoadLoadProfile(path string) (*LoadProfile, error) {
+	profile := DefaultLoadProfile()
+	if path == "" {
+		return &profile, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&profile); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid load profile %q: %w", path, err)
+	}
+	return &profile, nil
+}
+
+// Verify checks whether the profile is valid.
+func (profile *LoadProfile) Verify() error {
+	var group errs.Group
+	if profile.Duration <= 0 {
+		group.Add(errs.New("duration must be positive"))
+	}
+	if profile.Concurrency <= 0 {
+		group.Add(errs.New("concurrency must be positive"))
+	}
+	if profile.Bucket == "" {
+		group.Add(errs.New("bucket is required"))
+	}
+	if profile.Objects < 0 {
+		group.Add(errs.New("objects must not be negative"))
+	}
+	if len(profile.Sizes) == 0 {
+		group.Add(errs.New("at least one size is required"))
+	}
+	for _, size := range profile.Sizes {
+		if size.Size < 0 || size.Weight <= 0 {
+			group.Add(errs.New("invalid size %v with weight %d", size.Size, size.Weight))
+		}
+	}
+	operations := profile.Operations
+	if operations.Upload < 0 || operations.Download < 0 || operations.List < 0 || operations.Delete < 0 {
+		group.Add(errs.New("operation weights must not be negative"))
+	}
+	if operations.Upload+operations.Download+operations.List+operations.Delete <= 0 {
+		group.Add(errs.New("at least one operation is required"))
+	}
+	if profile.Multipart.Threshold > 0 && profile.Multipart.PartSize < 5*memory.MiB {
+		group.Add(errs.New("multipart part size must be at least 5MiB"))
+	}
+	return group.Err()
+}
+
+// randomSize returns an object size according to the weights.
+func (profile *LoadProfile) randomSize(rng *rand.Rand) memory.Size {
+	total := 0
+	for _, size := range profile.Sizes {
+		total += size.Weight
+	}
+	n := rng.Intn(total)
+	for _, size := range profile.Sizes {
+		if n < size.Weight {
+			return size.Size
+		}
+		n -= size.Weight
+	}
+	return profile.Sizes[len(profile.Sizes)-1].Size
+}
+
+// randomOperation returns an operation according to the weights.
+func (profile *LoadProfile) randomOperation(rng *rand.Rand) string {
+	operations := profile.Operations
+	n := rng.Intn(operations.Upload + operations.Download + operations.List + operations.Delete)
+	switch {
+	case n < operations.Upload:
+		return loadUpload
+	case n < operations.Upload+operations.Download:
+		return loadDownload
+	case n < operations.Upload+operations.Download+operations.List:
+		return loadList
+	default:
+		return loadDelete
+	}
+}
+
+// LoadResult contains the measurements of a load run.
+type LoadResult struct {
+	Label      string                          `json:"label,omitempty"`
+	Start      time.Time                       `json:"start"`
+	Elapsed    float64                         `json:"elapsedSeconds"`
+	Profile    LoadProfile                     `json:"profile"`
+	Operations map[string]*LoadOperationResult `json:"operations"`
+}
+
+// LoadOperationResult contains the measurements of a single operation.
+type LoadOperationResult struct {
+	Count          int         `json:"count"`
+	Errors         int         `json:"errors"`
+	Bytes          int64       `json:"bytes"`
+	OpsPerSecond   float64     `json:"opsPerSecond"`
+	BytesPerSecond float64     `json:"bytesPerSecond"`
+	Latency        LoadLatency `json:"latencyMilliseconds"`
+
+	durations []time.Duration
+}
+
+// LoadLatency contains the latency percentiles of successful operations in milliseconds.
+type LoadLatency struct {
+	Min  float64 `json:"min"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+// loadRecorder collects the measurements of the workers.
+type loadRecorder struct {
+	mu         sync.Mutex
+	operations map[string]*LoadOperationResult
+}
+
+func newLoadRecorder() *loadRecorder {
+	return &loadRecorder{operations: map[string]*LoadOperationResult{}}
+}
+
+// Record adds a single measurement.
+func (recorder *loadRecorder) Record(operation string, duration time.Duration, bytes int64, err error) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	result, ok := recorder.operations[operation]
+	if !ok {
+		result = &LoadOperationResult{}
+		recorder.operations[operation] = result
+	}
+
+	if err != nil {
+		result.Errors++
+		return
+	}
+	result.Count++
+	result.Bytes += bytes
+	result.durations = append(result.durations, duration)
+}
+
+// Summarize calculates the throughput and latency percentiles.
+func (recorder *loadRecorder) Summarize(elapsed time.Duration) map[string]*LoadOperationResult {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	for _, result := range recorder.operations {
+		result.OpsPerSecond = float64(result.Count) / elapsed.Seconds()
+		result.BytesPerSecond = float64(result.Bytes) / elapsed.Seconds()
+		result.Latency = summarizeLatency(result.durations)
+	}
+	return recorder.operations
+}
+
+func summarizeLatency(durations []time.Duration) LoadLatency {
+	if len(durations) == 0 {
+		return LoadLatency{}
+	}
+
+	sorted := append([]time.Duration{}, durations...)
+	sort.Slice(sorted, func(i, k int) bool { return sorted[i] < sorted[k] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+
+	percentile := func(p float64) float64 {
+		index := int(math.Ceil(p*float64(len(sorted)))) - 1
+		if index < 0 {
+			index = 0
+		}
+		return milliseconds(sorted[index])
+	}
+
+	return LoadLatency{
+		Min:  milliseconds(sorted[0]),
+		Mean: milliseconds(total / time.Duration(len(sorted))),
+		P50:  percentile(0.50),
+		P90:  percentile(0.90),
+		P99:  percentile(0.99),
+		Max:  milliseconds(sorted[len(sorted)-1]),
+	}
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// loadObjects tracks the objects uploaded by the workers.
+type loadObjects struct {
+	mu   sync.Mutex
+	next int
+	keys []string
+}
+
+func (objects *loadObjects) newKey(prefix string) string {
+	objects.mu.Lock()
+	defer objects.mu.Unlock()
+	objects.next++
+	return prefix + strconv.Itoa(objects.next)
+}
+
+func (objects *loadObjects) add(key string) {
+	objects.mu.Lock()
+	defer objects.mu.Unlock()
+	objects.keys = append(objects.keys, key)
+}
+
+func (objects *loadObjects) random(rng *rand.Rand) (string, bool) {
+	objects.mu.Lock()
+	defer objects.mu.Unlock()
+	if len(objects.keys) == 0 {
+		return "", false
+	}
+	return objects.keys[rng.Intn(len(objects.keys))], true
+}
+
+func (objects *loadObjects) remove(rng *rand.Rand) (string, bool) {
+	objects.mu.Lock()
+	defer objects.mu.Unlock()
+	if len(objects.keys) == 0 {
+		return "", false
+	}
+	i := rng.Intn(len(objects.keys))
+	key := objects.keys[i]
+	objects.keys[i] = objects.keys[len(objects.keys)-1]
+	objects.keys = objects.keys[:len(objects.keys)-1]
+	return key, true
+}
+
+// loadGenerator runs the workload of a profile against a project.
+type loadGenerator struct {
+	profile  *LoadProfile
+	project  *uplink.Project
+	prefix   string
+	objects  loadObjects
+	recorder *loadRecorder
+	data     []byte
+}
+
+func newLoadGenerator(profile *LoadProfile, project *uplink.Project) *loadGenerator {
+	data := make([]byte, memory.MiB.Int())
+	_, _ = rand.New(rand.NewSource(profile.Seed)).Read(data)
+
+	return &loadGenerator{
+		profile:  profile,
+		project:  project,
+		prefix:   fmt.Sprintf("load-%d/", profile.Seed),
+		recorder: newLoadRecorder(),
+		data:     data,
+	}
+}
+
+// Run uploads the initial objects and then runs the workers for the profile duration.
+func (generator *loadGenerator) Run(ctx context.Context, label string) (*LoadResult, error) {
+	profile := generator.profile
+	seed := profile.Seed
+
+	if _, err := generator.project.EnsureBucket(ctx, profile.Bucket); err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	for i := 0; i < profile.Objects; i++ {
+		if _, _, err := generator.upload(ctx, rng); err != nil {
+			return nil, fmt.Errorf("failed to upload initial objects: %w", err)
+		}
+	}
+
+	start := time.Now()
+	workerCtx, cancel := context.WithTimeout(ctx, profile.Duration)
+	defer cancel()
+
+	var group errgroup.Group
+	for i := 0; i < profile.Concurrency; i++ {
+		rng := rand.New(rand.NewSource(seed + int64(i) + 1))
+		group.Go(func() error {
+			generator.work(workerCtx, rng)
+			return nil
+		})
+	}
+	_ = group.Wait()
+	elapsed := time.Since(start)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &LoadResult{
+		Label:      label,
+		Start:      start,
+		Elapsed:    elapsed.Seconds(),
+		Profile:    *profile,
+		Operations: generator.recorder.Summarize(elapsed),
+	}, nil
+}
+
+// work executes random operations until the context is done.
+func (generator *loadGenerator) work(ctx context.Context, rng *rand.Rand) {
+	for ctx.Err() == nil {
+		operation := generator.profile.randomOperation(rng)
+
+		start := time.Now()
+		var bytes int64
+		var err error
+		switch operation {
+		case loadUpload:
+			operation, bytes, err = generator.upload(ctx, rng)
+		case loadDownload:
+			bytes, err = generator.download(ctx, rng)
+		case loadList:
+			err = generator.list(ctx)
+		case loadDelete:
+			err = generator.delete(ctx, rng)
+		}
+		duration := time.Since(start)
+
+		if errors.Is(err, errNoObjects) {
+			continue
+		}
+		if ctx.Err() != nil {
+			// operations interrupted by the end of the run are not measured.
+			return
+		}
+		generator.recorder.Record(operation, duration, bytes, err)
+	}
+}
+
+var errNoObjects = errs.New("no objects")
+
+func (generator *loadGenerator) upload(ctx context.Context, rng *rand.Rand) (operation string, _ int64, err error) {
+	size := generator.profile.randomSize(rng)
+	key := generator.objects.newKey(generator.prefix)
+
+	multipart := generator.profile.Multipart
+	if multipart.Threshold > 0 && size >= multipart.Threshold {
+		err = generator.uploadMultipart(ctx, key, size)
+		if err != nil {
+			return loadMultipartUpload, 0, err
+		}
+		generator.objects.add(key)
+		return loadMultipartUpload, size.Int64(), nil
+	}
+
+	upload, err := generator.project.UploadObject(ctx, generator.profile.Bucket, key, nil)
+	if err != nil {
+		return loadUpload, 0, err
+	}
+	if _, err := io.Copy(upload, generator.reader(rng, size.Int64())); err != nil {
+		return loadUpload, 0, errs.Combine(err, upload.Abort())
+	}
+	if err := upload.Commit(); err != nil {
+		return loadUpload, 0, err
+	}
+	generator.objects.add(key)
+	return loadUpload, size.Int64(), nil
+}
+
+func (generator *loadGenerator) uploadMultipart(ctx context.Context, key string, size memory.Size) (err error) {
+	bucket := generator.profile.Bucket
+	partSize := generator.profile.Multipart.PartSize.Int64()
+
+	info, err := generator.project.BeginUpload(ctx, bucket, key, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, generator.project.AbortUpload(ctx, bucket, key, info.UploadID))
+		}
+	}()
+
+	var partNumber uint32
+	for offset := int64(0); offset < size.Int64() || partNumber == 0; offset += partSize {
+		partNumber++
+		part, err := generator.project.UploadPart(ctx, bucket, key, info.UploadID, partNumber)
+		if err != nil {
+			return err
+		}
+
+		length := size.Int64() - offset
+		if length > partSize {
+			length = partSize
+		}
+		if _, err := io.Copy(part, generator.reader(nil, length)); err != nil {
+			return errs.Combine(err, part.Abort())
+		}
+		if err := part.Commit(); err != nil {
+			return err
+		}
+	}
+
+	_, err = generator.project.CommitUpload(ctx, bucket, key, info.UploadID, nil)
+	return err
+}
+
+func (generator *loadGenerator) download(ctx context.Context, rng *rand.Rand) (_ int64, err error) {
+	key, ok := generator.objects.random(rng)
+	if !ok {
+		return 0, errNoObjects
+	}
+
+	download, err := generator.project.DownloadObject(ctx, generator.profile.Bucket, key, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	return io.Copy(io.Discard, download)
+}
+
+func (generator *loadGenerator) list(ctx context.Context) error {
+	objects := generator.project.ListObjects(ctx, generator.profile.Bucket, &uplink.ListObjectsOptions{
+		Prefix:    generator.prefix,
+		Recursive: true,
+	})
+	for objects.Next() {
+	}
+	return objects.Err()
+}
+
+func (generator *loadGenerator) delete(ctx context.Context, rng *rand.Rand) error {
+	key, ok := generator.objects.remove(rng)
+	if !ok {
+		return errNoObjects
+	}
+	_, err := generator.project.DeleteObject(ctx, generator.profile.Bucket, key)
+	return err
+}
+
+// reader returns length bytes of the random data, starting at a random offset.
+func (generator *loadGenerator) reader(rng *rand.Rand, length int64) io.Reader {
+	offset := 0
+	if rng != nil {
+		offset = rng.Intn(len(generator.data))
+	}
+	return io.LimitReader(&repeatReader{data: generator.data, offset: offset}, length)
+}
+
+// repeatReader repeats data infinitely.
+type repeatReader struct {
+	data   []byte
+	offset int
+}
+
+func (r *repeatReader) Read(p []byte) (int, error) {
+	n := copy(p, r.data[r.offset:])
+	r.offset = (r.offset + n) % len(r.data)
+	return n, nil
+}
+
+// newLoadCmd returns the command, which runs the network and measures a generated workload.
+func newLoadCmd(flags *Flags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "load [profile.yaml]",
+		Short: "run network and measure a generated workload",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	access := cmd.Flags().String("access", "", "access grant to use (defaults to the access of the first gateway)")
+	output := cmd.Flags().String("output", "", "file to write the results as JSON to")
+	label := cmd.Flags().String("label", "", "label of the results, e.g. the tested commit")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) (err error) {
+		var profile string
+		if len(args) > 0 {
+			profile = args[0]
+		}
+		return networkLoad(flags, profile, *access, *output, *label)
+	}
+	return cmd
+}
+
+func networkLoad(flags *Flags, profilePath, accessData, outputPath, label string) (err error) {
+	profile, err := LoadLoadProfile(profilePath)
+	if err != nil {
+		return err
+	}
+	if err := profile.Verify(); err != nil {
+		return err
+	}
+
+	processes, err := newNetwork(flags)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = processes.Output.Flush() }()
+
+	ctx, cancel := NewCLIContext(context.Background())
+	defer cancel()
+
+	var group *errgroup.Group
+	if processes.FailFast {
+		group, ctx = errgroup.WithContext(ctx)
+	} else {
+		group = &errgroup.Group{}
+	}
+
+	processes.Start(ctx, group, "run")
+	defer func() {
+		cancel()
+		err = errs.Combine(err, group.Wait(), processes.Close())
+	}()
+
+	for _, process := range processes.List {
+		process.Status.Started.Wait(ctx)
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("network canceled: %w", err)
+	}
+
+	if accessData == "" {
+		for _, process := range processes.List {
+			for _, extra := range process.Extra {
+				if extra.Key == "ACCESS" {
+					accessData = extra.Value
+					break
+				}
+			}
+			if accessData != "" {
+				break
+			}
+		}
+	}
+	if accessData == "" {
+		return errors.New("no access grant found, use --access or run the network with gateways")
+	}
+
+	access, err := uplink.ParseAccess(accessData)
+	if err != nil {
+		return err
+	}
+	project, err := uplink.OpenProject(ctx, access)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, project.Close()) }()
+
+	if profile.Seed == 0 {
+		profile.Seed = time.Now().UnixNano()
+	}
+
+	output := processes.Output.Prefixed("load")
+	defer func() { _ = output.Flush() }()
+	fmt.Fprintf(output, "running %s workload with concurrency %d and seed %d\n", profile.Duration, profile.Concurrency, profile.Seed)
+
+	result, err := newLoadGenerator(profile, project).Run(ctx, label)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	if outputPath != "" {
+		if err := os.WriteFile(outputPath, data, 0644); err != nil {
+			return err
+		}
+	}
+
+	operations := make([]string, 0, len(result.Operations))
+	for operation := range result.Operations {
+		operations = append(operations, operation)
+	}
+	sort.Strings(operations)
+	for _, operation := range operations {
+		r := result.Operations[operation]
+		fmt.Fprintf(output, "%-16s count=%d errors=%d ops/s=%.2f MiB/s=%.2f p50=%.1fms p90=%.1fms p99=%.1fms\n",
+			operation, r.Count, r.Errors, r.OpsPerSecond, r.BytesPerSecond/float64(memory.MiB),
+			r.Latency.P50, r.Latency.P90, r.Latency.P99)
+	}
+	return nil
+}
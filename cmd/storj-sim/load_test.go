@@ -0,0 +1,97 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+)
+
+func TestLoadProfile(t *testing.T) {
+	profile, err := LoadLoadProfile("")
+	require.NoError(t, err)
+	require.NoError(t, profile.Verify())
+
+	path := filepath.Join(t.TempDir(), "profile.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+duration: 30s
+concurrency: 16
+sizes:
+  - size: 1KiB
+    weight: 3
+  - size: 64MiB
+    weight: 1
+operations:
+  upload: 1
+  download: 0
+  list: 0
+  delete: 0
+multipart:
+  threshold: 32MiB
+  part-size: 8MiB
+`), 0644))
+
+	profile, err = LoadLoadProfile(path)
+	require.NoError(t, err)
+	require.NoError(t, profile.Verify())
+	require.Equal(t, 30*time.Second, profile.Duration)
+	require.Equal(t, 16, profile.Concurrency)
+	require.Equal(t, "load", profile.Bucket, "defaults are kept")
+	require.Equal(t, []LoadSize{{Size: memory.KiB, Weight: 3}, {Size: 64 * memory.MiB, Weight: 1}}, profile.Sizes)
+	require.Equal(t, LoadMultipart{Threshold: 32 * memory.MiB, PartSize: 8 * memory.MiB}, profile.Multipart)
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		require.Equal(t, loadUpload, profile.randomOperation(rng))
+		require.Contains(t, []memory.Size{memory.KiB, 64 * memory.MiB}, profile.randomSize(rng))
+	}
+
+	invalid := *profile
+	invalid.Operations = LoadOperations{}
+	invalid.Multipart.PartSize = memory.MiB
+	require.Error(t, invalid.Verify())
+
+	require.NoError(t, os.WriteFile(path, []byte("unknown: 1\n"), 0644))
+	_, err = LoadLoadProfile(path)
+	require.Error(t, err)
+}
+
+func TestLoadRecorder(t *testing.T) {
+	recorder := newLoadRecorder()
+	for i := 1; i <= 100; i++ {
+		recorder.Record(loadDownload, time.Duration(i)*time.Millisecond, 1000, nil)
+	}
+	recorder.Record(loadDownload, time.Second, 0, errs.New("failure"))
+	recorder.Record(loadDelete, time.Millisecond, 0, errs.New("failure"))
+
+	results := recorder.Summarize(10 * time.Second)
+
+	download := results[loadDownload]
+	require.Equal(t, 100, download.Count)
+	require.Equal(t, 1, download.Errors)
+	require.EqualValues(t, 100000, download.Bytes)
+	require.Equal(t, 10.0, download.OpsPerSecond)
+	require.Equal(t, 10000.0, download.BytesPerSecond)
+	require.Equal(t, LoadLatency{Min: 1, Mean: 50.5, P50: 50, P90: 90, P99: 99, Max: 100}, download.Latency)
+
+	require.Equal(t, 0, results[loadDelete].Count)
+	require.Equal(t, 1, results[loadDelete].Errors)
+	require.Equal(t, LoadLatency{}, results[loadDelete].Latency)
+}
+
+func TestRepeatReader(t *testing.T) {
+	generator := &loadGenerator{data: []byte("abc")}
+	data, err := io.ReadAll(generator.reader(nil, 8))
+	require.NoError(t, err)
+	require.Equal(t, "abcabcab", string(data))
+}
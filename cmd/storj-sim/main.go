@@ -108,6 +108,15 @@ func main() {
 				return networkTest(&flags, args[0], args[1:])
 			},
 		}, &cobra.Command{
+			Use:   "chaos <scenario.yaml>",
+			Short: "run network and inject storage node failures from a scenario",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) (err error) {
+				return networkChaos(&flags, args[0])
+			},
+		},
+		newLoadCmd(&flags),
+		&cobra.Command{
 			Use:   "destroy",
 			Short: "destroys network if it exists",
 			RunE: func(cmd *cobra.Command, args []string) (err error) {
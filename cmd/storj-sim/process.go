@@ -15,6 +15,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -182,6 +183,9 @@ type Process struct {
 
 	stdout WriterFlusher
 	stderr WriterFlusher
+
+	mu      sync.Mutex
+	running *os.Process
 }
 
 // New creates a process which can be run in the specified directory.
@@ -301,6 +305,8 @@ func (process *Process) Exec(ctx context.Context, command string) (err error) {
 		return err
 	}
 	process.Info.Pid = cmd.Process.Pid
+	process.setRunning(cmd.Process)
+	defer process.setRunning(nil)
 
 	if command == "setup" || process.Address == "" {
 		// during setup we aren't starting the addresses, so we can release the dependencies immediately
@@ -334,6 +340,23 @@ func (process *Process) Exec(ctx context.Context, command string) (err error) {
 	return err
 }
 
+// setRunning updates the currently running operating system process.
+func (process *Process) setRunning(running *os.Process) {
+	process.mu.Lock()
+	defer process.mu.Unlock()
+	process.running = running
+}
+
+// Signal sends a signal to the process, if it's running.
+func (process *Process) Signal(sig os.Signal) error {
+	process.mu.Lock()
+	defer process.mu.Unlock()
+	if process.running == nil {
+		return fmt.Errorf("%s is not running", process.Name)
+	}
+	return process.running.Signal(sig)
+}
+
 // waitForAddress will monitor starting when we are able to start the process.
 func (process *Process) waitForAddress(maxStartupWait time.Duration) error {
 	start := time.Now()
@@ -4,6 +4,10 @@
 package main
 
 import (
+	"io"
+	"os"
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -25,6 +29,7 @@ func main() {
 	IncludeProfiling(root)
 
 	root.AddCommand(VerifyCommand(log))
+	root.AddCommand(ConsistencyCommand(log))
 
 	process.Exec(root)
 }
@@ -78,3 +83,68 @@ func VerifyCommand(log *zap.Logger) *cobra.Command {
 
 	return cmd
 }
+
+// ConsistencyCommand creates command for checking the consistency of the
+// objects, segments and node aliases.
+func ConsistencyCommand(log *zap.Logger) *cobra.Command {
+	var metabaseDB string
+	var ignoreVersionMismatch bool
+	var output string
+	var config verify.ConsistencyConfig
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "check metabase consistency and report the violations as CSV",
+	}
+
+	flag := cmd.Flags()
+
+	flag.StringVar(&metabaseDB, "metabasedb", "", "connection URL for MetabaseDB")
+	_ = cmd.MarkFlagRequired("metabasedb")
+
+	flag.BoolVar(&ignoreVersionMismatch, "ignore-version-mismatch", false, "ignore version mismatch")
+
+	flag.StringVar(&output, "output", "-", "path of the CSV file for the violations, '-' writes to stdout")
+	flag.IntVar(&config.BatchSize, "batch-size", 2500, "how many items to query in a batch")
+	flag.DurationVar(&config.AsOfSystemInterval, "as-of-system-interval", -5*time.Second, "as of system interval (cockroach only)")
+	flag.IntVar(&config.Ranges, "ranges", 1, "number of stream ID ranges checked separately, more ranges use less memory but iterate the objects more times")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) (err error) {
+		ctx, cancel := process.Ctx(cmd)
+		defer cancel()
+
+		mdb, err := metabase.Open(ctx, log.Named("mdb"), metabaseDB, metabase.Config{ApplicationName: "metabase-verify"})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		defer func() { _ = mdb.Close() }()
+
+		versionErr := mdb.CheckVersion(ctx)
+		if versionErr != nil {
+			log.Error("versions skewed", zap.Error(versionErr))
+			if !ignoreVersionMismatch {
+				return Error.Wrap(versionErr)
+			}
+		}
+
+		var w io.Writer = os.Stdout
+		if output != "-" {
+			file, err := os.Create(output)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+			w = file
+		}
+
+		reporter, err := verify.NewReporter(log.Named("report"), w)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		checker := verify.NewConsistency(log.Named("consistency"), mdb, config, reporter)
+		return Error.Wrap(errs.Combine(checker.RunOnce(ctx), reporter.Flush()))
+	}
+
+	return cmd
+}
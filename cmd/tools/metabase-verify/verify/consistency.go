@@ -0,0 +1,425 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package verify
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ConsistencyConfig contains configuration for the consistency checker.
+type ConsistencyConfig struct {
+	BatchSize          int
+	AsOfSystemInterval time.Duration
+
+	// Ranges is the number of stream ID ranges to check separately. The
+	// objects are iterated once per range, hence more ranges need less memory,
+	// but take longer.
+	Ranges int
+}
+
+// streamSummary contains the object information needed for verifying its
+// segments.
+type streamSummary struct {
+	status        metabase.ObjectStatus
+	segmentCount  int32
+	encryptedSize int64
+	// expiresAt is in unix nanoseconds, 0 means the object doesn't expire.
+	expiresAt int64
+}
+
+// Consistency verifies the consistency between objects, segments and node
+// aliases.
+//
+// The objects and segments are streamed in their natural order. For matching
+// segments to their objects, a compact summary of each object is kept in memory
+// for a single stream ID range at a time.
+type Consistency struct {
+	log      *zap.Logger
+	db       *metabase.DB
+	config   ConsistencyConfig
+	reporter *Reporter
+
+	startedAt time.Time
+	aliases   *metabase.NodeAliasMap
+}
+
+// NewConsistency creates a new consistency checker.
+func NewConsistency(log *zap.Logger, db *metabase.DB, config ConsistencyConfig, reporter *Reporter) *Consistency {
+	if config.Ranges <= 0 {
+		config.Ranges = 1
+	}
+	return &Consistency{
+		log:      log,
+		db:       db,
+		config:   config,
+		reporter: reporter,
+	}
+}
+
+// RunOnce runs all the consistency checks.
+func (checker *Consistency) RunOnce(ctx context.Context) (err error) {
+	checker.startedAt = time.Now()
+
+	checker.aliases, err = checker.db.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for i, streamRange := range streamRanges(checker.config.Ranges) {
+		checker.log.Info("checking range",
+			zap.Int("range", i+1), zap.Int("ranges", checker.config.Ranges),
+			zap.Stringer("start", streamRange.start), zap.Stringer("end", streamRange.end))
+
+		// the object level checks don't depend on the range, hence it's
+		// enough to run them once.
+		streams, err := checker.checkObjects(ctx, streamRange, i == 0)
+		if err != nil {
+			return err
+		}
+
+		if err := checker.checkSegments(ctx, streamRange, streams); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkObjects iterates over all objects and returns the summaries of the
+// objects within the stream range.
+func (checker *Consistency) checkObjects(ctx context.Context, streamRange streamRange, objectChecks bool) (_ map[uuid.UUID]streamSummary, err error) {
+	streams := map[uuid.UUID]streamSummary{}
+
+	var versions versionsCheck
+
+	err = checker.db.IterateLoopObjects(ctx, metabase.IterateLoopObjects{
+		BatchSize:          checker.config.BatchSize,
+		AsOfSystemTime:     checker.startedAt,
+		AsOfSystemInterval: checker.config.AsOfSystemInterval,
+	}, func(ctx context.Context, it metabase.LoopObjectsIterator) error {
+		var entry metabase.LoopObjectEntry
+		for it.Next(ctx, &entry) {
+			if objectChecks {
+				if err := checker.checkObject(&versions, entry); err != nil {
+					return err
+				}
+			}
+
+			if !streamRange.contains(entry.StreamID) {
+				continue
+			}
+
+			summary := streamSummary{
+				status:        entry.Status,
+				segmentCount:  entry.SegmentCount,
+				encryptedSize: entry.TotalEncryptedSize,
+			}
+			if entry.ExpiresAt != nil {
+				summary.expiresAt = entry.ExpiresAt.UnixNano()
+			}
+			streams[entry.StreamID] = summary
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if objectChecks {
+		if err := versions.finish(checker.reporter); err != nil {
+			return nil, err
+		}
+	}
+
+	return streams, nil
+}
+
+// checkObject runs the checks, which only need the object itself.
+func (checker *Consistency) checkObject(versions *versionsCheck, entry metabase.LoopObjectEntry) error {
+	if err := versions.add(checker.reporter, entry); err != nil {
+		return err
+	}
+
+	if entry.Status.IsDeleteMarker() && (entry.SegmentCount != 0 || entry.TotalEncryptedSize != 0) {
+		object := entry.ObjectStream
+		if err := checker.reporter.Report(Violation{
+			Check:   CheckDeleteMarkerSegments,
+			Object:  &object,
+			Details: fmt.Sprintf("segment count %d, total encrypted size %d", entry.SegmentCount, entry.TotalEncryptedSize),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if entry.ExpiresAt != nil && entry.ExpiresAt.Before(entry.CreatedAt) {
+		object := entry.ObjectStream
+		if err := checker.reporter.Report(Violation{
+			Check:   CheckObjectExpiration,
+			Object:  &object,
+			Details: fmt.Sprintf("expires at %s, created at %s", entry.ExpiresAt.Format(time.RFC3339Nano), entry.CreatedAt.Format(time.RFC3339Nano)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkSegments iterates over the segments within the stream range and
+// verifies them against the objects.
+func (checker *Consistency) checkSegments(ctx context.Context, streamRange streamRange, streams map[uuid.UUID]streamSummary) (err error) {
+	var current segmentsCheck
+
+	err = checker.db.IterateLoopSegments(ctx, metabase.IterateLoopSegments{
+		BatchSize:          checker.config.BatchSize,
+		AsOfSystemTime:     checker.startedAt,
+		AsOfSystemInterval: checker.config.AsOfSystemInterval,
+		StartStreamID:      streamRange.start,
+		EndStreamID:        streamRange.end,
+		SkipPieces:         true,
+	}, func(ctx context.Context, it metabase.LoopSegmentsIterator) error {
+		var entry metabase.LoopSegmentEntry
+		for it.Next(ctx, &entry) {
+			if current.streamID != entry.StreamID {
+				if err := checker.finishStream(current, streams); err != nil {
+					return err
+				}
+				summary, found := streams[entry.StreamID]
+				current = segmentsCheck{
+					streamID: entry.StreamID,
+					summary:  summary,
+					found:    found,
+				}
+			}
+
+			if err := checker.checkSegment(&current, entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := checker.finishStream(current, streams); err != nil {
+		return err
+	}
+
+	// the streams, which didn't have any segments.
+	for streamID, summary := range streams {
+		if err := checker.finishStream(segmentsCheck{
+			streamID: streamID,
+			summary:  summary,
+			found:    true,
+		}, streams); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// segmentsCheck accumulates the segments of a single stream.
+type segmentsCheck struct {
+	streamID uuid.UUID
+	summary  streamSummary
+	found    bool
+
+	segmentCount  int32
+	encryptedSize int64
+}
+
+// checkSegment runs the checks of a single segment.
+func (checker *Consistency) checkSegment(current *segmentsCheck, entry metabase.LoopSegmentEntry) error {
+	current.segmentCount++
+	current.encryptedSize += int64(entry.EncryptedSize)
+
+	position := entry.Position
+	report := func(check Check, details string) error {
+		return checker.reporter.Report(Violation{
+			Check:    check,
+			StreamID: entry.StreamID,
+			Position: &position,
+			Details:  details,
+		})
+	}
+
+	if current.found {
+		var expiresAt int64
+		if entry.ExpiresAt != nil {
+			expiresAt = entry.ExpiresAt.UnixNano()
+		}
+		if expiresAt != current.summary.expiresAt {
+			err := report(CheckSegmentExpiration, fmt.Sprintf("segment expires at %s, object expires at %s",
+				formatUnixNano(expiresAt), formatUnixNano(current.summary.expiresAt)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if entry.Inline() {
+		return nil
+	}
+
+	totalShares := int(entry.Redundancy.TotalShares)
+	seen := make(map[uint16]struct{}, len(entry.AliasPieces))
+	for _, piece := range entry.AliasPieces {
+		if _, ok := checker.aliases.Node(piece.Alias); !ok {
+			if err := report(CheckNodeAlias, fmt.Sprintf("piece %d is on unknown node alias %d", piece.Number, piece.Alias)); err != nil {
+				return err
+			}
+		}
+
+		if _, ok := seen[piece.Number]; ok {
+			if err := report(CheckDuplicatePiece, fmt.Sprintf("piece %d is stored multiple times", piece.Number)); err != nil {
+				return err
+			}
+		}
+		seen[piece.Number] = struct{}{}
+
+		if int(piece.Number) >= totalShares {
+			if err := report(CheckPieceNumber, fmt.Sprintf("piece %d is outside of the total shares %d", piece.Number, totalShares)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// finishStream verifies the segments of a stream against its object and
+// removes the stream from streams.
+func (checker *Consistency) finishStream(current segmentsCheck, streams map[uuid.UUID]streamSummary) error {
+	if current.streamID.IsZero() {
+		return nil
+	}
+	delete(streams, current.streamID)
+
+	report := func(check Check, details string) error {
+		return checker.reporter.Report(Violation{
+			Check:    check,
+			StreamID: current.streamID,
+			Details:  details,
+		})
+	}
+
+	switch {
+	case !current.found:
+		return report(CheckOrphanSegment, fmt.Sprintf("%d segments without an object", current.segmentCount))
+	case current.summary.status == metabase.Pending:
+		// pending objects don't have their segment count and sizes set.
+		return nil
+	case current.summary.status.IsDeleteMarker():
+		if current.segmentCount > 0 {
+			return report(CheckDeleteMarkerSegments, fmt.Sprintf("%d segments", current.segmentCount))
+		}
+		return nil
+	}
+
+	if current.segmentCount != current.summary.segmentCount {
+		err := report(CheckSegmentCount, fmt.Sprintf("object segment count %d, segments %d", current.summary.segmentCount, current.segmentCount))
+		if err != nil {
+			return err
+		}
+	}
+	if current.encryptedSize != current.summary.encryptedSize {
+		err := report(CheckEncryptedSize, fmt.Sprintf("object total encrypted size %d, segments %d", current.summary.encryptedSize, current.encryptedSize))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// versionsCheck verifies the versions of the object keys. It relies on the
+// objects being ordered by their location.
+type versionsCheck struct {
+	location    metabase.ObjectLocation
+	unversioned []metabase.ObjectStream
+}
+
+func (check *versionsCheck) add(reporter *Reporter, entry metabase.LoopObjectEntry) error {
+	if location := entry.Location(); location != check.location {
+		if err := check.finish(reporter); err != nil {
+			return err
+		}
+		check.location = location
+	}
+
+	if entry.Status == metabase.CommittedUnversioned || entry.Status == metabase.DeleteMarkerUnversioned {
+		check.unversioned = append(check.unversioned, entry.ObjectStream)
+	}
+	return nil
+}
+
+func (check *versionsCheck) finish(reporter *Reporter) error {
+	defer func() { check.unversioned = check.unversioned[:0] }()
+
+	if len(check.unversioned) <= 1 {
+		return nil
+	}
+
+	versions := make([]metabase.Version, len(check.unversioned))
+	for i, object := range check.unversioned {
+		versions[i] = object.Version
+	}
+
+	for _, object := range check.unversioned {
+		object := object
+		err := reporter.Report(Violation{
+			Check:   CheckMultipleUnversioned,
+			Object:  &object,
+			Details: fmt.Sprintf("unversioned versions %v", versions),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamRange is a range of stream IDs, which excludes start and includes end.
+type streamRange struct {
+	start uuid.UUID
+	end   uuid.UUID
+}
+
+func (r streamRange) contains(streamID uuid.UUID) bool {
+	return (r.start.IsZero() || r.start.Less(streamID)) && !r.end.Less(streamID)
+}
+
+// streamRanges splits the stream IDs into n ranges of equal size.
+func streamRanges(n int) []streamRange {
+	ranges := make([]streamRange, n)
+
+	step := math.MaxUint64 / uint64(n)
+	var start uuid.UUID
+	for i := range ranges {
+		end := uuid.Max()
+		if i < n-1 {
+			binary.BigEndian.PutUint64(end[:8], step*uint64(i+1))
+		}
+		ranges[i] = streamRange{start: start, end: end}
+		start = end
+	}
+	return ranges
+}
+
+func formatUnixNano(t int64) string {
+	if t == 0 {
+		return "never"
+	}
+	return time.Unix(0, t).UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package verify_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/cmd/tools/metabase-verify/verify"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestConsistency(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		valid := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+		wrongCount := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+		orphaned := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+		metabasetest.CreatePendingObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+		_, err := db.UnderlyingTagSQL().ExecContext(ctx, `UPDATE objects SET segment_count = 3 WHERE stream_id = $1`, wrongCount.StreamID)
+		require.NoError(t, err)
+		_, err = db.UnderlyingTagSQL().ExecContext(ctx, `DELETE FROM objects WHERE stream_id = $1`, orphaned.StreamID)
+		require.NoError(t, err)
+
+		for _, ranges := range []int{1, 3} {
+			var buf bytes.Buffer
+			reporter, err := verify.NewReporter(zaptest.NewLogger(t), &buf)
+			require.NoError(t, err)
+
+			checker := verify.NewConsistency(zaptest.NewLogger(t), db, verify.ConsistencyConfig{
+				BatchSize: 2,
+				Ranges:    ranges,
+			}, reporter)
+			require.NoError(t, checker.RunOnce(ctx))
+			require.NoError(t, reporter.Flush())
+
+			require.EqualValues(t, 1, reporter.Count(verify.CheckSegmentCount))
+			require.EqualValues(t, 1, reporter.Count(verify.CheckOrphanSegment))
+			require.Zero(t, reporter.Count(verify.CheckEncryptedSize))
+			require.Zero(t, reporter.Count(verify.CheckNodeAlias))
+
+			records, err := csv.NewReader(&buf).ReadAll()
+			require.NoError(t, err)
+			require.Len(t, records, 3)
+			for _, record := range records[1:] {
+				require.NotEqual(t, valid.StreamID.String(), record[5])
+				require.NotEmpty(t, record[8], "fix suggestion")
+			}
+		}
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package verify
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Check is the name of a consistency check.
+type Check string
+
+const (
+	// CheckMultipleUnversioned reports object keys with more than one unversioned version.
+	CheckMultipleUnversioned = Check("multiple-unversioned")
+	// CheckDeleteMarkerSegments reports delete markers, which have segments.
+	CheckDeleteMarkerSegments = Check("delete-marker-segments")
+	// CheckSegmentCount reports objects, whose segment count doesn't match the segments.
+	CheckSegmentCount = Check("segment-count")
+	// CheckEncryptedSize reports objects, whose total encrypted size doesn't match the segments.
+	CheckEncryptedSize = Check("encrypted-size")
+	// CheckOrphanSegment reports segments without an object.
+	CheckOrphanSegment = Check("orphan-segment")
+	// CheckNodeAlias reports pieces, whose node alias doesn't exist.
+	CheckNodeAlias = Check("node-alias")
+	// CheckDuplicatePiece reports segments with the same piece number multiple times.
+	CheckDuplicatePiece = Check("duplicate-piece")
+	// CheckPieceNumber reports pieces with a number outside of the redundancy scheme.
+	CheckPieceNumber = Check("piece-number")
+	// CheckObjectExpiration reports objects, which expire before they were created.
+	CheckObjectExpiration = Check("object-expiration")
+	// CheckSegmentExpiration reports segments, whose expiration doesn't match the object.
+	CheckSegmentExpiration = Check("segment-expiration")
+)
+
+// fixSuggestions contains the suggested fix of each check.
+var fixSuggestions = map[Check]string{
+	CheckMultipleUnversioned:  "delete all the unversioned versions except the newest one",
+	CheckDeleteMarkerSegments: "delete the segments of the delete marker and reset its segment count and sizes",
+	CheckSegmentCount:         "recalculate segment_count from the segments, delete the object when it has no segments",
+	CheckEncryptedSize:        "recalculate total_encrypted_size from the segments",
+	CheckOrphanSegment:        "delete the segments of the stream",
+	CheckNodeAlias:            "remove the piece from the segment and let the repair checker evaluate it",
+	CheckDuplicatePiece:       "remove the duplicate pieces from the segment and let the repair checker evaluate it",
+	CheckPieceNumber:          "remove the piece from the segment and let the repair checker evaluate it",
+	CheckObjectExpiration:     "set expires_at of the object and its segments to a time after created_at",
+	CheckSegmentExpiration:    "set expires_at of the segment to the expires_at of its object",
+}
+
+// Violation describes a single inconsistency found in the metabase.
+type Violation struct {
+	Check Check
+
+	// Object is set for object level violations.
+	Object *metabase.ObjectStream
+	// StreamID and Position are set for stream and segment level violations.
+	StreamID uuid.UUID
+	Position *metabase.SegmentPosition
+
+	Details string
+}
+
+// Reporter writes violations as CSV.
+type Reporter struct {
+	log *zap.Logger
+
+	mu     sync.Mutex
+	w      *csv.Writer
+	counts map[Check]int64
+}
+
+// NewReporter creates a new reporter writing violations to w.
+func NewReporter(log *zap.Logger, w io.Writer) (*Reporter, error) {
+	reporter := &Reporter{
+		log:    log,
+		w:      csv.NewWriter(w),
+		counts: map[Check]int64{},
+	}
+
+	err := reporter.w.Write([]string{
+		"check", "project_id", "bucket_name", "object_key", "version",
+		"stream_id", "position", "details", "fix_suggestion",
+	})
+	return reporter, Error.Wrap(err)
+}
+
+// Report writes the violation.
+func (reporter *Reporter) Report(violation Violation) error {
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+
+	reporter.counts[violation.Check]++
+
+	var projectID, bucketName, objectKey, version, position string
+	streamID := violation.StreamID
+	if object := violation.Object; object != nil {
+		projectID = object.ProjectID.String()
+		bucketName = object.BucketName
+		// encrypted object keys are binary.
+		objectKey = hex.EncodeToString([]byte(object.ObjectKey))
+		version = strconv.FormatInt(int64(object.Version), 10)
+		streamID = object.StreamID
+	}
+	if violation.Position != nil {
+		position = strconv.FormatUint(violation.Position.Encode(), 10)
+	}
+
+	var streamIDString string
+	if !streamID.IsZero() {
+		streamIDString = streamID.String()
+	}
+
+	return Error.Wrap(reporter.w.Write([]string{
+		string(violation.Check), projectID, bucketName, objectKey, version,
+		streamIDString, position, violation.Details, fixSuggestions[violation.Check],
+	}))
+}
+
+// Flush writes the buffered violations and logs the number of violations
+// per check.
+func (reporter *Reporter) Flush() error {
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+
+	checks := make([]Check, 0, len(reporter.counts))
+	for check := range reporter.counts {
+		checks = append(checks, check)
+	}
+	sort.Slice(checks, func(i, k int) bool { return checks[i] < checks[k] })
+
+	for _, check := range checks {
+		reporter.log.Info("violations", zap.String("check", string(check)), zap.Int64("count", reporter.counts[check]))
+	}
+
+	reporter.w.Flush()
+	return Error.Wrap(reporter.w.Error())
+}
+
+// Count returns the number of reported violations of the check.
+func (reporter *Reporter) Count(check Check) int64 {
+	reporter.mu.Lock()
+	defer reporter.mu.Unlock()
+
+	return reporter.counts[check]
+}
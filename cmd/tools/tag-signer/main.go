@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/shared/process"
 )
 
@@ -69,6 +71,8 @@ type Config struct {
 	IdentityDir string `help:"location if the identity files" path:"true"`
 	NodeID      string `help:"the ID of the node, which will used this tag "`
 	Confirm     bool   `help:"enable comma in tag values" default:"false"`
+
+	Expires time.Duration `help:"validity period of the signed tags, Satellite ignores the tags after it. 0 means no expiration" default:"0"`
 }
 
 func init() {
@@ -103,9 +107,10 @@ func signTags(ctx context.Context, cfg Config, tagPairs []string) (string, error
 	if err != nil {
 		return "", errs.New("Wrong NodeID format: %v", err)
 	}
+	now := time.Now()
 	tagSet := &pb.NodeTagSet{
 		NodeId:   nodeID.Bytes(),
-		SignedAt: time.Now().Unix(),
+		SignedAt: now.Unix(),
 	}
 
 	tagSet.Tags, err = parseTagPairs(tagPairs, cfg.Confirm)
@@ -113,6 +118,16 @@ func signTags(ctx context.Context, cfg Config, tagPairs []string) (string, error
 		return "", err
 	}
 
+	if cfg.Expires < 0 {
+		return "", errs.New("--expires should be a positive duration")
+	}
+	if cfg.Expires > 0 {
+		tagSet.Tags = append(tagSet.Tags, &pb.Tag{
+			Name:  nodeselection.ExpiresTag,
+			Value: []byte(strconv.FormatInt(now.Add(cfg.Expires).Unix(), 10)),
+		})
+	}
+
 	signedMessage, err := nodetag.Sign(ctx, tagSet, signer)
 	if err != nil {
 		return "", err
@@ -163,10 +178,21 @@ func inspect(ctx context.Context, s string) error {
 			return err
 		}
 
+		expiresAt, err := nodeselection.TagSetExpiration(tags)
+		if err != nil {
+			return err
+		}
+
 		fmt.Println("SignedAt:          ", time.Unix(tags.SignedAt, 0).Format(time.RFC3339))
+		if !expiresAt.IsZero() {
+			fmt.Println("ExpiresAt:         ", expiresAt.Format(time.RFC3339))
+		}
 		fmt.Println("NodeID:            ", nodeID.String())
 		fmt.Println("Tags:")
 		for _, tag := range tags.Tags {
+			if tag.Name == nodeselection.ExpiresTag {
+				continue
+			}
 			fmt.Printf("   %s=%s\n", tag.Name, string(tag.Value))
 		}
 		fmt.Println()
@@ -191,6 +217,9 @@ func parseTagPairs(tagPairs []string, allowCommaValues bool) ([]*pb.Tag, error)
 		if len(parts) != 2 {
 			return nil, errs.New("tags should be in KEY=VALUE format, but it was %s", tag)
 		}
+		if parts[0] == nodeselection.ExpiresTag {
+			return nil, errs.New("%s is a reserved tag name, use --expires instead", nodeselection.ExpiresTag)
+		}
 		tags = append(tags, &pb.Tag{
 			Name:  parts[0],
 			Value: []byte(parts[1]),
@@ -81,6 +81,11 @@ func Test_parseTagPairs(t *testing.T) {
 			args:          []string{"key1=value1", "key2=value2,value3"},
 			expectedError: "multiple tags should be separated by spaces instead of commas, or specify --confirm to enable commas in tag values",
 		},
+		{
+			name:          "reserved expiration tag",
+			args:          []string{"key1=value1", "_expires=1700000000"},
+			expectedError: "_expires is a reserved tag name, use --expires instead",
+		},
 	}
 
 	for _, tt := range tests {
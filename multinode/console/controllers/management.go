@@ -0,0 +1,212 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/management"
+)
+
+var (
+	// ErrManagement is an internal error type for management web api controller.
+	ErrManagement = errs.Class("management web api controller")
+)
+
+// Management is a web api controller of the bulk management actions on the nodes.
+type Management struct {
+	log     *zap.Logger
+	service *management.Service
+}
+
+// NewManagement is a constructor for Management controller.
+func NewManagement(log *zap.Logger, service *management.Service) *Management {
+	return &Management{
+		log:     log,
+		service: service,
+	}
+}
+
+// InitiateGracefulExit handles starting the graceful exit of the nodes from a satellite.
+func (controller *Management) InitiateGracefulExit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var payload struct {
+		NodeIDs     []storj.NodeID `json:"nodeIds"`
+		SatelliteID storj.NodeID   `json:"satelliteId"`
+	}
+	if !controller.decodePayload(w, r, &payload, &payload.NodeIDs) {
+		return
+	}
+	if payload.SatelliteID.IsZero() {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.New("satellite id is not provided"))
+		return
+	}
+
+	results, err := controller.service.InitiateGracefulExit(ctx, payload.NodeIDs, payload.SatelliteID)
+	if err != nil {
+		controller.log.Error("initiate graceful exit internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrManagement.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrManagement.Wrap(err)))
+		return
+	}
+}
+
+// GracefulExitProgress handles retrieval of the progress of the graceful exits of all the nodes.
+func (controller *Management) GracefulExitProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	results, err := controller.service.GracefulExitProgress(ctx)
+	if err != nil {
+		controller.log.Error("graceful exit progress internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrManagement.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrManagement.Wrap(err)))
+		return
+	}
+}
+
+// ForgetSatellite handles removing the data of an untrusted satellite from the nodes.
+func (controller *Management) ForgetSatellite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var payload struct {
+		NodeIDs     []storj.NodeID `json:"nodeIds"`
+		SatelliteID storj.NodeID   `json:"satelliteId"`
+	}
+	if !controller.decodePayload(w, r, &payload, &payload.NodeIDs) {
+		return
+	}
+	if payload.SatelliteID.IsZero() {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.New("satellite id is not provided"))
+		return
+	}
+
+	results, err := controller.service.ForgetSatellite(ctx, payload.NodeIDs, payload.SatelliteID)
+	if err != nil {
+		controller.log.Error("forget satellite internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrManagement.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrManagement.Wrap(err)))
+		return
+	}
+}
+
+// UpdateAllocatedDiskSpace handles changing the allocated disk space of the nodes.
+func (controller *Management) UpdateAllocatedDiskSpace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var payload struct {
+		NodeIDs []storj.NodeID `json:"nodeIds"`
+		// Allocated is the allocated disk space in bytes.
+		Allocated int64 `json:"allocated"`
+	}
+	if !controller.decodePayload(w, r, &payload, &payload.NodeIDs) {
+		return
+	}
+	if payload.Allocated <= 0 {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.New("allocated disk space is not provided"))
+		return
+	}
+
+	results, err := controller.service.UpdateAllocatedDiskSpace(ctx, payload.NodeIDs, payload.Allocated)
+	if err != nil {
+		controller.log.Error("update allocated disk space internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrManagement.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrManagement.Wrap(err)))
+		return
+	}
+}
+
+// RecalculateUsedSpace handles triggering the used space recalculation of the nodes.
+func (controller *Management) RecalculateUsedSpace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var payload struct {
+		NodeIDs []storj.NodeID `json:"nodeIds"`
+	}
+	if !controller.decodePayload(w, r, &payload, &payload.NodeIDs) {
+		return
+	}
+
+	results, err := controller.service.RecalculateUsedSpace(ctx, payload.NodeIDs)
+	if err != nil {
+		controller.log.Error("recalculate used space internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrManagement.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrManagement.Wrap(err)))
+		return
+	}
+}
+
+// decodePayload decodes the request body into payload and checks that the node ids are provided.
+// It serves the error and returns false when the payload is invalid.
+func (controller *Management) decodePayload(w http.ResponseWriter, r *http.Request, payload interface{}, nodeIDs *[]storj.NodeID) bool {
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return false
+	}
+	if len(*nodeIDs) == 0 {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.New("node ids are not provided"))
+		return false
+	}
+	return true
+}
+
+// serveError set http statuses and send json error.
+func (controller *Management) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
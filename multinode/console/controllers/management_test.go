@@ -0,0 +1,116 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/rpc"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/console/controllers"
+	"storj.io/storj/multinode/management"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+)
+
+func TestManagement(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		log := zaptest.NewLogger(t)
+
+		offline := nodes.Node{ID: testrand.NodeID(), Name: "offline", PublicAddress: "127.0.0.1:1"}
+		require.NoError(t, db.Nodes().Add(ctx, offline))
+		unknownID := testrand.NodeID()
+
+		controller := controllers.NewManagement(log, management.NewService(log, rpc.Dialer{}, db.Nodes()))
+
+		serve := func(handler http.HandlerFunc, method, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, "/", strings.NewReader(body)).WithContext(ctx)
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			return rec
+		}
+
+		nodeIDs := `"nodeIds":["` + offline.ID.String() + `","` + unknownID.String() + `"]`
+		satelliteID := `"satelliteId":"` + testrand.NodeID().String() + `"`
+
+		t.Run("invalid payload", func(t *testing.T) {
+			for _, test := range []struct {
+				handler http.HandlerFunc
+				body    string
+			}{
+				{controller.InitiateGracefulExit, `{`},
+				{controller.InitiateGracefulExit, `{` + satelliteID + `}`},
+				{controller.InitiateGracefulExit, `{` + nodeIDs + `}`},
+				{controller.ForgetSatellite, `{"nodeIds":["invalid"],` + satelliteID + `}`},
+				{controller.ForgetSatellite, `{` + nodeIDs + `}`},
+				{controller.UpdateAllocatedDiskSpace, `{` + nodeIDs + `}`},
+				{controller.UpdateAllocatedDiskSpace, `{` + nodeIDs + `,"allocated":-1}`},
+				{controller.UpdateAllocatedDiskSpace, `{"allocated":1000}`},
+				{controller.RecalculateUsedSpace, `{"nodeIds":[]}`},
+			} {
+				rec := serve(test.handler, http.MethodPost, test.body)
+				require.Equal(t, http.StatusBadRequest, rec.Code, test.body)
+
+				var response struct {
+					Error string `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+				require.NotEmpty(t, response.Error)
+			}
+		})
+
+		// requireResults checks that the failures of the nodes are reported per node.
+		requireResults := func(t *testing.T, rec *httptest.ResponseRecorder) {
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var results []management.Result
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&results))
+			require.Len(t, results, 2)
+
+			require.Equal(t, offline.ID, results[0].NodeID)
+			require.Equal(t, offline.Name, results[0].Name)
+			require.NotEmpty(t, results[0].Error)
+
+			require.Equal(t, unknownID, results[1].NodeID)
+			require.Contains(t, results[1].Error, nodes.ErrNoNode.New("").Error())
+		}
+
+		t.Run("InitiateGracefulExit", func(t *testing.T) {
+			requireResults(t, serve(controller.InitiateGracefulExit, http.MethodPost, `{`+nodeIDs+`,`+satelliteID+`}`))
+		})
+
+		t.Run("ForgetSatellite", func(t *testing.T) {
+			requireResults(t, serve(controller.ForgetSatellite, http.MethodPost, `{`+nodeIDs+`,`+satelliteID+`}`))
+		})
+
+		t.Run("UpdateAllocatedDiskSpace", func(t *testing.T) {
+			requireResults(t, serve(controller.UpdateAllocatedDiskSpace, http.MethodPost, `{`+nodeIDs+`,"allocated":1000}`))
+		})
+
+		t.Run("RecalculateUsedSpace", func(t *testing.T) {
+			requireResults(t, serve(controller.RecalculateUsedSpace, http.MethodPost, `{`+nodeIDs+`}`))
+		})
+
+		t.Run("GracefulExitProgress", func(t *testing.T) {
+			rec := serve(controller.GracefulExitProgress, http.MethodGet, "")
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var results []management.GracefulExitProgressResult
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&results))
+			require.Len(t, results, 1)
+			require.Equal(t, offline.ID, results[0].NodeID)
+			require.NotEmpty(t, results[0].Error)
+			require.Empty(t, results[0].Progress)
+		})
+	})
+}
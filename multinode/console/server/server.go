@@ -19,6 +19,7 @@ import (
 	"storj.io/common/errs2"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/controllers"
+	"storj.io/storj/multinode/management"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
@@ -46,6 +47,7 @@ type Services struct {
 	Storage    *storage.Service
 	Bandwidth  *bandwidth.Service
 	Reputation *reputation.Service
+	Management *management.Service
 }
 
 // Server represents Multinode Dashboard http server.
@@ -63,6 +65,7 @@ type Server struct {
 	bandwidth  *bandwidth.Service
 	storage    *storage.Service
 	reputation *reputation.Service
+	management *management.Service
 }
 
 // NewServer returns new instance of Multinode Dashboard http server.
@@ -77,6 +80,7 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 		storage:    services.Storage,
 		bandwidth:  services.Bandwidth,
 		reputation: services.Reputation,
+		management: services.Management,
 	}
 
 	router := mux.NewRouter()
@@ -133,6 +137,14 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 	reputationRouter := apiRouter.PathPrefix("/reputation").Subrouter()
 	reputationRouter.HandleFunc("/satellites/{satelliteID}", reputationController.Stats)
 
+	managementController := controllers.NewManagement(server.log, server.management)
+	managementRouter := apiRouter.PathPrefix("/management").Subrouter()
+	managementRouter.HandleFunc("/graceful-exits", managementController.InitiateGracefulExit).Methods(http.MethodPost)
+	managementRouter.HandleFunc("/graceful-exits", managementController.GracefulExitProgress).Methods(http.MethodGet)
+	managementRouter.HandleFunc("/forget-satellite", managementController.ForgetSatellite).Methods(http.MethodPost)
+	managementRouter.HandleFunc("/allocated-disk-space", managementController.UpdateAllocatedDiskSpace).Methods(http.MethodPost)
+	managementRouter.HandleFunc("/recalculate-used-space", managementController.RecalculateUsedSpace).Methods(http.MethodPost)
+
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static").Handler(web.CacheHandler(staticServer))
 	router.PathPrefix("/").HandlerFunc(server.appHandler)
@@ -0,0 +1,52 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package management
+
+import (
+	"storj.io/common/storj"
+)
+
+// Result is the outcome of an action on a node.
+type Result struct {
+	NodeID storj.NodeID `json:"nodeId"`
+	Name   string       `json:"name"`
+	// Error is the reason why the action failed on the node, empty when it succeeded.
+	Error string `json:"error,omitempty"`
+}
+
+// ExitProgress contains the progress of the graceful exit of a node from a satellite.
+type ExitProgress struct {
+	SatelliteID       storj.NodeID `json:"satelliteId"`
+	DomainName        string       `json:"domainName"`
+	PercentComplete   float32      `json:"percentComplete"`
+	Successful        bool         `json:"successful"`
+	CompletionReceipt []byte       `json:"completionReceipt"`
+}
+
+// GracefulExitResult is the outcome of initiating a graceful exit on a node.
+type GracefulExitResult struct {
+	Result
+	Progress *ExitProgress `json:"progress,omitempty"`
+}
+
+// GracefulExitProgressResult contains the progress of the graceful exits of a node.
+type GracefulExitProgressResult struct {
+	Result
+	Progress []ExitProgress `json:"progress"`
+}
+
+// AllocatedDiskSpaceResult is the outcome of updating the allocated disk space of a node.
+type AllocatedDiskSpaceResult struct {
+	Result
+	// Allocated is the allocated disk space, which can be less than requested when the
+	// disk doesn't have enough free space.
+	Allocated int64 `json:"allocated"`
+}
+
+// RecalculateUsedSpaceResult is the outcome of triggering the used space recalculation of a node.
+type RecalculateUsedSpaceResult struct {
+	Result
+	// Started is false when a recalculation was already pending on the node.
+	Started bool `json:"started"`
+}
@@ -0,0 +1,239 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package management performs the management actions on the nodes, like starting a graceful
+// exit or changing the allocated disk space, on many nodes at once.
+package management
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is an error class for management service error.
+	Error = errs.Class("management")
+)
+
+// Service exposes the management actions on the nodes.
+//
+// The actions are performed on every node, even when they fail on some of them, and their
+// outcome is returned for every node.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	nodes  nodes.DB
+}
+
+// NewService creates new instance of management Service.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB) *Service {
+	return &Service{
+		log:    log,
+		dialer: dialer,
+		nodes:  nodes,
+	}
+}
+
+// InitiateGracefulExit starts the graceful exit of the nodes from the satellite.
+func (service *Service) InitiateGracefulExit(ctx context.Context, nodeIDs []storj.NodeID, satelliteID storj.NodeID) (_ []GracefulExitResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	results := make([]GracefulExitResult, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		results[i].Result, err = service.do(ctx, nodeID, func(ctx context.Context, conn *rpc.Conn, header *multinodepb.RequestHeader) error {
+			response, err := multinodepb.NewDRPCGracefulExitClient(conn).InitiateGracefulExit(ctx, &multinodepb.InitiateGracefulExitRequest{
+				Header:      header,
+				SatelliteId: satelliteID,
+			})
+			if err != nil {
+				return err
+			}
+
+			progress := exitProgress(response.Progress)
+			results[i].Progress = &progress
+			return nil
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	return results, nil
+}
+
+// GracefulExitProgress returns the progress of the graceful exits of all the nodes.
+func (service *Service) GracefulExitProgress(ctx context.Context) (_ []GracefulExitProgressResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := service.nodes.List(ctx)
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			return []GracefulExitProgressResult{}, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	results := make([]GracefulExitProgressResult, len(list))
+	for i, node := range list {
+		results[i].Progress = []ExitProgress{}
+		results[i].Result = service.dial(ctx, node, func(ctx context.Context, conn *rpc.Conn, header *multinodepb.RequestHeader) error {
+			response, err := multinodepb.NewDRPCGracefulExitClient(conn).GracefulExitProgress(ctx, &multinodepb.GracefulExitProgressRequest{
+				Header: header,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, progress := range response.Progress {
+				results[i].Progress = append(results[i].Progress, exitProgress(progress))
+			}
+			return nil
+		})
+	}
+
+	return results, nil
+}
+
+// ForgetSatellite starts removing the data of the untrusted satellite from the nodes.
+func (service *Service) ForgetSatellite(ctx context.Context, nodeIDs []storj.NodeID, satelliteID storj.NodeID) (_ []Result, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	results := make([]Result, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		results[i], err = service.do(ctx, nodeID, func(ctx context.Context, conn *rpc.Conn, header *multinodepb.RequestHeader) error {
+			_, err := multinodepb.NewDRPCNodeClient(conn).ForgetSatellite(ctx, &multinodepb.ForgetSatelliteRequest{
+				Header:      header,
+				SatelliteId: satelliteID,
+			})
+			return err
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	return results, nil
+}
+
+// UpdateAllocatedDiskSpace changes the allocated disk space of the nodes until they are restarted.
+func (service *Service) UpdateAllocatedDiskSpace(ctx context.Context, nodeIDs []storj.NodeID, allocated int64) (_ []AllocatedDiskSpaceResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	results := make([]AllocatedDiskSpaceResult, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		results[i].Result, err = service.do(ctx, nodeID, func(ctx context.Context, conn *rpc.Conn, header *multinodepb.RequestHeader) error {
+			response, err := multinodepb.NewDRPCStorageClient(conn).UpdateAllocatedDiskSpace(ctx, &multinodepb.UpdateAllocatedDiskSpaceRequest{
+				Header:    header,
+				Allocated: allocated,
+			})
+			if err != nil {
+				return err
+			}
+
+			results[i].Allocated = response.Allocated
+			return nil
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	return results, nil
+}
+
+// RecalculateUsedSpace triggers the recalculation of the used space of the nodes.
+func (service *Service) RecalculateUsedSpace(ctx context.Context, nodeIDs []storj.NodeID) (_ []RecalculateUsedSpaceResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	results := make([]RecalculateUsedSpaceResult, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		results[i].Result, err = service.do(ctx, nodeID, func(ctx context.Context, conn *rpc.Conn, header *multinodepb.RequestHeader) error {
+			response, err := multinodepb.NewDRPCStorageClient(conn).RecalculateUsedSpace(ctx, &multinodepb.RecalculateUsedSpaceRequest{
+				Header: header,
+			})
+			if err != nil {
+				return err
+			}
+
+			results[i].Started = response.Started
+			return nil
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	return results, nil
+}
+
+// do performs the action on the node. The error is returned only when the node couldn't be
+// retrieved from the database, the failure of the action is reported in the result.
+func (service *Service) do(ctx context.Context, nodeID storj.NodeID, action func(ctx context.Context, conn *rpc.Conn, header *multinodepb.RequestHeader) error) (_ Result, err error) {
+	node, err := service.nodes.Get(ctx, nodeID)
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			return Result{NodeID: nodeID, Error: err.Error()}, nil
+		}
+		return Result{}, err
+	}
+
+	return service.dial(ctx, node, action), nil
+}
+
+// dial dials the node and performs the action on it.
+func (service *Service) dial(ctx context.Context, node nodes.Node, action func(ctx context.Context, conn *rpc.Conn, header *multinodepb.RequestHeader) error) Result {
+	result := Result{
+		NodeID: node.ID,
+		Name:   node.Name,
+	}
+
+	err := func() (err error) {
+		conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+			ID:      node.ID,
+			Address: node.PublicAddress,
+		})
+		if err != nil {
+			return nodes.ErrNodeNotReachable.Wrap(err)
+		}
+		defer func() {
+			err = errs.Combine(err, conn.Close())
+		}()
+
+		err = action(ctx, conn, &multinodepb.RequestHeader{
+			ApiKey: node.APISecret[:],
+		})
+		if rpcstatus.Code(err) == rpcstatus.Unauthenticated {
+			return nodes.ErrNodeAPIKeyInvalid.Wrap(err)
+		}
+		return err
+	}()
+	if err != nil {
+		service.log.Warn("management action failed", zap.Stringer("Node ID", node.ID), zap.Error(err))
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// exitProgress converts the graceful exit progress of a satellite.
+func exitProgress(progress *multinodepb.ExitProgress) ExitProgress {
+	return ExitProgress{
+		SatelliteID:       progress.SatelliteId,
+		DomainName:        progress.DomainName,
+		PercentComplete:   progress.PercentComplete,
+		Successful:        progress.Successful,
+		CompletionReceipt: progress.CompletionReceipt,
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package management_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/peertls/extensions"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/management"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodeauth"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/private/server"
+)
+
+// mockNode implements the management endpoints of a storage node.
+type mockNode struct {
+	multinodepb.DRPCStorageUnimplementedServer
+	multinodepb.DRPCGracefulExitUnimplementedServer
+	multinodepb.DRPCNodeUnimplementedServer
+
+	secret    multinodeauth.Secret
+	satellite storj.NodeID
+	free      int64
+}
+
+func (node *mockNode) authenticate(header *multinodepb.RequestHeader) error {
+	if !bytes.Equal(header.GetApiKey(), node.secret[:]) {
+		return rpcstatus.Error(rpcstatus.Unauthenticated, "invalid api key")
+	}
+	return nil
+}
+
+func (node *mockNode) UpdateAllocatedDiskSpace(ctx context.Context, req *multinodepb.UpdateAllocatedDiskSpaceRequest) (*multinodepb.UpdateAllocatedDiskSpaceResponse, error) {
+	if err := node.authenticate(req.Header); err != nil {
+		return nil, err
+	}
+	allocated := req.Allocated
+	if allocated > node.free {
+		allocated = node.free
+	}
+	return &multinodepb.UpdateAllocatedDiskSpaceResponse{Allocated: allocated}, nil
+}
+
+func (node *mockNode) RecalculateUsedSpace(ctx context.Context, req *multinodepb.RecalculateUsedSpaceRequest) (*multinodepb.RecalculateUsedSpaceResponse, error) {
+	if err := node.authenticate(req.Header); err != nil {
+		return nil, err
+	}
+	return &multinodepb.RecalculateUsedSpaceResponse{Started: true}, nil
+}
+
+func (node *mockNode) InitiateGracefulExit(ctx context.Context, req *multinodepb.InitiateGracefulExitRequest) (*multinodepb.InitiateGracefulExitResponse, error) {
+	if err := node.authenticate(req.Header); err != nil {
+		return nil, err
+	}
+	if req.SatelliteId != node.satellite {
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "satellite is not trusted")
+	}
+	return &multinodepb.InitiateGracefulExitResponse{
+		Progress: &multinodepb.ExitProgress{SatelliteId: req.SatelliteId, DomainName: "satellite.test:7777"},
+	}, nil
+}
+
+func (node *mockNode) GracefulExitProgress(ctx context.Context, req *multinodepb.GracefulExitProgressRequest) (*multinodepb.GracefulExitProgressResponse, error) {
+	if err := node.authenticate(req.Header); err != nil {
+		return nil, err
+	}
+	return &multinodepb.GracefulExitProgressResponse{
+		Progress: []*multinodepb.ExitProgress{
+			{SatelliteId: node.satellite, DomainName: "satellite.test:7777", PercentComplete: 50},
+		},
+	}, nil
+}
+
+func (node *mockNode) ForgetSatellite(ctx context.Context, req *multinodepb.ForgetSatelliteRequest) (*multinodepb.ForgetSatelliteResponse, error) {
+	if err := node.authenticate(req.Header); err != nil {
+		return nil, err
+	}
+	return &multinodepb.ForgetSatelliteResponse{InProgress: true}, nil
+}
+
+func TestService(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		log := zaptest.NewLogger(t)
+
+		tlsConfig := tlsopts.Config{
+			PeerIDVersions: "*",
+			Extensions: extensions.Config{
+				Revocation:          false,
+				WhitelistSignedLeaf: false,
+			},
+		}
+
+		satelliteID := testrand.NodeID()
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// startNode starts a mock storage node and returns its id and address.
+		startNode := func(mock *mockNode) (storj.NodeID, string) {
+			ident, err := testidentity.NewTestIdentity(ctx)
+			require.NoError(t, err)
+			tlsOptions, err := tlsopts.NewOptions(ident, tlsConfig, nil)
+			require.NoError(t, err)
+
+			srv, err := server.New(log, tlsOptions, server.Config{
+				Address:        "127.0.0.1:0",
+				PrivateAddress: "127.0.0.1:0",
+				Config:         tlsConfig,
+			})
+			require.NoError(t, err)
+			require.NoError(t, multinodepb.DRPCRegisterStorage(srv.DRPC(), mock))
+			require.NoError(t, multinodepb.DRPCRegisterGracefulExit(srv.DRPC(), mock))
+			require.NoError(t, multinodepb.DRPCRegisterNode(srv.DRPC(), mock))
+
+			ctx.Go(func() error {
+				return srv.Run(runCtx)
+			})
+
+			return ident.ID, srv.Addr().String()
+		}
+
+		onlineSecret := multinodeauth.Secret{1}
+		online := nodes.Node{Name: "online", APISecret: onlineSecret}
+		online.ID, online.PublicAddress = startNode(&mockNode{secret: onlineSecret, satellite: satelliteID, free: 100})
+
+		wrongKey := nodes.Node{Name: "wrong key", APISecret: multinodeauth.Secret{2}}
+		wrongKey.ID, wrongKey.PublicAddress = startNode(&mockNode{secret: multinodeauth.Secret{3}, satellite: satelliteID, free: 100})
+
+		offline := nodes.Node{ID: testrand.NodeID(), Name: "offline", PublicAddress: "127.0.0.1:1"}
+
+		for _, node := range []nodes.Node{online, wrongKey, offline} {
+			require.NoError(t, db.Nodes().Add(ctx, node))
+		}
+		unknownID := testrand.NodeID()
+		nodeIDs := []storj.NodeID{online.ID, wrongKey.ID, offline.ID, unknownID}
+
+		clientIdent, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		clientTLSOptions, err := tlsopts.NewOptions(clientIdent, tlsConfig, nil)
+		require.NoError(t, err)
+
+		service := management.NewService(log, rpc.NewDefaultDialer(clientTLSOptions), db.Nodes())
+
+		// requireResults checks that the action succeeded only on the online node.
+		requireResults := func(t *testing.T, results []management.Result) {
+			require.Len(t, results, len(nodeIDs))
+			for i, result := range results {
+				require.Equal(t, nodeIDs[i], result.NodeID)
+			}
+
+			require.Empty(t, results[0].Error)
+			require.Equal(t, "online", results[0].Name)
+			require.Contains(t, results[1].Error, nodes.ErrNodeAPIKeyInvalid.New("").Error())
+			require.NotEmpty(t, results[2].Error)
+			require.Contains(t, results[3].Error, nodes.ErrNoNode.New("").Error())
+		}
+
+		t.Run("InitiateGracefulExit", func(t *testing.T) {
+			results, err := service.InitiateGracefulExit(ctx, nodeIDs, satelliteID)
+			require.NoError(t, err)
+
+			var base []management.Result
+			for _, result := range results {
+				base = append(base, result.Result)
+			}
+			requireResults(t, base)
+
+			require.NotNil(t, results[0].Progress)
+			require.Equal(t, satelliteID, results[0].Progress.SatelliteID)
+			for _, result := range results[1:] {
+				require.Nil(t, result.Progress)
+			}
+
+			// the failure of the node is reported in the result.
+			results, err = service.InitiateGracefulExit(ctx, nodeIDs[:1], testrand.NodeID())
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			require.Contains(t, results[0].Error, "satellite is not trusted")
+		})
+
+		t.Run("GracefulExitProgress", func(t *testing.T) {
+			results, err := service.GracefulExitProgress(ctx)
+			require.NoError(t, err)
+			require.Len(t, results, 3)
+
+			for _, result := range results {
+				if result.NodeID != online.ID {
+					require.NotEmpty(t, result.Error)
+					require.Empty(t, result.Progress)
+					continue
+				}
+				require.Empty(t, result.Error)
+				require.Len(t, result.Progress, 1)
+				require.Equal(t, satelliteID, result.Progress[0].SatelliteID)
+				require.EqualValues(t, 50, result.Progress[0].PercentComplete)
+			}
+		})
+
+		t.Run("ForgetSatellite", func(t *testing.T) {
+			results, err := service.ForgetSatellite(ctx, nodeIDs, satelliteID)
+			require.NoError(t, err)
+			requireResults(t, results)
+		})
+
+		t.Run("UpdateAllocatedDiskSpace", func(t *testing.T) {
+			results, err := service.UpdateAllocatedDiskSpace(ctx, nodeIDs, 1000)
+			require.NoError(t, err)
+
+			var base []management.Result
+			for _, result := range results {
+				base = append(base, result.Result)
+			}
+			requireResults(t, base)
+
+			// the node allocates only its free space.
+			require.EqualValues(t, 100, results[0].Allocated)
+			for _, result := range results[1:] {
+				require.Zero(t, result.Allocated)
+			}
+		})
+
+		t.Run("RecalculateUsedSpace", func(t *testing.T) {
+			results, err := service.RecalculateUsedSpace(ctx, nodeIDs)
+			require.NoError(t, err)
+
+			var base []management.Result
+			for _, result := range results {
+				base = append(base, result.Result)
+			}
+			requireResults(t, base)
+
+			require.True(t, results[0].Started)
+			for _, result := range results[1:] {
+				require.False(t, result.Started)
+			}
+		})
+	})
+}
@@ -5,6 +5,7 @@ package multinodedb
 
 import (
 	"context"
+	"io"
 	"strings"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -14,9 +15,9 @@ import (
 	"storj.io/storj/multinode"
 	"storj.io/storj/multinode/multinodedb/dbx"
 	"storj.io/storj/multinode/nodes"
-	"storj.io/storj/private/migrate"
 	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/migrate"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -109,6 +110,51 @@ func (db DB) MigrateToLatest(ctx context.Context) error {
 	return migration.Run(ctx, db.log)
 }
 
+// MigrateToVersion migrates the database forward or rolls it back to the
+// specified version.
+func (db DB) MigrateToVersion(ctx context.Context, version int) error {
+	migration, err := db.migration()
+	if err != nil {
+		return err
+	}
+	if err := migration.Rollback(ctx, db.log, version); err != nil {
+		return err
+	}
+	return migration.TargetVersion(version).Run(ctx, db.log)
+}
+
+// DryRunMigration writes the statements, which would migrate the database to
+// the specified version, to w without running them. Negative version means the
+// latest version.
+func (db DB) DryRunMigration(ctx context.Context, w io.Writer, version int) error {
+	migration, err := db.migration()
+	if err != nil {
+		return err
+	}
+	if version < 0 {
+		return migration.DryRun(ctx, w)
+	}
+
+	// only one of them writes anything, depending on whether the version is
+	// older or newer than the current one.
+	if err := migration.DryRunRollback(ctx, w, version); err != nil {
+		return err
+	}
+	return migration.TargetVersion(version).DryRun(ctx, w)
+}
+
+// migration returns the migration of the database implementation.
+func (db DB) migration() (*migrate.Migration, error) {
+	switch db.implementation {
+	case dbutil.SQLite3:
+		return db.SQLite3Migration(), nil
+	case dbutil.Postgres:
+		return db.PostgresMigration(), nil
+	default:
+		return nil, Error.New("migrations are not supported for %s", db.implementation)
+	}
+}
+
 // sqlite3SetDefaultOptions sets default options for disk-based db with URI filename source string
 // if no options were set.
 func sqlite3SetDefaultOptions(source string) string {
@@ -4,7 +4,7 @@
 package multinodedb
 
 import (
-	"storj.io/storj/private/migrate"
+	"storj.io/storj/shared/migrate"
 )
 
 // SQLite3Migration returns steps needed for migrating sqlite3 database.
@@ -19,6 +19,7 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/server"
+	"storj.io/storj/multinode/management"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
@@ -94,6 +95,11 @@ type Peer struct {
 		Service *reputation.Service
 	}
 
+	// performs the management actions on the nodes.
+	Management struct {
+		Service *management.Service
+	}
+
 	// Web server with web UI.
 	Console struct {
 		Listener net.Listener
@@ -172,6 +178,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		)
 	}
 
+	{ // management setup
+		peer.Management.Service = management.NewService(
+			peer.Log.Named("management:service"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+		)
+	}
+
 	{ // console setup
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
@@ -199,6 +213,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 				Storage:    peer.Storage.Service,
 				Bandwidth:  peer.Bandwidth.Service,
 				Reputation: peer.Reputation.Service,
+				Management: peer.Management.Service,
 			},
 		)
 		if err != nil {
@@ -0,0 +1,113 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrClient is the error class for the errors of the API clients, except the errors that the
+// API server responds with.
+var ErrClient = errs.Class("api client")
+
+// ClientAuth adds the authentication credentials to the requests sent by an API client.
+type ClientAuth func(r *http.Request)
+
+// BearerAuth returns a ClientAuth that authenticates the requests with the key through the
+// Authorization header.
+func BearerAuth(key string) ClientAuth {
+	return func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer "+key)
+	}
+}
+
+// CookieAuth returns a ClientAuth that authenticates the requests with the cookie.
+func CookieAuth(name, value string) ClientAuth {
+	return func(r *http.Request) {
+		r.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+}
+
+// Client sends requests to an API server. The API generator uses it in the generated API clients.
+type Client struct {
+	// BaseURL is the scheme and host of the API server, e.g. "https://satellite.example.test". The
+	// paths of the requests are appended to it.
+	BaseURL string
+	// HTTPClient is the client used for sending the requests. When nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+	// Auth adds the authentication credentials to the requests. When nil, they are sent without
+	// credentials.
+	Auth ClientAuth
+}
+
+// Do sends a request to path with query as query parameters and request, when it isn't nil, as
+// JSON body. It decodes the JSON body of the response into response when it isn't nil.
+//
+// It returns an HTTPError when the server responds with a non-successful status code.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, request, response any) (err error) {
+	u := strings.TrimSuffix(c.BaseURL, "/") + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return ErrClient.Wrap(err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return ErrClient.Wrap(err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Auth != nil {
+		c.Auth(req)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return ErrClient.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrClient.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var serverErr struct {
+			Error string `json:"error"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&serverErr); err != nil || serverErr.Error == "" {
+			serverErr.Error = http.StatusText(resp.StatusCode)
+		}
+
+		return HTTPError{
+			Status: resp.StatusCode,
+			Err:    errs.New("%s", serverErr.Error),
+		}
+	}
+
+	if response == nil {
+		return nil
+	}
+
+	return ErrClient.Wrap(json.NewDecoder(resp.Body).Decode(response))
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/api"
+)
+
+func TestClient(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	type message struct {
+		Text string `json:"text"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "token" {
+			api.ServeError(zaptest.NewLogger(t), w, http.StatusUnauthorized, errors.New("missing session"))
+			return
+		}
+
+		var request message
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			api.ServeError(zaptest.NewLogger(t), w, http.StatusBadRequest, err)
+			return
+		}
+
+		require.Equal(t, "value", r.URL.Query().Get("key"))
+		require.NoError(t, json.NewEncoder(w).Encode(message{Text: request.Text + " " + r.URL.Path}))
+	}))
+	defer server.Close()
+
+	var response message
+	c := &api.Client{BaseURL: server.URL, Auth: api.CookieAuth("session", "token")}
+	err := c.Do(ctx, http.MethodPost, "/echo", map[string][]string{"key": {"value"}}, message{Text: "hello"}, &response)
+	require.NoError(t, err)
+	require.Equal(t, "hello /echo", response.Text)
+
+	c.Auth = nil
+	err = c.Do(ctx, http.MethodPost, "/echo", nil, message{Text: "hello"}, &response)
+	var httpErr api.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusUnauthorized, httpErr.Status)
+	require.EqualError(t, httpErr.Err, "missing session")
+}
@@ -0,0 +1,126 @@
+// AUTOGENERATED BY private/apigen
+// DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/private/apigen/example/myapi"
+)
+
+const dateLayout = "2006-01-02T15:04:05.999Z"
+
+// DocumentsClient is a client of the Documents API endpoints.
+type DocumentsClient struct {
+	client *api.Client
+}
+
+// NewDocumentsClient creates a new client of the Documents API endpoints.
+func NewDocumentsClient(client *api.Client) *DocumentsClient {
+	return &DocumentsClient{client: client}
+}
+
+// Get calls the "Get Documents" endpoint.
+//
+// Get the paths to all the documents under the specified paths.
+func (c *DocumentsClient) Get(ctx context.Context) ([]myapi.Document, error) {
+	var response []myapi.Document
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/docs/", nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetOne calls the "Get One" endpoint.
+//
+// Get the document in the specified path.
+func (c *DocumentsClient) GetOne(ctx context.Context, path string) (*myapi.Document, error) {
+	var response myapi.Document
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/docs/"+url.PathEscape(path), nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetTag calls the "Get a tag" endpoint.
+//
+// Get the tag of the document in the specified path and tag label.
+func (c *DocumentsClient) GetTag(ctx context.Context, path string, tagName string) (*[2]string, error) {
+	var response [2]string
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/docs/"+url.PathEscape(path)+"/tag/"+url.PathEscape(tagName), nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetVersions calls the "Get Version" endpoint.
+//
+// Get all the version of the document in the specified path.
+func (c *DocumentsClient) GetVersions(ctx context.Context, path string) ([]myapi.Version, error) {
+	var response []myapi.Version
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/docs/"+url.PathEscape(path)+"/versions", nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateContent calls the "Update Content" endpoint.
+//
+// Update the content of the document with the specified path and ID if the last update is before the indicated date.
+func (c *DocumentsClient) UpdateContent(ctx context.Context, path string, id uuid.UUID, date time.Time, request myapi.NewDocument) (*myapi.Document, error) {
+	query := url.Values{}
+	query.Set("id", id.String())
+	query.Set("date", date.UTC().Format(dateLayout))
+
+	var response myapi.Document
+	err := c.client.Do(ctx, http.MethodPost, "/api/v0/docs/"+url.PathEscape(path), query, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UsersClient is a client of the Users API endpoints.
+type UsersClient struct {
+	client *api.Client
+}
+
+// NewUsersClient creates a new client of the Users API endpoints.
+func NewUsersClient(client *api.Client) *UsersClient {
+	return &UsersClient{client: client}
+}
+
+// Get calls the "Get Users" endpoint.
+//
+// Get the list of registered users.
+func (c *UsersClient) Get(ctx context.Context) ([]myapi.User, error) {
+	var response []myapi.User
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/users/", nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Create calls the "Create User" endpoint.
+//
+// Create a user.
+func (c *UsersClient) Create(ctx context.Context, request []myapi.User) error {
+	return c.client.Do(ctx, http.MethodPost, "/api/v0/users/", nil, request, nil)
+}
@@ -9,6 +9,7 @@ package main
 import (
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"go.uber.org/zap"
@@ -149,9 +150,11 @@ func main() {
 	})
 
 	a.MustWriteGo("api.gen.go")
+	a.MustWriteGoClient(filepath.Join("client", "client.gen.go"), "client")
 	a.MustWriteTS("client-api.gen.ts")
 	a.MustWriteTSMock("client-api-mock.gen.ts")
 	a.MustWriteDocs("apidocs.gen.md")
+	a.MustWriteOpenAPI("openapi.gen.json")
 }
 
 // authMiddleware customize endpoints to authenticate requests by API Key or Cookie.
@@ -178,7 +181,37 @@ func (a authMiddleware) Generate(api *apigen.API, group *apigen.EndpointGroup, e
 	}`, !nocookie, !noapikey)
 }
 
-var _ apigen.Middleware = authMiddleware{}
+// SecuritySchemes satisfies the apigen.SecurityMiddleware.
+func (a authMiddleware) SecuritySchemes() map[string]apigen.SecurityScheme {
+	return map[string]apigen.SecurityScheme{
+		"apiKey": {
+			Type:        "http",
+			Scheme:      "bearer",
+			Description: "API key sent through the Authorization header as 'Bearer <key>'",
+		},
+		"cookie": {
+			Type:        "apiKey",
+			In:          "cookie",
+			Name:        "_tokenKey",
+			Description: "Session token",
+		},
+	}
+}
+
+// Security satisfies the apigen.SecurityMiddleware.
+func (a authMiddleware) Security(api *apigen.API, group *apigen.EndpointGroup, ep *apigen.FullEndpoint) []string {
+	var schemes []string
+	if !apigen.LoadSetting(NoAPIKey, ep, false) {
+		schemes = append(schemes, "apiKey")
+	}
+	if !apigen.LoadSetting(NoCookie, ep, false) {
+		schemes = append(schemes, "cookie")
+	}
+
+	return schemes
+}
+
+var _ apigen.SecurityMiddleware = authMiddleware{}
 
 type (
 	tagNoAPIKey struct{}
@@ -0,0 +1,248 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// pathParamRegExp matches the path parameters of the endpoints' paths.
+var pathParamRegExp = regexp.MustCompile(`\{(\w+)\}`)
+
+// MustWriteGoClient writes generated Go code of an API client into a file. The client belongs to
+// the package packageName, which must be a different package than the one that uses the code
+// generated by MustWriteGo.
+//
+// If an error occurs, it panics.
+func (a *API) MustWriteGoClient(path, packageName string) {
+	generated, err := a.generateGoClient(packageName)
+	if err != nil {
+		panic(err)
+	}
+
+	err = os.WriteFile(path, generated, 0644)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+}
+
+// generateGoClient generates the API client code and returns an output.
+func (a *API) generateGoClient(packageName string) ([]byte, error) {
+	result := &StringBuilder{}
+	pf := result.Writelnf
+
+	if packageName == "" {
+		return nil, errs.New("Package name must be defined")
+	}
+
+	imports := newGoImports("")
+	i := imports.add
+
+	usesDates := false
+	for _, group := range a.EndpointGroups {
+		cname := capitalize(group.Name)
+		i("storj.io/storj/private/api")
+
+		pf("// %sClient is a client of the %s API endpoints.", cname, group.Name)
+		pf("type %sClient struct {", cname)
+		pf("client *api.Client")
+		pf("}")
+		pf("")
+		pf("// New%sClient creates a new client of the %s API endpoints.", cname, group.Name)
+		pf("func New%[1]sClient(client *api.Client) *%[1]sClient {", cname)
+		pf("return &%sClient{client: client}", cname)
+		pf("}")
+
+		for _, endpoint := range group.endpoints {
+			i("context", "net/http")
+			pf("")
+
+			var args string
+			params := make([]Param, 0, len(endpoint.PathParams)+len(endpoint.QueryParams))
+			params = append(append(params, endpoint.PathParams...), endpoint.QueryParams...)
+			for _, param := range params {
+				imports.addType(param.Type)
+				if param.Type == reflect.TypeOf(time.Time{}) {
+					usesDates = true
+				}
+				args += fmt.Sprintf(", %s %s", param.Name, param.Type.String())
+			}
+			if endpoint.Request != nil {
+				requestType := reflect.TypeOf(endpoint.Request)
+				imports.addType(requestType)
+				args += ", request " + requestType.String()
+			}
+
+			var responseType reflect.Type
+			returnType := "error"
+			if endpoint.Response != nil {
+				responseType = reflect.TypeOf(endpoint.Response)
+				imports.addType(responseType)
+
+				returnType = responseType.String()
+				if !isNillableType(responseType) {
+					returnType = "*" + returnType
+				}
+				returnType = fmt.Sprintf("(%s, error)", returnType)
+			}
+
+			description := strings.TrimSuffix(strings.TrimSpace(endpoint.Description), ".")
+			pf("// %s calls the %q endpoint.", endpoint.GoName, endpoint.Name)
+			pf("//")
+			pf("// %s.", description)
+			pf(
+				"func (c *%sClient) %s(ctx context.Context%s) %s {",
+				cname,
+				endpoint.GoName,
+				args,
+				returnType,
+			)
+
+			path, err := goClientPath(a.endpointBasePath()+"/"+strings.ToLower(group.Prefix), endpoint, i)
+			if err != nil {
+				return nil, err
+			}
+
+			query := "nil"
+			if len(endpoint.QueryParams) > 0 {
+				i("net/url")
+				query = "query"
+				pf("query := url.Values{}")
+				for _, param := range endpoint.QueryParams {
+					value, err := goClientParamValue(param, i)
+					if err != nil {
+						return nil, err
+					}
+					pf("query.Set(%q, %s)", param.Name, value)
+				}
+				pf("")
+			}
+
+			request := "nil"
+			if endpoint.Request != nil {
+				request = "request"
+			}
+
+			method := "http.Method" + capitalize(strings.ToLower(endpoint.Method))
+			if responseType == nil {
+				pf("return c.client.Do(ctx, %s, %s, %s, %s, nil)", method, path, query, request)
+				pf("}")
+				continue
+			}
+
+			pf("var response %s", responseType.String())
+			pf("err := c.client.Do(ctx, %s, %s, %s, %s, &response)", method, path, query, request)
+			pf("if err != nil {")
+			pf("return nil, err")
+			pf("}")
+			pf("")
+			if isNillableType(responseType) {
+				pf("return response, nil")
+			} else {
+				pf("return &response, nil")
+			}
+			pf("}")
+		}
+		pf("")
+	}
+
+	fileBody := result.String()
+	result = &StringBuilder{}
+	pf = result.Writelnf
+
+	pf("// AUTOGENERATED BY private/apigen")
+	pf("// DO NOT EDIT.")
+	pf("")
+
+	pf("package %s", packageName)
+	pf("")
+
+	imports.write(result)
+
+	if usesDates {
+		pf("const dateLayout = \"%s\"", DateFormat)
+		pf("")
+	}
+
+	result.WriteString(fileBody)
+
+	output, err := format.Source([]byte(result.String()))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return output, nil
+}
+
+// goClientPath returns the Go expression that builds the request path of the endpoint whose
+// group path is groupPath.
+func goClientPath(groupPath string, endpoint *FullEndpoint, i func(paths ...string)) (string, error) {
+	params := make(map[string]Param, len(endpoint.PathParams))
+	for _, param := range endpoint.PathParams {
+		params[param.Name] = param
+	}
+
+	var parts []string
+	literal := groupPath
+	last := 0
+	for _, match := range pathParamRegExp.FindAllStringSubmatchIndex(endpoint.Path, -1) {
+		literal += endpoint.Path[last:match[0]]
+		last = match[1]
+
+		name := endpoint.Path[match[2]:match[3]]
+		param, ok := params[name]
+		if !ok {
+			return "", errs.New("path parameter %q of endpoint %q isn't defined", name, endpoint.Name)
+		}
+
+		value, err := goClientParamValue(param, i)
+		if err != nil {
+			return "", err
+		}
+
+		i("net/url")
+		parts = append(parts, fmt.Sprintf("%q", literal), fmt.Sprintf("url.PathEscape(%s)", value))
+		literal = ""
+	}
+
+	literal += endpoint.Path[last:]
+	if literal != "" {
+		parts = append(parts, fmt.Sprintf("%q", literal))
+	}
+
+	return strings.Join(parts, " + "), nil
+}
+
+// goClientParamValue returns the Go expression that formats the parameter value as string.
+func goClientParamValue(param Param, i func(paths ...string)) (string, error) {
+	switch param.Type {
+	case reflect.TypeOf(uuid.UUID{}):
+		return param.Name + ".String()", nil
+	case reflect.TypeOf(time.Time{}):
+		return param.Name + ".UTC().Format(dateLayout)", nil
+	}
+
+	switch param.Type.Kind() {
+	case reflect.String:
+		if param.Type.Name() != "string" {
+			return "string(" + param.Name + ")", nil
+		}
+		return param.Name, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i("strconv")
+		return "strconv.FormatUint(uint64(" + param.Name + "), 10)", nil
+	default:
+		return "", errs.New("Unsupported parameter type \"%s\"", param.Type)
+	}
+}
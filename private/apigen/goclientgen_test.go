@@ -0,0 +1,62 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/api"
+	"storj.io/storj/private/apigen/example"
+	"storj.io/storj/private/apigen/example/client"
+	"storj.io/storj/private/apigen/example/myapi"
+)
+
+func TestAPIClient(t *testing.T) {
+	ctx := testcontext.NewWithTimeout(t, 5*time.Second)
+	defer ctx.Cleanup()
+
+	router := mux.NewRouter()
+	example.NewDocuments(zaptest.NewLogger(t), monkit.Package(), service{}, router, auth{})
+
+	var authorization string
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+			next.ServeHTTP(w, r)
+		})
+	})
+
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	docs := client.NewDocumentsClient(&api.Client{
+		BaseURL: server.URL,
+		Auth:    api.BearerAuth("secret"),
+	})
+
+	id := testrand.UUID()
+	date := time.Date(2023, 10, 5, 12, 30, 15, int(250*time.Millisecond), time.FixedZone("", 3600))
+
+	doc, err := docs.UpdateContent(ctx, "foo", id, date, myapi.NewDocument{Content: "bar"})
+	require.NoError(t, err)
+	require.Equal(t, "Bearer secret", authorization)
+	require.Equal(t, id, doc.ID)
+	require.True(t, date.Equal(doc.Date), "want=%s got=%s", date, doc.Date)
+	require.Equal(t, "foo", doc.PathParam)
+	require.Equal(t, "bar", doc.Body)
+
+	tag, err := docs.GetTag(ctx, "foo", "category")
+	require.NoError(t, err)
+	require.Equal(t, &[2]string{}, tag)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"go/format"
 	"os"
+	"path"
 	"reflect"
 	"sort"
 	"strings"
@@ -49,48 +50,8 @@ func (a *API) generateGo() ([]byte, error) {
 		packageName = parts[len(parts)-1]
 	}
 
-	imports := struct {
-		All      map[string]bool
-		Standard []string
-		External []string
-		Internal []string
-	}{
-		All: make(map[string]bool),
-	}
-
-	i := func(paths ...string) {
-		for _, path := range paths {
-			if path == "" || path == a.PackagePath {
-				continue
-			}
-
-			if _, ok := imports.All[path]; ok {
-				continue
-			}
-			imports.All[path] = true
-
-			var slice *[]string
-			switch {
-			case !strings.Contains(path, "."):
-				slice = &imports.Standard
-			case strings.HasPrefix(path, "storj.io"):
-				slice = &imports.Internal
-			default:
-				slice = &imports.External
-			}
-			*slice = append(*slice, path)
-		}
-	}
-
-	var getTypePackages func(t reflect.Type) []string
-	getTypePackages = func(t reflect.Type) []string {
-		t = getElementaryType(t)
-		if t.Kind() == reflect.Map {
-			pkgs := []string{getElementaryType(t.Key()).PkgPath()}
-			return append(pkgs, getTypePackages(t.Elem())...)
-		}
-		return []string{t.PkgPath()}
-	}
+	imports := newGoImports(a.PackagePath)
+	i := imports.add
 
 	for _, group := range a.EndpointGroups {
 		for _, method := range group.endpoints {
@@ -338,21 +299,9 @@ func (a *API) generateGo() ([]byte, error) {
 	pf("package %s", packageName)
 	pf("")
 
-	pf("import (")
-	slices := [][]string{imports.Standard, imports.External, imports.Internal}
-	for sn, slice := range slices {
-		sort.Strings(slice)
-		for pn, path := range slice {
-			pf(`"%s"`, path)
-			if pn == len(slice)-1 && sn < len(slices)-1 {
-				pf("")
-			}
-		}
-	}
-	pf(")")
-	pf("")
+	imports.write(result)
 
-	if _, ok := imports.All["time"]; ok {
+	if _, ok := imports.all["time"]; ok {
 		pf("const dateLayout = \"%s\"", DateFormat)
 		pf("")
 	}
@@ -367,6 +316,112 @@ func (a *API) generateGo() ([]byte, error) {
 	return output, nil
 }
 
+// goImports collects the packages imported by generated Go code.
+type goImports struct {
+	// self is the path of the package of the generated code, which must not import itself.
+	self     string
+	all      map[string]bool
+	standard []string
+	external []string
+	internal []string
+	// aliases are the names of the imported packages whose name differs from the last element of
+	// their path.
+	aliases map[string]string
+}
+
+func newGoImports(self string) *goImports {
+	return &goImports{
+		self:    self,
+		all:     make(map[string]bool),
+		aliases: make(map[string]string),
+	}
+}
+
+// add adds the packages to the imports list.
+func (imports *goImports) add(paths ...string) {
+	for _, path := range paths {
+		if path == "" || path == imports.self {
+			continue
+		}
+
+		if _, ok := imports.all[path]; ok {
+			continue
+		}
+		imports.all[path] = true
+
+		var slice *[]string
+		switch {
+		case !strings.Contains(path, "."):
+			slice = &imports.standard
+		case strings.HasPrefix(path, "storj.io"):
+			slice = &imports.internal
+		default:
+			slice = &imports.external
+		}
+		*slice = append(*slice, path)
+	}
+}
+
+// addType adds the packages of the types which t is composed of. Packages whose name differs from
+// the last element of their path are imported with their name as alias.
+func (imports *goImports) addType(t reflect.Type) {
+	for t.Name() == "" {
+		switch t.Kind() {
+		case reflect.Array, reflect.Chan, reflect.Ptr, reflect.Slice:
+			t = t.Elem()
+			continue
+		case reflect.Map:
+			imports.addType(t.Key())
+			t = t.Elem()
+			continue
+		}
+		return
+	}
+
+	p := t.PkgPath()
+	if p == "" || p == imports.self {
+		return
+	}
+
+	imports.add(p)
+	if name, _, _ := strings.Cut(t.String(), "."); name != path.Base(p) {
+		imports.aliases[p] = name
+	}
+}
+
+// write writes the import declaration into result.
+func (imports *goImports) write(result *StringBuilder) {
+	pf := result.Writelnf
+
+	pf("import (")
+	slices := [][]string{imports.standard, imports.external, imports.internal}
+	for sn, slice := range slices {
+		sort.Strings(slice)
+		for pn, path := range slice {
+			if alias, ok := imports.aliases[path]; ok {
+				pf(`%s "%s"`, alias, path)
+			} else {
+				pf(`"%s"`, path)
+			}
+			if pn == len(slice)-1 && sn < len(slices)-1 {
+				pf("")
+			}
+		}
+	}
+	pf(")")
+	pf("")
+}
+
+// getTypePackages returns the packages of the types which t is composed of.
+func getTypePackages(t reflect.Type) []string {
+	t = getElementaryType(t)
+	if t.Kind() == reflect.Map {
+		pkgs := []string{getElementaryType(t.Key()).PkgPath()}
+		return append(pkgs, getTypePackages(t.Elem())...)
+	}
+	return []string{t.PkgPath()}
+}
+
 // handleTypesPackage handles the way some type is used in generated code.
 // If type is from the same package then we use only type's name.
 // If type is from external package then we use type along with its appropriate package name.
@@ -0,0 +1,433 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+)
+
+// openAPIVersion is the version of the OpenAPI specification that the generated documents follow.
+const openAPIVersion = "3.1.0"
+
+// SecurityMiddleware is a Middleware that authenticates the requests.
+//
+// The OpenAPI generator uses it for documenting the authentication mechanisms of the endpoints of
+// the groups that use the middleware.
+type SecurityMiddleware interface {
+	Middleware
+	// SecuritySchemes returns the authentication mechanisms that the middleware supports indexed by
+	// a name which must be unique across all the middlewares of the API.
+	SecuritySchemes() map[string]SecurityScheme
+	// Security returns the names of the security schemes that authenticate the requests to ep, any
+	// of them is enough. It returns an empty slice when ep doesn't require authentication.
+	Security(api *API, group *EndpointGroup, ep *FullEndpoint) []string
+}
+
+// SecurityScheme is an OpenAPI security scheme.
+// See https://spec.openapis.org/oas/v3.1.0#security-scheme-object
+type SecurityScheme struct {
+	// Type is the type of the security scheme. E.g. "apiKey", "http".
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	// Name is the name of the header, query or cookie parameter. Only for the "apiKey" type.
+	Name string `json:"name,omitempty"`
+	// In is the location of the API key: "query", "header" or "cookie". Only for the "apiKey" type.
+	In string `json:"in,omitempty"`
+	// Scheme is the name of the HTTP authorization scheme. E.g. "bearer". Only for the "http" type.
+	Scheme string `json:"scheme,omitempty"`
+}
+
+// MustWriteOpenAPI generates the OpenAPI specification of the API and writes it to the specified
+// file path.
+// If an error occurs, it panics.
+func (api *API) MustWriteOpenAPI(path string) {
+	spec, err := api.generateOpenAPI()
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+
+	err = os.WriteFile(path, spec, 0644)
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+}
+
+// generateOpenAPI generates the OpenAPI specification of the API in JSON format.
+func (api *API) generateOpenAPI() ([]byte, error) {
+	schemas := newOpenAPISchemas()
+	doc := openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:       api.openAPITitle(),
+			Description: api.Description,
+			Version:     api.Version,
+		},
+		Paths: map[string]map[string]*openAPIOperation{},
+		Components: openAPIComponents{
+			Schemas: schemas.components,
+			Responses: map[string]*openAPIResponse{
+				"Error": {
+					Description: "The request failed.",
+					Content: map[string]openAPIMediaType{
+						"application/json": {Schema: &openAPISchema{
+							Type:       "object",
+							Properties: map[string]*openAPISchema{"error": {Type: "string"}},
+							Required:   []string{"error"},
+						}},
+					},
+				},
+			},
+			SecuritySchemes: map[string]SecurityScheme{},
+		},
+	}
+
+	if doc.Info.Version == "" {
+		doc.Info.Version = "unversioned"
+	}
+
+	for _, group := range api.EndpointGroups {
+		for _, m := range group.Middleware {
+			sm, ok := m.(SecurityMiddleware)
+			if !ok {
+				continue
+			}
+
+			for name, scheme := range sm.SecuritySchemes() {
+				if s, ok := doc.Components.SecuritySchemes[name]; ok && s != scheme {
+					return nil, errs.New("security scheme %q is defined with different values", name)
+				}
+				doc.Components.SecuritySchemes[name] = scheme
+			}
+		}
+
+		for _, endpoint := range group.endpoints {
+			op := &openAPIOperation{
+				OperationID: uncapitalize(group.Name) + capitalize(endpoint.TypeScriptName),
+				Summary:     endpoint.Name,
+				Description: endpoint.Description,
+				Tags:        []string{group.Name},
+				Responses: map[string]*openAPIResponse{
+					"default": {Ref: "#/components/responses/Error"},
+				},
+			}
+
+			for _, param := range endpoint.PathParams {
+				op.Parameters = append(op.Parameters, openAPIParameter{
+					Name:     param.Name,
+					In:       "path",
+					Required: true,
+					Schema:   schemas.schema(param.Type),
+				})
+			}
+			for _, param := range endpoint.QueryParams {
+				op.Parameters = append(op.Parameters, openAPIParameter{
+					Name:     param.Name,
+					In:       "query",
+					Required: true,
+					Schema:   schemas.schema(param.Type),
+				})
+			}
+
+			if endpoint.Request != nil {
+				op.RequestBody = &openAPIRequestBody{
+					Required: true,
+					Content: map[string]openAPIMediaType{
+						"application/json": {Schema: schemas.schema(reflect.TypeOf(endpoint.Request))},
+					},
+				}
+			}
+
+			success := &openAPIResponse{Description: "The request succeeded."}
+			if endpoint.Response != nil {
+				success.Content = map[string]openAPIMediaType{
+					"application/json": {Schema: schemas.schema(reflect.TypeOf(endpoint.Response))},
+				}
+			}
+			op.Responses["200"] = success
+
+			op.Security = api.openAPISecurity(group, endpoint)
+
+			p := api.endpointBasePath() + "/" + group.Prefix + endpoint.Path
+			if _, ok := doc.Paths[p]; !ok {
+				doc.Paths[p] = map[string]*openAPIOperation{}
+			}
+			doc.Paths[p][strings.ToLower(endpoint.Method)] = op
+		}
+	}
+
+	if len(doc.Components.SecuritySchemes) == 0 {
+		doc.Components.SecuritySchemes = nil
+	}
+
+	spec, err := json.MarshalIndent(doc, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(spec, '\n'), nil
+}
+
+// openAPITitle returns the title of the API, which is the name of the package that uses the
+// generated Go code.
+func (api *API) openAPITitle() string {
+	if api.PackageName != "" {
+		return api.PackageName
+	}
+
+	return path.Base(api.PackagePath)
+}
+
+// openAPISecurity returns the security requirements of ep. Each requirement is an alternative and
+// all the schemes of a requirement must be satisfied, hence when a group has more than one
+// security middleware, each requirement has one scheme of each middleware.
+//
+// It returns nil when ep doesn't require authentication.
+func (api *API) openAPISecurity(group *EndpointGroup, ep *FullEndpoint) []map[string][]string {
+	var requirements []map[string][]string
+	for _, m := range group.Middleware {
+		sm, ok := m.(SecurityMiddleware)
+		if !ok {
+			continue
+		}
+
+		names := sm.Security(api, group, ep)
+		if len(names) == 0 {
+			continue
+		}
+
+		if len(requirements) == 0 {
+			requirements = []map[string][]string{{}}
+		}
+
+		combined := make([]map[string][]string, 0, len(requirements)*len(names))
+		for _, req := range requirements {
+			for _, name := range names {
+				r := map[string][]string{name: {}}
+				for n := range req {
+					r[n] = []string{}
+				}
+				combined = append(combined, r)
+			}
+		}
+		requirements = combined
+	}
+
+	return requirements
+}
+
+// openAPISchemas generates the OpenAPI schemas of Go types and keeps the ones of the named structs
+// as components, so they are only defined once.
+type openAPISchemas struct {
+	components map[string]*openAPISchema
+	names      map[string]reflect.Type
+}
+
+func newOpenAPISchemas() *openAPISchemas {
+	return &openAPISchemas{
+		components: map[string]*openAPISchema{},
+		names:      map[string]reflect.Type{},
+	}
+}
+
+// schema returns the schema of t. It panics if t isn't supported.
+func (schemas *openAPISchemas) schema(t reflect.Type) *openAPISchema {
+	switch t {
+	case reflect.TypeOf(uuid.UUID{}):
+		return &openAPISchema{Type: "string", Format: "uuid"}
+	case reflect.TypeOf(time.Time{}):
+		return &openAPISchema{Type: "string", Format: "date-time"}
+	case reflect.TypeOf(memory.Size(0)):
+		return &openAPISchema{Type: "string", Description: "Amount of memory formatted as `15 GB`"}
+	}
+
+	switch k := t.Kind(); k {
+	case reflect.Ptr:
+		return schemas.schema(t.Elem()).nullable()
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s := &openAPISchema{Type: "integer"}
+		switch k {
+		case reflect.Int32:
+			s.Format = "int32"
+		case reflect.Int, reflect.Int64:
+			s.Format = "int64"
+		}
+		return s
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		zero := 0
+		return &openAPISchema{Type: "integer", Minimum: &zero}
+	case reflect.Float32:
+		return &openAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &openAPISchema{Type: "number", Format: "double"}
+	case reflect.Slice:
+		// encoding/json encodes []byte as a base64 string.
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &openAPISchema{Type: "string", ContentEncoding: "base64"}
+		}
+		return &openAPISchema{Type: "array", Items: schemas.schema(t.Elem())}
+	case reflect.Array:
+		n := t.Len()
+		return &openAPISchema{Type: "array", Items: schemas.schema(t.Elem()), MinItems: &n, MaxItems: &n}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			panic(fmt.Sprintf("map with keys of type %q is not supported", t.Key()))
+		}
+		return &openAPISchema{Type: "object", AdditionalProperties: schemas.schema(t.Elem())}
+	case reflect.Interface:
+		return &openAPISchema{}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return schemas.structSchema(t)
+		}
+
+		name := capitalize(t.Name())
+		ref := &openAPISchema{Ref: "#/components/schemas/" + name}
+		if other, ok := schemas.names[name]; ok {
+			if other != t {
+				panic(fmt.Sprintf("types %q and %q have the same name %q", other, t, name))
+			}
+			return ref
+		}
+
+		// register the name before generating the schema for supporting recursive types.
+		schemas.names[name] = t
+		schemas.components[name] = schemas.structSchema(t)
+		return ref
+	default:
+		panic(fmt.Sprintf("type %q is not supported", k.String()))
+	}
+}
+
+// structSchema returns the schema of the struct type t.
+func (schemas *openAPISchemas) structSchema(t reflect.Type) *openAPISchema {
+	s := &openAPISchema{
+		Type:       "object",
+		Properties: map[string]*openAPISchema{},
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonInfo := parseJSONTag(t, field)
+		if jsonInfo.Skip {
+			continue
+		}
+
+		fs := schemas.schema(field.Type)
+		if jsonInfo.OmitEmpty {
+			s.Properties[jsonInfo.FieldName] = fs
+			continue
+		}
+
+		if field.Type.Kind() != reflect.Ptr && isNillableType(field.Type) {
+			fs = fs.nullable()
+		}
+		s.Properties[jsonInfo.FieldName] = fs
+		s.Required = append(s.Required, jsonInfo.FieldName)
+	}
+
+	return s
+}
+
+// nullable returns a copy of s which also allows null values.
+func (s *openAPISchema) nullable() *openAPISchema {
+	if s.Ref != "" {
+		return &openAPISchema{OneOf: []*openAPISchema{s, {Type: "null"}}}
+	}
+
+	switch t := s.Type.(type) {
+	case string:
+		c := *s
+		c.Type = []string{t, "null"}
+		return &c
+	default:
+		// it's already nullable or it accepts any value.
+		return s
+	}
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema   `json:"schemas,omitempty"`
+	Responses       map[string]*openAPIResponse `json:"responses,omitempty"`
+	SecuritySchemes map[string]SecurityScheme   `json:"securitySchemes,omitempty"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Tags        []string                    `json:"tags,omitempty"`
+	Parameters  []openAPIParameter          `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+	Security    []map[string][]string       `json:"security,omitempty"`
+}
+
+type openAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Ref         string                      `json:"$ref,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+// openAPISchema is an OpenAPI schema object, which is a superset of JSON Schema.
+// See https://spec.openapis.org/oas/v3.1.0#schema-object
+type openAPISchema struct {
+	Ref string `json:"$ref,omitempty"`
+	// Type is either a string or a slice of strings.
+	Type                 any                       `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	ContentEncoding      string                    `json:"contentEncoding,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Minimum              *int                      `json:"minimum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	MinItems             *int                      `json:"minItems,omitempty"`
+	MaxItems             *int                      `json:"maxItems,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+	OneOf                []*openAPISchema          `json:"oneOf,omitempty"`
+}
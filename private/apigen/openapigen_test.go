@@ -0,0 +1,144 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+)
+
+var updateOpenAPI = flag.Bool("update-openapi", false, "update the OpenAPI golden files")
+
+type testOpenAPIAccount struct {
+	ID        uuid.UUID           `json:"id"`
+	CreatedAt time.Time           `json:"createdAt"`
+	Limit     memory.Size         `json:"limit"`
+	Owner     *testOpenAPIUser    `json:"owner"`
+	Members   []testOpenAPIUser   `json:"members"`
+	Labels    map[string]string   `json:"labels,omitempty"`
+	Secret    []byte              `json:"secret"`
+	Ranges    [][2]uint64         `json:"ranges"`
+	Parent    *testOpenAPIAccount `json:"parent,omitempty"`
+	Internal  string              `json:"-"`
+}
+
+type testOpenAPIUser struct {
+	Name  string  `json:"name"`
+	Email string  `json:"email,omitempty"`
+	Score float64 `json:"score"`
+	Age   int     `json:"age"`
+}
+
+type testOpenAPIAuth struct {
+	scheme string
+}
+
+func (a testOpenAPIAuth) Generate(api *API, group *EndpointGroup, ep *FullEndpoint) string {
+	return ""
+}
+
+func (a testOpenAPIAuth) SecuritySchemes() map[string]SecurityScheme {
+	return map[string]SecurityScheme{
+		a.scheme + "Key":    {Type: "http", Scheme: "bearer"},
+		a.scheme + "Cookie": {Type: "apiKey", In: "cookie", Name: a.scheme},
+	}
+}
+
+func (a testOpenAPIAuth) Security(api *API, group *EndpointGroup, ep *FullEndpoint) []string {
+	if LoadSetting("public", ep, false) {
+		return nil
+	}
+
+	return []string{a.scheme + "Key", a.scheme + "Cookie"}
+}
+
+func TestOpenAPI(t *testing.T) {
+	a := &API{
+		PackagePath: "storj.io/storj/private/apigen/testapi",
+		Version:     "v1",
+		BasePath:    "/api",
+		Description: "Manages accounts",
+	}
+
+	g := a.Group("Accounts", "accounts")
+	g.Middleware = append(g.Middleware, testOpenAPIAuth{scheme: "user"}, testOpenAPIAuth{scheme: "admin"})
+
+	g.Get("/{id}", &Endpoint{
+		Name:           "Get Account",
+		Description:    "Gets the account with the specified ID",
+		GoName:         "Get",
+		TypeScriptName: "get",
+		Response:       testOpenAPIAccount{},
+		PathParams:     []Param{NewParam("id", uuid.UUID{})},
+	})
+
+	g.Post("/", &Endpoint{
+		Name:           "Create Accounts",
+		Description:    "Creates the accounts",
+		GoName:         "Create",
+		TypeScriptName: "create",
+		Request:        []testOpenAPIAccount{},
+		QueryParams:    []Param{NewParam("limit", uint32(0))},
+	})
+
+	g = a.Group("Users", "users")
+	g.Middleware = append(g.Middleware, testOpenAPIAuth{scheme: "user"})
+
+	g.Get("/", &Endpoint{
+		Name:           "List Users",
+		Description:    "Lists the users",
+		GoName:         "List",
+		TypeScriptName: "list",
+		Response:       []testOpenAPIUser{},
+		QueryParams:    []Param{NewParam("since", time.Time{})},
+		Settings:       map[any]any{"public": true},
+	})
+
+	spec, err := a.generateOpenAPI()
+	require.NoError(t, err)
+
+	golden := filepath.Join("testdata", "openapi.golden.json")
+	if *updateOpenAPI {
+		require.NoError(t, os.WriteFile(golden, spec, 0644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(spec), "run the test with -update-openapi for updating the golden file")
+
+	t.Run("types with the same name", func(t *testing.T) {
+		type testOpenAPIUser struct {
+			Name string `json:"name"`
+		}
+
+		a := &API{PackagePath: "storj.io/storj/private/apigen/testapi"}
+		g := a.Group("Users", "users")
+		g.Get("/", &Endpoint{
+			Name:           "Get User",
+			Description:    "Gets the user",
+			GoName:         "Get",
+			TypeScriptName: "get",
+			Response:       testOpenAPIUser{},
+		})
+		g.Get("/other", &Endpoint{
+			Name:           "Get Other User",
+			Description:    "Gets the other user",
+			GoName:         "GetOther",
+			TypeScriptName: "getOther",
+			Response:       testOpenAPIAccount{},
+		})
+
+		require.Panics(t, func() {
+			_, _ = a.generateOpenAPI()
+		})
+	})
+}
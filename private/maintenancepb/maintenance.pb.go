@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maintenance.proto
+
+package maintenancepb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduleWindowRequest is a planned maintenance window of the calling node.
+type ScheduleWindowRequest struct {
+	Start                time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	End                  time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ScheduleWindowRequest) Reset()         { *m = ScheduleWindowRequest{} }
+func (m *ScheduleWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduleWindowRequest) ProtoMessage()    {}
+func (*ScheduleWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{0}
+}
+func (m *ScheduleWindowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduleWindowRequest.Unmarshal(m, b)
+}
+func (m *ScheduleWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduleWindowRequest.Marshal(b, m, deterministic)
+}
+func (m *ScheduleWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleWindowRequest.Merge(m, src)
+}
+func (m *ScheduleWindowRequest) XXX_Size() int {
+	return xxx_messageInfo_ScheduleWindowRequest.Size(m)
+}
+func (m *ScheduleWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleWindowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleWindowRequest proto.InternalMessageInfo
+
+func (m *ScheduleWindowRequest) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *ScheduleWindowRequest) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+type ScheduleWindowResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleWindowResponse) Reset()         { *m = ScheduleWindowResponse{} }
+func (m *ScheduleWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*ScheduleWindowResponse) ProtoMessage()    {}
+func (*ScheduleWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{1}
+}
+func (m *ScheduleWindowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduleWindowResponse.Unmarshal(m, b)
+}
+func (m *ScheduleWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduleWindowResponse.Marshal(b, m, deterministic)
+}
+func (m *ScheduleWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleWindowResponse.Merge(m, src)
+}
+func (m *ScheduleWindowResponse) XXX_Size() int {
+	return xxx_messageInfo_ScheduleWindowResponse.Size(m)
+}
+func (m *ScheduleWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleWindowResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ScheduleWindowRequest)(nil), "maintenance.ScheduleWindowRequest")
+	proto.RegisterType((*ScheduleWindowResponse)(nil), "maintenance.ScheduleWindowResponse")
+}
+
+func init() { proto.RegisterFile("maintenance.proto", fileDescriptor_6053ae89a3b3f561) }
+
+var fileDescriptor_6053ae89a3b3f561 = []byte{
+	// 233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcc, 0x4d, 0xcc, 0xcc,
+	0x2b, 0x49, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x46,
+	0x12, 0x92, 0xe2, 0x4a, 0xcf, 0x4f, 0xcf, 0x87, 0x48, 0x48, 0xc9, 0xa7, 0xe7, 0xe7, 0xa7, 0xe7,
+	0xa4, 0xea, 0x83, 0x79, 0x49, 0xa5, 0x69, 0xfa, 0x25, 0x99, 0xb9, 0xa9, 0xc5, 0x25, 0x89, 0xb9,
+	0x05, 0x10, 0x05, 0x4a, 0xdd, 0x8c, 0x5c, 0xa2, 0xc1, 0xc9, 0x19, 0xa9, 0x29, 0xa5, 0x39, 0xa9,
+	0xe1, 0x99, 0x79, 0x29, 0xf9, 0xe5, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x56, 0x5c,
+	0xac, 0xc5, 0x25, 0x89, 0x45, 0x25, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x52, 0x7a, 0x10,
+	0xa3, 0xf4, 0x60, 0x46, 0xe9, 0x85, 0xc0, 0x8c, 0x72, 0xe2, 0x38, 0x71, 0x4f, 0x9e, 0x61, 0xc2,
+	0x7d, 0x79, 0xc6, 0x20, 0x88, 0x16, 0x21, 0x33, 0x2e, 0xe6, 0xd4, 0xbc, 0x14, 0x09, 0x26, 0x12,
+	0x74, 0x82, 0x34, 0x28, 0x49, 0x70, 0x89, 0xa1, 0x3b, 0xa6, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5,
+	0x28, 0x8b, 0x8b, 0xdb, 0x17, 0xe1, 0x47, 0xa1, 0x68, 0x2e, 0x3e, 0x54, 0x85, 0x42, 0x4a, 0x7a,
+	0xc8, 0xc1, 0x82, 0xd5, 0x4b, 0x52, 0xca, 0x78, 0xd5, 0x40, 0x6c, 0x52, 0x62, 0x70, 0x52, 0x8b,
+	0x52, 0x29, 0x2e, 0xc9, 0x2f, 0xca, 0xd2, 0xcb, 0xcc, 0xd7, 0x07, 0x33, 0xf4, 0x0b, 0x8a, 0x32,
+	0xcb, 0x12, 0x4b, 0x52, 0xf5, 0x91, 0xb4, 0x17, 0x24, 0x25, 0xb1, 0x81, 0x3d, 0x64, 0x0c, 0x18,
+	0x00, 0x70, 0xaa, 0xbf, 0x17, 0x91, 0x01, 0x00, 0x00,
+}
@@ -100,10 +100,12 @@ func (m *DiskSpaceRequest) GetHeader() *RequestHeader {
 }
 
 type DiskSpaceResponse struct {
-	Allocated            int64    `protobuf:"varint,1,opt,name=allocated,proto3" json:"allocated,omitempty"`
-	UsedPieces           int64    `protobuf:"varint,2,opt,name=used_pieces,json=usedPieces,proto3" json:"used_pieces,omitempty"`
-	UsedTrash            int64    `protobuf:"varint,3,opt,name=used_trash,json=usedTrash,proto3" json:"used_trash,omitempty"`
-	Free                 int64    `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`
+	Allocated  int64 `protobuf:"varint,1,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	UsedPieces int64 `protobuf:"varint,2,opt,name=used_pieces,json=usedPieces,proto3" json:"used_pieces,omitempty"`
+	UsedTrash  int64 `protobuf:"varint,3,opt,name=used_trash,json=usedTrash,proto3" json:"used_trash,omitempty"`
+	// Free is the actual amount of free space on the whole disk, not just allocated disk space, in bytes.
+	Free int64 `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`
+	// Available is the amount of free space on the allocated disk space, in bytes.
 	Available            int64    `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
 	Overused             int64    `protobuf:"varint,6,opt,name=overused,proto3" json:"overused,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -448,6 +450,167 @@ func (m *StorageUsageSatelliteResponse) GetAverageUsageBytes() float64 {
 	return 0
 }
 
+type UpdateAllocatedDiskSpaceRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Allocated            int64          `protobuf:"varint,2,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UpdateAllocatedDiskSpaceRequest) Reset()         { *m = UpdateAllocatedDiskSpaceRequest{} }
+func (m *UpdateAllocatedDiskSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAllocatedDiskSpaceRequest) ProtoMessage()    {}
+func (*UpdateAllocatedDiskSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{8}
+}
+func (m *UpdateAllocatedDiskSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAllocatedDiskSpaceRequest.Unmarshal(m, b)
+}
+func (m *UpdateAllocatedDiskSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAllocatedDiskSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateAllocatedDiskSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAllocatedDiskSpaceRequest.Merge(m, src)
+}
+func (m *UpdateAllocatedDiskSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateAllocatedDiskSpaceRequest.Size(m)
+}
+func (m *UpdateAllocatedDiskSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAllocatedDiskSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAllocatedDiskSpaceRequest proto.InternalMessageInfo
+
+func (m *UpdateAllocatedDiskSpaceRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *UpdateAllocatedDiskSpaceRequest) GetAllocated() int64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+type UpdateAllocatedDiskSpaceResponse struct {
+	Allocated            int64    `protobuf:"varint,1,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateAllocatedDiskSpaceResponse) Reset()         { *m = UpdateAllocatedDiskSpaceResponse{} }
+func (m *UpdateAllocatedDiskSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAllocatedDiskSpaceResponse) ProtoMessage()    {}
+func (*UpdateAllocatedDiskSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{9}
+}
+func (m *UpdateAllocatedDiskSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAllocatedDiskSpaceResponse.Unmarshal(m, b)
+}
+func (m *UpdateAllocatedDiskSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAllocatedDiskSpaceResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateAllocatedDiskSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAllocatedDiskSpaceResponse.Merge(m, src)
+}
+func (m *UpdateAllocatedDiskSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateAllocatedDiskSpaceResponse.Size(m)
+}
+func (m *UpdateAllocatedDiskSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAllocatedDiskSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAllocatedDiskSpaceResponse proto.InternalMessageInfo
+
+func (m *UpdateAllocatedDiskSpaceResponse) GetAllocated() int64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+type RecalculateUsedSpaceRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RecalculateUsedSpaceRequest) Reset()         { *m = RecalculateUsedSpaceRequest{} }
+func (m *RecalculateUsedSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*RecalculateUsedSpaceRequest) ProtoMessage()    {}
+func (*RecalculateUsedSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{10}
+}
+func (m *RecalculateUsedSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecalculateUsedSpaceRequest.Unmarshal(m, b)
+}
+func (m *RecalculateUsedSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecalculateUsedSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *RecalculateUsedSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecalculateUsedSpaceRequest.Merge(m, src)
+}
+func (m *RecalculateUsedSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_RecalculateUsedSpaceRequest.Size(m)
+}
+func (m *RecalculateUsedSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecalculateUsedSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecalculateUsedSpaceRequest proto.InternalMessageInfo
+
+func (m *RecalculateUsedSpaceRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type RecalculateUsedSpaceResponse struct {
+	// Started is false when a recalculation was already pending.
+	Started              bool     `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecalculateUsedSpaceResponse) Reset()         { *m = RecalculateUsedSpaceResponse{} }
+func (m *RecalculateUsedSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*RecalculateUsedSpaceResponse) ProtoMessage()    {}
+func (*RecalculateUsedSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{11}
+}
+func (m *RecalculateUsedSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecalculateUsedSpaceResponse.Unmarshal(m, b)
+}
+func (m *RecalculateUsedSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecalculateUsedSpaceResponse.Marshal(b, m, deterministic)
+}
+func (m *RecalculateUsedSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecalculateUsedSpaceResponse.Merge(m, src)
+}
+func (m *RecalculateUsedSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_RecalculateUsedSpaceResponse.Size(m)
+}
+func (m *RecalculateUsedSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecalculateUsedSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecalculateUsedSpaceResponse proto.InternalMessageInfo
+
+func (m *RecalculateUsedSpaceResponse) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
 type BandwidthMonthSummaryRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -459,7 +622,7 @@ func (m *BandwidthMonthSummaryRequest) Reset()         { *m = BandwidthMonthSumm
 func (m *BandwidthMonthSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*BandwidthMonthSummaryRequest) ProtoMessage()    {}
 func (*BandwidthMonthSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{8}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{12}
 }
 func (m *BandwidthMonthSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BandwidthMonthSummaryRequest.Unmarshal(m, b)
@@ -497,7 +660,7 @@ func (m *BandwidthMonthSummaryResponse) Reset()         { *m = BandwidthMonthSum
 func (m *BandwidthMonthSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*BandwidthMonthSummaryResponse) ProtoMessage()    {}
 func (*BandwidthMonthSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{9}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{13}
 }
 func (m *BandwidthMonthSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BandwidthMonthSummaryResponse.Unmarshal(m, b)
@@ -536,7 +699,7 @@ func (m *BandwidthSummarySatelliteRequest) Reset()         { *m = BandwidthSumma
 func (m *BandwidthSummarySatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*BandwidthSummarySatelliteRequest) ProtoMessage()    {}
 func (*BandwidthSummarySatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{10}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{14}
 }
 func (m *BandwidthSummarySatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BandwidthSummarySatelliteRequest.Unmarshal(m, b)
@@ -574,7 +737,7 @@ func (m *BandwidthSummarySatelliteResponse) Reset()         { *m = BandwidthSumm
 func (m *BandwidthSummarySatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*BandwidthSummarySatelliteResponse) ProtoMessage()    {}
 func (*BandwidthSummarySatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{11}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{15}
 }
 func (m *BandwidthSummarySatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BandwidthSummarySatelliteResponse.Unmarshal(m, b)
@@ -612,7 +775,7 @@ func (m *BandwidthSummaryRequest) Reset()         { *m = BandwidthSummaryRequest
 func (m *BandwidthSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*BandwidthSummaryRequest) ProtoMessage()    {}
 func (*BandwidthSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{12}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{16}
 }
 func (m *BandwidthSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BandwidthSummaryRequest.Unmarshal(m, b)
@@ -650,7 +813,7 @@ func (m *BandwidthSummaryResponse) Reset()         { *m = BandwidthSummaryRespon
 func (m *BandwidthSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*BandwidthSummaryResponse) ProtoMessage()    {}
 func (*BandwidthSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{13}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{17}
 }
 func (m *BandwidthSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BandwidthSummaryResponse.Unmarshal(m, b)
@@ -689,7 +852,7 @@ func (m *EgressSummarySatelliteRequest) Reset()         { *m = EgressSummarySate
 func (m *EgressSummarySatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EgressSummarySatelliteRequest) ProtoMessage()    {}
 func (*EgressSummarySatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{14}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{18}
 }
 func (m *EgressSummarySatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EgressSummarySatelliteRequest.Unmarshal(m, b)
@@ -727,7 +890,7 @@ func (m *EgressSummarySatelliteResponse) Reset()         { *m = EgressSummarySat
 func (m *EgressSummarySatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EgressSummarySatelliteResponse) ProtoMessage()    {}
 func (*EgressSummarySatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{15}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{19}
 }
 func (m *EgressSummarySatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EgressSummarySatelliteResponse.Unmarshal(m, b)
@@ -765,7 +928,7 @@ func (m *EgressSummaryRequest) Reset()         { *m = EgressSummaryRequest{} }
 func (m *EgressSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*EgressSummaryRequest) ProtoMessage()    {}
 func (*EgressSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{16}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{20}
 }
 func (m *EgressSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EgressSummaryRequest.Unmarshal(m, b)
@@ -803,7 +966,7 @@ func (m *EgressSummaryResponse) Reset()         { *m = EgressSummaryResponse{} }
 func (m *EgressSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*EgressSummaryResponse) ProtoMessage()    {}
 func (*EgressSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{17}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{21}
 }
 func (m *EgressSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EgressSummaryResponse.Unmarshal(m, b)
@@ -842,7 +1005,7 @@ func (m *IngressSummarySatelliteRequest) Reset()         { *m = IngressSummarySa
 func (m *IngressSummarySatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*IngressSummarySatelliteRequest) ProtoMessage()    {}
 func (*IngressSummarySatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{18}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{22}
 }
 func (m *IngressSummarySatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IngressSummarySatelliteRequest.Unmarshal(m, b)
@@ -880,7 +1043,7 @@ func (m *IngressSummarySatelliteResponse) Reset()         { *m = IngressSummaryS
 func (m *IngressSummarySatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*IngressSummarySatelliteResponse) ProtoMessage()    {}
 func (*IngressSummarySatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{19}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{23}
 }
 func (m *IngressSummarySatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IngressSummarySatelliteResponse.Unmarshal(m, b)
@@ -918,7 +1081,7 @@ func (m *IngressSummaryRequest) Reset()         { *m = IngressSummaryRequest{} }
 func (m *IngressSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*IngressSummaryRequest) ProtoMessage()    {}
 func (*IngressSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{20}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{24}
 }
 func (m *IngressSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IngressSummaryRequest.Unmarshal(m, b)
@@ -956,7 +1119,7 @@ func (m *IngressSummaryResponse) Reset()         { *m = IngressSummaryResponse{}
 func (m *IngressSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*IngressSummaryResponse) ProtoMessage()    {}
 func (*IngressSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{21}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{25}
 }
 func (m *IngressSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IngressSummaryResponse.Unmarshal(m, b)
@@ -995,7 +1158,7 @@ func (m *DailySatelliteRequest) Reset()         { *m = DailySatelliteRequest{} }
 func (m *DailySatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*DailySatelliteRequest) ProtoMessage()    {}
 func (*DailySatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{22}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{26}
 }
 func (m *DailySatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DailySatelliteRequest.Unmarshal(m, b)
@@ -1033,7 +1196,7 @@ func (m *DailySatelliteResponse) Reset()         { *m = DailySatelliteResponse{}
 func (m *DailySatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*DailySatelliteResponse) ProtoMessage()    {}
 func (*DailySatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{23}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{27}
 }
 func (m *DailySatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DailySatelliteResponse.Unmarshal(m, b)
@@ -1071,7 +1234,7 @@ func (m *DailyRequest) Reset()         { *m = DailyRequest{} }
 func (m *DailyRequest) String() string { return proto.CompactTextString(m) }
 func (*DailyRequest) ProtoMessage()    {}
 func (*DailyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{24}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{28}
 }
 func (m *DailyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DailyRequest.Unmarshal(m, b)
@@ -1109,7 +1272,7 @@ func (m *DailyResponse) Reset()         { *m = DailyResponse{} }
 func (m *DailyResponse) String() string { return proto.CompactTextString(m) }
 func (*DailyResponse) ProtoMessage()    {}
 func (*DailyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{25}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{29}
 }
 func (m *DailyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DailyResponse.Unmarshal(m, b)
@@ -1150,7 +1313,7 @@ func (m *UsageRollup) Reset()         { *m = UsageRollup{} }
 func (m *UsageRollup) String() string { return proto.CompactTextString(m) }
 func (*UsageRollup) ProtoMessage()    {}
 func (*UsageRollup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{26}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{30}
 }
 func (m *UsageRollup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsageRollup.Unmarshal(m, b)
@@ -1211,7 +1374,7 @@ func (m *Egress) Reset()         { *m = Egress{} }
 func (m *Egress) String() string { return proto.CompactTextString(m) }
 func (*Egress) ProtoMessage()    {}
 func (*Egress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{27}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{31}
 }
 func (m *Egress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Egress.Unmarshal(m, b)
@@ -1264,7 +1427,7 @@ func (m *Ingress) Reset()         { *m = Ingress{} }
 func (m *Ingress) String() string { return proto.CompactTextString(m) }
 func (*Ingress) ProtoMessage()    {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{28}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{32}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ingress.Unmarshal(m, b)
@@ -1309,7 +1472,7 @@ func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
 func (m *VersionRequest) String() string { return proto.CompactTextString(m) }
 func (*VersionRequest) ProtoMessage()    {}
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{29}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{33}
 }
 func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionRequest.Unmarshal(m, b)
@@ -1347,7 +1510,7 @@ func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
 func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()    {}
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{30}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{34}
 }
 func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionResponse.Unmarshal(m, b)
@@ -1385,7 +1548,7 @@ func (m *LastContactRequest) Reset()         { *m = LastContactRequest{} }
 func (m *LastContactRequest) String() string { return proto.CompactTextString(m) }
 func (*LastContactRequest) ProtoMessage()    {}
 func (*LastContactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{31}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{35}
 }
 func (m *LastContactRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LastContactRequest.Unmarshal(m, b)
@@ -1423,7 +1586,7 @@ func (m *LastContactResponse) Reset()         { *m = LastContactResponse{} }
 func (m *LastContactResponse) String() string { return proto.CompactTextString(m) }
 func (*LastContactResponse) ProtoMessage()    {}
 func (*LastContactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{32}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{36}
 }
 func (m *LastContactResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LastContactResponse.Unmarshal(m, b)
@@ -1462,7 +1625,7 @@ func (m *ReputationRequest) Reset()         { *m = ReputationRequest{} }
 func (m *ReputationRequest) String() string { return proto.CompactTextString(m) }
 func (*ReputationRequest) ProtoMessage()    {}
 func (*ReputationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{33}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{37}
 }
 func (m *ReputationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReputationRequest.Unmarshal(m, b)
@@ -1502,7 +1665,7 @@ func (m *AuditWindow) Reset()         { *m = AuditWindow{} }
 func (m *AuditWindow) String() string { return proto.CompactTextString(m) }
 func (*AuditWindow) ProtoMessage()    {}
 func (*AuditWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{34}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{38}
 }
 func (m *AuditWindow) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuditWindow.Unmarshal(m, b)
@@ -1562,7 +1725,7 @@ func (m *ReputationResponse) Reset()         { *m = ReputationResponse{} }
 func (m *ReputationResponse) String() string { return proto.CompactTextString(m) }
 func (*ReputationResponse) ProtoMessage()    {}
 func (*ReputationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{35}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{39}
 }
 func (m *ReputationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReputationResponse.Unmarshal(m, b)
@@ -1656,7 +1819,7 @@ func (m *ReputationResponse_Online) Reset()         { *m = ReputationResponse_On
 func (m *ReputationResponse_Online) String() string { return proto.CompactTextString(m) }
 func (*ReputationResponse_Online) ProtoMessage()    {}
 func (*ReputationResponse_Online) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{35, 0}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{39, 0}
 }
 func (m *ReputationResponse_Online) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReputationResponse_Online.Unmarshal(m, b)
@@ -1702,7 +1865,7 @@ func (m *ReputationResponse_Audit) Reset()         { *m = ReputationResponse_Aud
 func (m *ReputationResponse_Audit) String() string { return proto.CompactTextString(m) }
 func (*ReputationResponse_Audit) ProtoMessage()    {}
 func (*ReputationResponse_Audit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{35, 1}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{39, 1}
 }
 func (m *ReputationResponse_Audit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReputationResponse_Audit.Unmarshal(m, b)
@@ -1796,7 +1959,7 @@ func (m *TrustedSatellitesRequest) Reset()         { *m = TrustedSatellitesReque
 func (m *TrustedSatellitesRequest) String() string { return proto.CompactTextString(m) }
 func (*TrustedSatellitesRequest) ProtoMessage()    {}
 func (*TrustedSatellitesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{36}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{40}
 }
 func (m *TrustedSatellitesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrustedSatellitesRequest.Unmarshal(m, b)
@@ -1834,7 +1997,7 @@ func (m *TrustedSatellitesResponse) Reset()         { *m = TrustedSatellitesResp
 func (m *TrustedSatellitesResponse) String() string { return proto.CompactTextString(m) }
 func (*TrustedSatellitesResponse) ProtoMessage()    {}
 func (*TrustedSatellitesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{37}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{41}
 }
 func (m *TrustedSatellitesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrustedSatellitesResponse.Unmarshal(m, b)
@@ -1873,7 +2036,7 @@ func (m *TrustedSatellitesResponse_NodeURL) Reset()         { *m = TrustedSatell
 func (m *TrustedSatellitesResponse_NodeURL) String() string { return proto.CompactTextString(m) }
 func (*TrustedSatellitesResponse_NodeURL) ProtoMessage()    {}
 func (*TrustedSatellitesResponse_NodeURL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{37, 0}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{41, 0}
 }
 func (m *TrustedSatellitesResponse_NodeURL) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrustedSatellitesResponse_NodeURL.Unmarshal(m, b)
@@ -1911,7 +2074,7 @@ func (m *OperatorRequest) Reset()         { *m = OperatorRequest{} }
 func (m *OperatorRequest) String() string { return proto.CompactTextString(m) }
 func (*OperatorRequest) ProtoMessage()    {}
 func (*OperatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{38}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{42}
 }
 func (m *OperatorRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OperatorRequest.Unmarshal(m, b)
@@ -1951,7 +2114,7 @@ func (m *OperatorResponse) Reset()         { *m = OperatorResponse{} }
 func (m *OperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*OperatorResponse) ProtoMessage()    {}
 func (*OperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{39}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{43}
 }
 func (m *OperatorResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OperatorResponse.Unmarshal(m, b)
@@ -1992,6 +2155,84 @@ func (m *OperatorResponse) GetWalletFeatures() []string {
 	return nil
 }
 
+type ForgetSatelliteRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ForgetSatelliteRequest) Reset()         { *m = ForgetSatelliteRequest{} }
+func (m *ForgetSatelliteRequest) String() string { return proto.CompactTextString(m) }
+func (*ForgetSatelliteRequest) ProtoMessage()    {}
+func (*ForgetSatelliteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{44}
+}
+func (m *ForgetSatelliteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForgetSatelliteRequest.Unmarshal(m, b)
+}
+func (m *ForgetSatelliteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForgetSatelliteRequest.Marshal(b, m, deterministic)
+}
+func (m *ForgetSatelliteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForgetSatelliteRequest.Merge(m, src)
+}
+func (m *ForgetSatelliteRequest) XXX_Size() int {
+	return xxx_messageInfo_ForgetSatelliteRequest.Size(m)
+}
+func (m *ForgetSatelliteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForgetSatelliteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForgetSatelliteRequest proto.InternalMessageInfo
+
+func (m *ForgetSatelliteRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type ForgetSatelliteResponse struct {
+	// InProgress is true when the data of the satellite is still being removed.
+	InProgress           bool     `protobuf:"varint,1,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForgetSatelliteResponse) Reset()         { *m = ForgetSatelliteResponse{} }
+func (m *ForgetSatelliteResponse) String() string { return proto.CompactTextString(m) }
+func (*ForgetSatelliteResponse) ProtoMessage()    {}
+func (*ForgetSatelliteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{45}
+}
+func (m *ForgetSatelliteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForgetSatelliteResponse.Unmarshal(m, b)
+}
+func (m *ForgetSatelliteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForgetSatelliteResponse.Marshal(b, m, deterministic)
+}
+func (m *ForgetSatelliteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForgetSatelliteResponse.Merge(m, src)
+}
+func (m *ForgetSatelliteResponse) XXX_Size() int {
+	return xxx_messageInfo_ForgetSatelliteResponse.Size(m)
+}
+func (m *ForgetSatelliteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForgetSatelliteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForgetSatelliteResponse proto.InternalMessageInfo
+
+func (m *ForgetSatelliteResponse) GetInProgress() bool {
+	if m != nil {
+		return m.InProgress
+	}
+	return false
+}
+
 type EstimatedPayoutSatelliteRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
@@ -2004,7 +2245,7 @@ func (m *EstimatedPayoutSatelliteRequest) Reset()         { *m = EstimatedPayout
 func (m *EstimatedPayoutSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutSatelliteRequest) ProtoMessage()    {}
 func (*EstimatedPayoutSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{40}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{46}
 }
 func (m *EstimatedPayoutSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutSatelliteRequest.Unmarshal(m, b)
@@ -2042,7 +2283,7 @@ func (m *EstimatedPayoutSatelliteResponse) Reset()         { *m = EstimatedPayou
 func (m *EstimatedPayoutSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutSatelliteResponse) ProtoMessage()    {}
 func (*EstimatedPayoutSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{41}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{47}
 }
 func (m *EstimatedPayoutSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutSatelliteResponse.Unmarshal(m, b)
@@ -2080,7 +2321,7 @@ func (m *EstimatedPayoutRequest) Reset()         { *m = EstimatedPayoutRequest{}
 func (m *EstimatedPayoutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutRequest) ProtoMessage()    {}
 func (*EstimatedPayoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{42}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{48}
 }
 func (m *EstimatedPayoutRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutRequest.Unmarshal(m, b)
@@ -2118,7 +2359,7 @@ func (m *EstimatedPayoutResponse) Reset()         { *m = EstimatedPayoutResponse
 func (m *EstimatedPayoutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutResponse) ProtoMessage()    {}
 func (*EstimatedPayoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{43}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{49}
 }
 func (m *EstimatedPayoutResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutResponse.Unmarshal(m, b)
@@ -2156,7 +2397,7 @@ func (m *SummaryRequest) Reset()         { *m = SummaryRequest{} }
 func (m *SummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*SummaryRequest) ProtoMessage()    {}
 func (*SummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{44}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{50}
 }
 func (m *SummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryRequest.Unmarshal(m, b)
@@ -2194,7 +2435,7 @@ func (m *SummaryResponse) Reset()         { *m = SummaryResponse{} }
 func (m *SummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*SummaryResponse) ProtoMessage()    {}
 func (*SummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{45}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{51}
 }
 func (m *SummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryResponse.Unmarshal(m, b)
@@ -2233,7 +2474,7 @@ func (m *SummaryPeriodRequest) Reset()         { *m = SummaryPeriodRequest{} }
 func (m *SummaryPeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*SummaryPeriodRequest) ProtoMessage()    {}
 func (*SummaryPeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{46}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{52}
 }
 func (m *SummaryPeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryPeriodRequest.Unmarshal(m, b)
@@ -2278,7 +2519,7 @@ func (m *SummaryPeriodResponse) Reset()         { *m = SummaryPeriodResponse{} }
 func (m *SummaryPeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*SummaryPeriodResponse) ProtoMessage()    {}
 func (*SummaryPeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{47}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{53}
 }
 func (m *SummaryPeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryPeriodResponse.Unmarshal(m, b)
@@ -2317,7 +2558,7 @@ func (m *SummarySatelliteRequest) Reset()         { *m = SummarySatelliteRequest
 func (m *SummarySatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*SummarySatelliteRequest) ProtoMessage()    {}
 func (*SummarySatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{48}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{54}
 }
 func (m *SummarySatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatelliteRequest.Unmarshal(m, b)
@@ -2355,7 +2596,7 @@ func (m *SummarySatelliteResponse) Reset()         { *m = SummarySatelliteRespon
 func (m *SummarySatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*SummarySatelliteResponse) ProtoMessage()    {}
 func (*SummarySatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{49}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{55}
 }
 func (m *SummarySatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatelliteResponse.Unmarshal(m, b)
@@ -2395,7 +2636,7 @@ func (m *SummarySatellitePeriodRequest) Reset()         { *m = SummarySatelliteP
 func (m *SummarySatellitePeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*SummarySatellitePeriodRequest) ProtoMessage()    {}
 func (*SummarySatellitePeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{50}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{56}
 }
 func (m *SummarySatellitePeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatellitePeriodRequest.Unmarshal(m, b)
@@ -2440,7 +2681,7 @@ func (m *SummarySatellitePeriodResponse) Reset()         { *m = SummarySatellite
 func (m *SummarySatellitePeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*SummarySatellitePeriodResponse) ProtoMessage()    {}
 func (*SummarySatellitePeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{51}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{57}
 }
 func (m *SummarySatellitePeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatellitePeriodResponse.Unmarshal(m, b)
@@ -2478,7 +2719,7 @@ func (m *EarnedRequest) Reset()         { *m = EarnedRequest{} }
 func (m *EarnedRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedRequest) ProtoMessage()    {}
 func (*EarnedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{52}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{58}
 }
 func (m *EarnedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedRequest.Unmarshal(m, b)
@@ -2516,7 +2757,7 @@ func (m *EarnedResponse) Reset()         { *m = EarnedResponse{} }
 func (m *EarnedResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedResponse) ProtoMessage()    {}
 func (*EarnedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{53}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{59}
 }
 func (m *EarnedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedResponse.Unmarshal(m, b)
@@ -2554,7 +2795,7 @@ func (m *EarnedSatelliteRequest) Reset()         { *m = EarnedSatelliteRequest{}
 func (m *EarnedSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatelliteRequest) ProtoMessage()    {}
 func (*EarnedSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{54}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{60}
 }
 func (m *EarnedSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatelliteRequest.Unmarshal(m, b)
@@ -2592,7 +2833,7 @@ func (m *EarnedSatelliteResponse) Reset()         { *m = EarnedSatelliteResponse
 func (m *EarnedSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatelliteResponse) ProtoMessage()    {}
 func (*EarnedSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{55}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{61}
 }
 func (m *EarnedSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatelliteResponse.Unmarshal(m, b)
@@ -2631,7 +2872,7 @@ func (m *EarnedSatellite) Reset()         { *m = EarnedSatellite{} }
 func (m *EarnedSatellite) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatellite) ProtoMessage()    {}
 func (*EarnedSatellite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{56}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{62}
 }
 func (m *EarnedSatellite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatellite.Unmarshal(m, b)
@@ -2669,7 +2910,7 @@ func (m *UndistributedRequest) Reset()         { *m = UndistributedRequest{} }
 func (m *UndistributedRequest) String() string { return proto.CompactTextString(m) }
 func (*UndistributedRequest) ProtoMessage()    {}
 func (*UndistributedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{57}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{63}
 }
 func (m *UndistributedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UndistributedRequest.Unmarshal(m, b)
@@ -2707,7 +2948,7 @@ func (m *UndistributedResponse) Reset()         { *m = UndistributedResponse{} }
 func (m *UndistributedResponse) String() string { return proto.CompactTextString(m) }
 func (*UndistributedResponse) ProtoMessage()    {}
 func (*UndistributedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{58}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{64}
 }
 func (m *UndistributedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UndistributedResponse.Unmarshal(m, b)
@@ -2746,7 +2987,7 @@ func (m *PaystubSatelliteRequest) Reset()         { *m = PaystubSatelliteRequest
 func (m *PaystubSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatelliteRequest) ProtoMessage()    {}
 func (*PaystubSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{59}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{65}
 }
 func (m *PaystubSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatelliteRequest.Unmarshal(m, b)
@@ -2784,7 +3025,7 @@ func (m *PaystubSatelliteResponse) Reset()         { *m = PaystubSatelliteRespon
 func (m *PaystubSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatelliteResponse) ProtoMessage()    {}
 func (*PaystubSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{60}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{66}
 }
 func (m *PaystubSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatelliteResponse.Unmarshal(m, b)
@@ -2822,7 +3063,7 @@ func (m *PaystubRequest) Reset()         { *m = PaystubRequest{} }
 func (m *PaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubRequest) ProtoMessage()    {}
 func (*PaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{61}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{67}
 }
 func (m *PaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubRequest.Unmarshal(m, b)
@@ -2860,7 +3101,7 @@ func (m *PaystubResponse) Reset()         { *m = PaystubResponse{} }
 func (m *PaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubResponse) ProtoMessage()    {}
 func (*PaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{62}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{68}
 }
 func (m *PaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubResponse.Unmarshal(m, b)
@@ -2899,7 +3140,7 @@ func (m *PaystubPeriodRequest) Reset()         { *m = PaystubPeriodRequest{} }
 func (m *PaystubPeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubPeriodRequest) ProtoMessage()    {}
 func (*PaystubPeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{63}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{69}
 }
 func (m *PaystubPeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubPeriodRequest.Unmarshal(m, b)
@@ -2944,7 +3185,7 @@ func (m *PaystubPeriodResponse) Reset()         { *m = PaystubPeriodResponse{} }
 func (m *PaystubPeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubPeriodResponse) ProtoMessage()    {}
 func (*PaystubPeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{64}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{70}
 }
 func (m *PaystubPeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubPeriodResponse.Unmarshal(m, b)
@@ -2984,7 +3225,7 @@ func (m *PaystubSatellitePeriodRequest) Reset()         { *m = PaystubSatelliteP
 func (m *PaystubSatellitePeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatellitePeriodRequest) ProtoMessage()    {}
 func (*PaystubSatellitePeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{65}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{71}
 }
 func (m *PaystubSatellitePeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatellitePeriodRequest.Unmarshal(m, b)
@@ -3029,7 +3270,7 @@ func (m *PaystubSatellitePeriodResponse) Reset()         { *m = PaystubSatellite
 func (m *PaystubSatellitePeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatellitePeriodResponse) ProtoMessage()    {}
 func (*PaystubSatellitePeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{66}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{72}
 }
 func (m *PaystubSatellitePeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatellitePeriodResponse.Unmarshal(m, b)
@@ -3068,7 +3309,7 @@ func (m *PayoutInfo) Reset()         { *m = PayoutInfo{} }
 func (m *PayoutInfo) String() string { return proto.CompactTextString(m) }
 func (*PayoutInfo) ProtoMessage()    {}
 func (*PayoutInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{67}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{73}
 }
 func (m *PayoutInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayoutInfo.Unmarshal(m, b)
@@ -3124,7 +3365,7 @@ func (m *Paystub) Reset()         { *m = Paystub{} }
 func (m *Paystub) String() string { return proto.CompactTextString(m) }
 func (*Paystub) ProtoMessage()    {}
 func (*Paystub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{68}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{74}
 }
 func (m *Paystub) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Paystub.Unmarshal(m, b)
@@ -3239,7 +3480,7 @@ func (m *HeldAmountHistoryRequest) Reset()         { *m = HeldAmountHistoryReque
 func (m *HeldAmountHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*HeldAmountHistoryRequest) ProtoMessage()    {}
 func (*HeldAmountHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{69}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{75}
 }
 func (m *HeldAmountHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryRequest.Unmarshal(m, b)
@@ -3277,7 +3518,7 @@ func (m *HeldAmountHistoryResponse) Reset()         { *m = HeldAmountHistoryResp
 func (m *HeldAmountHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*HeldAmountHistoryResponse) ProtoMessage()    {}
 func (*HeldAmountHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{70}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{76}
 }
 func (m *HeldAmountHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryResponse.Unmarshal(m, b)
@@ -3316,7 +3557,7 @@ func (m *HeldAmountHistoryResponse_HeldAmount) Reset()         { *m = HeldAmount
 func (m *HeldAmountHistoryResponse_HeldAmount) String() string { return proto.CompactTextString(m) }
 func (*HeldAmountHistoryResponse_HeldAmount) ProtoMessage()    {}
 func (*HeldAmountHistoryResponse_HeldAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{70, 0}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{76, 0}
 }
 func (m *HeldAmountHistoryResponse_HeldAmount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryResponse_HeldAmount.Unmarshal(m, b)
@@ -3366,7 +3607,7 @@ func (m *HeldAmountHistoryResponse_HeldAmountHistory) String() string {
 }
 func (*HeldAmountHistoryResponse_HeldAmountHistory) ProtoMessage() {}
 func (*HeldAmountHistoryResponse_HeldAmountHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{70, 1}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{76, 1}
 }
 func (m *HeldAmountHistoryResponse_HeldAmountHistory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryResponse_HeldAmountHistory.Unmarshal(m, b)
@@ -3404,7 +3645,7 @@ func (m *EstimatedPayoutTotalRequest) Reset()         { *m = EstimatedPayoutTota
 func (m *EstimatedPayoutTotalRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutTotalRequest) ProtoMessage()    {}
 func (*EstimatedPayoutTotalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{71}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{77}
 }
 func (m *EstimatedPayoutTotalRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutTotalRequest.Unmarshal(m, b)
@@ -3442,7 +3683,7 @@ func (m *EstimatedPayoutTotalResponse) Reset()         { *m = EstimatedPayoutTot
 func (m *EstimatedPayoutTotalResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutTotalResponse) ProtoMessage()    {}
 func (*EstimatedPayoutTotalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{72}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{78}
 }
 func (m *EstimatedPayoutTotalResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutTotalResponse.Unmarshal(m, b)
@@ -3480,7 +3721,7 @@ func (m *AllSatellitesSummaryRequest) Reset()         { *m = AllSatellitesSummar
 func (m *AllSatellitesSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesSummaryRequest) ProtoMessage()    {}
 func (*AllSatellitesSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{73}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{79}
 }
 func (m *AllSatellitesSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesSummaryRequest.Unmarshal(m, b)
@@ -3518,7 +3759,7 @@ func (m *AllSatellitesSummaryResponse) Reset()         { *m = AllSatellitesSumma
 func (m *AllSatellitesSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesSummaryResponse) ProtoMessage()    {}
 func (*AllSatellitesSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{74}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{80}
 }
 func (m *AllSatellitesSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesSummaryResponse.Unmarshal(m, b)
@@ -3557,7 +3798,7 @@ func (m *AllSatellitesPeriodSummaryRequest) Reset()         { *m = AllSatellites
 func (m *AllSatellitesPeriodSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesPeriodSummaryRequest) ProtoMessage()    {}
 func (*AllSatellitesPeriodSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{75}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{81}
 }
 func (m *AllSatellitesPeriodSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesPeriodSummaryRequest.Unmarshal(m, b)
@@ -3602,7 +3843,7 @@ func (m *AllSatellitesPeriodSummaryResponse) Reset()         { *m = AllSatellite
 func (m *AllSatellitesPeriodSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesPeriodSummaryResponse) ProtoMessage()    {}
 func (*AllSatellitesPeriodSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{76}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{82}
 }
 func (m *AllSatellitesPeriodSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesPeriodSummaryResponse.Unmarshal(m, b)
@@ -3641,7 +3882,7 @@ func (m *SatelliteSummaryRequest) Reset()         { *m = SatelliteSummaryRequest
 func (m *SatelliteSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*SatelliteSummaryRequest) ProtoMessage()    {}
 func (*SatelliteSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{77}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{83}
 }
 func (m *SatelliteSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatelliteSummaryRequest.Unmarshal(m, b)
@@ -3679,7 +3920,7 @@ func (m *SatelliteSummaryResponse) Reset()         { *m = SatelliteSummaryRespon
 func (m *SatelliteSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*SatelliteSummaryResponse) ProtoMessage()    {}
 func (*SatelliteSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{78}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{84}
 }
 func (m *SatelliteSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatelliteSummaryResponse.Unmarshal(m, b)
@@ -3719,7 +3960,7 @@ func (m *SatellitePeriodSummaryRequest) Reset()         { *m = SatellitePeriodSu
 func (m *SatellitePeriodSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodSummaryRequest) ProtoMessage()    {}
 func (*SatellitePeriodSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{79}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{85}
 }
 func (m *SatellitePeriodSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodSummaryRequest.Unmarshal(m, b)
@@ -3764,7 +4005,7 @@ func (m *SatellitePeriodSummaryResponse) Reset()         { *m = SatellitePeriodS
 func (m *SatellitePeriodSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodSummaryResponse) ProtoMessage()    {}
 func (*SatellitePeriodSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{80}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{86}
 }
 func (m *SatellitePeriodSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodSummaryResponse.Unmarshal(m, b)
@@ -3802,7 +4043,7 @@ func (m *EarnedPerSatelliteRequest) Reset()         { *m = EarnedPerSatelliteReq
 func (m *EarnedPerSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedPerSatelliteRequest) ProtoMessage()    {}
 func (*EarnedPerSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{81}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{87}
 }
 func (m *EarnedPerSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedPerSatelliteRequest.Unmarshal(m, b)
@@ -3840,7 +4081,7 @@ func (m *EarnedPerSatelliteResponse) Reset()         { *m = EarnedPerSatelliteRe
 func (m *EarnedPerSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedPerSatelliteResponse) ProtoMessage()    {}
 func (*EarnedPerSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{82}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{88}
 }
 func (m *EarnedPerSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedPerSatelliteResponse.Unmarshal(m, b)
@@ -3879,7 +4120,7 @@ func (m *SatellitePaystubRequest) Reset()         { *m = SatellitePaystubRequest
 func (m *SatellitePaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*SatellitePaystubRequest) ProtoMessage()    {}
 func (*SatellitePaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{83}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{89}
 }
 func (m *SatellitePaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePaystubRequest.Unmarshal(m, b)
@@ -3917,7 +4158,7 @@ func (m *SatellitePaystubResponse) Reset()         { *m = SatellitePaystubRespon
 func (m *SatellitePaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*SatellitePaystubResponse) ProtoMessage()    {}
 func (*SatellitePaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{84}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{90}
 }
 func (m *SatellitePaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePaystubResponse.Unmarshal(m, b)
@@ -3956,7 +4197,7 @@ func (m *PeriodPaystubRequest) Reset()         { *m = PeriodPaystubRequest{} }
 func (m *PeriodPaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*PeriodPaystubRequest) ProtoMessage()    {}
 func (*PeriodPaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{85}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{91}
 }
 func (m *PeriodPaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeriodPaystubRequest.Unmarshal(m, b)
@@ -4001,7 +4242,7 @@ func (m *PeriodPaystubResponse) Reset()         { *m = PeriodPaystubResponse{} }
 func (m *PeriodPaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*PeriodPaystubResponse) ProtoMessage()    {}
 func (*PeriodPaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{86}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{92}
 }
 func (m *PeriodPaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeriodPaystubResponse.Unmarshal(m, b)
@@ -4041,7 +4282,7 @@ func (m *SatellitePeriodPaystubRequest) Reset()         { *m = SatellitePeriodPa
 func (m *SatellitePeriodPaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodPaystubRequest) ProtoMessage()    {}
 func (*SatellitePeriodPaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{87}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{93}
 }
 func (m *SatellitePeriodPaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodPaystubRequest.Unmarshal(m, b)
@@ -4086,7 +4327,7 @@ func (m *SatellitePeriodPaystubResponse) Reset()         { *m = SatellitePeriodP
 func (m *SatellitePeriodPaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodPaystubResponse) ProtoMessage()    {}
 func (*SatellitePeriodPaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{88}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{94}
 }
 func (m *SatellitePeriodPaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodPaystubResponse.Unmarshal(m, b)
@@ -4113,6 +4354,222 @@ func (m *SatellitePeriodPaystubResponse) GetPaystub() *Paystub {
 	return nil
 }
 
+type ExitProgress struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	DomainName           string   `protobuf:"bytes,2,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	PercentComplete      float32  `protobuf:"fixed32,3,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	Successful           bool     `protobuf:"varint,4,opt,name=successful,proto3" json:"successful,omitempty"`
+	CompletionReceipt    []byte   `protobuf:"bytes,5,opt,name=completion_receipt,json=completionReceipt,proto3" json:"completion_receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExitProgress) Reset()         { *m = ExitProgress{} }
+func (m *ExitProgress) String() string { return proto.CompactTextString(m) }
+func (*ExitProgress) ProtoMessage()    {}
+func (*ExitProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{95}
+}
+func (m *ExitProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitProgress.Unmarshal(m, b)
+}
+func (m *ExitProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitProgress.Marshal(b, m, deterministic)
+}
+func (m *ExitProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitProgress.Merge(m, src)
+}
+func (m *ExitProgress) XXX_Size() int {
+	return xxx_messageInfo_ExitProgress.Size(m)
+}
+func (m *ExitProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitProgress proto.InternalMessageInfo
+
+func (m *ExitProgress) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *ExitProgress) GetPercentComplete() float32 {
+	if m != nil {
+		return m.PercentComplete
+	}
+	return 0
+}
+
+func (m *ExitProgress) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+func (m *ExitProgress) GetCompletionReceipt() []byte {
+	if m != nil {
+		return m.CompletionReceipt
+	}
+	return nil
+}
+
+type InitiateGracefulExitRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InitiateGracefulExitRequest) Reset()         { *m = InitiateGracefulExitRequest{} }
+func (m *InitiateGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitRequest) ProtoMessage()    {}
+func (*InitiateGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{96}
+}
+func (m *InitiateGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitRequest.Merge(m, src)
+}
+func (m *InitiateGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Size(m)
+}
+func (m *InitiateGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitRequest proto.InternalMessageInfo
+
+func (m *InitiateGracefulExitRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type InitiateGracefulExitResponse struct {
+	Progress             *ExitProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *InitiateGracefulExitResponse) Reset()         { *m = InitiateGracefulExitResponse{} }
+func (m *InitiateGracefulExitResponse) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitResponse) ProtoMessage()    {}
+func (*InitiateGracefulExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{97}
+}
+func (m *InitiateGracefulExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitResponse.Merge(m, src)
+}
+func (m *InitiateGracefulExitResponse) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Size(m)
+}
+func (m *InitiateGracefulExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitResponse proto.InternalMessageInfo
+
+func (m *InitiateGracefulExitResponse) GetProgress() *ExitProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type GracefulExitProgressRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GracefulExitProgressRequest) Reset()         { *m = GracefulExitProgressRequest{} }
+func (m *GracefulExitProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitProgressRequest) ProtoMessage()    {}
+func (*GracefulExitProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{98}
+}
+func (m *GracefulExitProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitProgressRequest.Unmarshal(m, b)
+}
+func (m *GracefulExitProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GracefulExitProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitProgressRequest.Merge(m, src)
+}
+func (m *GracefulExitProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitProgressRequest.Size(m)
+}
+func (m *GracefulExitProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitProgressRequest proto.InternalMessageInfo
+
+func (m *GracefulExitProgressRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type GracefulExitProgressResponse struct {
+	Progress             []*ExitProgress `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GracefulExitProgressResponse) Reset()         { *m = GracefulExitProgressResponse{} }
+func (m *GracefulExitProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitProgressResponse) ProtoMessage()    {}
+func (*GracefulExitProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{99}
+}
+func (m *GracefulExitProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitProgressResponse.Unmarshal(m, b)
+}
+func (m *GracefulExitProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GracefulExitProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitProgressResponse.Merge(m, src)
+}
+func (m *GracefulExitProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitProgressResponse.Size(m)
+}
+func (m *GracefulExitProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitProgressResponse proto.InternalMessageInfo
+
+func (m *GracefulExitProgressResponse) GetProgress() []*ExitProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RequestHeader)(nil), "multinode.RequestHeader")
 	proto.RegisterType((*DiskSpaceRequest)(nil), "multinode.DiskSpaceRequest")
@@ -4122,6 +4579,10 @@ func init() {
 	proto.RegisterType((*StorageUsageResponse)(nil), "multinode.StorageUsageResponse")
 	proto.RegisterType((*StorageUsageSatelliteRequest)(nil), "multinode.StorageUsageSatelliteRequest")
 	proto.RegisterType((*StorageUsageSatelliteResponse)(nil), "multinode.StorageUsageSatelliteResponse")
+	proto.RegisterType((*UpdateAllocatedDiskSpaceRequest)(nil), "multinode.UpdateAllocatedDiskSpaceRequest")
+	proto.RegisterType((*UpdateAllocatedDiskSpaceResponse)(nil), "multinode.UpdateAllocatedDiskSpaceResponse")
+	proto.RegisterType((*RecalculateUsedSpaceRequest)(nil), "multinode.RecalculateUsedSpaceRequest")
+	proto.RegisterType((*RecalculateUsedSpaceResponse)(nil), "multinode.RecalculateUsedSpaceResponse")
 	proto.RegisterType((*BandwidthMonthSummaryRequest)(nil), "multinode.BandwidthMonthSummaryRequest")
 	proto.RegisterType((*BandwidthMonthSummaryResponse)(nil), "multinode.BandwidthMonthSummaryResponse")
 	proto.RegisterType((*BandwidthSummarySatelliteRequest)(nil), "multinode.BandwidthSummarySatelliteRequest")
@@ -4157,6 +4618,8 @@ func init() {
 	proto.RegisterType((*TrustedSatellitesResponse_NodeURL)(nil), "multinode.TrustedSatellitesResponse.NodeURL")
 	proto.RegisterType((*OperatorRequest)(nil), "multinode.OperatorRequest")
 	proto.RegisterType((*OperatorResponse)(nil), "multinode.OperatorResponse")
+	proto.RegisterType((*ForgetSatelliteRequest)(nil), "multinode.ForgetSatelliteRequest")
+	proto.RegisterType((*ForgetSatelliteResponse)(nil), "multinode.ForgetSatelliteResponse")
 	proto.RegisterType((*EstimatedPayoutSatelliteRequest)(nil), "multinode.EstimatedPayoutSatelliteRequest")
 	proto.RegisterType((*EstimatedPayoutSatelliteResponse)(nil), "multinode.EstimatedPayoutSatelliteResponse")
 	proto.RegisterType((*EstimatedPayoutRequest)(nil), "multinode.EstimatedPayoutRequest")
@@ -4208,191 +4671,216 @@ func init() {
 	proto.RegisterType((*PeriodPaystubResponse)(nil), "multinode.PeriodPaystubResponse")
 	proto.RegisterType((*SatellitePeriodPaystubRequest)(nil), "multinode.SatellitePeriodPaystubRequest")
 	proto.RegisterType((*SatellitePeriodPaystubResponse)(nil), "multinode.SatellitePeriodPaystubResponse")
+	proto.RegisterType((*ExitProgress)(nil), "multinode.ExitProgress")
+	proto.RegisterType((*InitiateGracefulExitRequest)(nil), "multinode.InitiateGracefulExitRequest")
+	proto.RegisterType((*InitiateGracefulExitResponse)(nil), "multinode.InitiateGracefulExitResponse")
+	proto.RegisterType((*GracefulExitProgressRequest)(nil), "multinode.GracefulExitProgressRequest")
+	proto.RegisterType((*GracefulExitProgressResponse)(nil), "multinode.GracefulExitProgressResponse")
 }
 
 func init() { proto.RegisterFile("multinode.proto", fileDescriptor_9a45fd79b06f3a1b) }
 
 var fileDescriptor_9a45fd79b06f3a1b = []byte{
-	// 2886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xff, 0x86, 0x8f, 0x5d, 0x6e, 0xed, 0xf2, 0xd5, 0xe2, 0x63, 0x39, 0xe2, 0x4b, 0x43, 0x7d,
-	0x12, 0x15, 0x4b, 0x94, 0x4d, 0x1b, 0x4e, 0xec, 0xd8, 0x88, 0x97, 0x92, 0x6c, 0xd2, 0x96, 0x2c,
-	0x66, 0x28, 0x39, 0x86, 0x15, 0x78, 0x3d, 0xe4, 0x34, 0xc9, 0xb1, 0x67, 0x67, 0xd6, 0x33, 0xbd,
-	0x64, 0x08, 0x04, 0x46, 0x0e, 0x89, 0x73, 0x0a, 0x90, 0xb3, 0x11, 0xe4, 0x9a, 0x5b, 0x0e, 0xb9,
-	0xe4, 0x98, 0x5b, 0x60, 0x20, 0xff, 0x41, 0x0e, 0x0e, 0x90, 0x5b, 0x2e, 0xb9, 0xe4, 0x96, 0x53,
-	0xd0, 0x8f, 0x79, 0xf4, 0xbc, 0x96, 0x9c, 0x95, 0x41, 0xdf, 0xa6, 0xab, 0xab, 0x7e, 0x5d, 0x55,
-	0xdd, 0x5d, 0xd3, 0x55, 0xdd, 0x30, 0xd9, 0xe9, 0xd9, 0xc4, 0x72, 0x5c, 0x13, 0x6f, 0x74, 0x3d,
-	0x97, 0xb8, 0xa8, 0x16, 0x12, 0x54, 0x38, 0x72, 0x8f, 0x5c, 0x4e, 0x56, 0x57, 0x8e, 0x5c, 0xf7,
-	0xc8, 0xc6, 0x77, 0x59, 0x6b, 0xbf, 0x77, 0x78, 0x97, 0x58, 0x1d, 0xec, 0x13, 0xa3, 0xd3, 0xe5,
-	0x0c, 0xda, 0x3a, 0x8c, 0xeb, 0xf8, 0xf3, 0x1e, 0xf6, 0xc9, 0x36, 0x36, 0x4c, 0xec, 0xa1, 0x79,
-	0xa8, 0x1a, 0x5d, 0xab, 0xfd, 0x19, 0x3e, 0x6b, 0x2a, 0xab, 0xca, 0x7a, 0x43, 0xaf, 0x18, 0x5d,
-	0xeb, 0x3d, 0x7c, 0xa6, 0xdd, 0x87, 0xa9, 0xfb, 0x96, 0xff, 0xd9, 0x5e, 0xd7, 0x38, 0xc0, 0x42,
-	0x04, 0xbd, 0x08, 0x95, 0x63, 0x26, 0xc6, 0x78, 0xeb, 0x9b, 0xcd, 0x8d, 0x48, 0x2f, 0x09, 0x56,
-	0x17, 0x7c, 0xda, 0x5f, 0x14, 0x98, 0x8e, 0xc1, 0xf8, 0x5d, 0xd7, 0xf1, 0x31, 0x5a, 0x84, 0x9a,
-	0x61, 0xdb, 0xee, 0x81, 0x41, 0xb0, 0xc9, 0xa0, 0x86, 0xf5, 0x88, 0x80, 0x56, 0xa0, 0xde, 0xf3,
-	0xb1, 0xd9, 0xee, 0x5a, 0xf8, 0x00, 0xfb, 0xcd, 0x21, 0xd6, 0x0f, 0x94, 0xb4, 0xcb, 0x28, 0x68,
-	0x09, 0x58, 0xab, 0x4d, 0x3c, 0xc3, 0x3f, 0x6e, 0x0e, 0x73, 0x79, 0x4a, 0x79, 0x42, 0x09, 0x08,
-	0xc1, 0xc8, 0xa1, 0x87, 0x71, 0x73, 0x84, 0x75, 0xb0, 0x6f, 0x36, 0xe2, 0x89, 0x61, 0xd9, 0xc6,
-	0xbe, 0x8d, 0x9b, 0xa3, 0x62, 0xc4, 0x80, 0x80, 0x54, 0x18, 0x73, 0x4f, 0xb0, 0x47, 0x21, 0x9a,
-	0x15, 0xd6, 0x19, 0xb6, 0xb5, 0x3f, 0x2a, 0xd0, 0xd8, 0x23, 0xae, 0x67, 0x1c, 0xe1, 0xa7, 0xbe,
-	0x71, 0x84, 0x91, 0x06, 0xe3, 0x06, 0x69, 0x7b, 0xd8, 0x27, 0x6d, 0xe2, 0x12, 0xc3, 0x66, 0x06,
-	0x28, 0x7a, 0xdd, 0x20, 0x3a, 0xf6, 0xc9, 0x13, 0x4a, 0x42, 0xef, 0xc1, 0x84, 0xe5, 0x10, 0xec,
-	0x9d, 0x18, 0x76, 0xdb, 0x27, 0x86, 0x47, 0x98, 0x15, 0xf5, 0x4d, 0x75, 0x83, 0x4f, 0xd0, 0x46,
-	0x30, 0x41, 0x1b, 0x4f, 0x82, 0x09, 0xda, 0x1a, 0xfb, 0xfa, 0x9b, 0x95, 0xff, 0xfb, 0xed, 0x3f,
-	0x56, 0x14, 0x7d, 0x3c, 0x90, 0xdd, 0xa3, 0xa2, 0xe8, 0x0e, 0x5c, 0x91, 0x06, 0x6c, 0xef, 0x9f,
-	0x11, 0xec, 0x33, 0xbb, 0x15, 0x7d, 0x2a, 0x36, 0xec, 0x16, 0xa5, 0x6b, 0x7f, 0x56, 0xe0, 0x4a,
-	0x5c, 0xe1, 0xd2, 0x93, 0x87, 0x7e, 0x40, 0x1d, 0xe9, 0x76, 0x2e, 0xa4, 0x3b, 0x93, 0x40, 0xaf,
-	0xc0, 0x10, 0x71, 0x99, 0x86, 0xe7, 0x95, 0x1b, 0x22, 0xae, 0xf6, 0x7b, 0x05, 0x66, 0x64, 0xcd,
-	0xc5, 0x7a, 0x79, 0x03, 0xc6, 0x7d, 0x4e, 0x6f, 0xf7, 0x68, 0x47, 0x53, 0x59, 0x1d, 0x5e, 0xaf,
-	0x6f, 0xce, 0xc7, 0x2c, 0x90, 0xe4, 0x1a, 0x7e, 0x7c, 0xc2, 0x9a, 0x50, 0xf5, 0x7b, 0x9d, 0x8e,
-	0xe1, 0x9d, 0x31, 0x4b, 0x14, 0x3d, 0x68, 0xa2, 0x0d, 0xb8, 0x62, 0x9c, 0xe0, 0x08, 0x57, 0xf2,
-	0xec, 0xb4, 0xe8, 0x62, 0x20, 0xdc, 0xb5, 0xff, 0x51, 0x60, 0x31, 0x3e, 0xd0, 0x9e, 0x41, 0xb0,
-	0x6d, 0x5b, 0x64, 0x00, 0x1f, 0xbf, 0x04, 0x0d, 0x3f, 0x40, 0x69, 0x5b, 0x26, 0xd3, 0xb0, 0xb1,
-	0x35, 0x41, 0xfd, 0xf2, 0xf7, 0x6f, 0x56, 0x2a, 0xef, 0xbb, 0x26, 0xde, 0xb9, 0xaf, 0xd7, 0x43,
-	0x9e, 0x1d, 0x33, 0x9c, 0x96, 0xe1, 0x92, 0xd3, 0x32, 0x72, 0xc1, 0x69, 0xf9, 0x83, 0x02, 0x4b,
-	0x39, 0x56, 0x7f, 0xc7, 0xe6, 0x67, 0x17, 0x16, 0xb7, 0x0c, 0xc7, 0x3c, 0xb5, 0x4c, 0x72, 0xfc,
-	0xc8, 0x75, 0xc8, 0xf1, 0x1e, 0x07, 0x2a, 0x1f, 0xbf, 0x5e, 0x86, 0xa5, 0x1c, 0x44, 0x61, 0x3a,
-	0x82, 0x11, 0x16, 0x36, 0x78, 0x14, 0x63, 0xdf, 0xda, 0xaf, 0x15, 0x58, 0x0d, 0xa5, 0x84, 0xc0,
-	0xa5, 0x2c, 0x15, 0xed, 0x4d, 0xb8, 0x56, 0xa0, 0x88, 0x30, 0x21, 0xe6, 0x7f, 0x6e, 0x45, 0xd0,
-	0xd4, 0xde, 0x83, 0xf9, 0xa4, 0x78, 0x79, 0x57, 0xbe, 0x02, 0xcd, 0x34, 0x58, 0x5f, 0x15, 0x7e,
-	0xa9, 0xc0, 0xd2, 0x83, 0x23, 0x0f, 0xfb, 0xfe, 0xa5, 0x3a, 0xf2, 0x75, 0x58, 0xce, 0xd3, 0xa2,
-	0xaf, 0x09, 0xdb, 0x30, 0x23, 0xc9, 0x96, 0x77, 0xe1, 0x4b, 0x30, 0x9b, 0x40, 0xea, 0x3b, 0xf8,
-	0xaf, 0x14, 0x58, 0xde, 0x71, 0x2e, 0xdf, 0x81, 0x3f, 0x84, 0x95, 0x5c, 0x35, 0xfa, 0x1a, 0xb1,
-	0x03, 0xb3, 0xb2, 0x70, 0x79, 0x17, 0x6e, 0xc2, 0x5c, 0x12, 0xaa, 0xef, 0xf0, 0x3f, 0x87, 0xd9,
-	0xfb, 0x86, 0x65, 0x5f, 0x92, 0xe7, 0xf6, 0x60, 0x2e, 0x39, 0xba, 0xd0, 0xf8, 0x35, 0x68, 0xf0,
-	0xb0, 0xe8, 0xb9, 0xb6, 0xdd, 0xeb, 0x8a, 0xa8, 0x3b, 0x17, 0x53, 0x82, 0x87, 0x5b, 0xd6, 0xab,
-	0xd7, 0x7b, 0x51, 0x43, 0x7b, 0x0b, 0x1a, 0x0c, 0xb4, 0xbc, 0x23, 0xdf, 0x85, 0x71, 0x81, 0x30,
-	0xb8, 0x36, 0x7f, 0x53, 0xa0, 0x1e, 0xeb, 0x44, 0xb7, 0xa0, 0x82, 0xd9, 0x1c, 0x09, 0x6d, 0xa6,
-	0x63, 0x20, 0x7c, 0x03, 0xe8, 0x82, 0x01, 0xdd, 0x86, 0xaa, 0xc5, 0xe7, 0x53, 0x1c, 0x53, 0x50,
-	0x8c, 0x57, 0xcc, 0xb4, 0x1e, 0xb0, 0xa0, 0x39, 0xa8, 0x98, 0xd8, 0xc6, 0x04, 0x8b, 0x53, 0xa3,
-	0x68, 0x65, 0x9c, 0xd7, 0x46, 0x4a, 0x9f, 0xd7, 0xb4, 0x87, 0x50, 0x79, 0x10, 0x0e, 0xe7, 0xe1,
-	0xae, 0x61, 0x79, 0x62, 0x45, 0x89, 0x16, 0x9a, 0x81, 0x51, 0xa3, 0x67, 0x5a, 0x44, 0x9c, 0x6d,
-	0x79, 0x83, 0x52, 0xf9, 0xdf, 0x93, 0xeb, 0xc6, 0x1b, 0xda, 0xf7, 0xa1, 0xba, 0xe3, 0xc8, 0x70,
-	0xa6, 0x04, 0x67, 0x46, 0x82, 0x43, 0x71, 0xc1, 0x2d, 0x98, 0xf8, 0x00, 0x7b, 0xbe, 0xe5, 0x3a,
-	0xe5, 0x27, 0xf9, 0x05, 0x98, 0x0c, 0x31, 0xa2, 0x6d, 0x72, 0xc2, 0x49, 0x0c, 0xa5, 0xa6, 0x07,
-	0x4d, 0xed, 0x6d, 0x40, 0x0f, 0x0d, 0x9f, 0xdc, 0x73, 0x1d, 0x62, 0x1c, 0x90, 0xf2, 0x83, 0x7e,
-	0x0c, 0x57, 0x24, 0x1c, 0x31, 0xf0, 0x3b, 0xd0, 0xb0, 0x0d, 0x9f, 0xb4, 0x0f, 0x38, 0x5d, 0xc0,
-	0x9d, 0x6f, 0x86, 0xea, 0x76, 0x04, 0xa8, 0xfd, 0x0c, 0xa6, 0x75, 0xdc, 0xed, 0x11, 0x83, 0x0c,
-	0xe2, 0x9b, 0x32, 0x5b, 0xf9, 0x2b, 0x05, 0xea, 0x2d, 0x3a, 0xd7, 0x3f, 0xb1, 0x1c, 0xd3, 0x3d,
-	0xa5, 0x26, 0x9d, 0xb2, 0x2f, 0xb1, 0xe8, 0x2e, 0x64, 0x12, 0x97, 0xe4, 0x29, 0xc2, 0x35, 0x68,
-	0xb8, 0x8e, 0x6d, 0x39, 0xb8, 0x7d, 0xe0, 0xf6, 0x1c, 0xbe, 0xae, 0x46, 0xf5, 0x3a, 0xa7, 0xdd,
-	0xa3, 0x24, 0x9a, 0x55, 0xf1, 0xec, 0x81, 0x73, 0x0c, 0x33, 0x0e, 0x60, 0x24, 0xc6, 0xa0, 0xfd,
-	0xb7, 0x0a, 0x28, 0xee, 0x97, 0xf0, 0x6c, 0x57, 0xe1, 0x30, 0x42, 0xbb, 0xeb, 0x92, 0x63, 0x92,
-	0xec, 0x1b, 0x8f, 0x19, 0xaf, 0x2e, 0x64, 0xd0, 0x6b, 0xf1, 0x95, 0x5e, 0xdf, 0x5c, 0x2b, 0x16,
-	0x66, 0xbe, 0x09, 0xb6, 0xc3, 0x23, 0x98, 0x34, 0x2d, 0xff, 0xf3, 0x9e, 0x61, 0x5b, 0x87, 0x16,
-	0x36, 0xdb, 0x06, 0x39, 0xe7, 0x89, 0x57, 0x61, 0xfe, 0x99, 0x88, 0x0b, 0xb7, 0x08, 0xf5, 0xb5,
-	0xdf, 0xf3, 0xbb, 0xd8, 0x31, 0x39, 0xd6, 0xc8, 0x05, 0xb0, 0xea, 0xa1, 0x64, 0x8b, 0xa0, 0x0f,
-	0x60, 0xc6, 0x3d, 0x3c, 0x64, 0xce, 0x96, 0x00, 0x47, 0x2f, 0x00, 0x88, 0x04, 0xc2, 0x5e, 0x0c,
-	0xf7, 0x19, 0xcc, 0x07, 0xb8, 0x3d, 0xc7, 0xc4, 0x5e, 0xdb, 0xc3, 0x27, 0x16, 0x3e, 0xa5, 0xd0,
-	0x95, 0x0b, 0x40, 0x07, 0xca, 0x3d, 0xa5, 0x18, 0x3a, 0x83, 0x68, 0x11, 0xd4, 0x82, 0xda, 0x09,
-	0x26, 0x84, 0x6b, 0x5a, 0xbb, 0x00, 0xdc, 0x18, 0x17, 0x6b, 0x11, 0x74, 0x0f, 0xa0, 0xd7, 0x35,
-	0x0d, 0x81, 0x51, 0xbd, 0xc0, 0x52, 0xad, 0x09, 0x39, 0xae, 0xc7, 0xa7, 0xae, 0xe5, 0x70, 0x8c,
-	0xb1, 0x0b, 0x60, 0x8c, 0x71, 0xb1, 0x16, 0x51, 0x97, 0xa1, 0xc2, 0x17, 0x19, 0x8d, 0x7b, 0xfe,
-	0x81, 0xeb, 0x61, 0x91, 0x81, 0xf3, 0x86, 0xfa, 0xa7, 0x21, 0x18, 0x6d, 0x05, 0x01, 0x35, 0xdd,
-	0x8f, 0x6e, 0xc1, 0x14, 0x9f, 0x37, 0x1a, 0xb4, 0xda, 0x9c, 0x81, 0xe7, 0x1d, 0x93, 0x11, 0x7d,
-	0x8f, 0xb1, 0x66, 0xec, 0x99, 0xe1, 0xf8, 0x9e, 0x41, 0x6b, 0x30, 0xee, 0xf7, 0x0e, 0x0e, 0xb0,
-	0xef, 0x0b, 0x16, 0x5e, 0x73, 0x68, 0x08, 0x22, 0x67, 0xa2, 0xd1, 0xde, 0xee, 0x1e, 0x1b, 0x6c,
-	0x85, 0x28, 0x3a, 0x6f, 0xd0, 0xc4, 0x61, 0x1f, 0x13, 0x83, 0xcd, 0xad, 0xa2, 0xb3, 0x6f, 0x0a,
-	0xd7, 0x73, 0x3e, 0x73, 0xdc, 0x53, 0xa7, 0xcd, 0x25, 0xaa, 0xac, 0xb3, 0x21, 0x88, 0x2d, 0x26,
-	0x78, 0x0d, 0x82, 0x76, 0x9b, 0x01, 0x8c, 0xf1, 0xf2, 0x83, 0xa0, 0x6d, 0x51, 0x9c, 0x17, 0xa1,
-	0x7a, 0x6c, 0xd1, 0x1c, 0xeb, 0xac, 0x59, 0x4b, 0xfd, 0x85, 0x63, 0x01, 0x48, 0x0f, 0xd8, 0xb4,
-	0x87, 0xd0, 0x7c, 0xe2, 0xf5, 0x7c, 0x82, 0xcd, 0xf0, 0x98, 0xe1, 0x97, 0x8f, 0xe0, 0x7f, 0x55,
-	0x60, 0x21, 0x03, 0x4e, 0x44, 0x94, 0x67, 0x80, 0x08, 0xef, 0x6c, 0x87, 0xc1, 0xd1, 0x17, 0xc7,
-	0x85, 0xdb, 0x31, 0xec, 0x5c, 0x84, 0x0d, 0x1a, 0x5b, 0x9f, 0xea, 0x0f, 0xf5, 0x69, 0x92, 0x64,
-	0x51, 0x1f, 0x42, 0x55, 0xf4, 0xa2, 0x9b, 0x50, 0xa5, 0x38, 0x6d, 0xf1, 0xbf, 0x4c, 0xc7, 0xe6,
-	0x0a, 0xed, 0xde, 0x31, 0xe9, 0x2f, 0xcd, 0x30, 0xcd, 0xf0, 0x0c, 0x51, 0xd3, 0x83, 0xa6, 0x76,
-	0x0f, 0x26, 0x1f, 0x77, 0xb1, 0x67, 0x10, 0xd7, 0x2b, 0xef, 0x0d, 0x0b, 0xa6, 0x22, 0x10, 0xe1,
-	0x83, 0x19, 0x18, 0xc5, 0x1d, 0xc3, 0xb2, 0xc5, 0x3f, 0x94, 0x37, 0xe8, 0x0f, 0xfe, 0xd4, 0xb0,
-	0x6d, 0x4c, 0x84, 0x1e, 0xa2, 0x85, 0x6e, 0xc2, 0x24, 0xff, 0x6a, 0x1f, 0x62, 0x83, 0xf4, 0x3c,
-	0x96, 0x03, 0x0f, 0xaf, 0xd7, 0xf4, 0x09, 0x4e, 0x7e, 0x5b, 0x50, 0xb5, 0x2f, 0x15, 0x58, 0x79,
-	0xe0, 0x13, 0xab, 0x43, 0xb7, 0xdb, 0xae, 0x71, 0xe6, 0xf6, 0xc8, 0xe5, 0x1c, 0x5a, 0x7f, 0x0c,
-	0xab, 0xf9, 0x7a, 0x08, 0x1f, 0xdc, 0x01, 0x84, 0x03, 0x9e, 0x36, 0x36, 0x3c, 0xc7, 0x72, 0x8e,
-	0x7c, 0x71, 0xb4, 0x99, 0x0e, 0x7b, 0x1e, 0x88, 0x0e, 0xed, 0x5d, 0x98, 0x4b, 0x40, 0x96, 0x9f,
-	0x92, 0x6d, 0x98, 0x4f, 0x61, 0x95, 0xd3, 0x6a, 0x0b, 0x26, 0x06, 0xce, 0x49, 0x76, 0x60, 0x32,
-	0x99, 0x8c, 0xbc, 0x0a, 0xf5, 0x2e, 0xd3, 0xab, 0x6d, 0x39, 0x87, 0xae, 0x40, 0x9a, 0x8d, 0x21,
-	0x71, 0xad, 0x77, 0x9c, 0x43, 0x57, 0x87, 0x6e, 0xf8, 0xad, 0x7d, 0x02, 0x33, 0x02, 0x6a, 0x17,
-	0x7b, 0x96, 0x6b, 0x96, 0x9f, 0xf4, 0x39, 0xa8, 0x74, 0x19, 0x44, 0xb0, 0x16, 0x79, 0x4b, 0x7b,
-	0x0c, 0xb3, 0x89, 0x11, 0x06, 0x54, 0xf9, 0x0b, 0x98, 0xbf, 0xd4, 0xcc, 0x54, 0x87, 0x66, 0x6e,
-	0x4a, 0x5a, 0xd6, 0xa6, 0xdf, 0x29, 0xb0, 0x94, 0x04, 0x1d, 0x74, 0x42, 0x4a, 0x54, 0x0a, 0xa3,
-	0x39, 0x1c, 0x96, 0xe6, 0xf0, 0x43, 0x58, 0xce, 0xd3, 0x6e, 0x40, 0xc3, 0x5b, 0x30, 0x4e, 0xb7,
-	0x06, 0x2e, 0x6f, 0xa7, 0x76, 0x03, 0x26, 0x02, 0x88, 0x28, 0x58, 0x46, 0x95, 0xf6, 0x61, 0x9d,
-	0x37, 0x58, 0x3c, 0x60, 0x7c, 0x83, 0x2f, 0x1b, 0xed, 0x13, 0x98, 0x4f, 0x61, 0x89, 0xc1, 0x1f,
-	0xc0, 0x14, 0x66, 0x5d, 0xd1, 0xcf, 0x4a, 0xfc, 0xab, 0xd4, 0x78, 0x56, 0x9a, 0x90, 0x9e, 0xc4,
-	0x32, 0x41, 0xfb, 0x08, 0x26, 0x13, 0x3c, 0xd9, 0x66, 0x95, 0x59, 0xc1, 0xdb, 0x30, 0xf3, 0xd4,
-	0x31, 0x2d, 0x9f, 0x78, 0xd6, 0x7e, 0x8f, 0x0c, 0xe2, 0xfb, 0x3b, 0x30, 0x9b, 0x40, 0x2a, 0x9c,
-	0x82, 0x2f, 0x60, 0x7e, 0xd7, 0x38, 0xf3, 0x49, 0x6f, 0xff, 0x72, 0xb6, 0xee, 0x36, 0x34, 0xd3,
-	0xe3, 0x0b, 0x8d, 0x6f, 0x43, 0xb5, 0xcb, 0xfb, 0x84, 0x06, 0x48, 0x5e, 0xbd, 0xb4, 0x47, 0x0f,
-	0x58, 0x68, 0x18, 0x0f, 0x68, 0xa5, 0x9d, 0xf7, 0x23, 0x98, 0x0c, 0x31, 0x4a, 0x29, 0xf1, 0x09,
-	0xcc, 0x08, 0xda, 0xb7, 0x15, 0xbc, 0x1f, 0xc0, 0x6c, 0x62, 0x84, 0x52, 0x8a, 0xd2, 0xf0, 0x96,
-	0x74, 0xfc, 0x77, 0x28, 0xbc, 0xbd, 0x0f, 0xcb, 0x79, 0xda, 0x95, 0x32, 0xf7, 0x15, 0x80, 0x28,
-	0xdc, 0xd1, 0x83, 0xfb, 0x31, 0xb6, 0xc3, 0x8a, 0x3f, 0xfd, 0xa6, 0x34, 0x56, 0x97, 0xe1, 0x05,
-	0x18, 0xf6, 0xad, 0xfd, 0x66, 0x18, 0xaa, 0x02, 0x0a, 0x69, 0x30, 0xce, 0x6b, 0x63, 0xe2, 0x22,
-	0x2f, 0xb8, 0x33, 0x64, 0xc4, 0x16, 0xbb, 0xc1, 0x43, 0x57, 0xa1, 0xc6, 0x79, 0x8e, 0x70, 0x50,
-	0x18, 0x1a, 0x63, 0x84, 0x77, 0x30, 0x41, 0xeb, 0x30, 0x15, 0x76, 0xb6, 0x45, 0x4d, 0x89, 0xa7,
-	0x23, 0x13, 0x01, 0x8f, 0xce, 0x6b, 0x4b, 0x37, 0x60, 0x32, 0xe2, 0xe4, 0xb9, 0x37, 0x4f, 0x4a,
-	0xc6, 0x03, 0x46, 0x9e, 0x1c, 0xad, 0x42, 0xe3, 0xc0, 0xed, 0x74, 0x43, 0x8d, 0xf8, 0xa5, 0x28,
-	0x50, 0x9a, 0x50, 0x68, 0x01, 0xc6, 0x18, 0x07, 0xd5, 0x87, 0xdf, 0x8a, 0x56, 0x69, 0x9b, 0xaa,
-	0x73, 0x03, 0x26, 0x83, 0xae, 0x40, 0x9b, 0x2a, 0x1f, 0x44, 0x70, 0x08, 0x65, 0xae, 0xc3, 0x44,
-	0xc8, 0xc7, 0x75, 0x19, 0xe3, 0x09, 0x92, 0x60, 0xe3, 0xaa, 0x04, 0x1e, 0xad, 0x65, 0x78, 0x14,
-	0x22, 0x8f, 0xa2, 0x55, 0xa8, 0xc7, 0x62, 0x53, 0xb3, 0xce, 0xba, 0xe2, 0x24, 0xa4, 0xc2, 0x98,
-	0x69, 0xf9, 0x5d, 0xd7, 0xc7, 0x66, 0xb3, 0xc1, 0x5d, 0x18, 0xb4, 0x69, 0x8a, 0xb3, 0x8d, 0x6d,
-	0xb3, 0xd5, 0xa1, 0x49, 0xd9, 0x36, 0xcf, 0x7b, 0xca, 0x6f, 0xf6, 0xaf, 0x87, 0x60, 0x21, 0x03,
-	0x4e, 0xac, 0xaf, 0xdd, 0x28, 0x01, 0xe3, 0xff, 0x8a, 0x57, 0x63, 0x80, 0xb9, 0x62, 0x19, 0x3d,
-	0x01, 0x8c, 0xfa, 0x06, 0x40, 0xd4, 0x1b, 0x5b, 0xf9, 0x4a, 0x7c, 0xe5, 0x53, 0xba, 0xd1, 0x09,
-	0x2b, 0x40, 0xc3, 0xba, 0x68, 0xa9, 0x5f, 0x29, 0x30, 0x9d, 0x02, 0x4f, 0x6d, 0x39, 0xa5, 0xff,
-	0x96, 0xd3, 0xa1, 0x41, 0xa7, 0xa7, 0xcd, 0x71, 0x69, 0xbe, 0x44, 0xad, 0xbb, 0x7b, 0x41, 0xeb,
-	0xf4, 0xfa, 0x71, 0xf8, 0xed, 0x6b, 0x8f, 0xe1, 0x6a, 0xe2, 0x30, 0xce, 0x6e, 0xb3, 0xcb, 0xcf,
-	0xcd, 0x23, 0x58, 0xcc, 0x06, 0x2c, 0x77, 0xc4, 0x7f, 0x0c, 0x57, 0x5b, 0xb6, 0x1d, 0xe5, 0x98,
-	0x03, 0x9f, 0xf7, 0x3f, 0x80, 0xc5, 0x6c, 0xc0, 0x01, 0x0f, 0x5f, 0x1d, 0xb8, 0x26, 0xe1, 0xf2,
-	0xa0, 0x37, 0xa8, 0xba, 0xb9, 0x3f, 0x93, 0x9f, 0x82, 0x56, 0x34, 0xdc, 0x73, 0x48, 0x0b, 0x02,
-	0xe8, 0x81, 0x4d, 0x28, 0x99, 0x16, 0xa4, 0xc6, 0x7f, 0x1e, 0x69, 0x81, 0xfc, 0x4b, 0xba, 0x04,
-	0xd3, 0x0a, 0xd3, 0x82, 0x1c, 0xed, 0x06, 0x34, 0xfc, 0x11, 0x2c, 0xf0, 0xd3, 0xef, 0x2e, 0xf6,
-	0x9e, 0xc3, 0x71, 0xfd, 0x00, 0xd4, 0x2c, 0xb8, 0xe7, 0x7b, 0x62, 0x8f, 0x2f, 0xc0, 0x41, 0xcf,
-	0x86, 0x25, 0x0f, 0xb7, 0xe9, 0xf1, 0x4b, 0x9f, 0x2b, 0xd9, 0x74, 0x0e, 0x6c, 0x46, 0xd1, 0xb9,
-	0x52, 0x1e, 0xa1, 0xf4, 0xb9, 0x32, 0xb1, 0x02, 0x2f, 0xc1, 0xf3, 0x45, 0xe7, 0xca, 0x3c, 0xed,
-	0xca, 0x98, 0xbb, 0xf9, 0x8b, 0x21, 0xa8, 0x8a, 0x77, 0x31, 0xe8, 0x6d, 0xa8, 0x85, 0xef, 0xe4,
-	0xd0, 0xd5, 0x98, 0x54, 0xf2, 0x11, 0x9e, 0xba, 0x98, 0xdd, 0x29, 0x34, 0xd8, 0x86, 0x51, 0xfe,
-	0xaa, 0x66, 0x39, 0xef, 0xf1, 0x8d, 0x80, 0x59, 0xc9, 0xed, 0x17, 0x48, 0x07, 0x30, 0x21, 0x3f,
-	0xf7, 0x41, 0x37, 0x73, 0x44, 0x92, 0x3b, 0x5a, 0x5d, 0xef, 0xcf, 0xc8, 0x07, 0xd9, 0xfc, 0x67,
-	0x05, 0x6a, 0xe1, 0xab, 0x10, 0x64, 0x40, 0x23, 0xfe, 0xc8, 0x46, 0x1a, 0xb0, 0xe8, 0x61, 0x8f,
-	0x34, 0x60, 0xf1, 0x7b, 0x9d, 0x13, 0x58, 0xc8, 0x7d, 0x11, 0x83, 0x5e, 0xc8, 0x82, 0xc9, 0x29,
-	0x4e, 0xa9, 0xb7, 0xcf, 0xc7, 0x1c, 0x16, 0xbd, 0xa7, 0x92, 0x4c, 0x48, 0x2b, 0x40, 0x08, 0x46,
-	0x59, 0x2b, 0xe4, 0x11, 0xe0, 0x1d, 0x98, 0xcb, 0x7e, 0x9d, 0x82, 0xd6, 0x53, 0x37, 0xe7, 0x79,
-	0xe6, 0xdc, 0x3a, 0x07, 0xa7, 0x18, 0x4e, 0x87, 0x71, 0x89, 0x03, 0xad, 0xe4, 0xc9, 0x06, 0xe0,
-	0xab, 0xf9, 0x0c, 0x02, 0xb3, 0x0b, 0xf3, 0x39, 0xef, 0x43, 0xd0, 0xad, 0xf4, 0x8d, 0x7e, 0x9e,
-	0x11, 0xdf, 0x3b, 0x0f, 0xab, 0x18, 0xf1, 0x29, 0x4c, 0xc8, 0x2c, 0x68, 0x35, 0x57, 0x3a, 0xc0,
-	0xbf, 0x56, 0xc0, 0x11, 0xc1, 0xca, 0xcf, 0x35, 0x24, 0xd8, 0xcc, 0x77, 0x24, 0x12, 0x6c, 0xce,
-	0x5b, 0x8f, 0xd7, 0x61, 0x94, 0xf5, 0xa0, 0xf9, 0x24, 0x6f, 0x00, 0xd2, 0x4c, 0x77, 0x88, 0x4d,
-	0xf6, 0xe5, 0x30, 0x8c, 0xd0, 0x38, 0x87, 0xde, 0x82, 0xaa, 0xb8, 0xce, 0x47, 0x0b, 0x31, 0x6e,
-	0xf9, 0x99, 0x80, 0xaa, 0x66, 0x75, 0x09, 0x35, 0x1e, 0x42, 0x3d, 0x76, 0x37, 0x8f, 0x96, 0x62,
-	0xac, 0xe9, 0xbb, 0x7f, 0x75, 0x39, 0xaf, 0x5b, 0xa0, 0xed, 0x00, 0x44, 0xb7, 0xc0, 0x68, 0x31,
-	0xe7, 0x72, 0x98, 0x63, 0x2d, 0x15, 0x5e, 0x1d, 0xa3, 0x8f, 0x61, 0x3a, 0x75, 0x5f, 0x84, 0xd6,
-	0x8a, 0x6f, 0x93, 0x38, 0xf0, 0xf5, 0xf3, 0x5c, 0x39, 0xa1, 0x7b, 0x30, 0x16, 0x5c, 0xe2, 0xa0,
-	0xb8, 0x83, 0x12, 0xd7, 0x43, 0xea, 0xd5, 0xcc, 0x3e, 0x31, 0x11, 0xff, 0xaa, 0xb1, 0x92, 0x80,
-	0xdb, 0x23, 0x3e, 0x9d, 0x8b, 0x60, 0xdd, 0xc5, 0xe7, 0x22, 0xb1, 0xe0, 0xd4, 0xac, 0xae, 0x68,
-	0x1b, 0x4a, 0x95, 0x78, 0x69, 0x1b, 0x66, 0xdd, 0x02, 0x48, 0xdb, 0x30, 0xbb, 0x88, 0xff, 0x0c,
-	0xa6, 0x52, 0xfb, 0x4f, 0x4b, 0x4b, 0xa5, 0x56, 0xf0, 0x5a, 0x21, 0x4f, 0x14, 0xa6, 0xb2, 0xcb,
-	0xce, 0x52, 0x98, 0x2a, 0xac, 0x9b, 0x4b, 0x61, 0xaa, 0x4f, 0x0d, 0xfb, 0x4d, 0xa8, 0xf0, 0x43,
-	0x1e, 0x6a, 0xa6, 0xce, 0x7d, 0x01, 0xdc, 0x42, 0x46, 0x8f, 0x10, 0xff, 0x30, 0x5d, 0xb1, 0xbd,
-	0x56, 0x70, 0x7e, 0x14, 0x80, 0x5a, 0x11, 0x8b, 0x40, 0xf6, 0xa1, 0x99, 0x77, 0x39, 0x86, 0xe2,
-	0x11, 0xac, 0xcf, 0x4d, 0x9e, 0xfa, 0xc2, 0xb9, 0x78, 0x63, 0xe6, 0xc8, 0x3c, 0xb2, 0x39, 0x99,
-	0x57, 0x6b, 0xb2, 0x39, 0x39, 0x37, 0x66, 0x3a, 0x8c, 0x4b, 0x45, 0x63, 0x69, 0x1d, 0x66, 0x15,
-	0xa6, 0xa5, 0x75, 0x98, 0x5d, 0x6f, 0x7e, 0x06, 0x53, 0xc9, 0x12, 0x9e, 0xb4, 0x0e, 0x73, 0xca,
-	0xce, 0xd2, 0x3a, 0xcc, 0x2d, 0x0d, 0xbf, 0x15, 0x15, 0xe6, 0x16, 0x32, 0xce, 0x67, 0x19, 0x5b,
-	0x2f, 0x79, 0xce, 0xd3, 0x61, 0x5c, 0xaa, 0xa3, 0x4a, 0x26, 0x67, 0xd5, 0x70, 0x25, 0x93, 0xb3,
-	0x4b, 0xb0, 0x1d, 0x98, 0xcb, 0xae, 0x5a, 0x4a, 0xbb, 0xa3, 0xb0, 0xec, 0x2a, 0xed, 0x8e, 0x3e,
-	0x25, 0xd0, 0x8f, 0xb3, 0x2a, 0x42, 0x6b, 0xc5, 0x85, 0x9c, 0x74, 0xc0, 0xcc, 0xad, 0xf6, 0x6c,
-	0xfe, 0xbb, 0x06, 0x15, 0xb1, 0xce, 0x8e, 0x60, 0x26, 0xab, 0xde, 0x81, 0x6e, 0xc4, 0x5f, 0x25,
-	0xe4, 0x57, 0x58, 0xd4, 0x9b, 0x7d, 0xf9, 0x84, 0x4d, 0x67, 0xa0, 0xe6, 0x57, 0x24, 0xd0, 0xed,
-	0x3c, 0x98, 0xac, 0x4c, 0x5c, 0xbd, 0x73, 0x4e, 0xee, 0x58, 0xe0, 0x4c, 0x94, 0x0b, 0xe4, 0xc0,
-	0x99, 0x5d, 0xcb, 0x90, 0x03, 0x67, 0x5e, 0xbd, 0x81, 0x06, 0xce, 0xcc, 0xc4, 0x5c, 0x0e, 0x9c,
-	0x45, 0x95, 0x05, 0x39, 0x70, 0x16, 0x67, 0xf9, 0x03, 0x06, 0x4e, 0x03, 0x50, 0x3a, 0x3b, 0x47,
-	0xd7, 0x53, 0x02, 0x19, 0xb5, 0x00, 0xf5, 0xff, 0xfb, 0x70, 0x5d, 0x66, 0x04, 0x3d, 0x82, 0x99,
-	0xac, 0xb2, 0xa2, 0xb4, 0x8c, 0x0b, 0x0a, 0x99, 0xd2, 0x32, 0x2e, 0xac, 0x4f, 0x7e, 0x4b, 0x01,
-	0x35, 0x59, 0x4d, 0xc8, 0x5e, 0x9f, 0x89, 0x28, 0xb8, 0x56, 0xc8, 0xf3, 0x5c, 0x03, 0x6a, 0x3c,
-	0xa3, 0x96, 0x03, 0x6a, 0x46, 0x25, 0x40, 0x0e, 0xa8, 0x99, 0xc9, 0x78, 0x7a, 0xd7, 0x04, 0xe0,
-	0x05, 0xbb, 0x26, 0x31, 0xca, 0xad, 0x73, 0x70, 0xf2, 0xe1, 0xb6, 0xae, 0x7f, 0xa4, 0xd1, 0x08,
-	0xf8, 0xe9, 0x86, 0xe5, 0xde, 0x65, 0x1f, 0x77, 0xbb, 0x9e, 0x75, 0x62, 0x10, 0x7c, 0x37, 0x84,
-	0xe8, 0xee, 0xef, 0x57, 0xd8, 0x2b, 0xb7, 0x97, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xde, 0x43,
-	0x56, 0xb1, 0xd2, 0x37, 0x00, 0x00,
+	// 3206 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x73, 0xdc, 0xc6,
+	0xb5, 0xbe, 0xe0, 0x88, 0x33, 0x9c, 0x33, 0x43, 0x8e, 0xd8, 0xa2, 0xc8, 0x21, 0x44, 0x91, 0x14,
+	0xa8, 0x2b, 0x92, 0x96, 0x44, 0xd9, 0xb4, 0xcb, 0xd7, 0xf6, 0xb5, 0xeb, 0x6a, 0xa8, 0x87, 0x49,
+	0x5b, 0xb2, 0x78, 0x41, 0xd1, 0x71, 0xd9, 0x29, 0x8f, 0xc1, 0x41, 0x93, 0x84, 0x8d, 0x01, 0x60,
+	0xa0, 0x87, 0x32, 0xab, 0x12, 0x2f, 0x52, 0x79, 0xac, 0x52, 0x95, 0xb5, 0x2b, 0x95, 0x6d, 0x76,
+	0x59, 0x64, 0x93, 0x65, 0x76, 0x29, 0x57, 0xe5, 0x1f, 0xa4, 0x52, 0x4e, 0x2a, 0xbb, 0x6c, 0xb2,
+	0xc9, 0x2e, 0xab, 0x54, 0x3f, 0xf0, 0x1c, 0x00, 0x33, 0xc4, 0xc8, 0xa1, 0x77, 0xe8, 0xd3, 0x5f,
+	0x7f, 0x7d, 0x4e, 0x3f, 0x0e, 0xba, 0xcf, 0x69, 0x68, 0x74, 0x7b, 0x26, 0x31, 0x2c, 0x5b, 0xc7,
+	0x1b, 0x8e, 0x6b, 0x13, 0x1b, 0x55, 0x03, 0x81, 0x0c, 0x47, 0xf6, 0x91, 0xcd, 0xc5, 0xf2, 0xd2,
+	0x91, 0x6d, 0x1f, 0x99, 0xf8, 0x0e, 0x2b, 0x1d, 0xf4, 0x0e, 0xef, 0x10, 0xa3, 0x8b, 0x3d, 0xa2,
+	0x75, 0x1d, 0x0e, 0x50, 0xd6, 0x60, 0x52, 0xc5, 0x9f, 0xf7, 0xb0, 0x47, 0xb6, 0xb1, 0xa6, 0x63,
+	0x17, 0xcd, 0x41, 0x45, 0x73, 0x8c, 0xf6, 0x67, 0xf8, 0xb4, 0x29, 0x2d, 0x4b, 0x6b, 0x75, 0xb5,
+	0xac, 0x39, 0xc6, 0xbb, 0xf8, 0x54, 0xb9, 0x0f, 0x17, 0xef, 0x1b, 0xde, 0x67, 0x7b, 0x8e, 0xd6,
+	0xc1, 0xa2, 0x09, 0x7a, 0x11, 0xca, 0xc7, 0xac, 0x19, 0xc3, 0xd6, 0x36, 0x9b, 0x1b, 0xa1, 0x5e,
+	0x31, 0x5a, 0x55, 0xe0, 0x94, 0xdf, 0x4b, 0x30, 0x1d, 0xa1, 0xf1, 0x1c, 0xdb, 0xf2, 0x30, 0x5a,
+	0x80, 0xaa, 0x66, 0x9a, 0x76, 0x47, 0x23, 0x58, 0x67, 0x54, 0x25, 0x35, 0x14, 0xa0, 0x25, 0xa8,
+	0xf5, 0x3c, 0xac, 0xb7, 0x1d, 0x03, 0x77, 0xb0, 0xd7, 0x1c, 0x63, 0xf5, 0x40, 0x45, 0xbb, 0x4c,
+	0x82, 0xae, 0x02, 0x2b, 0xb5, 0x89, 0xab, 0x79, 0xc7, 0xcd, 0x12, 0x6f, 0x4f, 0x25, 0x4f, 0xa9,
+	0x00, 0x21, 0xb8, 0x70, 0xe8, 0x62, 0xdc, 0xbc, 0xc0, 0x2a, 0xd8, 0x37, 0xeb, 0xf1, 0x44, 0x33,
+	0x4c, 0xed, 0xc0, 0xc4, 0xcd, 0x71, 0xd1, 0xa3, 0x2f, 0x40, 0x32, 0x4c, 0xd8, 0x27, 0xd8, 0xa5,
+	0x14, 0xcd, 0x32, 0xab, 0x0c, 0xca, 0xca, 0x6f, 0x24, 0xa8, 0xef, 0x11, 0xdb, 0xd5, 0x8e, 0xf0,
+	0xbe, 0xa7, 0x1d, 0x61, 0xa4, 0xc0, 0xa4, 0x46, 0xda, 0x2e, 0xf6, 0x48, 0x9b, 0xd8, 0x44, 0x33,
+	0x99, 0x01, 0x92, 0x5a, 0xd3, 0x88, 0x8a, 0x3d, 0xf2, 0x94, 0x8a, 0xd0, 0xbb, 0x30, 0x65, 0x58,
+	0x04, 0xbb, 0x27, 0x9a, 0xd9, 0xf6, 0x88, 0xe6, 0x12, 0x66, 0x45, 0x6d, 0x53, 0xde, 0xe0, 0x13,
+	0xb4, 0xe1, 0x4f, 0xd0, 0xc6, 0x53, 0x7f, 0x82, 0xb6, 0x26, 0xbe, 0xfe, 0x66, 0xe9, 0xbf, 0x7e,
+	0xf1, 0x97, 0x25, 0x49, 0x9d, 0xf4, 0xdb, 0xee, 0xd1, 0xa6, 0xe8, 0x36, 0x5c, 0x8a, 0x75, 0xd8,
+	0x3e, 0x38, 0x25, 0xd8, 0x63, 0x76, 0x4b, 0xea, 0xc5, 0x48, 0xb7, 0x5b, 0x54, 0xae, 0xfc, 0x4e,
+	0x82, 0x4b, 0x51, 0x85, 0x0b, 0x4f, 0x1e, 0x7a, 0x8d, 0x0e, 0xa4, 0xdd, 0x3d, 0x93, 0xee, 0xac,
+	0x05, 0x7a, 0x05, 0xc6, 0x88, 0xdd, 0x2c, 0x9d, 0xa1, 0xdd, 0x18, 0xb1, 0x95, 0x5f, 0x49, 0x30,
+	0x13, 0xd7, 0x5c, 0xac, 0x97, 0x37, 0x61, 0xd2, 0xe3, 0xf2, 0x76, 0x8f, 0x56, 0x34, 0xa5, 0xe5,
+	0xd2, 0x5a, 0x6d, 0x73, 0x2e, 0x62, 0x41, 0xac, 0x5d, 0xdd, 0x8b, 0x4e, 0x58, 0x13, 0x2a, 0x5e,
+	0xaf, 0xdb, 0xd5, 0xdc, 0x53, 0x66, 0x89, 0xa4, 0xfa, 0x45, 0xb4, 0x01, 0x97, 0xb4, 0x13, 0x1c,
+	0xf2, 0xc6, 0x46, 0x76, 0x5a, 0x54, 0x31, 0x12, 0x3e, 0xb4, 0xff, 0x94, 0x60, 0x21, 0xda, 0xd1,
+	0x9e, 0x46, 0xb0, 0x69, 0x1a, 0x64, 0x84, 0x31, 0x7e, 0x09, 0xea, 0x9e, 0xcf, 0xd2, 0x36, 0x74,
+	0xa6, 0x61, 0x7d, 0x6b, 0x8a, 0x8e, 0xcb, 0x9f, 0xbe, 0x59, 0x2a, 0xbf, 0x67, 0xeb, 0x78, 0xe7,
+	0xbe, 0x5a, 0x0b, 0x30, 0x3b, 0x7a, 0x30, 0x2d, 0xa5, 0x82, 0xd3, 0x72, 0xe1, 0x8c, 0xd3, 0xf2,
+	0x6b, 0x09, 0xae, 0x66, 0x58, 0xfd, 0x1d, 0x9b, 0x9f, 0xcf, 0x61, 0x69, 0xdf, 0xd1, 0x35, 0x82,
+	0x5b, 0xbe, 0x33, 0x19, 0xdd, 0x85, 0xc5, 0x9d, 0xd5, 0x58, 0xc2, 0x59, 0x29, 0x77, 0x61, 0x39,
+	0xbb, 0xcb, 0x61, 0xdc, 0x9d, 0xf2, 0x04, 0xae, 0xa8, 0xb8, 0xa3, 0x99, 0x9d, 0x9e, 0xa9, 0x11,
+	0xbc, 0xef, 0x61, 0x7d, 0x44, 0x9f, 0xfb, 0x1a, 0x2c, 0xa4, 0x13, 0x0a, 0x75, 0xe8, 0x78, 0x53,
+	0xc7, 0x22, 0x94, 0x99, 0x50, 0xfd, 0xa2, 0xb2, 0x0b, 0x0b, 0x5b, 0x9a, 0xa5, 0x3f, 0x33, 0x74,
+	0x72, 0xfc, 0xd8, 0xb6, 0xc8, 0xf1, 0x1e, 0x9f, 0x88, 0xe2, 0xba, 0xbc, 0x0c, 0x57, 0x33, 0x18,
+	0x85, 0x32, 0x08, 0x2e, 0x30, 0xb7, 0xcb, 0x87, 0x85, 0x7d, 0x2b, 0x3f, 0x93, 0x60, 0x39, 0x68,
+	0x25, 0x1a, 0x9c, 0xcb, 0x56, 0x53, 0xde, 0x82, 0x6b, 0x39, 0x8a, 0x44, 0xc6, 0x53, 0xac, 0x5f,
+	0x6e, 0x85, 0x5f, 0x54, 0xde, 0x85, 0xb9, 0x64, 0xf3, 0xe2, 0x43, 0xf9, 0x0a, 0x34, 0xfb, 0xc9,
+	0x06, 0xaa, 0xf0, 0x63, 0x09, 0xae, 0x3e, 0x38, 0x72, 0xb1, 0xe7, 0x9d, 0xeb, 0x40, 0xbe, 0x01,
+	0x8b, 0x59, 0x5a, 0x0c, 0x34, 0x61, 0x1b, 0x66, 0x62, 0x6d, 0x8b, 0x0f, 0xe1, 0x4b, 0x70, 0x39,
+	0xc1, 0x34, 0xb0, 0xf3, 0x9f, 0x48, 0xb0, 0xb8, 0x63, 0x9d, 0xff, 0x00, 0xfe, 0x2f, 0x2c, 0x65,
+	0xaa, 0x31, 0xd0, 0x88, 0x1d, 0xb8, 0x1c, 0x6f, 0x5c, 0x7c, 0x08, 0x37, 0x61, 0x36, 0x49, 0x35,
+	0xb0, 0xfb, 0x1f, 0xc0, 0xe5, 0xfb, 0x9a, 0x61, 0x9e, 0xd3, 0xc8, 0xed, 0xc1, 0x6c, 0xb2, 0x77,
+	0xa1, 0xf1, 0xeb, 0x50, 0xe7, 0xbf, 0x15, 0xd7, 0x36, 0xcd, 0x9e, 0x23, 0xfe, 0x5a, 0xb3, 0x11,
+	0x25, 0xf8, 0xef, 0x8a, 0xd5, 0xaa, 0xb5, 0x5e, 0x58, 0x50, 0xee, 0x42, 0x9d, 0x91, 0x16, 0x1f,
+	0xc8, 0x77, 0x60, 0x52, 0x30, 0x8c, 0xae, 0xcd, 0x1f, 0x25, 0xa8, 0x45, 0x2a, 0xd1, 0x3a, 0x94,
+	0x31, 0x9b, 0x23, 0xa1, 0xcd, 0x74, 0x84, 0x84, 0x6f, 0x00, 0x55, 0x00, 0xd0, 0x2d, 0xa8, 0x18,
+	0x7c, 0x3e, 0xc5, 0x31, 0x0f, 0x45, 0xb0, 0x62, 0xa6, 0x55, 0x1f, 0x82, 0x66, 0xa1, 0xac, 0x63,
+	0x13, 0x13, 0x2c, 0x4e, 0xdd, 0xa2, 0x94, 0x72, 0xde, 0xbd, 0x50, 0xf8, 0xbc, 0xab, 0x3c, 0x82,
+	0xf2, 0x83, 0xa0, 0x3b, 0x17, 0x3b, 0x9a, 0xe1, 0x8a, 0x15, 0x25, 0x4a, 0x68, 0x06, 0xc6, 0xb5,
+	0x9e, 0x6e, 0x10, 0xf1, 0x3b, 0xe6, 0x05, 0x2a, 0xe5, 0xa7, 0x0f, 0xae, 0x1b, 0x2f, 0x28, 0xff,
+	0x03, 0x95, 0x1d, 0x2b, 0x4e, 0xa7, 0xc7, 0xe8, 0xf4, 0xb0, 0xe1, 0x58, 0xb4, 0xe1, 0x16, 0x4c,
+	0xbd, 0x8f, 0x5d, 0xcf, 0xb0, 0xad, 0xe2, 0x93, 0x7c, 0x13, 0x1a, 0x01, 0x47, 0xb8, 0x4d, 0x4e,
+	0xb8, 0x88, 0xb1, 0x54, 0x55, 0xbf, 0xa8, 0x3c, 0x04, 0xf4, 0x48, 0xf3, 0xc8, 0x3d, 0xdb, 0x22,
+	0x5a, 0x87, 0x14, 0xef, 0xf4, 0x63, 0xb8, 0x14, 0xe3, 0x11, 0x1d, 0xbf, 0x0d, 0x75, 0x53, 0xf3,
+	0x48, 0xbb, 0xc3, 0xe5, 0x4d, 0xe9, 0x0c, 0x33, 0x54, 0x33, 0x43, 0x42, 0xe5, 0x0b, 0x98, 0x56,
+	0xb1, 0xd3, 0x23, 0x1a, 0x19, 0x65, 0x6c, 0x8a, 0x6c, 0xe5, 0xaf, 0x24, 0xa8, 0xb5, 0xe8, 0x5c,
+	0x7f, 0xcf, 0xb0, 0x74, 0xfb, 0x19, 0x35, 0xe9, 0x19, 0xfb, 0x12, 0x8b, 0xee, 0x4c, 0x26, 0xf1,
+	0x96, 0xfc, 0x8a, 0x75, 0x0d, 0xea, 0xb6, 0x65, 0x1a, 0x16, 0x6e, 0x77, 0xec, 0x9e, 0xc5, 0xd7,
+	0xd5, 0xb8, 0x5a, 0xe3, 0xb2, 0x7b, 0x54, 0x44, 0x6f, 0xa5, 0xfc, 0xf6, 0xc5, 0x11, 0x25, 0x86,
+	0x00, 0x26, 0x62, 0x00, 0xe5, 0x5f, 0x15, 0x40, 0xd1, 0x71, 0x09, 0xce, 0xc6, 0x65, 0x4e, 0x23,
+	0xb4, 0xbb, 0x1e, 0x1b, 0x98, 0x24, 0x7c, 0xe3, 0x09, 0xc3, 0xaa, 0xa2, 0x0d, 0x7a, 0x3d, 0xba,
+	0xd2, 0x6b, 0x9b, 0x2b, 0xf9, 0x8d, 0xd9, 0xd8, 0xf8, 0xdb, 0xe1, 0x31, 0x34, 0x74, 0xc3, 0xfb,
+	0xbc, 0xa7, 0x99, 0xc6, 0xa1, 0x81, 0xf5, 0xb6, 0x46, 0x86, 0xbc, 0x31, 0x48, 0x6c, 0x7c, 0xa6,
+	0xa2, 0x8d, 0x5b, 0x84, 0x8e, 0xb5, 0xd7, 0xf3, 0x1c, 0x6c, 0xe9, 0x9c, 0xeb, 0xc2, 0x19, 0xb8,
+	0x6a, 0x41, 0xcb, 0x16, 0x41, 0xef, 0xc3, 0x8c, 0x7d, 0x78, 0xc8, 0x06, 0x3b, 0x46, 0x38, 0x7e,
+	0x06, 0x42, 0x24, 0x18, 0xf6, 0x22, 0xbc, 0x1f, 0xc1, 0x9c, 0xcf, 0xdb, 0xb3, 0x74, 0xec, 0xb6,
+	0x5d, 0x7c, 0x62, 0xe0, 0x67, 0x94, 0xba, 0x7c, 0x06, 0x6a, 0x5f, 0xb9, 0x7d, 0xca, 0xa1, 0x32,
+	0x8a, 0x16, 0x41, 0x2d, 0xa8, 0x9e, 0x60, 0x42, 0xb8, 0xa6, 0xd5, 0x33, 0xd0, 0x4d, 0xf0, 0x66,
+	0x2d, 0x82, 0xee, 0x01, 0xf4, 0xd8, 0x4d, 0x81, 0x71, 0x54, 0xce, 0xb0, 0x54, 0xab, 0xa2, 0x1d,
+	0xd7, 0xe3, 0x53, 0xdb, 0xb0, 0x38, 0xc7, 0xc4, 0x19, 0x38, 0x26, 0x78, 0xb3, 0x16, 0x91, 0x17,
+	0xa1, 0xcc, 0x17, 0x19, 0xf5, 0x7b, 0x5e, 0xc7, 0x76, 0xb1, 0x88, 0x60, 0xf0, 0x82, 0xfc, 0xdb,
+	0x31, 0x18, 0x6f, 0xf9, 0x0e, 0xb5, 0xbf, 0x1e, 0xad, 0xc3, 0x45, 0x3e, 0x6f, 0xd4, 0x69, 0xb5,
+	0x39, 0x80, 0xdf, 0xdb, 0x1a, 0xa1, 0x7c, 0x8f, 0x41, 0x53, 0xf6, 0x4c, 0x29, 0xba, 0x67, 0xd0,
+	0x0a, 0x4c, 0x7a, 0xbd, 0x4e, 0x07, 0x7b, 0x9e, 0x80, 0xf0, 0x98, 0x4d, 0x5d, 0x08, 0x39, 0x88,
+	0x7a, 0x7b, 0xd3, 0x39, 0xd6, 0xd8, 0x0a, 0x91, 0x54, 0x5e, 0xa0, 0x17, 0x87, 0x03, 0x4c, 0x34,
+	0x36, 0xb7, 0x92, 0xca, 0xbe, 0x29, 0x5d, 0xcf, 0xfa, 0xcc, 0xb2, 0x9f, 0x59, 0x6d, 0xde, 0xa2,
+	0xc2, 0x2a, 0xeb, 0x42, 0xd8, 0x62, 0x0d, 0xaf, 0x81, 0x5f, 0x6e, 0x33, 0x82, 0x09, 0x86, 0xa9,
+	0x09, 0xd9, 0x16, 0xe5, 0x79, 0x11, 0x2a, 0xc7, 0x06, 0xbd, 0xa3, 0x9e, 0x36, 0xab, 0x7d, 0x7f,
+	0xe1, 0x88, 0x03, 0x52, 0x7d, 0x98, 0xf2, 0x08, 0x9a, 0x4f, 0xdd, 0x9e, 0x47, 0xb0, 0x1e, 0x1c,
+	0x33, 0xbc, 0xe2, 0x1e, 0xfc, 0x0f, 0x12, 0xcc, 0xa7, 0xd0, 0x09, 0x8f, 0xf2, 0x11, 0x20, 0xc2,
+	0x2b, 0xdb, 0x81, 0x73, 0xf4, 0xc4, 0x71, 0xe1, 0x56, 0x84, 0x3b, 0x93, 0x61, 0x83, 0xfa, 0xd6,
+	0x7d, 0xf5, 0x91, 0x3a, 0x4d, 0x92, 0x10, 0xf9, 0x11, 0x54, 0x44, 0x2d, 0x5a, 0x85, 0x0a, 0xe5,
+	0x69, 0x8b, 0xff, 0x65, 0xbf, 0x6f, 0x2e, 0xd3, 0xea, 0x1d, 0x9d, 0xfe, 0xd2, 0x34, 0x5d, 0x0f,
+	0xce, 0x10, 0x55, 0xd5, 0x2f, 0x2a, 0xf7, 0xa0, 0xf1, 0xc4, 0xc1, 0xae, 0x46, 0x6c, 0xb7, 0xf8,
+	0x68, 0x18, 0x70, 0x31, 0x24, 0x11, 0x63, 0x30, 0x03, 0xe3, 0xb8, 0xab, 0x19, 0xa6, 0xf8, 0x87,
+	0xf2, 0x02, 0xfd, 0xc1, 0x3f, 0xd3, 0x4c, 0x13, 0x13, 0xa1, 0x87, 0x28, 0xa1, 0x55, 0x68, 0xf0,
+	0xaf, 0xf6, 0x21, 0xd6, 0x48, 0xcf, 0x65, 0x31, 0x84, 0xd2, 0x5a, 0x55, 0x9d, 0xe2, 0xe2, 0x87,
+	0x42, 0xaa, 0xfc, 0x10, 0x66, 0x1f, 0xda, 0xee, 0x11, 0x26, 0xe7, 0x75, 0x4b, 0x9a, 0xeb, 0xeb,
+	0x5e, 0x18, 0xbc, 0x04, 0x35, 0xc3, 0x6a, 0x3b, 0xae, 0x1d, 0x9e, 0xeb, 0x26, 0x54, 0x30, 0xac,
+	0x5d, 0x21, 0x51, 0x7e, 0x2a, 0xc1, 0xd2, 0x03, 0x8f, 0x18, 0x5d, 0x8d, 0x60, 0x7d, 0x57, 0x3b,
+	0xb5, 0x7b, 0xe7, 0x64, 0xc4, 0xff, 0xc3, 0x72, 0xb6, 0x1e, 0xc2, 0x9a, 0xdb, 0x80, 0xb0, 0x8f,
+	0x69, 0x63, 0xcd, 0xb5, 0x0c, 0xeb, 0xc8, 0x13, 0xa7, 0xb2, 0xe9, 0xa0, 0xe6, 0x81, 0xa8, 0x50,
+	0xde, 0x81, 0xd9, 0x04, 0x65, 0xf1, 0xd5, 0xb4, 0x0d, 0x73, 0x7d, 0x5c, 0xc5, 0xb4, 0xda, 0x82,
+	0xa9, 0x91, 0xaf, 0x53, 0x3b, 0xd0, 0x48, 0xde, 0xa3, 0x5e, 0x85, 0x9a, 0xc3, 0xf4, 0x6a, 0x1b,
+	0xd6, 0xa1, 0x2d, 0x98, 0x2e, 0x47, 0x98, 0xb8, 0xd6, 0x3b, 0xd6, 0xa1, 0xad, 0x82, 0x13, 0x7c,
+	0x2b, 0x9f, 0xc0, 0x8c, 0xa0, 0xda, 0xc5, 0xae, 0x61, 0xeb, 0xc5, 0x27, 0x7d, 0x16, 0xca, 0x0e,
+	0xa3, 0xf0, 0xb7, 0x11, 0x2f, 0x29, 0x4f, 0xe0, 0x72, 0xa2, 0x87, 0x11, 0x55, 0xfe, 0x12, 0xe6,
+	0xce, 0xf5, 0x52, 0xad, 0x42, 0x33, 0xf3, 0x36, 0x5d, 0xd4, 0xa6, 0x5f, 0xd2, 0x68, 0x69, 0x82,
+	0x74, 0xd4, 0x09, 0x29, 0x10, 0x24, 0x0e, 0xe7, 0xb0, 0x14, 0x9b, 0xc3, 0x0f, 0x60, 0x31, 0x4b,
+	0xbb, 0x11, 0x0d, 0x6f, 0xc1, 0x24, 0xdd, 0x1a, 0xb8, 0xb8, 0x9d, 0xca, 0x0d, 0x98, 0xf2, 0x29,
+	0x42, 0x3f, 0x1f, 0x26, 0x59, 0x4a, 0x2a, 0x2f, 0x30, 0x7f, 0xc0, 0x70, 0xa3, 0x2f, 0x1b, 0xe5,
+	0x13, 0x98, 0xeb, 0xe3, 0x12, 0x9d, 0x3f, 0x80, 0x8b, 0x98, 0x55, 0x85, 0xff, 0x59, 0xf1, 0x9b,
+	0x95, 0xa3, 0x17, 0xea, 0x44, 0xeb, 0x06, 0x8e, 0x0b, 0x94, 0x0f, 0xa1, 0x91, 0xc0, 0xa4, 0x9b,
+	0x55, 0x64, 0x05, 0x6f, 0xc3, 0xcc, 0xbe, 0xa5, 0x1b, 0x1e, 0x71, 0x8d, 0x83, 0x1e, 0x19, 0x65,
+	0xec, 0x6f, 0xc3, 0xe5, 0x04, 0x53, 0xee, 0x14, 0x7c, 0x09, 0x73, 0xbb, 0xda, 0xa9, 0x47, 0x7a,
+	0x07, 0xe7, 0xb3, 0x75, 0xb7, 0xa1, 0xd9, 0xdf, 0xbf, 0xd0, 0xf8, 0x16, 0x54, 0x1c, 0x5e, 0xd7,
+	0x94, 0xfa, 0x62, 0x1a, 0xa2, 0x95, 0xea, 0x43, 0xa8, 0x1b, 0xf7, 0x65, 0x85, 0x07, 0xef, 0xff,
+	0xa0, 0x11, 0x70, 0x14, 0x52, 0xe2, 0x13, 0x98, 0x11, 0xb2, 0x6f, 0xcb, 0x79, 0x3f, 0x80, 0xcb,
+	0x89, 0x1e, 0x0a, 0x29, 0x4a, 0xdd, 0x5b, 0x72, 0xe0, 0xbf, 0x43, 0xee, 0xed, 0x3d, 0x58, 0xcc,
+	0xd2, 0xae, 0x90, 0xb9, 0xaf, 0x00, 0x84, 0xee, 0x8e, 0xde, 0x39, 0x8e, 0xb1, 0x19, 0x24, 0x2b,
+	0xe8, 0x37, 0x95, 0x39, 0x9a, 0x50, 0xba, 0xa4, 0xb2, 0x6f, 0xe5, 0xe7, 0x25, 0xa8, 0x08, 0x2a,
+	0x9a, 0x2e, 0xe6, 0x61, 0x3d, 0x91, 0xc3, 0xf5, 0xd3, 0xc5, 0x4c, 0xd8, 0x62, 0xc9, 0x5b, 0x74,
+	0x05, 0xaa, 0x1c, 0x73, 0x84, 0xfd, 0x98, 0xd6, 0x04, 0x13, 0xbc, 0x8d, 0x09, 0x5a, 0x83, 0x8b,
+	0x41, 0x65, 0x5b, 0x84, 0xc3, 0xf8, 0x4d, 0x6a, 0xca, 0xc7, 0xa8, 0x4c, 0x8a, 0x6e, 0x40, 0x23,
+	0x44, 0xf2, 0xb0, 0x01, 0xbf, 0x4f, 0x4d, 0xfa, 0x40, 0x7e, 0xaf, 0x5b, 0x86, 0x7a, 0xc7, 0xee,
+	0x3a, 0x81, 0x46, 0x3c, 0x1f, 0x0e, 0x54, 0x26, 0x14, 0x9a, 0x87, 0x09, 0x86, 0xa0, 0xfa, 0xf0,
+	0x84, 0x78, 0x85, 0x96, 0xa9, 0x3a, 0x37, 0xa0, 0xe1, 0x57, 0xf9, 0xda, 0x54, 0x78, 0x27, 0x02,
+	0x21, 0x94, 0xb9, 0x0e, 0x53, 0x01, 0x8e, 0xeb, 0x32, 0xc1, 0xef, 0x76, 0x02, 0xc6, 0x55, 0xf1,
+	0x47, 0xb4, 0x9a, 0x32, 0xa2, 0x10, 0x8e, 0x28, 0x5a, 0x86, 0x5a, 0xc4, 0x37, 0x35, 0x6b, 0xac,
+	0x2a, 0x2a, 0xa2, 0x39, 0x7c, 0xdd, 0xf0, 0x1c, 0xdb, 0xc3, 0x7a, 0xb3, 0xce, 0x87, 0xd0, 0x2f,
+	0xd3, 0xdb, 0xd9, 0x36, 0x36, 0xf5, 0x56, 0x97, 0xde, 0x27, 0xb7, 0xf9, 0x95, 0xad, 0xf8, 0x66,
+	0xff, 0x7a, 0x0c, 0xe6, 0x53, 0xe8, 0xc4, 0xfa, 0xda, 0x0d, 0xef, 0x8e, 0xfc, 0x5f, 0xf1, 0x6a,
+	0x84, 0x30, 0xb3, 0x59, 0x4a, 0x8d, 0x4f, 0x23, 0xbf, 0x09, 0x10, 0xd6, 0x46, 0x56, 0xbe, 0x14,
+	0x5d, 0xf9, 0x54, 0xae, 0x75, 0x83, 0xe0, 0x55, 0x49, 0x15, 0x25, 0xf9, 0x2b, 0x09, 0xa6, 0xfb,
+	0xc8, 0xfb, 0xb6, 0x9c, 0x34, 0x78, 0xcb, 0xa9, 0x50, 0xa7, 0xd3, 0xd3, 0xe6, 0xbc, 0xf4, 0xaa,
+	0x47, 0xad, 0xbb, 0x73, 0x46, 0xeb, 0xd4, 0xda, 0x71, 0xf0, 0xed, 0xd1, 0xdc, 0x67, 0xe2, 0x30,
+	0xce, 0x1e, 0x32, 0x14, 0x9f, 0x9b, 0xc7, 0xb0, 0x90, 0x4e, 0x58, 0xec, 0x88, 0xff, 0x04, 0xae,
+	0xb4, 0x4c, 0x33, 0xbc, 0x1e, 0x8f, 0x7c, 0xde, 0x7f, 0x1f, 0x16, 0xd2, 0x09, 0x47, 0x3c, 0x7c,
+	0x75, 0xe1, 0x5a, 0x8c, 0x97, 0x3b, 0xbd, 0x51, 0xd5, 0xcd, 0xfc, 0x99, 0x7c, 0x1f, 0x94, 0xbc,
+	0xee, 0x9e, 0xc3, 0xb5, 0xc0, 0xa7, 0x1e, 0xd9, 0x84, 0x82, 0xd7, 0x82, 0xbe, 0xfe, 0x9f, 0xc7,
+	0xb5, 0x20, 0xfe, 0x4b, 0x3a, 0x07, 0xd3, 0x72, 0xaf, 0x05, 0x19, 0xda, 0x8d, 0x68, 0xf8, 0x63,
+	0x98, 0xe7, 0xa7, 0xdf, 0x5d, 0xec, 0x3e, 0x87, 0xe3, 0x7a, 0x07, 0xe4, 0x34, 0xba, 0xe7, 0x7b,
+	0x62, 0x8f, 0x2e, 0xc0, 0x51, 0xcf, 0x86, 0x05, 0x0f, 0xb7, 0xfd, 0xfd, 0x17, 0x3e, 0x57, 0xb2,
+	0xe9, 0x1c, 0xd9, 0x8c, 0xbc, 0x73, 0x65, 0xbc, 0x87, 0xc2, 0xe7, 0xca, 0xc4, 0x0a, 0x3c, 0x87,
+	0x91, 0xcf, 0x3b, 0x57, 0x66, 0x69, 0x57, 0xc8, 0xdc, 0x3f, 0x4b, 0x50, 0x7f, 0xf0, 0x85, 0x41,
+	0xfc, 0xf0, 0x5d, 0x91, 0x1f, 0xf2, 0x12, 0xd4, 0x74, 0xbb, 0xab, 0x19, 0x56, 0xdb, 0xd2, 0xba,
+	0x58, 0x4c, 0x0b, 0x70, 0xd1, 0x7b, 0x5a, 0x97, 0x45, 0xea, 0x1d, 0xec, 0x76, 0xb0, 0x45, 0x93,
+	0x7e, 0x5d, 0x27, 0xc8, 0xdb, 0x8e, 0xa9, 0x0d, 0x21, 0xbf, 0x27, 0xc4, 0x68, 0x11, 0x40, 0xc4,
+	0xdc, 0x0f, 0x7b, 0x26, 0x3b, 0x35, 0x4e, 0xa8, 0x11, 0x09, 0xfd, 0x6f, 0x0a, 0x0a, 0x1a, 0xf4,
+	0x77, 0x71, 0x07, 0x1b, 0x0e, 0x3f, 0x38, 0xd6, 0xd5, 0xe9, 0xb0, 0x46, 0xe5, 0x15, 0xca, 0x8f,
+	0x24, 0xb8, 0xb2, 0x63, 0x19, 0xc4, 0xd0, 0x08, 0x7e, 0xdb, 0xd5, 0x3a, 0xf8, 0xb0, 0x67, 0x52,
+	0x73, 0xff, 0xc3, 0x89, 0xff, 0x85, 0x74, 0x1d, 0xc4, 0x8c, 0xbd, 0x0c, 0x13, 0xb1, 0x78, 0x6a,
+	0xfc, 0xc1, 0x5a, 0x74, 0x76, 0xd4, 0x00, 0x48, 0x4f, 0x04, 0x51, 0xb2, 0x00, 0x51, 0xd8, 0xa1,
+	0xed, 0xc1, 0x42, 0x3a, 0x61, 0xaa, 0x96, 0xa5, 0xa1, 0xb4, 0xdc, 0xfc, 0xa6, 0x04, 0x15, 0xf1,
+	0xe2, 0x0e, 0x3d, 0x84, 0x6a, 0xf0, 0x24, 0x0d, 0x5d, 0x89, 0xb4, 0x4d, 0xbe, 0x8d, 0x93, 0x17,
+	0xd2, 0x2b, 0x85, 0x22, 0xdb, 0x30, 0xce, 0xdf, 0xeb, 0x2d, 0x66, 0x3d, 0xeb, 0x13, 0x34, 0x4b,
+	0x99, 0xf5, 0x82, 0xa9, 0x03, 0x53, 0xf1, 0x87, 0x84, 0x68, 0x35, 0xa3, 0x49, 0xf2, 0x87, 0x21,
+	0xaf, 0x0d, 0x06, 0x8a, 0x4e, 0x3c, 0x68, 0x66, 0x3d, 0xcc, 0x43, 0x2f, 0x44, 0x1f, 0x55, 0xe4,
+	0x3f, 0x18, 0x94, 0x6f, 0x0e, 0x85, 0x15, 0x9d, 0x1e, 0xc1, 0x4c, 0xda, 0xd3, 0x3b, 0x74, 0x23,
+	0xb6, 0x0c, 0x32, 0x1f, 0xfb, 0xc9, 0xab, 0x03, 0x71, 0xbc, 0xa3, 0xcd, 0xbf, 0x95, 0xa1, 0x1a,
+	0xbc, 0x06, 0x43, 0x1a, 0xd4, 0xa3, 0x8f, 0xeb, 0x62, 0xc3, 0x99, 0xf7, 0xa0, 0x4f, 0x5e, 0x1b,
+	0x0c, 0x14, 0x96, 0x9d, 0xc0, 0x7c, 0xe6, 0x4b, 0x38, 0x74, 0x33, 0x8d, 0x26, 0x23, 0xb2, 0x2b,
+	0xdf, 0x1a, 0x0e, 0x1c, 0x24, 0xbb, 0x2e, 0x26, 0x41, 0x48, 0xc9, 0x61, 0xf0, 0x7b, 0x59, 0xc9,
+	0xc5, 0x08, 0xf2, 0x2e, 0xcc, 0xa6, 0xbf, 0x4a, 0x43, 0x6b, 0x7d, 0x2f, 0x66, 0xb2, 0xcc, 0x59,
+	0x1f, 0x02, 0x29, 0xba, 0x53, 0x61, 0x32, 0x86, 0x40, 0x4b, 0x59, 0x6d, 0x7d, 0xf2, 0xe5, 0x6c,
+	0x80, 0xe0, 0x74, 0x60, 0x2e, 0xe3, 0x5d, 0x18, 0x5a, 0xef, 0x7f, 0xc9, 0x93, 0x65, 0xc4, 0x0b,
+	0xc3, 0x40, 0x45, 0x8f, 0xfb, 0x30, 0x15, 0x87, 0xa0, 0xe5, 0xcc, 0xd6, 0x3e, 0xff, 0xb5, 0x1c,
+	0x44, 0x48, 0x1b, 0x7f, 0xa6, 0x15, 0xa3, 0x4d, 0x7d, 0x3f, 0x26, 0x5f, 0xcb, 0x41, 0x08, 0xda,
+	0x37, 0x60, 0x9c, 0xd5, 0xa0, 0xb9, 0x24, 0xd6, 0x27, 0x69, 0xf6, 0x57, 0x88, 0x4d, 0xf6, 0xd7,
+	0x12, 0x5c, 0xa0, 0x3f, 0x16, 0x74, 0x17, 0x2a, 0xe2, 0x19, 0x0f, 0x9a, 0x8f, 0xa0, 0xe3, 0xcf,
+	0x83, 0x64, 0x39, 0xad, 0x4a, 0xa8, 0xf1, 0x08, 0x6a, 0x91, 0x37, 0x39, 0xe8, 0x6a, 0x04, 0xda,
+	0xff, 0xe6, 0x47, 0x5e, 0xcc, 0xaa, 0x16, 0x6c, 0x3b, 0x00, 0xe1, 0xeb, 0x0f, 0xb4, 0x90, 0xf1,
+	0x28, 0x84, 0x73, 0x5d, 0xcd, 0x7d, 0x32, 0x82, 0x3e, 0x86, 0xe9, 0xbe, 0x3c, 0x31, 0x5a, 0xc9,
+	0xcf, 0x22, 0x73, 0xe2, 0xeb, 0xc3, 0xa4, 0x9a, 0xd1, 0x3d, 0x98, 0xf0, 0x93, 0xb7, 0x28, 0x3a,
+	0x40, 0x89, 0xb4, 0xb0, 0x7c, 0x25, 0xb5, 0x4e, 0x90, 0x7c, 0x00, 0x8d, 0x44, 0x5e, 0x14, 0x45,
+	0xa7, 0x3e, 0x3d, 0x65, 0x2b, 0x2b, 0x79, 0x10, 0x31, 0xc5, 0x7f, 0xaf, 0xb2, 0x48, 0x9d, 0xdd,
+	0x23, 0x1e, 0x9d, 0x65, 0x7f, 0x45, 0x47, 0x67, 0x39, 0xb1, 0x94, 0xe5, 0xb4, 0xaa, 0x70, 0x83,
+	0xc7, 0x12, 0x64, 0xb1, 0x0d, 0x9e, 0x96, 0x9c, 0x93, 0x97, 0xb3, 0x01, 0xa1, 0x03, 0xec, 0xdb,
+	0xd9, 0x4a, 0x7f, 0xab, 0x3e, 0xeb, 0x57, 0x72, 0x31, 0xa1, 0x03, 0x4c, 0xcf, 0x06, 0xc5, 0x1c,
+	0x60, 0x6e, 0x3a, 0x4b, 0x5e, 0x1f, 0x02, 0x29, 0xba, 0x7b, 0x0b, 0xca, 0xfc, 0xee, 0x85, 0x9a,
+	0x7d, 0xd7, 0x31, 0x9f, 0x6e, 0x3e, 0xa5, 0x26, 0x5c, 0x06, 0xc9, 0x44, 0xca, 0xb5, 0x9c, 0x6b,
+	0x5d, 0xca, 0x32, 0xc8, 0xca, 0xf4, 0x78, 0xd0, 0xcc, 0xca, 0x59, 0xc7, 0x0e, 0x0b, 0x03, 0x12,
+	0xec, 0xf2, 0xcd, 0xa1, 0xb0, 0x11, 0x73, 0xe2, 0x98, 0xb8, 0x39, 0xa9, 0x19, 0x6f, 0x59, 0xc9,
+	0x83, 0x84, 0xeb, 0x30, 0x96, 0xcb, 0x89, 0xad, 0xc3, 0xb4, 0x7c, 0x91, 0xbc, 0x9c, 0x0d, 0x08,
+	0xd7, 0x61, 0x32, 0xb2, 0x1e, 0x5b, 0x87, 0x19, 0xd9, 0x20, 0x79, 0x25, 0x17, 0x23, 0xc8, 0xef,
+	0x86, 0xf1, 0xf2, 0xf9, 0x7e, 0x7c, 0xda, 0xd6, 0x4b, 0x5e, 0xbf, 0x54, 0x98, 0x8c, 0xa5, 0x37,
+	0x62, 0x26, 0xa7, 0xa5, 0x56, 0xe4, 0xe5, 0x6c, 0x40, 0xb8, 0x3b, 0xd2, 0x93, 0x09, 0xb1, 0xdd,
+	0x91, 0x9b, 0x0d, 0x91, 0xd7, 0x87, 0x40, 0x86, 0xae, 0xb8, 0x3f, 0x50, 0xbb, 0x92, 0x1f, 0x5f,
+	0xed, 0x77, 0xc5, 0x99, 0x41, 0xd8, 0xcd, 0x7f, 0x54, 0xa1, 0x2c, 0xd6, 0xd9, 0x11, 0xcc, 0xa4,
+	0x85, 0x21, 0x63, 0xe7, 0xd4, 0x9c, 0xc0, 0xa7, 0xbc, 0x3a, 0x10, 0x27, 0x6c, 0x3a, 0x05, 0x39,
+	0x3b, 0x50, 0x88, 0x6e, 0x65, 0xd1, 0xa4, 0x05, 0xc8, 0xe4, 0xdb, 0x43, 0xa2, 0x23, 0x8e, 0x33,
+	0x11, 0xc5, 0x8b, 0x3b, 0xce, 0xf4, 0x10, 0xa3, 0xbc, 0x92, 0x8b, 0x89, 0x38, 0xce, 0xd4, 0x78,
+	0x59, 0xdc, 0x71, 0xe6, 0x05, 0xfc, 0xe4, 0xf5, 0x21, 0x90, 0xcf, 0xc7, 0x71, 0x6a, 0x80, 0xfa,
+	0x83, 0x66, 0xe8, 0x7a, 0x5f, 0x83, 0x94, 0x10, 0x9d, 0xfc, 0xdf, 0x03, 0x50, 0xe7, 0xe9, 0x41,
+	0x8f, 0x60, 0x26, 0x2d, 0xda, 0x1f, 0x5b, 0xc6, 0x39, 0xf9, 0x05, 0x79, 0x75, 0x20, 0xee, 0xdb,
+	0x75, 0xa8, 0xc9, 0x20, 0x5f, 0xfa, 0xfa, 0x4c, 0x78, 0xc1, 0x95, 0x5c, 0xcc, 0x73, 0x75, 0xa8,
+	0xd1, 0x40, 0x57, 0xdc, 0xa1, 0xa6, 0x04, 0xe8, 0xe4, 0xe5, 0x6c, 0x40, 0xe6, 0xae, 0xf1, 0xc9,
+	0x73, 0x76, 0x4d, 0xa2, 0x97, 0xf5, 0x21, 0x90, 0xfe, 0xf9, 0x5d, 0x82, 0x7a, 0x34, 0xb6, 0x42,
+	0xd7, 0x4b, 0x5a, 0x44, 0x28, 0xb6, 0x5e, 0x72, 0xc2, 0x56, 0xf2, 0xea, 0x40, 0x5c, 0xb8, 0x30,
+	0xd3, 0x82, 0x3a, 0xb1, 0x8e, 0x72, 0xc2, 0x48, 0xf2, 0xea, 0x40, 0x1c, 0xef, 0x68, 0xeb, 0xfa,
+	0x87, 0x0a, 0x75, 0xf2, 0x9f, 0x6e, 0x18, 0xf6, 0x1d, 0xf6, 0x71, 0xc7, 0x71, 0x8d, 0x13, 0x8d,
+	0xe0, 0x3b, 0x01, 0x81, 0x73, 0x70, 0x50, 0x66, 0x4f, 0x83, 0x5f, 0xfe, 0xf7, 0x00, 0xe5, 0x6f,
+	0x2a, 0xbc, 0x47, 0x3e, 0x00, 0x00,
 }
@@ -45,6 +45,8 @@ type DRPCStorageClient interface {
 	DiskSpace(ctx context.Context, in *DiskSpaceRequest) (*DiskSpaceResponse, error)
 	Usage(ctx context.Context, in *StorageUsageRequest) (*StorageUsageResponse, error)
 	UsageSatellite(ctx context.Context, in *StorageUsageSatelliteRequest) (*StorageUsageSatelliteResponse, error)
+	UpdateAllocatedDiskSpace(ctx context.Context, in *UpdateAllocatedDiskSpaceRequest) (*UpdateAllocatedDiskSpaceResponse, error)
+	RecalculateUsedSpace(ctx context.Context, in *RecalculateUsedSpaceRequest) (*RecalculateUsedSpaceResponse, error)
 }
 
 type drpcStorageClient struct {
@@ -84,10 +86,30 @@ func (c *drpcStorageClient) UsageSatellite(ctx context.Context, in *StorageUsage
 	return out, nil
 }
 
+func (c *drpcStorageClient) UpdateAllocatedDiskSpace(ctx context.Context, in *UpdateAllocatedDiskSpaceRequest) (*UpdateAllocatedDiskSpaceResponse, error) {
+	out := new(UpdateAllocatedDiskSpaceResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Storage/UpdateAllocatedDiskSpace", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcStorageClient) RecalculateUsedSpace(ctx context.Context, in *RecalculateUsedSpaceRequest) (*RecalculateUsedSpaceResponse, error) {
+	out := new(RecalculateUsedSpaceResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Storage/RecalculateUsedSpace", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCStorageServer interface {
 	DiskSpace(context.Context, *DiskSpaceRequest) (*DiskSpaceResponse, error)
 	Usage(context.Context, *StorageUsageRequest) (*StorageUsageResponse, error)
 	UsageSatellite(context.Context, *StorageUsageSatelliteRequest) (*StorageUsageSatelliteResponse, error)
+	UpdateAllocatedDiskSpace(context.Context, *UpdateAllocatedDiskSpaceRequest) (*UpdateAllocatedDiskSpaceResponse, error)
+	RecalculateUsedSpace(context.Context, *RecalculateUsedSpaceRequest) (*RecalculateUsedSpaceResponse, error)
 }
 
 type DRPCStorageUnimplementedServer struct{}
@@ -104,9 +126,17 @@ func (s *DRPCStorageUnimplementedServer) UsageSatellite(context.Context, *Storag
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCStorageUnimplementedServer) UpdateAllocatedDiskSpace(context.Context, *UpdateAllocatedDiskSpaceRequest) (*UpdateAllocatedDiskSpaceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCStorageUnimplementedServer) RecalculateUsedSpace(context.Context, *RecalculateUsedSpaceRequest) (*RecalculateUsedSpaceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 type DRPCStorageDescription struct{}
 
-func (DRPCStorageDescription) NumMethods() int { return 3 }
+func (DRPCStorageDescription) NumMethods() int { return 5 }
 
 func (DRPCStorageDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -137,6 +167,24 @@ func (DRPCStorageDescription) Method(n int) (string, drpc.Encoding, drpc.Receive
 						in1.(*StorageUsageSatelliteRequest),
 					)
 			}, DRPCStorageServer.UsageSatellite, true
+	case 3:
+		return "/multinode.Storage/UpdateAllocatedDiskSpace", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCStorageServer).
+					UpdateAllocatedDiskSpace(
+						ctx,
+						in1.(*UpdateAllocatedDiskSpaceRequest),
+					)
+			}, DRPCStorageServer.UpdateAllocatedDiskSpace, true
+	case 4:
+		return "/multinode.Storage/RecalculateUsedSpace", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCStorageServer).
+					RecalculateUsedSpace(
+						ctx,
+						in1.(*RecalculateUsedSpaceRequest),
+					)
+			}, DRPCStorageServer.RecalculateUsedSpace, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -194,6 +242,38 @@ func (x *drpcStorage_UsageSatelliteStream) SendAndClose(m *StorageUsageSatellite
 	return x.CloseSend()
 }
 
+type DRPCStorage_UpdateAllocatedDiskSpaceStream interface {
+	drpc.Stream
+	SendAndClose(*UpdateAllocatedDiskSpaceResponse) error
+}
+
+type drpcStorage_UpdateAllocatedDiskSpaceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcStorage_UpdateAllocatedDiskSpaceStream) SendAndClose(m *UpdateAllocatedDiskSpaceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCStorage_RecalculateUsedSpaceStream interface {
+	drpc.Stream
+	SendAndClose(*RecalculateUsedSpaceResponse) error
+}
+
+type drpcStorage_RecalculateUsedSpaceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcStorage_RecalculateUsedSpaceStream) SendAndClose(m *RecalculateUsedSpaceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCBandwidthClient interface {
 	DRPCConn() drpc.Conn
 
@@ -597,6 +677,7 @@ type DRPCNodeClient interface {
 	Reputation(ctx context.Context, in *ReputationRequest) (*ReputationResponse, error)
 	TrustedSatellites(ctx context.Context, in *TrustedSatellitesRequest) (*TrustedSatellitesResponse, error)
 	Operator(ctx context.Context, in *OperatorRequest) (*OperatorResponse, error)
+	ForgetSatellite(ctx context.Context, in *ForgetSatelliteRequest) (*ForgetSatelliteResponse, error)
 }
 
 type drpcNodeClient struct {
@@ -654,12 +735,22 @@ func (c *drpcNodeClient) Operator(ctx context.Context, in *OperatorRequest) (*Op
 	return out, nil
 }
 
+func (c *drpcNodeClient) ForgetSatellite(ctx context.Context, in *ForgetSatelliteRequest) (*ForgetSatelliteResponse, error) {
+	out := new(ForgetSatelliteResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Node/ForgetSatellite", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCNodeServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	LastContact(context.Context, *LastContactRequest) (*LastContactResponse, error)
 	Reputation(context.Context, *ReputationRequest) (*ReputationResponse, error)
 	TrustedSatellites(context.Context, *TrustedSatellitesRequest) (*TrustedSatellitesResponse, error)
 	Operator(context.Context, *OperatorRequest) (*OperatorResponse, error)
+	ForgetSatellite(context.Context, *ForgetSatelliteRequest) (*ForgetSatelliteResponse, error)
 }
 
 type DRPCNodeUnimplementedServer struct{}
@@ -684,9 +775,13 @@ func (s *DRPCNodeUnimplementedServer) Operator(context.Context, *OperatorRequest
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCNodeUnimplementedServer) ForgetSatellite(context.Context, *ForgetSatelliteRequest) (*ForgetSatelliteResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 type DRPCNodeDescription struct{}
 
-func (DRPCNodeDescription) NumMethods() int { return 5 }
+func (DRPCNodeDescription) NumMethods() int { return 6 }
 
 func (DRPCNodeDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -735,6 +830,15 @@ func (DRPCNodeDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver,
 						in1.(*OperatorRequest),
 					)
 			}, DRPCNodeServer.Operator, true
+	case 5:
+		return "/multinode.Node/ForgetSatellite", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeServer).
+					ForgetSatellite(
+						ctx,
+						in1.(*ForgetSatelliteRequest),
+					)
+			}, DRPCNodeServer.ForgetSatellite, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -824,6 +928,22 @@ func (x *drpcNode_OperatorStream) SendAndClose(m *OperatorResponse) error {
 	return x.CloseSend()
 }
 
+type DRPCNode_ForgetSatelliteStream interface {
+	drpc.Stream
+	SendAndClose(*ForgetSatelliteResponse) error
+}
+
+type drpcNode_ForgetSatelliteStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNode_ForgetSatelliteStream) SendAndClose(m *ForgetSatelliteResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCPayoutsClient interface {
 	DRPCConn() drpc.Conn
 
@@ -1973,3 +2093,118 @@ func (x *drpcPayout_SatellitePeriodPaystubStream) SendAndClose(m *SatellitePerio
 	}
 	return x.CloseSend()
 }
+
+type DRPCGracefulExitClient interface {
+	DRPCConn() drpc.Conn
+
+	InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error)
+	GracefulExitProgress(ctx context.Context, in *GracefulExitProgressRequest) (*GracefulExitProgressResponse, error)
+}
+
+type drpcGracefulExitClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCGracefulExitClient(cc drpc.Conn) DRPCGracefulExitClient {
+	return &drpcGracefulExitClient{cc}
+}
+
+func (c *drpcGracefulExitClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcGracefulExitClient) InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error) {
+	out := new(InitiateGracefulExitResponse)
+	err := c.cc.Invoke(ctx, "/multinode.GracefulExit/InitiateGracefulExit", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcGracefulExitClient) GracefulExitProgress(ctx context.Context, in *GracefulExitProgressRequest) (*GracefulExitProgressResponse, error) {
+	out := new(GracefulExitProgressResponse)
+	err := c.cc.Invoke(ctx, "/multinode.GracefulExit/GracefulExitProgress", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCGracefulExitServer interface {
+	InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error)
+	GracefulExitProgress(context.Context, *GracefulExitProgressRequest) (*GracefulExitProgressResponse, error)
+}
+
+type DRPCGracefulExitUnimplementedServer struct{}
+
+func (s *DRPCGracefulExitUnimplementedServer) InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCGracefulExitUnimplementedServer) GracefulExitProgress(context.Context, *GracefulExitProgressRequest) (*GracefulExitProgressResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCGracefulExitDescription struct{}
+
+func (DRPCGracefulExitDescription) NumMethods() int { return 2 }
+
+func (DRPCGracefulExitDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/multinode.GracefulExit/InitiateGracefulExit", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCGracefulExitServer).
+					InitiateGracefulExit(
+						ctx,
+						in1.(*InitiateGracefulExitRequest),
+					)
+			}, DRPCGracefulExitServer.InitiateGracefulExit, true
+	case 1:
+		return "/multinode.GracefulExit/GracefulExitProgress", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCGracefulExitServer).
+					GracefulExitProgress(
+						ctx,
+						in1.(*GracefulExitProgressRequest),
+					)
+			}, DRPCGracefulExitServer.GracefulExitProgress, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterGracefulExit(mux drpc.Mux, impl DRPCGracefulExitServer) error {
+	return mux.Register(impl, DRPCGracefulExitDescription{})
+}
+
+type DRPCGracefulExit_InitiateGracefulExitStream interface {
+	drpc.Stream
+	SendAndClose(*InitiateGracefulExitResponse) error
+}
+
+type drpcGracefulExit_InitiateGracefulExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcGracefulExit_InitiateGracefulExitStream) SendAndClose(m *InitiateGracefulExitResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCGracefulExit_GracefulExitProgressStream interface {
+	drpc.Stream
+	SendAndClose(*GracefulExitProgressResponse) error
+}
+
+type drpcGracefulExit_GracefulExitProgressStream struct {
+	drpc.Stream
+}
+
+func (x *drpcGracefulExit_GracefulExitProgressStream) SendAndClose(m *GracefulExitProgressResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
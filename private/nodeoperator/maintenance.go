@@ -0,0 +1,29 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeoperator
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// MaintenanceError is the error class for invalid maintenance windows.
+var MaintenanceError = errs.Class("maintenance window")
+
+// MaintenanceWindow is a period, when the storage node is expected to be offline.
+type MaintenanceWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Duration returns the length of the maintenance window.
+func (window MaintenanceWindow) Duration() time.Duration {
+	return window.End.Sub(window.Start)
+}
+
+// Contains returns true if the time is within the maintenance window.
+func (window MaintenanceWindow) Contains(t time.Time) bool {
+	return !t.Before(window.Start) && t.Before(window.End)
+}
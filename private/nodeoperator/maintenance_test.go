@@ -0,0 +1,24 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeoperator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/private/nodeoperator"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	window := nodeoperator.MaintenanceWindow{Start: start, End: start.Add(4 * time.Hour)}
+
+	require.Equal(t, 4*time.Hour, window.Duration())
+	require.True(t, window.Contains(start))
+	require.True(t, window.Contains(start.Add(time.Hour)))
+	require.False(t, window.Contains(start.Add(-time.Second)))
+	require.False(t, window.Contains(window.End))
+}
@@ -0,0 +1,8 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package settlementpb contains protobuf definitions for reconciling the bandwidth settlement
+// between storage nodes and satellites.
+package settlementpb
+
+//go:generate go run gen.go
@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: settlement.proto
+
+package settlementpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SettledWindowsRequest struct {
+	// Windows starting at or after from and before to are returned.
+	From                 time.Time `protobuf:"bytes,1,opt,name=from,proto3,stdtime" json:"from"`
+	To                   time.Time `protobuf:"bytes,2,opt,name=to,proto3,stdtime" json:"to"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SettledWindowsRequest) Reset()         { *m = SettledWindowsRequest{} }
+func (m *SettledWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*SettledWindowsRequest) ProtoMessage()    {}
+func (*SettledWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5f2a06c6108c8ef, []int{0}
+}
+func (m *SettledWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettledWindowsRequest.Unmarshal(m, b)
+}
+func (m *SettledWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettledWindowsRequest.Marshal(b, m, deterministic)
+}
+func (m *SettledWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettledWindowsRequest.Merge(m, src)
+}
+func (m *SettledWindowsRequest) XXX_Size() int {
+	return xxx_messageInfo_SettledWindowsRequest.Size(m)
+}
+func (m *SettledWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettledWindowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettledWindowsRequest proto.InternalMessageInfo
+
+func (m *SettledWindowsRequest) GetFrom() time.Time {
+	if m != nil {
+		return m.From
+	}
+	return time.Time{}
+}
+
+func (m *SettledWindowsRequest) GetTo() time.Time {
+	if m != nil {
+		return m.To
+	}
+	return time.Time{}
+}
+
+type SettledWindowsResponse struct {
+	Windows              []*SettledWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SettledWindowsResponse) Reset()         { *m = SettledWindowsResponse{} }
+func (m *SettledWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*SettledWindowsResponse) ProtoMessage()    {}
+func (*SettledWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5f2a06c6108c8ef, []int{1}
+}
+func (m *SettledWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettledWindowsResponse.Unmarshal(m, b)
+}
+func (m *SettledWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettledWindowsResponse.Marshal(b, m, deterministic)
+}
+func (m *SettledWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettledWindowsResponse.Merge(m, src)
+}
+func (m *SettledWindowsResponse) XXX_Size() int {
+	return xxx_messageInfo_SettledWindowsResponse.Size(m)
+}
+func (m *SettledWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettledWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettledWindowsResponse proto.InternalMessageInfo
+
+func (m *SettledWindowsResponse) GetWindows() []*SettledWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+type SettledWindow struct {
+	// Window is the start of the hour in which the orders were created.
+	Window time.Time `protobuf:"bytes,1,opt,name=window,proto3,stdtime" json:"window"`
+	// ActionSettled contains the settled amounts by piece action.
+	ActionSettled        map[int32]int64 `protobuf:"bytes,2,rep,name=action_settled,json=actionSettled,proto3" json:"action_settled,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SettledWindow) Reset()         { *m = SettledWindow{} }
+func (m *SettledWindow) String() string { return proto.CompactTextString(m) }
+func (*SettledWindow) ProtoMessage()    {}
+func (*SettledWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5f2a06c6108c8ef, []int{2}
+}
+func (m *SettledWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettledWindow.Unmarshal(m, b)
+}
+func (m *SettledWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettledWindow.Marshal(b, m, deterministic)
+}
+func (m *SettledWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettledWindow.Merge(m, src)
+}
+func (m *SettledWindow) XXX_Size() int {
+	return xxx_messageInfo_SettledWindow.Size(m)
+}
+func (m *SettledWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettledWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettledWindow proto.InternalMessageInfo
+
+func (m *SettledWindow) GetWindow() time.Time {
+	if m != nil {
+		return m.Window
+	}
+	return time.Time{}
+}
+
+func (m *SettledWindow) GetActionSettled() map[int32]int64 {
+	if m != nil {
+		return m.ActionSettled
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SettledWindowsRequest)(nil), "settlement.SettledWindowsRequest")
+	proto.RegisterType((*SettledWindowsResponse)(nil), "settlement.SettledWindowsResponse")
+	proto.RegisterType((*SettledWindow)(nil), "settlement.SettledWindow")
+	proto.RegisterMapType((map[int32]int64)(nil), "settlement.SettledWindow.ActionSettledEntry")
+}
+
+func init() { proto.RegisterFile("settlement.proto", fileDescriptor_a5f2a06c6108c8ef) }
+
+var fileDescriptor_a5f2a06c6108c8ef = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x41, 0x4f, 0xf2, 0x40,
+	0x10, 0xfd, 0xb6, 0x7c, 0xf0, 0x7d, 0x19, 0x02, 0x21, 0x1b, 0x35, 0xb5, 0x17, 0xb0, 0xc6, 0x84,
+	0x83, 0xd9, 0x26, 0xe0, 0x81, 0x18, 0x0f, 0x4a, 0xe2, 0xd1, 0x4b, 0x31, 0x21, 0xf1, 0x62, 0x8a,
+	0x2c, 0x4d, 0x95, 0x76, 0x6a, 0x77, 0x80, 0xf0, 0x0b, 0xbc, 0xfa, 0xb3, 0xfc, 0x15, 0x7a, 0xf1,
+	0x87, 0x18, 0x77, 0xa9, 0x80, 0x06, 0x13, 0x6e, 0x3b, 0x6f, 0xde, 0x9b, 0x79, 0xfb, 0x06, 0x6a,
+	0x4a, 0x12, 0x8d, 0x65, 0x2c, 0x13, 0x12, 0x69, 0x86, 0x84, 0x1c, 0x96, 0x88, 0x03, 0x21, 0x86,
+	0x68, 0x70, 0xa7, 0x1e, 0x22, 0x86, 0x63, 0xe9, 0xe9, 0x6a, 0x30, 0x19, 0x79, 0x14, 0xc5, 0x52,
+	0x51, 0x10, 0xa7, 0x86, 0xe0, 0x3e, 0x31, 0xd8, 0xed, 0x69, 0xed, 0xb0, 0x1f, 0x25, 0x43, 0x9c,
+	0x29, 0x5f, 0x3e, 0x4e, 0xa4, 0x22, 0xde, 0x81, 0xbf, 0xa3, 0x0c, 0x63, 0x9b, 0x35, 0x58, 0xb3,
+	0xdc, 0x72, 0x84, 0x99, 0x24, 0xf2, 0x49, 0xe2, 0x3a, 0x9f, 0xd4, 0xfd, 0xff, 0xf2, 0x5a, 0xff,
+	0xf3, 0xfc, 0x56, 0x67, 0xbe, 0x56, 0xf0, 0x13, 0xb0, 0x08, 0x6d, 0x6b, 0x0b, 0x9d, 0x45, 0xe8,
+	0x5e, 0xc1, 0xde, 0x77, 0x23, 0x2a, 0xc5, 0x44, 0x49, 0xde, 0x86, 0x7f, 0x33, 0x03, 0xd9, 0xac,
+	0x51, 0x68, 0x96, 0x5b, 0xfb, 0x62, 0x25, 0x80, 0x35, 0x91, 0x9f, 0x33, 0xdd, 0x77, 0x06, 0x95,
+	0xb5, 0x16, 0x3f, 0x83, 0x92, 0x69, 0x6e, 0xf5, 0xa5, 0x85, 0x86, 0xf7, 0xa0, 0x1a, 0xdc, 0x51,
+	0x84, 0xc9, 0xad, 0xd9, 0x3d, 0xb4, 0x2d, 0xed, 0xe5, 0x78, 0xa3, 0x17, 0x71, 0xa1, 0xf9, 0x0b,
+	0xec, 0x32, 0xa1, 0x6c, 0xee, 0x57, 0x82, 0x55, 0xcc, 0x39, 0x07, 0xfe, 0x93, 0xc4, 0x6b, 0x50,
+	0x78, 0x90, 0x73, 0xed, 0xb2, 0xe8, 0x7f, 0x3e, 0xf9, 0x0e, 0x14, 0xa7, 0xc1, 0x78, 0x22, 0x75,
+	0xa8, 0x05, 0xdf, 0x14, 0xa7, 0x56, 0x87, 0xb5, 0x24, 0x40, 0xef, 0x6b, 0x3f, 0xef, 0x43, 0x75,
+	0x3d, 0x43, 0x7e, 0xb0, 0xd1, 0x5e, 0x7e, 0x68, 0xc7, 0xfd, 0x8d, 0x62, 0x4e, 0xd0, 0x3d, 0xba,
+	0x39, 0x54, 0x84, 0xd9, 0xbd, 0x88, 0xd0, 0xd3, 0x0f, 0x2f, 0xcd, 0xa2, 0x69, 0x40, 0xd2, 0x5b,
+	0x6a, 0xd3, 0xc1, 0xa0, 0xa4, 0xa3, 0x6c, 0x7f, 0x0c, 0x00, 0xfe, 0xdd, 0x6e, 0xa8, 0xa1, 0x02,
+	0x00, 0x00,
+}
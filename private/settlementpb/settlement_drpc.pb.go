@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.33
+// source: settlement.proto
+
+package settlementpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_settlement_proto struct{}
+
+func (drpcEncoding_File_settlement_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_settlement_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_settlement_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_settlement_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCSettlementClient interface {
+	DRPCConn() drpc.Conn
+
+	SettledWindows(ctx context.Context, in *SettledWindowsRequest) (*SettledWindowsResponse, error)
+}
+
+type drpcSettlementClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCSettlementClient(cc drpc.Conn) DRPCSettlementClient {
+	return &drpcSettlementClient{cc}
+}
+
+func (c *drpcSettlementClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcSettlementClient) SettledWindows(ctx context.Context, in *SettledWindowsRequest) (*SettledWindowsResponse, error) {
+	out := new(SettledWindowsResponse)
+	err := c.cc.Invoke(ctx, "/settlement.Settlement/SettledWindows", drpcEncoding_File_settlement_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCSettlementServer interface {
+	SettledWindows(context.Context, *SettledWindowsRequest) (*SettledWindowsResponse, error)
+}
+
+type DRPCSettlementUnimplementedServer struct{}
+
+func (s *DRPCSettlementUnimplementedServer) SettledWindows(context.Context, *SettledWindowsRequest) (*SettledWindowsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCSettlementDescription struct{}
+
+func (DRPCSettlementDescription) NumMethods() int { return 1 }
+
+func (DRPCSettlementDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/settlement.Settlement/SettledWindows", drpcEncoding_File_settlement_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCSettlementServer).
+					SettledWindows(
+						ctx,
+						in1.(*SettledWindowsRequest),
+					)
+			}, DRPCSettlementServer.SettledWindows, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterSettlement(mux drpc.Mux, impl DRPCSettlementServer) error {
+	return mux.Register(impl, DRPCSettlementDescription{})
+}
+
+type DRPCSettlement_SettledWindowsStream interface {
+	drpc.Stream
+	SendAndClose(*SettledWindowsResponse) error
+}
+
+type drpcSettlement_SettledWindowsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcSettlement_SettledWindowsStream) SendAndClose(m *SettledWindowsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_settlement_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
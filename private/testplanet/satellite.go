@@ -28,6 +28,7 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/accounting/live"
+	"storj.io/storj/satellite/accounting/objectstats"
 	"storj.io/storj/satellite/accounting/projectbwcleanup"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/rolluparchive"
@@ -159,6 +160,7 @@ type Satellite struct {
 		ProjectUsage     *accounting.Service
 		ProjectBWCleanup *projectbwcleanup.Chore
 		RollupArchive    *rolluparchive.Chore
+		ObjectStats      *objectstats.Chore
 	}
 
 	LiveAccounting struct {
@@ -502,14 +504,17 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 
 	planet.databases = append(planet.databases, revocationDB)
 
-	liveAccounting, err := live.OpenCache(ctx, log.Named("live-accounting"), config.LiveAccounting)
+	liveAccounting, err := live.OpenCache(ctx, log.Named("live-accounting"), config.LiveAccounting, db.LiveAccounting())
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
 	planet.databases = append(planet.databases, liveAccounting)
 
-	config.Payments.Provider = "mock"
-	config.Payments.MockProvider = stripe.NewStripeMock(db.StripeCoinPayments().Customers(), db.Console().Users())
+	// the offline provider doesn't need an external service, so it can be tested as is.
+	if config.Payments.Provider != "offline" {
+		config.Payments.Provider = "mock"
+		config.Payments.MockProvider = stripe.NewStripeMock(db.StripeCoinPayments().Customers(), db.Console().Users())
+	}
 
 	peer, err := satellite.New(log, identity, db, metabaseDB, revocationDB, liveAccounting, versionInfo, &config, nil)
 	if err != nil {
@@ -647,6 +652,7 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.Accounting.ProjectUsage = api.Accounting.ProjectUsage
 	system.Accounting.ProjectBWCleanup = peer.Accounting.ProjectBWCleanupChore
 	system.Accounting.RollupArchive = peer.Accounting.RollupArchiveChore
+	system.Accounting.ObjectStats = peer.Accounting.ObjectStatsChore
 
 	system.LiveAccounting = peer.LiveAccounting
 
@@ -669,7 +675,7 @@ func (planet *Planet) newAPI(ctx context.Context, index int, identity *identity.
 	}
 	planet.databases = append(planet.databases, revocationDB)
 
-	liveAccounting, err := live.OpenCache(ctx, log.Named("live-accounting"), config.LiveAccounting)
+	liveAccounting, err := live.OpenCache(ctx, log.Named("live-accounting"), config.LiveAccounting, db.LiveAccounting())
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
@@ -696,7 +702,7 @@ func (planet *Planet) newAdmin(ctx context.Context, index int, identity *identit
 	prefix := "satellite-admin" + strconv.Itoa(index)
 	log := planet.log.Named(prefix)
 
-	liveAccounting, err := live.OpenCache(ctx, log.Named("live-accounting"), config.LiveAccounting)
+	liveAccounting, err := live.OpenCache(ctx, log.Named("live-accounting"), config.LiveAccounting, db.LiveAccounting())
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
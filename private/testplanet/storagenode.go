@@ -178,6 +178,7 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 				ArchiveTTL:      time.Hour,
 				MaxSleep:        0,
 				Path:            filepath.Join(storageDir, "orders"),
+				SettledCacheTTL: time.Minute,
 			},
 			Monitor: monitor.Config{
 				MinimumDiskSpace:          100 * memory.MB,
@@ -206,7 +207,8 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 			Interval: defaultInterval,
 		},
 		Contact: contact.Config{
-			Interval: defaultInterval,
+			Interval:        defaultInterval,
+			MaintenancePath: filepath.Join(storageDir, "maintenance.json"),
 		},
 		GracefulExit: gracefulexit.Config{
 			ChoreInterval:          defaultInterval,
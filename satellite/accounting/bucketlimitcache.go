@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrGetBucketLimitCache error for getting bucket limits from cache.
+var ErrGetBucketLimitCache = errs.Class("get bucket limits from cache")
+
+// BucketLimitDB stores information about buckets limits.
+//
+// architecture: Database
+type BucketLimitDB interface {
+	// GetBucketLimits returns the usage limits of the bucket.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (buckets.Limits, error)
+}
+
+// BucketLimitCache stores the storage, bandwidth and segment limits of the
+// buckets.
+type BucketLimitCache struct {
+	bucketLimitDB BucketLimitDB
+
+	state *lrucache.ExpiringLRUOf[buckets.Limits]
+}
+
+// NewBucketLimitCache creates a new bucket limit cache to store the limits of
+// each bucket.
+func NewBucketLimitCache(db BucketLimitDB, config ProjectLimitConfig) *BucketLimitCache {
+	return &BucketLimitCache{
+		bucketLimitDB: db,
+		state: lrucache.NewOf[buckets.Limits](lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+			Name:       "accounting-bucketlimit",
+		}),
+	}
+}
+
+// GetLimits returns the bucket limits from cache. Buckets, which don't exist,
+// don't have any limits.
+func (c *BucketLimitCache) GetLimits(ctx context.Context, bucket metabase.BucketLocation) (_ buckets.Limits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := c.state.Get(ctx, string(bucket.Prefix()),
+		func() (buckets.Limits, error) {
+			limits, err := c.bucketLimitDB.GetBucketLimits(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+			if buckets.ErrBucketNotFound.Has(err) {
+				return buckets.Limits{}, nil
+			}
+			return limits, err
+		})
+	if err != nil {
+		return buckets.Limits{}, ErrGetBucketLimitCache.Wrap(err)
+	}
+	return limits, nil
+}
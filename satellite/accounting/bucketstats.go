@@ -4,9 +4,17 @@
 package accounting
 
 import (
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
+// ErrObjectStatsNotFound is returned when there are no object statistics for a bucket.
+var ErrObjectStatsNotFound = errs.Class("bucket object stats not found")
+
 // BucketTally contains information about aggregate data stored in a bucket.
 type BucketTally struct {
 	metabase.BucketLocation
@@ -36,3 +44,36 @@ func (s *BucketTally) Segments() int64 {
 func (s *BucketTally) Bytes() int64 {
 	return s.TotalBytes
 }
+
+// BucketObjectStats contains the object statistics of a bucket.
+type BucketObjectStats struct {
+	ProjectID   uuid.UUID `json:"projectID"`
+	BucketName  string    `json:"bucketName"`
+	CollectedAt time.Time `json:"collectedAt"`
+
+	ObjectCount        int64 `json:"objectCount"`
+	PendingObjectCount int64 `json:"pendingObjectCount"`
+	TotalBytes         int64 `json:"totalBytes"`
+
+	SizeHistogram []ObjectSizeClass `json:"sizeHistogram"`
+	// Prefixes contains the largest top-level prefixes by total bytes.
+	Prefixes []PrefixStats `json:"prefixes"`
+}
+
+// ObjectSizeClass is the count of objects with an encrypted size in
+// [MinSize, MaxSize). MaxSize is zero for the last class, which isn't bounded.
+type ObjectSizeClass struct {
+	MinSize     int64 `json:"minSize"`
+	MaxSize     int64 `json:"maxSize"`
+	ObjectCount int64 `json:"objectCount"`
+}
+
+// PrefixStats contains the statistics of the objects with the same top-level
+// prefix. The prefix is the first path component of the encrypted object key,
+// because the plaintext keys aren't known by the satellite. It's empty for the
+// objects without a path separator.
+type PrefixStats struct {
+	Prefix      []byte `json:"prefix"`
+	ObjectCount int64  `json:"objectCount"`
+	TotalBytes  int64  `json:"totalBytes"`
+}
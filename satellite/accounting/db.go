@@ -165,16 +165,44 @@ type BucketUsageRollup struct {
 	Before time.Time `json:"before"`
 }
 
+// BucketDailyUsage is the usage of a bucket during a single UTC day.
+type BucketDailyUsage struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	// Placement and UserAgent are the placement and the user agent of the bucket, which determine its prices.
+	Placement storj.PlacementConstraint
+	UserAgent []byte
+
+	// Day is the start of the day.
+	Day time.Time
+
+	StorageByteHours float64
+	SegmentHours     float64
+	ObjectHours      float64
+
+	GetEgress    int64
+	RepairEgress int64
+	AuditEgress  int64
+}
+
+// BucketDailyUsageCursor selects the buckets whose daily usage is iterated.
+type BucketDailyUsageCursor struct {
+	// Offset is the number of buckets, in name order, which are skipped.
+	Offset uint64
+	// Limit is the number of buckets which are iterated. Every bucket is iterated when it is 0.
+	Limit uint
+}
+
 // ProjectReportItem is total bucket usage info with project details for certain period.
 type ProjectReportItem struct {
-	ProjectID   uuid.UUID
-	ProjectName string
+	ProjectID   uuid.UUID `json:"projectID"`
+	ProjectName string    `json:"projectName"`
 
-	BucketName   string
-	Storage      float64
-	Egress       float64
-	SegmentCount float64
-	ObjectCount  float64
+	BucketName   string  `json:"bucketName"`
+	Storage      float64 `json:"storage"`
+	Egress       float64 `json:"egress"`
+	SegmentCount float64 `json:"segmentCount"`
+	ObjectCount  float64 `json:"objectCount"`
 
 	Since  time.Time `json:"since"`
 	Before time.Time `json:"before"`
@@ -201,6 +229,13 @@ type Usage struct {
 	Segments int64
 }
 
+// BucketLiveUsage contains bucket's usage tracked by live accounting.
+type BucketLiveUsage struct {
+	Storage   int64
+	Segments  int64
+	Bandwidth int64
+}
+
 // StoragenodeAccounting stores information about bandwidth and storage usage for storage nodes.
 //
 // architecture: Database
@@ -250,6 +285,11 @@ type ProjectAccounting interface {
 	GetProjectSettledBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (_ int64, err error)
 	// GetProjectBandwidth returns project allocated bandwidth for the specified year, month and day.
 	GetProjectBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, day int, asOfSystemInterval time.Duration) (int64, error)
+	// GetBucketBandwidth returns bucket allocated egress for the specified year and month.
+	GetBucketBandwidth(ctx context.Context, projectID uuid.UUID, bucketName []byte, year int, month time.Month, asOfSystemInterval time.Duration) (int64, error)
+	// GetBucketLatestTally returns the storage and segments of the bucket's most
+	// recent tally. It returns zero usage when the bucket hasn't been tallied yet.
+	GetBucketLatestTally(ctx context.Context, projectID uuid.UUID, bucketName []byte, asOfSystemInterval time.Duration) (Usage, error)
 	// GetProjectSettledBandwidth returns the used settled bandwidth for the specified year and month.
 	GetProjectSettledBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, asOfSystemInterval time.Duration) (int64, error)
 	// GetProjectDailyBandwidth returns bandwidth (allocated and settled) for the specified day.
@@ -278,12 +318,19 @@ type ProjectAccounting interface {
 	// GetProjectTotalByPartner retrieves project usage for a given period categorized by partner name.
 	// Unpartnered usage or usage for a partner not present in partnerNames is mapped to the empty string.
 	GetProjectTotalByPartner(ctx context.Context, projectID uuid.UUID, partnerNames []string, since, before time.Time) (usages map[string]ProjectUsage, err error)
+	// GetProjectTotalByPartnerAndPlacement retrieves project usage for a given period categorized by partner name
+	// and bucket placement. Partner names are mapped the same way as in GetProjectTotalByPartner.
+	GetProjectTotalByPartnerAndPlacement(ctx context.Context, projectID uuid.UUID, partnerNames []string, since, before time.Time) (usages map[string]map[storj.PlacementConstraint]ProjectUsage, err error)
 	// GetProjectObjectsSegments returns project objects and segments number.
 	GetProjectObjectsSegments(ctx context.Context, projectID uuid.UUID) (ProjectObjectsSegments, error)
 	// GetBucketUsageRollups returns usage rollup per each bucket for specified period of time.
 	GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]BucketUsageRollup, error)
 	// GetSingleBucketUsageRollup returns usage rollup per single bucket for specified period of time.
 	GetSingleBucketUsageRollup(ctx context.Context, projectID uuid.UUID, bucket string, since, before time.Time) (*BucketUsageRollup, error)
+	// IterateBucketDailyUsage calls fn for the usage of the buckets selected by the cursor on every day of the specified
+	// period and returns the number of buckets with usage in that period. Buckets are iterated in name order and days
+	// in chronological order.
+	IterateBucketDailyUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time, cursor BucketDailyUsageCursor, fn func(context.Context, BucketDailyUsage) error) (bucketCount uint64, err error)
 	// GetBucketTotals returns per bucket total usage summary since bucket creation.
 	GetBucketTotals(ctx context.Context, projectID uuid.UUID, cursor BucketUsageCursor, before time.Time) (*BucketUsagePage, error)
 	// ArchiveRollupsBefore archives rollups older than a given time and returns number of bucket bandwidth rollups archived.
@@ -292,6 +339,12 @@ type ProjectAccounting interface {
 	GetRollupsSince(ctx context.Context, since time.Time) ([]orders.BucketBandwidthRollup, error)
 	// GetArchivedRollupsSince retrieves all archived bandwidth rollup records since a given time. A hard limit batch size is used for results.
 	GetArchivedRollupsSince(ctx context.Context, since time.Time) ([]orders.BucketBandwidthRollup, error)
+	// ReplaceBucketObjectStats stores the object statistics collected at collectedAt and removes the
+	// statistics of the buckets, which were collected before.
+	ReplaceBucketObjectStats(ctx context.Context, collectedAt time.Time, stats []BucketObjectStats) error
+	// GetBucketObjectStats returns the latest object statistics of a bucket.
+	// It returns ErrObjectStatsNotFound when they haven't been collected.
+	GetBucketObjectStats(ctx context.Context, projectID uuid.UUID, bucketName []byte) (BucketObjectStats, error)
 }
 
 // Cache stores live information about project storage which has not yet been synced to ProjectAccounting.
@@ -344,7 +397,54 @@ type Cache interface {
 	AddProjectStorageUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, spaceLimit int64) error
 	// GetAllProjectTotals return the total projects' storage and segments used space.
 	GetAllProjectTotals(ctx context.Context) (map[uuid.UUID]Usage, error)
+	// GetBucketUsage returns the bucket's storage, segment and bandwidth usage.
+	// It returns ErrKeyNotFound when the bucket usage isn't cached.
+	GetBucketUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (BucketLiveUsage, error)
+	// InsertBucketUsage inserts the bucket usage if it doesn't exist. It
+	// returns true if it's inserted, otherwise false.
+	InsertBucketUsage(ctx context.Context, bucket metabase.BucketLocation, usage BucketLiveUsage, ttl time.Duration, now time.Time) (inserted bool, _ error)
+	// UpdateBucketUsage increases the bucket usage when it's cached, otherwise
+	// the increment is ignored, because the usage is initialized from the
+	// databases by InsertBucketUsage.
+	UpdateBucketUsage(ctx context.Context, bucket metabase.BucketLocation, increment BucketLiveUsage, now time.Time) error
 	// Close the client, releasing any open resources. Once it's called any other
 	// method must be called.
 	Close() error
 }
+
+// LiveAccountingDB stores the live accounting counters in the satellite database.
+// It's used as the backend of the Cache, when Redis is not available.
+//
+// Counter names are "storage", "segment" and "bandwidth:<month>:<day>" for
+// projects and "bucket-<kind>:<month>:<day>:<bucket>" for buckets.
+//
+// architecture: Database
+type LiveAccountingDB interface {
+	// GetCounter returns the value of a counter, which didn't expire before now.
+	// It returns ErrKeyNotFound when the counter doesn't exist.
+	GetCounter(ctx context.Context, projectID uuid.UUID, name string, now time.Time) (int64, error)
+	// IncrementCounters adds the increments to the counters. Missing and expired
+	// counters are created with the increment and its expiration.
+	IncrementCounters(ctx context.Context, increments []LiveAccountingIncrement, now time.Time) error
+	// IncrementCounterUpToLimit adds the increment to the counter, unless the new value exceeds the limit.
+	// It returns false, when the counter wasn't increased.
+	IncrementCounterUpToLimit(ctx context.Context, projectID uuid.UUID, name string, increment, limit int64) (bool, error)
+	// InsertCounter inserts a counter, if it doesn't exist or it's expired. It returns true if it's inserted.
+	InsertCounter(ctx context.Context, projectID uuid.UUID, name string, value int64, expiresAt, now time.Time) (bool, error)
+	// GetProjectTotals returns the storage and segment counters of all projects.
+	GetProjectTotals(ctx context.Context) (map[uuid.UUID]Usage, error)
+	// IncrementExistingCounters adds the increments to the counters, which
+	// exist and didn't expire before now. The other increments are ignored.
+	IncrementExistingCounters(ctx context.Context, increments []LiveAccountingIncrement, now time.Time) error
+	// DeleteExpiredCounters deletes the counters, which expired before the given time.
+	DeleteExpiredCounters(ctx context.Context, before time.Time) (int64, error)
+}
+
+// LiveAccountingIncrement is an increment of a live accounting counter.
+type LiveAccountingIncrement struct {
+	ProjectID uuid.UUID
+	Name      string
+	Value     int64
+	// ExpiresAt is used only when the counter is created. Zero value means that the counter never expires.
+	ExpiresAt time.Time
+}
@@ -26,11 +26,13 @@ type Config struct {
 	StorageBackend     string        `help:"what to use for storing real-time accounting data"`
 	BandwidthCacheTTL  time.Duration `default:"5m" help:"bandwidth cache key time to live"`
 	AsOfSystemInterval time.Duration `default:"-10s" help:"as of system interval"`
-	BatchSize          int           `default:"5000" help:"how much projects usage should be requested from redis cache at once"`
+	BatchSize          int           `default:"5000" help:"how much projects usage should be requested from redis cache at once, or how many counters are buffered before flushing them to the satellite database"`
+	FlushInterval      time.Duration `default:"1s" help:"how often the buffered counters are flushed to the satellite database. 0 means that they are written immediately"`
 }
 
 // OpenCache creates a new accounting.Cache instance using the type specified backend in
-// the provided config.
+// the provided config. The satellite database is used only by the
+// "satellitedb" backend.
 //
 // The cache instance may be returned despite of returning the
 // accounting.ErrSystemOrNetError because some backends allows to reconnect on
@@ -40,7 +42,7 @@ type Config struct {
 // For this reason, the components that uses the cache should operate despite
 // the backend is not responding successfully although their service is
 // degraded.
-func OpenCache(ctx context.Context, log *zap.Logger, config Config) (accounting.Cache, error) {
+func OpenCache(ctx context.Context, log *zap.Logger, config Config, db accounting.LiveAccountingDB) (accounting.Cache, error) {
 	parts := strings.SplitN(config.StorageBackend, ":", 2)
 	var backendType string
 	if len(parts) == 0 || parts[0] == "" {
@@ -51,7 +53,9 @@ func OpenCache(ctx context.Context, log *zap.Logger, config Config) (accounting.
 	switch backendType {
 	case "redis":
 		return openRedisLiveAccounting(ctx, config.StorageBackend, config.BatchSize)
+	case "satellitedb":
+		return openDatabaseLiveAccounting(log, db, config.FlushInterval, config.BatchSize)
 	default:
-		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently only redis is supported", backendType)
+		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently redis and satellitedb are supported", backendType)
 	}
 }
@@ -0,0 +1,465 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metabase"
+)
+
+const (
+	storageCounter = "storage"
+	segmentCounter = "segment"
+
+	// cleanupInterval is how often the expired counters are deleted from the database.
+	cleanupInterval = 10 * time.Minute
+)
+
+// counterKey identifies a live accounting counter.
+type counterKey struct {
+	projectID uuid.UUID
+	name      string
+}
+
+// pendingIncrement is an increment, which isn't written to the database yet.
+type pendingIncrement struct {
+	value     int64
+	expiresAt time.Time
+}
+
+// databaseLiveAccounting implements accounting.Cache using the satellite database.
+//
+// The increments without a limit are buffered in memory and written to the
+// database in batches, every flush interval or once the batch size is reached.
+// Reads return the database value together with the buffered increments of
+// the process. The increments with a limit are checked by the database in a
+// single statement, hence the limits are enforced across all the processes
+// sharing the database; only the increments buffered by the other processes
+// aren't taken into account.
+type databaseLiveAccounting struct {
+	log *zap.Logger
+	db  accounting.LiveAccountingDB
+
+	batchSize int
+
+	// flushMu is held exclusively while the pending increments are written
+	// to the database, so that the reads don't miss or double count them.
+	flushMu sync.RWMutex
+
+	mu      sync.Mutex
+	pending map[counterKey]pendingIncrement
+
+	cancel  context.CancelFunc
+	group   errgroup.Group
+	flush   *sync2.Cycle
+	cleanup *sync2.Cycle
+}
+
+// openDatabaseLiveAccounting returns a databaseLiveAccounting cache instance.
+//
+// Zero flush interval means that the increments are written to the database
+// immediately.
+func openDatabaseLiveAccounting(log *zap.Logger, db accounting.LiveAccountingDB, flushInterval time.Duration, batchSize int) (*databaseLiveAccounting, error) {
+	if db == nil {
+		return nil, accounting.ErrInvalidArgument.New("satellite database is required for the satellitedb backend")
+	}
+
+	cache := &databaseLiveAccounting{
+		log:       log,
+		db:        db,
+		batchSize: batchSize,
+		pending:   make(map[counterKey]pendingIncrement),
+		cleanup:   sync2.NewCycle(cleanupInterval),
+	}
+
+	var ctx context.Context
+	ctx, cache.cancel = context.WithCancel(context.Background())
+
+	if flushInterval > 0 {
+		cache.flush = sync2.NewCycle(flushInterval)
+		cache.flush.SetDelayStart()
+		cache.flush.Start(ctx, &cache.group, func(ctx context.Context) error {
+			if err := cache.flushPending(ctx); err != nil {
+				cache.log.Error("failed to flush live accounting counters", zap.Error(err))
+			}
+			return nil
+		})
+	}
+
+	cache.cleanup.Start(ctx, &cache.group, func(ctx context.Context) error {
+		deleted, err := cache.db.DeleteExpiredCounters(ctx, time.Now())
+		if err != nil {
+			cache.log.Error("failed to delete expired live accounting counters", zap.Error(err))
+			return nil
+		}
+		mon.IntVal("live_accounting_expired_counters_deleted").Observe(deleted)
+		return nil
+	})
+
+	return cache, nil
+}
+
+// GetProjectStorageUsage gets inline and remote storage totals for a given
+// project, back to the time of the last accounting tally.
+func (cache *databaseLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	return cache.getCounter(ctx, counterKey{projectID, storageCounter})
+}
+
+// GetProjectBandwidthUsage returns the current bandwidth usage
+// from specific project.
+func (cache *databaseLiveAccounting) GetProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID, now)(&err)
+
+	return cache.getCounter(ctx, counterKey{projectID, bandwidthCounter(now)})
+}
+
+// GetProjectSegmentUsage returns the current segment usage from specific project.
+func (cache *databaseLiveAccounting) GetProjectSegmentUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	return cache.getCounter(ctx, counterKey{projectID, segmentCounter})
+}
+
+// InsertProjectBandwidthUsage inserts a project bandwidth usage if it
+// doesn't exist. It returns true if it's inserted, otherwise false.
+func (cache *databaseLiveAccounting) InsertProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, value int64, ttl time.Duration, now time.Time) (inserted bool, err error) {
+	defer mon.Task()(&ctx, projectID, value, ttl, now)(&err)
+
+	cache.flushMu.RLock()
+	defer cache.flushMu.RUnlock()
+
+	key := counterKey{projectID, bandwidthCounter(now)}
+	if _, ok := cache.pendingValue(key); ok {
+		return false, nil
+	}
+
+	current := time.Now()
+	inserted, err = cache.db.InsertCounter(ctx, key.projectID, key.name, value, current.Add(ttl), current)
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.Wrap(err)
+	}
+	return inserted, nil
+}
+
+// UpdateProjectBandwidthUsage increment the bandwidth cache key value.
+func (cache *databaseLiveAccounting) UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, projectID, increment, ttl, now)(&err)
+
+	return cache.increment(ctx, counterKey{projectID, bandwidthCounter(now)}, increment, time.Now().Add(ttl))
+}
+
+// UpdateProjectSegmentUsage increment the segment cache key value.
+func (cache *databaseLiveAccounting) UpdateProjectSegmentUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	return cache.increment(ctx, counterKey{projectID, segmentCounter}, increment, time.Time{})
+}
+
+// AddProjectSegmentUsageUpToLimit increases segment usage up to the limit.
+// If the limit is exceeded, the usage is not increased and accounting.ErrProjectLimitExceeded is returned.
+func (cache *databaseLiveAccounting) AddProjectSegmentUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, segmentLimit int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	incremented, err := cache.incrementUpToLimit(ctx, counterKey{projectID, segmentCounter}, increment, segmentLimit)
+	if err != nil {
+		return err
+	}
+	if !incremented {
+		return accounting.ErrProjectLimitExceeded.New("Additional %d segments exceed project limit of %d", increment, segmentLimit)
+	}
+	return nil
+}
+
+// AddProjectStorageUsage lets the live accounting know that the given
+// project has just added spaceUsed bytes of storage (from the user's
+// perspective; i.e. segment size).
+func (cache *databaseLiveAccounting) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx, projectID, spaceUsed)(&err)
+
+	return cache.increment(ctx, counterKey{projectID, storageCounter}, spaceUsed, time.Time{})
+}
+
+// AddProjectStorageUsageUpToLimit increases storage usage up to the limit.
+// If the limit is exceeded, the usage is not increased and accounting.ErrProjectLimitExceeded is returned.
+func (cache *databaseLiveAccounting) AddProjectStorageUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, spaceLimit int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	incremented, err := cache.incrementUpToLimit(ctx, counterKey{projectID, storageCounter}, increment, spaceLimit)
+	if err != nil {
+		return err
+	}
+	if !incremented {
+		return accounting.ErrProjectLimitExceeded.New("Additional storage of %d bytes exceeds project limit of %d", increment, spaceLimit)
+	}
+	return nil
+}
+
+// GetAllProjectTotals returns the storage and segment totals of all the projects.
+func (cache *databaseLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.flushMu.RLock()
+	defer cache.flushMu.RUnlock()
+
+	totals, err := cache.db.GetProjectTotals(ctx)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.Wrap(err)
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for key, pending := range cache.pending {
+		switch key.name {
+		case storageCounter:
+			usage := totals[key.projectID]
+			usage.Storage += pending.value
+			totals[key.projectID] = usage
+		case segmentCounter:
+			usage := totals[key.projectID]
+			usage.Segments += pending.value
+			totals[key.projectID] = usage
+		}
+	}
+
+	return totals, nil
+}
+
+// GetBucketUsage returns the bucket's storage, segment and bandwidth usage.
+func (cache *databaseLiveAccounting) GetBucketUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (_ accounting.BucketLiveUsage, err error) {
+	defer mon.Task()(&ctx, bucket.ProjectID, now)(&err)
+
+	var usage accounting.BucketLiveUsage
+	found := false
+	current := time.Now()
+	for _, counter := range bucketCounters(&usage) {
+		*counter.value, err = cache.db.GetCounter(ctx, bucket.ProjectID, bucketCounter(counter.kind, bucket, now), current)
+		if err != nil {
+			if accounting.ErrKeyNotFound.Has(err) {
+				continue
+			}
+			return accounting.BucketLiveUsage{}, accounting.ErrSystemOrNetError.Wrap(err)
+		}
+		found = true
+	}
+	if !found {
+		return accounting.BucketLiveUsage{}, accounting.ErrKeyNotFound.New("%s/%s", bucket.ProjectID, bucket.BucketName)
+	}
+
+	return usage, nil
+}
+
+// InsertBucketUsage inserts the bucket usage if it doesn't exist. It returns
+// true if it's inserted, otherwise false.
+func (cache *databaseLiveAccounting) InsertBucketUsage(ctx context.Context, bucket metabase.BucketLocation, usage accounting.BucketLiveUsage, ttl time.Duration, now time.Time) (inserted bool, err error) {
+	defer mon.Task()(&ctx, bucket.ProjectID, ttl, now)(&err)
+
+	current := time.Now()
+	for _, counter := range bucketCounters(&usage) {
+		ok, err := cache.db.InsertCounter(ctx, bucket.ProjectID, bucketCounter(counter.kind, bucket, now), *counter.value, current.Add(ttl), current)
+		if err != nil {
+			return false, accounting.ErrSystemOrNetError.Wrap(err)
+		}
+		inserted = inserted || ok
+	}
+
+	return inserted, nil
+}
+
+// UpdateBucketUsage increases the bucket usage when it's cached. The bucket
+// increments aren't buffered, because they are applied only to the existing
+// counters.
+func (cache *databaseLiveAccounting) UpdateBucketUsage(ctx context.Context, bucket metabase.BucketLocation, increment accounting.BucketLiveUsage, now time.Time) (err error) {
+	defer mon.Task()(&ctx, bucket.ProjectID, now)(&err)
+
+	var increments []accounting.LiveAccountingIncrement
+	for _, counter := range bucketCounters(&increment) {
+		if *counter.value == 0 {
+			continue
+		}
+		increments = append(increments, accounting.LiveAccountingIncrement{
+			ProjectID: bucket.ProjectID,
+			Name:      bucketCounter(counter.kind, bucket, now),
+			Value:     *counter.value,
+		})
+	}
+
+	err = cache.db.IncrementExistingCounters(ctx, increments, time.Now())
+	return accounting.ErrSystemOrNetError.Wrap(err)
+}
+
+// Close flushes the pending increments and stops the background jobs.
+func (cache *databaseLiveAccounting) Close() error {
+	if cache.flush != nil {
+		cache.flush.Close()
+	}
+	cache.cleanup.Close()
+	cache.cancel()
+	_ = cache.group.Wait()
+
+	return cache.flushPending(context.Background())
+}
+
+// getCounter returns the counter value including the pending increments.
+func (cache *databaseLiveAccounting) getCounter(ctx context.Context, key counterKey) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.flushMu.RLock()
+	defer cache.flushMu.RUnlock()
+
+	value, err := cache.db.GetCounter(ctx, key.projectID, key.name, time.Now())
+	if err != nil && !accounting.ErrKeyNotFound.Has(err) {
+		return 0, accounting.ErrSystemOrNetError.Wrap(err)
+	}
+
+	pending, ok := cache.pendingValue(key)
+	if err != nil && !ok {
+		return 0, err
+	}
+
+	return value + pending, nil
+}
+
+// pendingValue returns the pending increment of the counter.
+func (cache *databaseLiveAccounting) pendingValue(key counterKey) (int64, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	pending, ok := cache.pending[key]
+	return pending.value, ok
+}
+
+// increment adds the increment to the pending increments, or writes it
+// directly to the database when the increments aren't buffered.
+func (cache *databaseLiveAccounting) increment(ctx context.Context, key counterKey, value int64, expiresAt time.Time) (err error) {
+	if cache.flush == nil {
+		err := cache.db.IncrementCounters(ctx, []accounting.LiveAccountingIncrement{{
+			ProjectID: key.projectID,
+			Name:      key.name,
+			Value:     value,
+			ExpiresAt: expiresAt,
+		}}, time.Now())
+		return accounting.ErrSystemOrNetError.Wrap(err)
+	}
+
+	cache.mu.Lock()
+	pending, ok := cache.pending[key]
+	pending.value += value
+	if !ok {
+		pending.expiresAt = expiresAt
+	}
+	cache.pending[key] = pending
+	full := len(cache.pending) >= cache.batchSize
+	cache.mu.Unlock()
+
+	if full {
+		cache.flush.Trigger()
+	}
+	return nil
+}
+
+// incrementUpToLimit atomically increments the counter in the database,
+// unless the counter together with the pending increments exceeds the limit.
+func (cache *databaseLiveAccounting) incrementUpToLimit(ctx context.Context, key counterKey, increment, limit int64) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.flushMu.RLock()
+	defer cache.flushMu.RUnlock()
+
+	// the pending increments aren't in the database yet, hence they are
+	// subtracted from the limit instead of being flushed first.
+	pending, _ := cache.pendingValue(key)
+
+	incremented, err := cache.db.IncrementCounterUpToLimit(ctx, key.projectID, key.name, increment, limit-pending)
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.Wrap(err)
+	}
+	return incremented, nil
+}
+
+// flushPending writes the pending increments to the database. The increments
+// are kept for the next flush, when the write fails.
+func (cache *databaseLiveAccounting) flushPending(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.flushMu.Lock()
+	defer cache.flushMu.Unlock()
+
+	cache.mu.Lock()
+	pending := cache.pending
+	cache.pending = make(map[counterKey]pendingIncrement, len(pending))
+	cache.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	increments := make([]accounting.LiveAccountingIncrement, 0, len(pending))
+	for key, increment := range pending {
+		increments = append(increments, accounting.LiveAccountingIncrement{
+			ProjectID: key.projectID,
+			Name:      key.name,
+			Value:     increment.value,
+			ExpiresAt: increment.expiresAt,
+		})
+	}
+
+	err = cache.db.IncrementCounters(ctx, increments, time.Now())
+	if err != nil {
+		cache.mu.Lock()
+		for key, increment := range pending {
+			current, ok := cache.pending[key]
+			current.value += increment.value
+			if !ok {
+				current.expiresAt = increment.expiresAt
+			}
+			cache.pending[key] = current
+		}
+		cache.mu.Unlock()
+
+		return accounting.ErrSystemOrNetError.Wrap(err)
+	}
+
+	mon.IntVal("live_accounting_flushed_counters").Observe(int64(len(increments)))
+	return nil
+}
+
+// bucketCounterValue is a bucket usage value stored in a separate counter.
+type bucketCounterValue struct {
+	kind  string
+	value *int64
+}
+
+// bucketCounters returns the counters of the bucket usage.
+func bucketCounters(usage *accounting.BucketLiveUsage) []bucketCounterValue {
+	return []bucketCounterValue{
+		{kind: "storage", value: &usage.Storage},
+		{kind: "segment", value: &usage.Segments},
+		{kind: "bandwidth", value: &usage.Bandwidth},
+	}
+}
+
+// bucketCounter returns the name of the bucket usage counter for the day.
+func bucketCounter(kind string, bucket metabase.BucketLocation, now time.Time) string {
+	_, month, day := now.Date()
+	return "bucket-" + kind + ":" + strconv.Itoa(int(month)) + ":" + strconv.Itoa(day) + ":" + bucket.BucketName
+}
+
+// bandwidthCounter returns the name of the project bandwidth counter for the day.
+func bandwidthCounter(now time.Time) string {
+	_, month, day := now.Date()
+	return "bandwidth:" + strconv.Itoa(int(month)) + ":" + strconv.Itoa(day)
+}
@@ -7,11 +7,13 @@ import (
 	"context"
 	"math/rand"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap/zaptest"
 	"golang.org/x/sync/errgroup"
 
@@ -19,8 +21,11 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testredis"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/accounting/live"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
 func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
@@ -30,6 +35,12 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "satellitedb",
+		},
+		{
+			backend: "satellitedb-batched",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -41,58 +52,51 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.backend, func(t *testing.T) {
-			ctx := testcontext.New(t)
-
-			var config live.Config
-			if tt.backend == "redis" {
-				config = live.Config{
-					StorageBackend: "redis://" + redis.Addr() + "?db=0",
-				}
-			}
-
-			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
-			require.NoError(t, err)
-			defer ctx.Check(cache.Close)
+			runBackend(t, tt.backend, redis, func(ctx *testcontext.Context, t *testing.T, config live.Config, db accounting.LiveAccountingDB) {
+				cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config, db)
+				require.NoError(t, err)
+				defer ctx.Check(cache.Close)
 
-			populatedData, err := populateCache(ctx, cache)
-			require.NoError(t, err)
+				populatedData, err := populateCache(ctx, cache)
+				require.NoError(t, err)
 
-			// make sure all of the "projects" got all space updates and got right totals
-			for _, pdata := range populatedData {
-				pdata := pdata
+				// make sure all of the "projects" got all space updates and got right totals
+				for _, pdata := range populatedData {
+					pdata := pdata
 
-				t.Run("storage", func(t *testing.T) {
-					spaceUsed, err := cache.GetProjectStorageUsage(ctx, pdata.projectID)
-					require.NoError(t, err)
-					assert.Equalf(t, pdata.storageSum, spaceUsed, "projectID %v", pdata.projectID)
+					t.Run("storage", func(t *testing.T) {
+						spaceUsed, err := cache.GetProjectStorageUsage(ctx, pdata.projectID)
+						require.NoError(t, err)
+						assert.Equalf(t, pdata.storageSum, spaceUsed, "projectID %v", pdata.projectID)
 
-					// upate it again and check
-					negativeVal := -(rand.Int63n(pdata.storageSum) + 1)
-					pdata.storageSum += negativeVal
-					err = cache.AddProjectStorageUsage(ctx, pdata.projectID, negativeVal)
-					require.NoError(t, err)
+						// upate it again and check
+						negativeVal := -(rand.Int63n(pdata.storageSum) + 1)
+						pdata.storageSum += negativeVal
+						err = cache.AddProjectStorageUsage(ctx, pdata.projectID, negativeVal)
+						require.NoError(t, err)
 
-					spaceUsed, err = cache.GetProjectStorageUsage(ctx, pdata.projectID)
-					require.NoError(t, err)
-					assert.EqualValues(t, pdata.storageSum, spaceUsed)
-				})
+						spaceUsed, err = cache.GetProjectStorageUsage(ctx, pdata.projectID)
+						require.NoError(t, err)
+						assert.EqualValues(t, pdata.storageSum, spaceUsed)
+					})
 
-				t.Run("bandwidth", func(t *testing.T) {
-					bandwidthUsed, err := cache.GetProjectBandwidthUsage(ctx, pdata.projectID, pdata.bandwidthNow)
-					require.NoError(t, err)
-					assert.Equalf(t, pdata.bandwidthSum, bandwidthUsed, "projectID %v", pdata.projectID)
+					t.Run("bandwidth", func(t *testing.T) {
+						bandwidthUsed, err := cache.GetProjectBandwidthUsage(ctx, pdata.projectID, pdata.bandwidthNow)
+						require.NoError(t, err)
+						assert.Equalf(t, pdata.bandwidthSum, bandwidthUsed, "projectID %v", pdata.projectID)
 
-					// upate it again and check
-					negativeVal := -(rand.Int63n(pdata.bandwidthSum) + 1)
-					pdata.bandwidthSum += negativeVal
-					err = cache.UpdateProjectBandwidthUsage(ctx, pdata.projectID, negativeVal, time.Second*2, pdata.bandwidthNow)
-					require.NoError(t, err)
+						// upate it again and check
+						negativeVal := -(rand.Int63n(pdata.bandwidthSum) + 1)
+						pdata.bandwidthSum += negativeVal
+						err = cache.UpdateProjectBandwidthUsage(ctx, pdata.projectID, negativeVal, time.Second*2, pdata.bandwidthNow)
+						require.NoError(t, err)
 
-					bandwidthUsed, err = cache.GetProjectBandwidthUsage(ctx, pdata.projectID, pdata.bandwidthNow)
-					require.NoError(t, err)
-					assert.EqualValues(t, pdata.bandwidthSum, bandwidthUsed)
-				})
-			}
+						bandwidthUsed, err = cache.GetProjectBandwidthUsage(ctx, pdata.projectID, pdata.bandwidthNow)
+						require.NoError(t, err)
+						assert.EqualValues(t, pdata.bandwidthSum, bandwidthUsed)
+					})
+				}
+			})
 		})
 	}
 }
@@ -104,6 +108,12 @@ func TestGetAllProjectTotals(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "satellitedb",
+		},
+		{
+			backend: "satellitedb-batched",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -115,51 +125,46 @@ func TestGetAllProjectTotals(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.backend, func(t *testing.T) {
-			ctx := testcontext.New(t)
-
-			var config live.Config
-			if tt.backend == "redis" {
-				config = live.Config{
-					StorageBackend: "redis://" + redis.Addr() + "?db=0",
-				}
-			}
-
-			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
-			require.NoError(t, err)
-			defer ctx.Check(cache.Close)
-
-			projectIDs := make([]uuid.UUID, 1000)
-			for i := range projectIDs {
-				projectIDs[i] = testrand.UUID()
-				err := cache.AddProjectStorageUsage(ctx, projectIDs[i], int64(i))
+			runBackend(t, tt.backend, redis, func(ctx *testcontext.Context, t *testing.T, config live.Config, db accounting.LiveAccountingDB) {
+				cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config, db)
 				require.NoError(t, err)
-				err = cache.UpdateProjectSegmentUsage(ctx, projectIDs[i], int64(i))
-				require.NoError(t, err)
-			}
 
-			for _, batchSize := range []int{1, 2, 3, 10, 13, 10000} {
-				t.Run("batch-size-"+strconv.Itoa(batchSize), func(t *testing.T) {
-					config.BatchSize = batchSize
-					testCache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
+				projectIDs := make([]uuid.UUID, 1000)
+				for i := range projectIDs {
+					projectIDs[i] = testrand.UUID()
+					err := cache.AddProjectStorageUsage(ctx, projectIDs[i], int64(i))
 					require.NoError(t, err)
-					defer ctx.Check(testCache.Close)
-
-					usage, err := testCache.GetAllProjectTotals(ctx)
+					err = cache.UpdateProjectSegmentUsage(ctx, projectIDs[i], int64(i))
 					require.NoError(t, err)
-					require.Len(t, usage, len(projectIDs))
+				}
+
+				// closing flushes the buffered increments, which the other caches don't see.
+				require.NoError(t, cache.Close())
 
-					// make sure each project ID and total was received
-					for _, projID := range projectIDs {
-						totalStorage, err := testCache.GetProjectStorageUsage(ctx, projID)
+				for _, batchSize := range []int{1, 2, 3, 10, 13, 10000} {
+					t.Run("batch-size-"+strconv.Itoa(batchSize), func(t *testing.T) {
+						config.BatchSize = batchSize
+						testCache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config, db)
 						require.NoError(t, err)
-						assert.Equal(t, totalStorage, usage[projID].Storage)
+						defer ctx.Check(testCache.Close)
 
-						totalSegments, err := testCache.GetProjectSegmentUsage(ctx, projID)
+						usage, err := testCache.GetAllProjectTotals(ctx)
 						require.NoError(t, err)
-						assert.Equal(t, totalSegments, usage[projID].Segments)
-					}
-				})
-			}
+						require.Len(t, usage, len(projectIDs))
+
+						// make sure each project ID and total was received
+						for _, projID := range projectIDs {
+							totalStorage, err := testCache.GetProjectStorageUsage(ctx, projID)
+							require.NoError(t, err)
+							assert.Equal(t, totalStorage, usage[projID].Storage)
+
+							totalSegments, err := testCache.GetProjectSegmentUsage(ctx, projID)
+							require.NoError(t, err)
+							assert.Equal(t, totalSegments, usage[projID].Segments)
+						}
+					})
+				}
+			})
 		})
 	}
 }
@@ -171,6 +176,12 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "satellitedb",
+		},
+		{
+			backend: "satellitedb-batched",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -183,33 +194,26 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.backend, func(t *testing.T) {
-			ctx := testcontext.New(t)
-
-			var config live.Config
-			if tt.backend == "redis" {
-				config = live.Config{
-					StorageBackend: "redis://" + redis.Addr() + "?db=0",
-				}
-			}
-
-			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
-			require.NoError(t, err)
-			defer ctx.Check(cache.Close)
+			runBackend(t, tt.backend, redis, func(ctx *testcontext.Context, t *testing.T, config live.Config, db accounting.LiveAccountingDB) {
+				cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config, db)
+				require.NoError(t, err)
+				defer ctx.Check(cache.Close)
 
-			var (
-				projectID = testrand.UUID()
-				now       = time.Now()
-			)
-			err = cache.UpdateProjectBandwidthUsage(ctx, projectID, rand.Int63n(4096)+1, time.Second, now)
-			require.NoError(t, err)
+				var (
+					projectID = testrand.UUID()
+					now       = time.Now()
+				)
+				err = cache.UpdateProjectBandwidthUsage(ctx, projectID, rand.Int63n(4096)+1, time.Second, now)
+				require.NoError(t, err)
 
-			if tt.backend == "redis" {
-				redis.FastForward(time.Second)
-			}
+				if tt.backend == "redis" {
+					redis.FastForward(time.Second)
+				}
 
-			time.Sleep(2 * time.Second)
-			_, err = cache.GetProjectBandwidthUsage(ctx, projectID, now)
-			require.Error(t, err)
+				time.Sleep(2 * time.Second)
+				_, err = cache.GetProjectBandwidthUsage(ctx, projectID, now)
+				require.Error(t, err)
+			})
 		})
 	}
 }
@@ -221,6 +225,12 @@ func TestInsertProjectBandwidthUsage(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "satellitedb",
+		},
+		{
+			backend: "satellitedb-batched",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -233,51 +243,317 @@ func TestInsertProjectBandwidthUsage(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.backend, func(t *testing.T) {
-			ctx := testcontext.New(t)
+			runBackend(t, tt.backend, redis, func(ctx *testcontext.Context, t *testing.T, config live.Config, db accounting.LiveAccountingDB) {
+				cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config, db)
+				require.NoError(t, err)
+				defer ctx.Check(cache.Close)
+
+				var (
+					projectID = testrand.UUID()
+					now       = time.Now()
+				)
+				expVal := rand.Int63n(4096) + 1
+				inserted, err := cache.InsertProjectBandwidthUsage(ctx, projectID, expVal, time.Second, now)
+				require.NoError(t, err)
+				assert.True(t, inserted, "is inserted")
+
+				val, err := cache.GetProjectBandwidthUsage(ctx, projectID, now)
+				require.NoError(t, err)
+				require.Equal(t, expVal, val, "inserted value")
 
-			var config live.Config
-			if tt.backend == "redis" {
-				config = live.Config{
-					StorageBackend: "redis://" + redis.Addr() + "?db=0",
+				{ // This shouldn't set the value because it already exists.
+					newVal := rand.Int63n(4096) + 1
+					inserted, err := cache.InsertProjectBandwidthUsage(ctx, projectID, newVal, time.Second, now)
+					require.NoError(t, err)
+					assert.False(t, inserted, "is inserted")
+
+					val, err := cache.GetProjectBandwidthUsage(ctx, projectID, now)
+					require.NoError(t, err)
+					require.Equal(t, expVal, val, "inserted value")
 				}
-			}
 
-			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
+				if tt.backend == "redis" {
+					redis.FastForward(time.Second)
+				}
+
+				time.Sleep(2 * time.Second)
+				_, err = cache.GetProjectBandwidthUsage(ctx, projectID, now)
+				require.Error(t, err)
+			})
+		})
+	}
+}
+
+func TestBucketUsage(t *testing.T) {
+	tests := []struct {
+		backend string
+	}{
+		{
+			backend: "redis",
+		},
+		{
+			backend: "satellitedb",
+		},
+		{
+			backend: "satellitedb-batched",
+		},
+	}
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	redis, err := testredis.Start(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(redis.Close)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.backend, func(t *testing.T) {
+			runBackend(t, tt.backend, redis, func(ctx *testcontext.Context, t *testing.T, config live.Config, db accounting.LiveAccountingDB) {
+				cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config, db)
+				require.NoError(t, err)
+				defer ctx.Check(cache.Close)
+
+				now := time.Now()
+				bucket := metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "bucket"}
+				other := metabase.BucketLocation{ProjectID: bucket.ProjectID, BucketName: "other"}
+
+				_, err = cache.GetBucketUsage(ctx, bucket, now)
+				require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+				// the increments of buckets, which aren't cached, are ignored.
+				require.NoError(t, cache.UpdateBucketUsage(ctx, bucket, accounting.BucketLiveUsage{Storage: 10}, now))
+				_, err = cache.GetBucketUsage(ctx, bucket, now)
+				require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+				inserted, err := cache.InsertBucketUsage(ctx, bucket, accounting.BucketLiveUsage{
+					Storage:   100,
+					Segments:  2,
+					Bandwidth: 50,
+				}, time.Hour, now)
+				require.NoError(t, err)
+				require.True(t, inserted)
+
+				inserted, err = cache.InsertBucketUsage(ctx, bucket, accounting.BucketLiveUsage{Storage: 1}, time.Hour, now)
+				require.NoError(t, err)
+				require.False(t, inserted)
+
+				require.NoError(t, cache.UpdateBucketUsage(ctx, bucket, accounting.BucketLiveUsage{Storage: 10, Segments: 1}, now))
+				require.NoError(t, cache.UpdateBucketUsage(ctx, bucket, accounting.BucketLiveUsage{Bandwidth: 5}, now))
+
+				usage, err := cache.GetBucketUsage(ctx, bucket, now)
+				require.NoError(t, err)
+				require.Equal(t, accounting.BucketLiveUsage{Storage: 110, Segments: 3, Bandwidth: 55}, usage)
+
+				_, err = cache.GetBucketUsage(ctx, other, now)
+				require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+				// the bucket usage isn't part of the project totals.
+				totals, err := cache.GetAllProjectTotals(ctx)
+				require.NoError(t, err)
+				require.NotContains(t, totals, bucket.ProjectID)
+			})
+		})
+	}
+}
+
+func TestSatelliteDBBackend_Batching(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		config := live.Config{
+			StorageBackend: "satellitedb",
+			FlushInterval:  time.Hour,
+			BatchSize:      1000,
+		}
+
+		first, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("first"), config, db.LiveAccounting())
+		require.NoError(t, err)
+		defer ctx.Check(first.Close)
+
+		second, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("second"), config, db.LiveAccounting())
+		require.NoError(t, err)
+
+		projectID := testrand.UUID()
+
+		require.NoError(t, second.AddProjectStorageUsage(ctx, projectID, 100))
+		require.NoError(t, second.AddProjectStorageUsage(ctx, projectID, 50))
+
+		// the increments are buffered by the second cache.
+		used, err := second.GetProjectStorageUsage(ctx, projectID)
+		require.NoError(t, err)
+		require.EqualValues(t, 150, used)
+
+		_, err = first.GetProjectStorageUsage(ctx, projectID)
+		require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+		// the buffered increments are taken into account by the limits.
+		err = second.AddProjectStorageUsageUpToLimit(ctx, projectID, 100, 200)
+		require.True(t, accounting.ErrProjectLimitExceeded.Has(err))
+		require.NoError(t, second.AddProjectStorageUsageUpToLimit(ctx, projectID, 50, 200))
+
+		// closing flushes the buffered increments.
+		require.NoError(t, second.Close())
+
+		used, err = first.GetProjectStorageUsage(ctx, projectID)
+		require.NoError(t, err)
+		require.EqualValues(t, 200, used)
+
+		// the limits are shared by all the caches.
+		err = first.AddProjectStorageUsageUpToLimit(ctx, projectID, 1, 200)
+		require.True(t, accounting.ErrProjectLimitExceeded.Has(err))
+
+		totals, err := first.GetAllProjectTotals(ctx)
+		require.NoError(t, err)
+		require.Equal(t, accounting.Usage{Storage: 200}, totals[projectID])
+	})
+}
+
+func TestSatelliteDBBackend_Flush(t *testing.T) {
+	runBackend(t, "satellitedb-batched", nil, func(ctx *testcontext.Context, t *testing.T, config live.Config, db accounting.LiveAccountingDB) {
+		failing := &failingLiveAccountingDB{LiveAccountingDB: db}
+
+		// requireFlushed checks that the counter eventually reaches the value in the database.
+		requireFlushed := func(t *testing.T, projectID uuid.UUID, expected int64) {
+			require.Eventually(t, func() bool {
+				value, err := db.GetCounter(ctx, projectID, "storage", time.Now())
+				return err == nil && value == expected
+			}, 10*time.Second, 50*time.Millisecond)
+		}
+
+		t.Run("interval", func(t *testing.T) {
+			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t), config, failing)
 			require.NoError(t, err)
 			defer ctx.Check(cache.Close)
 
-			var (
-				projectID = testrand.UUID()
-				now       = time.Now()
-			)
-			expVal := rand.Int63n(4096) + 1
-			inserted, err := cache.InsertProjectBandwidthUsage(ctx, projectID, expVal, time.Second, now)
+			projectID := testrand.UUID()
+			require.NoError(t, cache.AddProjectStorageUsage(ctx, projectID, 100))
+
+			// the pending increment is read before it's flushed.
+			_, err = db.GetCounter(ctx, projectID, "storage", time.Now())
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+			used, err := cache.GetProjectStorageUsage(ctx, projectID)
 			require.NoError(t, err)
-			assert.True(t, inserted, "is inserted")
+			require.EqualValues(t, 100, used)
+
+			requireFlushed(t, projectID, 100)
 
-			val, err := cache.GetProjectBandwidthUsage(ctx, projectID, now)
+			used, err = cache.GetProjectStorageUsage(ctx, projectID)
 			require.NoError(t, err)
-			require.Equal(t, expVal, val, "inserted value")
+			require.EqualValues(t, 100, used)
+		})
 
-			{ // This shouldn't set the value because it already exists.
-				newVal := rand.Int63n(4096) + 1
-				inserted, err := cache.InsertProjectBandwidthUsage(ctx, projectID, newVal, time.Second, now)
-				require.NoError(t, err)
-				assert.False(t, inserted, "is inserted")
+		t.Run("batch size", func(t *testing.T) {
+			config := config
+			config.FlushInterval = time.Hour
 
-				val, err := cache.GetProjectBandwidthUsage(ctx, projectID, now)
-				require.NoError(t, err)
-				require.Equal(t, expVal, val, "inserted value")
+			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t), config, failing)
+			require.NoError(t, err)
+			defer ctx.Check(cache.Close)
+
+			projectIDs := make([]uuid.UUID, config.BatchSize)
+			for i := range projectIDs {
+				projectIDs[i] = testrand.UUID()
+				require.NoError(t, cache.AddProjectStorageUsage(ctx, projectIDs[i], int64(i+1)))
 			}
 
-			if tt.backend == "redis" {
-				redis.FastForward(time.Second)
+			// the flush is triggered by the full batch, long before the interval.
+			for i, projectID := range projectIDs {
+				requireFlushed(t, projectID, int64(i+1))
 			}
+		})
+
+		t.Run("failed flush", func(t *testing.T) {
+			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t), config, failing)
+			require.NoError(t, err)
+			defer ctx.Check(cache.Close)
+
+			failing.setFail(true)
 
-			time.Sleep(2 * time.Second)
-			_, err = cache.GetProjectBandwidthUsage(ctx, projectID, now)
-			require.Error(t, err)
+			projectID := testrand.UUID()
+			require.NoError(t, cache.AddProjectStorageUsage(ctx, projectID, 100))
+
+			require.Eventually(t, func() bool {
+				return failing.failedWrites() > 0
+			}, 10*time.Second, 50*time.Millisecond)
+
+			// the increments are kept after the failed flush.
+			require.NoError(t, cache.AddProjectStorageUsage(ctx, projectID, 50))
+
+			used, err := cache.GetProjectStorageUsage(ctx, projectID)
+			require.NoError(t, err)
+			require.EqualValues(t, 150, used)
+
+			_, err = db.GetCounter(ctx, projectID, "storage", time.Now())
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+			failing.setFail(false)
+			requireFlushed(t, projectID, 150)
+
+			used, err = cache.GetProjectStorageUsage(ctx, projectID)
+			require.NoError(t, err)
+			require.EqualValues(t, 150, used)
+		})
+	})
+}
+
+// failingLiveAccountingDB fails the writes of the increments, while it's set to fail.
+type failingLiveAccountingDB struct {
+	accounting.LiveAccountingDB
+
+	mu     sync.Mutex
+	fail   bool
+	failed int
+}
+
+func (db *failingLiveAccountingDB) setFail(fail bool) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.fail = fail
+}
+
+func (db *failingLiveAccountingDB) failedWrites() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.failed
+}
+
+func (db *failingLiveAccountingDB) IncrementCounters(ctx context.Context, increments []accounting.LiveAccountingIncrement, now time.Time) error {
+	db.mu.Lock()
+	if db.fail {
+		db.failed++
+		db.mu.Unlock()
+		return errs.New("write failed")
+	}
+	db.mu.Unlock()
+
+	return db.LiveAccountingDB.IncrementCounters(ctx, increments, now)
+}
+
+// runBackend runs the test with the live accounting configuration of the backend.
+func runBackend(t *testing.T, backend string, redis testredis.Server, test func(ctx *testcontext.Context, t *testing.T, config live.Config, db accounting.LiveAccountingDB)) {
+	switch backend {
+	case "redis":
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		test(ctx, t, live.Config{
+			StorageBackend: "redis://" + redis.Addr() + "?db=0",
+		}, nil)
+	case "satellitedb":
+		satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+			test(ctx, t, live.Config{
+				StorageBackend: "satellitedb",
+			}, db.LiveAccounting())
+		})
+	case "satellitedb-batched":
+		satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+			test(ctx, t, live.Config{
+				StorageBackend: "satellitedb",
+				FlushInterval:  time.Second,
+				BatchSize:      10,
+			}, db.LiveAccounting())
 		})
+	default:
+		t.Fatalf("unknown backend %q", backend)
 	}
 }
 
@@ -15,6 +15,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metabase"
 )
 
 type redisLiveAccounting struct {
@@ -225,8 +226,8 @@ func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ ma
 	for it.Next(ctx) {
 		key := it.Val()
 
-		// skip bandwidth keys
-		if strings.HasSuffix(key, "bandwidth") {
+		// skip bandwidth and bucket keys
+		if strings.HasSuffix(key, "bandwidth") || strings.HasSuffix(key, bucketKeySuffix) {
 			continue
 		}
 
@@ -342,6 +343,80 @@ func parseAnyAsInt64(v any) (int64, error) {
 	return i, nil
 }
 
+// GetBucketUsage returns the bucket's storage, segment and bandwidth usage.
+func (cache *redisLiveAccounting) GetBucketUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (_ accounting.BucketLiveUsage, err error) {
+	defer mon.Task()(&ctx, bucket.ProjectID, now)(&err)
+
+	key := createBucketKey(bucket, now)
+	values, err := cache.client.HMGet(ctx, key, "storage", "segments", "bandwidth").Result()
+	if err != nil {
+		return accounting.BucketLiveUsage{}, accounting.ErrSystemOrNetError.New("Redis hmget failed: %w", err)
+	}
+	if values[0] == nil && values[1] == nil && values[2] == nil {
+		return accounting.BucketLiveUsage{}, accounting.ErrKeyNotFound.New("%q", key)
+	}
+
+	var usage accounting.BucketLiveUsage
+	for i, value := range []*int64{&usage.Storage, &usage.Segments, &usage.Bandwidth} {
+		*value, err = parseAnyAsInt64(values[i])
+		if err != nil {
+			return accounting.BucketLiveUsage{}, err
+		}
+	}
+
+	return usage, nil
+}
+
+// InsertBucketUsage inserts the bucket usage if it doesn't exist. It returns
+// true if it's inserted, otherwise false.
+func (cache *redisLiveAccounting) InsertBucketUsage(ctx context.Context, bucket metabase.BucketLocation, usage accounting.BucketLiveUsage, ttl time.Duration, now time.Time) (inserted bool, err error) {
+	defer mon.Task()(&ctx, bucket.ProjectID, ttl, now)(&err)
+
+	// The following script will set the cache key to the usage with an
+	// expiration time to live when it doesn't exist, otherwise it ignores it.
+	script := redis.NewScript(`if redis.call("exists", KEYS[1]) == 1 then
+		return 0
+	end
+
+	redis.call("hset", KEYS[1], "storage", ARGV[1], "segments", ARGV[2], "bandwidth", ARGV[3])
+	redis.call("expire", KEYS[1], ARGV[4])
+	return 1
+	`)
+
+	key := createBucketKey(bucket, now)
+	insert, err := script.Run(ctx, cache.client, []string{key}, usage.Storage, usage.Segments, usage.Bandwidth, int(ttl.Seconds())).Int()
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return insert == 1, nil
+}
+
+// UpdateBucketUsage increases the bucket usage when it's cached.
+func (cache *redisLiveAccounting) UpdateBucketUsage(ctx context.Context, bucket metabase.BucketLocation, increment accounting.BucketLiveUsage, now time.Time) (err error) {
+	defer mon.Task()(&ctx, bucket.ProjectID, now)(&err)
+
+	// The following script will increment the usage only when the cache key
+	// exists, because the usage is initialized from the databases.
+	script := redis.NewScript(`if redis.call("exists", KEYS[1]) == 0 then
+		return 0
+	end
+
+	redis.call("hincrby", KEYS[1], "storage", ARGV[1])
+	redis.call("hincrby", KEYS[1], "segments", ARGV[2])
+	redis.call("hincrby", KEYS[1], "bandwidth", ARGV[3])
+	return 1
+	`)
+
+	key := createBucketKey(bucket, now)
+	err = script.Run(ctx, cache.client, []string{key}, increment.Storage, increment.Segments, increment.Bandwidth).Err()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return nil
+}
+
 // Close the DB connection.
 func (cache *redisLiveAccounting) Close() error {
 	err := cache.client.Close()
@@ -380,6 +455,17 @@ func createBandwidthProjectIDKey(projectID uuid.UUID, now time.Time) string {
 	return string(projectID[:]) + string(byte(month)) + string(byte(day)) + ":bandwidth"
 }
 
+// bucketKeySuffix is the suffix of the bucket keys.
+const bucketKeySuffix = ":bucket"
+
+// createBucketKey creates the bucket key.
+// The current month and day is combined with the bucket location, because
+// the bandwidth usage is monthly.
+func createBucketKey(bucket metabase.BucketLocation, now time.Time) string {
+	_, month, day := now.Date()
+	return string(bucket.ProjectID[:]) + bucket.BucketName + "/" + string(byte(month)) + string(byte(day)) + bucketKeySuffix
+}
+
 // createSegmentProjectIDKey creates the segment project key.
 func createSegmentProjectIDKey(projectID uuid.UUID) string {
 	return string(projectID[:]) + ":segment"
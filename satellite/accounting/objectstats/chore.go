@@ -0,0 +1,305 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package objectstats
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+var (
+	// Error is a standard error class for this package.
+	Error = errs.Class("object stats")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the object stats chore.
+type Config struct {
+	Enabled            bool          `help:"whether to collect per bucket object and prefix statistics" default:"false"`
+	Interval           time.Duration `help:"how frequently the object stats should be collected" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	MaxPrefixes        int           `help:"maximum number of top-level prefixes, with the most bytes, stored per bucket" default:"100"`
+	BatchSize          int           `help:"number of objects to fetch from the metabase at once" default:"2500"`
+	Ranges             int           `help:"number of project ID ranges the objects are split into" default:"16"`
+	Concurrency        int           `help:"number of project ID ranges collected at the same time" default:"4"`
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// sizeClassBounds are the upper bounds (exclusive) of the object size
+// histogram classes. The last class doesn't have an upper bound.
+var sizeClassBounds = []int64{
+	4 * memory.KiB.Int64(),
+	64 * memory.KiB.Int64(),
+	memory.MiB.Int64(),
+	16 * memory.MiB.Int64(),
+	64 * memory.MiB.Int64(),
+	256 * memory.MiB.Int64(),
+	memory.GiB.Int64(),
+}
+
+// Chore collects per bucket object statistics at a given interval.
+//
+// Segments don't reference the key of their object, hence the statistics are
+// collected by iterating the objects, which are ordered by the object key.
+// The objects are split into project ID ranges, which are collected
+// concurrently, outside of the ranged loop.
+//
+// architecture: Chore
+type Chore struct {
+	log               *zap.Logger
+	Loop              *sync2.Cycle
+	projectAccounting accounting.ProjectAccounting
+	metabaseDB        *metabase.DB
+	config            Config
+
+	nowFn func() time.Time
+
+	BucketStats []accounting.BucketObjectStats
+}
+
+// NewChore creates new object stats chore.
+func NewChore(log *zap.Logger, projectAccounting accounting.ProjectAccounting, metabaseDB *metabase.DB, config Config) *Chore {
+	return &Chore{
+		log:               log,
+		Loop:              sync2.NewCycle(config.Interval),
+		projectAccounting: projectAccounting,
+		metabaseDB:        metabaseDB,
+		config:            config,
+		nowFn:             time.Now,
+	}
+}
+
+// Run starts the object stats chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.Collect(ctx); err != nil {
+			chore.log.Error("error collecting object stats", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow overrides the time source used by the chore.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// Collect collects the object statistics of all buckets and stores them.
+func (chore *Chore) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ranges, err := rangedloop.CreateUUIDRanges(uint32(chore.config.Ranges))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	collectedAt := chore.nowFn()
+
+	var mu sync.Mutex
+	var stats []accounting.BucketObjectStats
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	if chore.config.Concurrency > 0 {
+		group.SetLimit(chore.config.Concurrency)
+	}
+	for _, uuidRange := range ranges {
+		uuidRange := uuidRange
+		group.Go(func() error {
+			rangeStats, err := chore.collectRange(groupCtx, uuidRange, collectedAt)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			stats = append(stats, rangeStats...)
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	sort.Slice(stats, func(i, k int) bool {
+		if stats[i].ProjectID != stats[k].ProjectID {
+			return stats[i].ProjectID.Less(stats[k].ProjectID)
+		}
+		return stats[i].BucketName < stats[k].BucketName
+	})
+	chore.BucketStats = stats
+
+	mon.IntVal("object_stats_buckets").Observe(int64(len(stats)))
+
+	return Error.Wrap(chore.projectAccounting.ReplaceBucketObjectStats(ctx, collectedAt, stats))
+}
+
+// collectRange collects the object statistics of the buckets in the project ID range.
+func (chore *Chore) collectRange(ctx context.Context, uuidRange rangedloop.UUIDRange, collectedAt time.Time) (stats []accounting.BucketObjectStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	opts := metabase.IterateLoopObjects{
+		BatchSize:          chore.config.BatchSize,
+		AsOfSystemTime:     collectedAt,
+		AsOfSystemInterval: chore.config.AsOfSystemInterval,
+	}
+	if uuidRange.Start != nil {
+		opts.StartProjectID = *uuidRange.Start
+	}
+	if uuidRange.End != nil {
+		opts.EndProjectID = *uuidRange.End
+	}
+
+	var current *bucketCollector
+	err = chore.metabaseDB.IterateLoopObjects(ctx, opts, func(ctx context.Context, it metabase.LoopObjectsIterator) error {
+		var entry metabase.LoopObjectEntry
+		for it.Next(ctx, &entry) {
+			if entry.Expired(collectedAt) {
+				continue
+			}
+
+			location := entry.Location().Bucket()
+			if current == nil || current.bucket != location {
+				if current != nil {
+					stats = append(stats, current.finish())
+				}
+				current = newBucketCollector(location, collectedAt, chore.config.MaxPrefixes)
+			}
+
+			current.add(entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		stats = append(stats, current.finish())
+	}
+	return stats, nil
+}
+
+// bucketCollector accumulates the statistics of a single bucket. Objects
+// must be added in the object key order.
+type bucketCollector struct {
+	bucket      metabase.BucketLocation
+	maxPrefixes int
+
+	stats   accounting.BucketObjectStats
+	current *accounting.PrefixStats
+}
+
+func newBucketCollector(bucket metabase.BucketLocation, collectedAt time.Time, maxPrefixes int) *bucketCollector {
+	histogram := make([]accounting.ObjectSizeClass, len(sizeClassBounds)+1)
+	var min int64
+	for i, max := range sizeClassBounds {
+		histogram[i] = accounting.ObjectSizeClass{MinSize: min, MaxSize: max}
+		min = max
+	}
+	histogram[len(sizeClassBounds)] = accounting.ObjectSizeClass{MinSize: min}
+
+	return &bucketCollector{
+		bucket:      bucket,
+		maxPrefixes: maxPrefixes,
+		stats: accounting.BucketObjectStats{
+			ProjectID:     bucket.ProjectID,
+			BucketName:    bucket.BucketName,
+			CollectedAt:   collectedAt,
+			SizeHistogram: histogram,
+		},
+	}
+}
+
+func (collector *bucketCollector) add(entry metabase.LoopObjectEntry) {
+	if entry.Status == metabase.Pending {
+		collector.stats.PendingObjectCount++
+		return
+	}
+	if entry.Status.IsDeleteMarker() {
+		return
+	}
+
+	collector.stats.ObjectCount++
+	collector.stats.TotalBytes += entry.TotalEncryptedSize
+
+	class := sort.Search(len(sizeClassBounds), func(i int) bool {
+		return entry.TotalEncryptedSize < sizeClassBounds[i]
+	})
+	collector.stats.SizeHistogram[class].ObjectCount++
+
+	if collector.maxPrefixes <= 0 {
+		return
+	}
+
+	prefix := topLevelPrefix(entry.ObjectKey)
+	if collector.current == nil || !bytes.Equal(collector.current.Prefix, prefix) {
+		collector.flushPrefix()
+		collector.current = &accounting.PrefixStats{Prefix: prefix}
+	}
+	collector.current.ObjectCount++
+	collector.current.TotalBytes += entry.TotalEncryptedSize
+}
+
+// flushPrefix adds the current prefix to the bucket statistics. The prefixes
+// are trimmed to the ones with the most bytes only once there are twice as
+// many as needed, to avoid sorting them for every prefix.
+func (collector *bucketCollector) flushPrefix() {
+	if collector.current == nil {
+		return
+	}
+
+	collector.stats.Prefixes = append(collector.stats.Prefixes, *collector.current)
+	collector.current = nil
+
+	if len(collector.stats.Prefixes) >= 2*collector.maxPrefixes {
+		collector.trimPrefixes()
+	}
+}
+
+// trimPrefixes keeps only the prefixes with the most bytes.
+func (collector *bucketCollector) trimPrefixes() {
+	prefixes := collector.stats.Prefixes
+	sort.SliceStable(prefixes, func(i, k int) bool {
+		return prefixes[i].TotalBytes > prefixes[k].TotalBytes
+	})
+	if len(prefixes) > collector.maxPrefixes {
+		prefixes = prefixes[:collector.maxPrefixes]
+	}
+	collector.stats.Prefixes = prefixes
+}
+
+func (collector *bucketCollector) finish() accounting.BucketObjectStats {
+	collector.flushPrefix()
+	collector.trimPrefixes()
+	return collector.stats
+}
+
+// topLevelPrefix returns the first path component of the encrypted object key.
+// Objects without a path separator are accounted under an empty prefix.
+func topLevelPrefix(key metabase.ObjectKey) []byte {
+	i := bytes.IndexByte([]byte(key), '/')
+	if i < 0 {
+		return []byte{}
+	}
+	return []byte(key[:i])
+}
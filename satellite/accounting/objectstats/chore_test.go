@@ -0,0 +1,93 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package objectstats_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
+)
+
+func TestObjectStatsChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 2,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.ObjectStats.Enabled = true
+				config.ObjectStats.MaxPrefixes = 1
+				config.ObjectStats.BatchSize = 2
+				config.ObjectStats.Ranges = 4
+				config.ObjectStats.Concurrency = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		chore := sat.Accounting.ObjectStats
+		chore.Loop.Pause()
+
+		for _, key := range []string{"dir/first", "dir/second", "root"} {
+			require.NoError(t, uplink.Upload(ctx, sat, "testbucket", key, testrand.Bytes(memory.KiB)))
+		}
+		require.NoError(t, uplink.Upload(ctx, sat, "otherbucket", "large", testrand.Bytes(100*memory.KiB)))
+		// the buckets of the other project are collected in another range.
+		require.NoError(t, planet.Uplinks[1].Upload(ctx, sat, "testbucket", "object", testrand.Bytes(memory.KiB)))
+
+		project, err := uplink.OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		_, err = project.BeginUpload(ctx, "testbucket", "dir/pending", nil)
+		require.NoError(t, err)
+
+		chore.Loop.TriggerWait()
+		require.Len(t, chore.BucketStats, 3)
+
+		projectID := uplink.Projects[0].ID
+		stats, err := sat.DB.ProjectAccounting().GetBucketObjectStats(ctx, projectID, []byte("testbucket"))
+		require.NoError(t, err)
+		require.EqualValues(t, 3, stats.ObjectCount)
+		require.EqualValues(t, 1, stats.PendingObjectCount)
+		require.NotZero(t, stats.TotalBytes)
+
+		var histogramCount int64
+		for _, class := range stats.SizeHistogram {
+			histogramCount += class.ObjectCount
+		}
+		require.EqualValues(t, 3, histogramCount)
+
+		// only the prefix with the most bytes, i.e. the encrypted "dir", is kept.
+		require.Len(t, stats.Prefixes, 1)
+		require.NotEmpty(t, stats.Prefixes[0].Prefix)
+		require.EqualValues(t, 2, stats.Prefixes[0].ObjectCount)
+
+		stats, err = sat.DB.ProjectAccounting().GetBucketObjectStats(ctx, projectID, []byte("otherbucket"))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, stats.ObjectCount)
+		require.Len(t, stats.Prefixes, 1)
+		require.Empty(t, stats.Prefixes[0].Prefix)
+
+		stats, err = sat.DB.ProjectAccounting().GetBucketObjectStats(ctx, planet.Uplinks[1].Projects[0].ID, []byte("testbucket"))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, stats.ObjectCount)
+
+		// the stats of the buckets without objects are removed with the next run.
+		require.NoError(t, planet.Uplinks[1].DeleteObject(ctx, sat, "testbucket", "object"))
+
+		chore.Loop.TriggerWait()
+		require.Len(t, chore.BucketStats, 2)
+
+		_, err = sat.DB.ProjectAccounting().GetBucketObjectStats(ctx, planet.Uplinks[1].Projects[0].ID, []byte("testbucket"))
+		require.True(t, accounting.ErrObjectStatsNotFound.Has(err))
+	})
+}
@@ -13,6 +13,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -31,6 +32,7 @@ type Service struct {
 	projectAccountingDB ProjectAccounting
 	liveAccounting      Cache
 	projectLimitCache   *ProjectLimitCache
+	bucketLimitCache    *BucketLimitCache
 	metabaseDB          metabase.DB
 	bandwidthCacheTTL   time.Duration
 	nowFn               func() time.Time
@@ -38,11 +40,12 @@ type Service struct {
 }
 
 // NewService created new instance of project usage service.
-func NewService(projectAccountingDB ProjectAccounting, liveAccounting Cache, limitCache *ProjectLimitCache, metabaseDB metabase.DB, bandwidthCacheTTL, asOfSystemInterval time.Duration) *Service {
+func NewService(projectAccountingDB ProjectAccounting, liveAccounting Cache, limitCache *ProjectLimitCache, bucketLimitCache *BucketLimitCache, metabaseDB metabase.DB, bandwidthCacheTTL, asOfSystemInterval time.Duration) *Service {
 	return &Service{
 		projectAccountingDB: projectAccountingDB,
 		liveAccounting:      liveAccounting,
 		projectLimitCache:   limitCache,
+		bucketLimitCache:    bucketLimitCache,
 		metabaseDB:          metabaseDB,
 		bandwidthCacheTTL:   bandwidthCacheTTL,
 		nowFn:               time.Now,
@@ -326,6 +329,165 @@ func (usage *Service) AddProjectStorageUsage(ctx context.Context, projectID uuid
 	return usage.liveAccounting.AddProjectStorageUsage(ctx, projectID, spaceUsed)
 }
 
+// ExceedsBucketUploadLimits returns combined checks for the storage and
+// segment limits of the bucket. Supply nonzero headroom parameters to check if
+// there is room for a new object. Buckets without limits never exceed them.
+func (usage *Service) ExceedsBucketUploadLimits(
+	ctx context.Context, bucket metabase.BucketLocation, storageSizeHeadroom int64, segmentCountHeadroom int64) (limit UploadLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.getBucketLimits(ctx, bucket)
+	if err != nil {
+		return UploadLimit{}, ErrProjectUsage.Wrap(err)
+	}
+	if limits.Storage == nil && limits.Segments == nil {
+		return UploadLimit{}, nil
+	}
+
+	current, err := usage.getBucketUsage(ctx, bucket)
+	if err != nil {
+		return UploadLimit{}, ErrProjectUsage.Wrap(err)
+	}
+
+	if limits.Storage != nil {
+		limit.StorageLimit = memory.Size(*limits.Storage)
+		limit.ExceedsStorage = (current.Storage + storageSizeHeadroom) > *limits.Storage
+	}
+	if limits.Segments != nil {
+		limit.SegmentsLimit = *limits.Segments
+		limit.ExceedsSegments = (current.Segments + segmentCountHeadroom) > *limits.Segments
+	}
+
+	return limit, nil
+}
+
+// ExceedsBucketBandwidthUsage returns true if the bandwidth usage of the bucket
+// in the current month has exceeded its limit. Buckets without a bandwidth
+// limit never exceed it.
+func (usage *Service) ExceedsBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.getBucketLimits(ctx, bucket)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+	if limits.Bandwidth == nil {
+		return false, 0, nil
+	}
+
+	current, err := usage.getBucketUsage(ctx, bucket)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	limit = memory.Size(*limits.Bandwidth)
+	return current.Bandwidth >= limit.Int64(), limit, nil
+}
+
+// AddBucketUsage lets the live accounting know that the given bucket has just
+// added storage bytes and segments. It's a no-op for buckets without limits.
+func (usage *Service) AddBucketUsage(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.getBucketLimits(ctx, bucket)
+	if err != nil || limits.IsZero() {
+		return ErrProjectUsage.Wrap(err)
+	}
+
+	return ErrProjectUsage.Wrap(usage.liveAccounting.UpdateBucketUsage(ctx, bucket, BucketLiveUsage{
+		Storage:  storage,
+		Segments: segments,
+	}, usage.nowFn()))
+}
+
+// RemoveBucketUsage lets the live accounting know that the given bucket has
+// just removed storage bytes and segments, e.g. by deleting objects. It's a
+// no-op for buckets without limits.
+func (usage *Service) RemoveBucketUsage(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return usage.AddBucketUsage(ctx, bucket, -storage, -segments)
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth usage of the bucket. It's
+// a no-op for buckets without limits.
+func (usage *Service) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.getBucketLimits(ctx, bucket)
+	if err != nil || limits.IsZero() {
+		return ErrProjectUsage.Wrap(err)
+	}
+
+	return ErrProjectUsage.Wrap(usage.liveAccounting.UpdateBucketUsage(ctx, bucket, BucketLiveUsage{
+		Bandwidth: increment,
+	}, usage.nowFn()))
+}
+
+// HasBucketLimits returns true if the bucket has any limits, hence its usage is
+// tracked by the live accounting.
+func (usage *Service) HasBucketLimits(ctx context.Context, bucket metabase.BucketLocation) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.getBucketLimits(ctx, bucket)
+	if err != nil {
+		return false, ErrProjectUsage.Wrap(err)
+	}
+	return !limits.IsZero(), nil
+}
+
+// getBucketLimits returns the limits of the bucket. They're empty when the
+// bucket limits aren't enabled.
+func (usage *Service) getBucketLimits(ctx context.Context, bucket metabase.BucketLocation) (buckets.Limits, error) {
+	if usage.bucketLimitCache == nil {
+		return buckets.Limits{}, nil
+	}
+	return usage.bucketLimitCache.GetLimits(ctx, bucket)
+}
+
+// getBucketUsage returns the usage of the bucket from the cache. When it isn't
+// cached, the storage and segments are seeded from the latest tally of the
+// bucket and the bandwidth of the current month from the accounting database.
+//
+// The cached usage doesn't expire until the end of the day, because the
+// uploads since the latest tally are only known from the cache increments,
+// hence they would be lost when the usage is seeded again from the tally.
+func (usage *Service) getBucketUsage(ctx context.Context, bucket metabase.BucketLocation) (current BucketLiveUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := usage.nowFn()
+	current, err = usage.liveAccounting.GetBucketUsage(ctx, bucket, now)
+	if !ErrKeyNotFound.Has(err) {
+		return current, err
+	}
+
+	tally, err := usage.projectAccountingDB.GetBucketLatestTally(ctx, bucket.ProjectID, []byte(bucket.BucketName), usage.asOfSystemInterval)
+	if err != nil {
+		return BucketLiveUsage{}, err
+	}
+	current = BucketLiveUsage{
+		Storage:  tally.Storage,
+		Segments: tally.Segments,
+	}
+
+	current.Bandwidth, err = usage.projectAccountingDB.GetBucketBandwidth(ctx, bucket.ProjectID, []byte(bucket.BucketName), now.Year(), now.Month(), usage.asOfSystemInterval)
+	if err != nil {
+		return BucketLiveUsage{}, err
+	}
+
+	year, month, day := now.Date()
+	ttl := time.Date(year, month, day+1, 0, 0, 0, 0, now.Location()).Sub(now)
+	if ttl < time.Minute {
+		ttl = time.Minute
+	}
+
+	_, err = usage.liveAccounting.InsertBucketUsage(ctx, bucket, current, ttl, now)
+	if err != nil {
+		return BucketLiveUsage{}, err
+	}
+	return current, nil
+}
+
 // SetNow allows tests to have the Service act as if the current time is whatever they want.
 func (usage *Service) SetNow(now func() time.Time) {
 	usage.nowFn = now
@@ -167,21 +167,6 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 	{ // setup payments
 		pc := config.Payments
 
-		var stripeClient stripe.Client
-		switch pc.Provider {
-		case "": // just new mock, only used in testing binaries
-			stripeClient = stripe.NewStripeMock(
-				peer.DB.StripeCoinPayments().Customers(),
-				peer.DB.Console().Users(),
-			)
-		case "mock":
-			stripeClient = pc.MockProvider
-		case "stripecoinpayments":
-			stripeClient = stripe.NewStripeClient(log, pc.StripeCoinPayments)
-		default:
-			return nil, errs.New("invalid stripe coin payments provider %q", pc.Provider)
-		}
-
 		prices, err := pc.UsagePrice.ToModel()
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -192,35 +177,64 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		placementPriceOverrides, err := pc.PlacementPriceOverrides.ToModels()
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		peer.FreezeAccounts.Service = console.NewAccountFreezeService(
 			db.Console(),
 			peer.Analytics.Service,
 			config.Console.AccountFreeze,
 		)
 
-		peer.Payments.Service, err = stripe.NewService(
-			peer.Log.Named("payments.stripe:service"),
-			stripeClient,
-			pc.StripeCoinPayments,
-			peer.DB.StripeCoinPayments(),
-			peer.DB.Wallets(),
-			peer.DB.Billing(),
-			peer.DB.Console().Projects(),
-			peer.DB.Console().Users(),
-			peer.DB.ProjectAccounting(),
-			prices,
-			priceOverrides,
-			pc.PackagePlans.Packages,
-			pc.BonusRate,
-			peer.Analytics.Service,
-		)
+		if pc.Provider == "offline" {
+			offlineService, err := NewOfflinePayments(peer.Log, peer.DB, pc)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Payments.Accounts = offlineService.Accounts()
+		} else {
+			var stripeClient stripe.Client
+			switch pc.Provider {
+			case "": // just new mock, only used in testing binaries
+				stripeClient = stripe.NewStripeMock(
+					peer.DB.StripeCoinPayments().Customers(),
+					peer.DB.Console().Users(),
+				)
+			case "mock":
+				stripeClient = pc.MockProvider
+			case "stripecoinpayments":
+				stripeClient = stripe.NewStripeClient(log, pc.StripeCoinPayments)
+			default:
+				return nil, errs.New("invalid stripe coin payments provider %q", pc.Provider)
+			}
 
-		if err != nil {
-			return nil, errs.Combine(err, peer.Close())
-		}
+			peer.Payments.Service, err = stripe.NewService(
+				peer.Log.Named("payments.stripe:service"),
+				stripeClient,
+				pc.StripeCoinPayments,
+				peer.DB.StripeCoinPayments(),
+				peer.DB.Wallets(),
+				peer.DB.Billing(),
+				peer.DB.Console().Projects(),
+				peer.DB.Console().Users(),
+				peer.DB.ProjectAccounting(),
+				prices,
+				priceOverrides,
+				placementPriceOverrides,
+				pc.PackagePlans.Packages,
+				pc.BonusRate,
+				peer.Analytics.Service,
+			)
 
-		peer.Payments.Stripe = stripeClient
-		peer.Payments.Accounts = peer.Payments.Service.Accounts()
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			peer.Payments.Stripe = stripeClient
+			peer.Payments.Accounts = peer.Payments.Service.Accounts()
+		}
 	}
 
 	{ // setup live accounting
@@ -241,6 +255,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			peer.DB.ProjectAccounting(),
 			peer.LiveAccounting.Cache,
 			peer.ProjectLimits.Cache,
+			accounting.NewBucketLimitCache(peer.DB.Buckets(), config.ProjectLimit),
 			*metabaseDB,
 			config.LiveAccounting.BandwidthCacheTTL,
 			config.LiveAccounting.AsOfSystemInterval,
@@ -0,0 +1,59 @@
+// AUTOGENERATED BY private/apigen
+// DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"storj.io/storj/private/api"
+	admin "storj.io/storj/satellite/admin/back-office"
+)
+
+// PlacementManagementClient is a client of the PlacementManagement API endpoints.
+type PlacementManagementClient struct {
+	client *api.Client
+}
+
+// NewPlacementManagementClient creates a new client of the PlacementManagement API endpoints.
+func NewPlacementManagementClient(client *api.Client) *PlacementManagementClient {
+	return &PlacementManagementClient{client: client}
+}
+
+// GetPlacements calls the "Get placements" endpoint.
+//
+// Gets placement rule IDs and their locations.
+func (c *PlacementManagementClient) GetPlacements(ctx context.Context) ([]admin.PlacementInfo, error) {
+	var response []admin.PlacementInfo
+	err := c.client.Do(ctx, http.MethodGet, "/back-office/api/v1/placements/", nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UserManagementClient is a client of the UserManagement API endpoints.
+type UserManagementClient struct {
+	client *api.Client
+}
+
+// NewUserManagementClient creates a new client of the UserManagement API endpoints.
+func NewUserManagementClient(client *api.Client) *UserManagementClient {
+	return &UserManagementClient{client: client}
+}
+
+// GetUserByEmail calls the "Get user" endpoint.
+//
+// Gets user by email address.
+func (c *UserManagementClient) GetUserByEmail(ctx context.Context, email string) (*admin.User, error) {
+	var response admin.User
+	err := c.client.Do(ctx, http.MethodGet, "/back-office/api/v1/users/"+url.PathEscape(email), nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -49,8 +49,10 @@ func main() {
 
 	modroot := findModuleRootDir()
 	api.MustWriteGo(filepath.Join(modroot, "satellite", "admin", "back-office", "handlers.gen.go"))
+	api.MustWriteGoClient(filepath.Join(modroot, "satellite", "admin", "back-office", "client", "client.gen.go"), "client")
 	api.MustWriteTS(filepath.Join(modroot, "satellite", "admin", "back-office", "ui", "src", "api", "client.gen.ts"))
 	api.MustWriteDocs(filepath.Join(modroot, "satellite", "admin", "back-office", "api-docs.gen.md"))
+	api.MustWriteOpenAPI(filepath.Join(modroot, "satellite", "admin", "back-office", "openapi.gen.json"))
 }
 
 func findModuleRootDir() string {
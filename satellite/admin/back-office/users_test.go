@@ -17,8 +17,10 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/api"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/admin/back-office/client"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
@@ -52,7 +54,16 @@ func TestGetUser(t *testing.T) {
 		require.Equal(t, http.StatusNotFound, apiErr.Status)
 		require.Error(t, apiErr.Err)
 
-		_, err := consoleDB.Users().Insert(ctx, consoleUser)
+		apiClient := client.NewUserManagementClient(&api.Client{
+			BaseURL: "http://" + sat.Admin.Admin.Listener.Addr().String(),
+		})
+
+		_, err := apiClient.GetUserByEmail(ctx, consoleUser.Email)
+		var httpErr api.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		require.Equal(t, http.StatusNotFound, httpErr.Status)
+
+		_, err = consoleDB.Users().Insert(ctx, consoleUser)
 		require.NoError(t, err)
 
 		consoleUser.PaidTier = true
@@ -164,5 +175,15 @@ func TestGetUser(t *testing.T) {
 			require.Equal(t, total.bandwidth, info.BandwidthUsed, name)
 			require.Equal(t, total.segments, *info.SegmentUsed, name)
 		}
+
+		// the generated client receives the same user as the service returns.
+		httpUser, err := apiClient.GetUserByEmail(ctx, consoleUser.Email)
+		require.NoError(t, err)
+		require.Equal(t, user.ID, httpUser.ID)
+		require.Equal(t, user.Email, httpUser.Email)
+		require.Equal(t, user.Status, httpUser.Status)
+		require.Equal(t, user.DefaultPlacement, httpUser.DefaultPlacement)
+		require.WithinDuration(t, user.CreatedAt, httpUser.CreatedAt, time.Second)
+		require.ElementsMatch(t, user.ProjectUsageLimits, httpUser.ProjectUsageLimits)
 	})
 }
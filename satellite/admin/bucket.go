@@ -6,6 +6,7 @@ package admin
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -132,3 +133,72 @@ func (server *Server) getBucketInfo(w http.ResponseWriter, r *http.Request) {
 		sendJSONData(w, http.StatusOK, data)
 	}
 }
+
+func (server *Server) getBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	limits, err := server.buckets.GetBucketLimits(ctx, bucket, project.UUID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get bucket limits", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(limits)
+	if err != nil {
+		sendJSONError(w, "failed to marshal bucket limits", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) updateBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var limits buckets.Limits
+	err = json.Unmarshal(body, &limits)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, limit := range []*int64{limits.Storage, limits.Bandwidth, limits.Segments} {
+		if limit != nil && *limit < 0 {
+			sendJSONError(w, "limits can not be negative", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	err = server.buckets.UpdateBucketLimits(ctx, bucket, project.UUID, limits)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to update bucket limits", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/durability"
+)
+
+// defaultDurabilityStatLimit is the default number of worst class values returned per class.
+const defaultDurabilityStatLimit = 10
+
+// durabilityReport is the latest durability report of a class with its worst class values.
+type durabilityReport struct {
+	durability.ReportSummary
+	Worst []durability.ClassStat `json:"worst"`
+}
+
+func (server *Server) getDurabilityReports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit, ok := durabilityStatLimit(w, r)
+	if !ok {
+		return
+	}
+
+	summaries, err := server.db.DurabilityReports().GetReports(ctx)
+	if err != nil {
+		sendJSONError(w, "failed to get durability reports",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reports := make([]durabilityReport, 0, len(summaries))
+	for _, summary := range summaries {
+		worst, err := server.db.DurabilityReports().GetWorstStats(ctx, summary.Class, limit)
+		if err != nil {
+			sendJSONError(w, "failed to get durability stats",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+		reports = append(reports, durabilityReport{ReportSummary: summary, Worst: worst})
+	}
+
+	data, err := json.Marshal(reports)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getDurabilityReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	class, ok := mux.Vars(r)["class"]
+	if !ok {
+		sendJSONError(w, "class missing", "", http.StatusBadRequest)
+		return
+	}
+
+	limit, ok := durabilityStatLimit(w, r)
+	if !ok {
+		return
+	}
+
+	summary, err := server.db.DurabilityReports().GetReport(ctx, class)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if durability.ErrReportNotFound.Has(err) {
+			status = http.StatusNotFound
+		}
+		sendJSONError(w, "failed to get durability report", err.Error(), status)
+		return
+	}
+
+	worst, err := server.db.DurabilityReports().GetWorstStats(ctx, class, limit)
+	if err != nil {
+		sendJSONError(w, "failed to get durability stats",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(durabilityReport{ReportSummary: summary, Worst: worst})
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// durabilityStatLimit parses the optional limit query parameter. It responds with an error when it's invalid.
+func durabilityStatLimit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	limitParam := r.URL.Query().Get("limit")
+	if limitParam == "" {
+		return defaultDurabilityStatLimit, true
+	}
+
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit <= 0 {
+		sendJSONError(w, "invalid limit", "limit must be a positive number", http.StatusBadRequest)
+		return 0, false
+	}
+	return limit, true
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/payments/offline"
+)
+
+func (server *Server) listUserInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get user details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	invoices, err := server.payments.Invoices().List(ctx, user.ID)
+	if err != nil {
+		sendJSONError(w, "failed to list invoices",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(invoices)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	invoices, ok := server.manualInvoices(w)
+	if !ok {
+		return
+	}
+
+	invoiceID, ok := mux.Vars(r)["id"]
+	if !ok {
+		sendJSONError(w, "invoice id missing", "", http.StatusBadRequest)
+		return
+	}
+
+	formatName := r.URL.Query().Get("format")
+	if formatName == "" {
+		formatName = string(offline.FormatJSON)
+	}
+	format, err := offline.ParseFormat(formatName)
+	if err != nil {
+		sendJSONError(w, "invalid format",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// render into a buffer first, to be able to respond with an error.
+	var buf bytes.Buffer
+	err = invoices.Render(ctx, &buf, invoiceID, format)
+	if err != nil {
+		sendInvoiceError(w, "failed to render invoice", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	if format != offline.FormatJSON {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "invoice-"+invoiceID+"."+string(format)))
+	}
+	_, _ = io.Copy(w, &buf) // any error here entitles a client side disconnect or similar, which we do not care about.
+}
+
+func (server *Server) markInvoicePaid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	invoices, ok := server.manualInvoices(w)
+	if !ok {
+		return
+	}
+
+	invoiceID, ok := mux.Vars(r)["id"]
+	if !ok {
+		sendJSONError(w, "invoice id missing", "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Reference string `json:"reference"`
+	}
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.Reference == "" {
+		sendJSONError(w, "reference is required", "", http.StatusBadRequest)
+		return
+	}
+
+	invoice, err := invoices.MarkPaid(ctx, invoiceID, input.Reference)
+	if err != nil {
+		sendInvoiceError(w, "failed to mark invoice as paid", err)
+		return
+	}
+
+	data, err := json.Marshal(invoice)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// manualInvoices returns the invoices of the payments provider, when they can be settled manually.
+// Otherwise it responds with an error.
+func (server *Server) manualInvoices(w http.ResponseWriter) (offline.ManualInvoices, bool) {
+	invoices, ok := server.payments.Invoices().(offline.ManualInvoices)
+	if !ok {
+		sendJSONError(w, "not supported by payments provider",
+			"invoices can only be managed with the offline payments provider", http.StatusConflict)
+		return nil, false
+	}
+	return invoices, true
+}
+
+func sendInvoiceError(w http.ResponseWriter, errMsg string, err error) {
+	status := http.StatusInternalServerError
+	if offline.ErrInvoiceNotFound.Has(err) {
+		status = http.StatusNotFound
+	}
+	sendJSONError(w, errMsg, err.Error(), status)
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/offline"
+)
+
+func TestInvoices(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+				config.Payments.Provider = "offline"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		owner := planet.Uplinks[0].Projects[0].Owner
+
+		invoice := offline.Invoice{
+			ID:          testrand.UUID(),
+			UserID:      owner.ID,
+			PeriodStart: time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+			PeriodEnd:   time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Description: "Usage for 2023-04",
+			Amount:      100,
+			Status:      payments.InvoiceStatusOpen,
+			LineItems: []offline.LineItem{
+				{Description: "storage", Quantity: 10, UnitCents: decimal.NewFromInt(10), Amount: 100},
+			},
+			DueDate: time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC),
+		}
+		require.NoError(t, sat.DB.OfflineInvoices().Insert(ctx, invoice))
+
+		baseURL := "http://" + address.String() + "/api"
+		invoiceURL := baseURL + "/invoices/" + invoice.ID.String()
+
+		t.Run("List", func(t *testing.T) {
+			body := assertReq(ctx, t, baseURL+"/users/"+owner.Email+"/invoices", http.MethodGet, "", http.StatusOK, "", authToken)
+
+			var list []payments.Invoice
+			require.NoError(t, json.Unmarshal(body, &list))
+			require.Len(t, list, 1)
+			require.Equal(t, invoice.ID.String(), list[0].ID)
+			require.Equal(t, invoice.Amount, list[0].Amount)
+
+			assertReq(ctx, t, baseURL+"/users/user-not-exist@not-exist.test/invoices", http.MethodGet, "", http.StatusNotFound, "", authToken)
+		})
+
+		t.Run("Get", func(t *testing.T) {
+			body := assertReq(ctx, t, invoiceURL, http.MethodGet, "", http.StatusOK, "", authToken)
+			require.Contains(t, string(body), invoice.Description)
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, invoiceURL+"?format=csv", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", authToken)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			data, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.NoError(t, res.Body.Close())
+
+			require.Equal(t, http.StatusOK, res.StatusCode, string(data))
+			require.Equal(t, offline.FormatCSV.ContentType(), res.Header.Get("Content-Type"))
+			require.Contains(t, res.Header.Get("Content-Disposition"), "invoice-"+invoice.ID.String()+".csv")
+			require.Contains(t, string(data), "storage")
+
+			assertReq(ctx, t, invoiceURL+"?format=xml", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+			assertReq(ctx, t, baseURL+"/invoices/"+testrand.UUID().String(), http.MethodGet, "", http.StatusNotFound, "", authToken)
+		})
+
+		t.Run("MarkPaid", func(t *testing.T) {
+			assertReq(ctx, t, invoiceURL+"/paid", http.MethodPut, `{"reference":""}`, http.StatusBadRequest, "", authToken)
+			assertReq(ctx, t, baseURL+"/invoices/"+testrand.UUID().String()+"/paid", http.MethodPut, `{"reference":"wire transfer"}`, http.StatusNotFound, "", authToken)
+
+			body := assertReq(ctx, t, invoiceURL+"/paid", http.MethodPut, `{"reference":"wire transfer"}`, http.StatusOK, "", authToken)
+
+			var paid payments.Invoice
+			require.NoError(t, json.Unmarshal(body, &paid))
+			require.Equal(t, payments.InvoiceStatusPaid, paid.Status)
+
+			stored, err := sat.DB.OfflineInvoices().Get(ctx, invoice.ID)
+			require.NoError(t, err)
+			require.Equal(t, "wire transfer", stored.PaymentReference)
+			require.NotNil(t, stored.PaidAt)
+		})
+	})
+}
+
+func TestInvoices_NotOffline(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+
+		link := "http://" + address.String() + "/api/invoices/" + testrand.UUID().String()
+		assertReq(ctx, t, link, http.MethodGet, "", http.StatusConflict, "", sat.Config.Console.AuthToken)
+	})
+}
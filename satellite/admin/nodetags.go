@@ -0,0 +1,71 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// nodeTag is the JSON representation of a node tag.
+type nodeTag struct {
+	NodeID   storj.NodeID `json:"nodeId"`
+	Name     string       `json:"name"`
+	Value    string       `json:"value"`
+	SignedAt time.Time    `json:"signedAt"`
+	Signer   storj.NodeID `json:"signer"`
+}
+
+func (server *Server) listNodeTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	name := query.Get("name")
+
+	var signer storj.NodeID
+	if signerParam := query.Get("signer"); signerParam != "" {
+		var err error
+		signer, err = storj.NodeIDFromString(signerParam)
+		if err != nil {
+			sendJSONError(w, "invalid signer",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if name == "" && signer.IsZero() {
+		sendJSONError(w, "name or signer is required", "", http.StatusBadRequest)
+		return
+	}
+
+	tags, err := server.db.OverlayCache().ListNodeTags(ctx, name, signer)
+	if err != nil {
+		sendJSONError(w, "failed to list node tags",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	result := make([]nodeTag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, nodeTag{
+			NodeID:   tag.NodeID,
+			Name:     tag.Name,
+			Value:    string(tag.Value),
+			SignedAt: tag.SignedAt,
+			Signer:   tag.Signer,
+		})
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
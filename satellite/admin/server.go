@@ -28,7 +28,9 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/durability"
 	"storj.io/storj/satellite/oidc"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripe"
 )
@@ -73,6 +75,10 @@ type DB interface {
 	Buckets() buckets.DB
 	// Attribution returns database for value attribution.
 	Attribution() attribution.DB
+	// DurabilityReports returns database for the latest durability reports.
+	DurabilityReports() durability.DB
+	// OverlayCache returns database for storage nodes.
+	OverlayCache() overlay.DB
 }
 
 // Server provides endpoints for administrative tasks.
@@ -156,6 +162,8 @@ func NewServer(
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}", server.getBucketInfo).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/limits", server.getBucketLimits).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/limits", server.updateBucketLimits).Methods("PUT")
 	fullAccessAPI.HandleFunc("/projects/{project}/usage", server.checkProjectUsage).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/useragent", server.updateProjectsUserAgent).Methods("PATCH")
 	fullAccessAPI.HandleFunc("/projects/{project}/geofence", server.createGeofenceForProject).Methods("POST")
@@ -179,8 +187,14 @@ func NewServer(
 	limitUpdateAPI.HandleFunc("/users/{useremail}/legal-freeze", server.legalFreezeUser).Methods("PUT")
 	limitUpdateAPI.HandleFunc("/users/{useremail}/legal-freeze", server.legalUnfreezeUser).Methods("DELETE")
 	limitUpdateAPI.HandleFunc("/users/pending-deletion", server.usersPendingDeletion).Methods("GET")
+	limitUpdateAPI.HandleFunc("/users/{useremail}/invoices", server.listUserInvoices).Methods("GET")
+	limitUpdateAPI.HandleFunc("/invoices/{id}", server.getInvoice).Methods("GET")
+	limitUpdateAPI.HandleFunc("/invoices/{id}/paid", server.markInvoicePaid).Methods("PUT")
 	limitUpdateAPI.HandleFunc("/projects/{project}/limit", server.getProjectLimit).Methods("GET")
 	limitUpdateAPI.HandleFunc("/projects/{project}/limit", server.putProjectLimit).Methods("PUT", "POST")
+	limitUpdateAPI.HandleFunc("/durability", server.getDurabilityReports).Methods("GET")
+	limitUpdateAPI.HandleFunc("/durability/{class}", server.getDurabilityReport).Methods("GET")
+	limitUpdateAPI.HandleFunc("/nodes/tags", server.listNodeTags).Methods("GET")
 
 	// NewServer adds the backoffice.PahtPrefix for the static assets, but not for the API because the
 	// generator already add the PathPrefix to router when the API handlers are hooked.
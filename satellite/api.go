@@ -26,7 +26,9 @@ import (
 	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/maintenancepb"
 	"storj.io/storj/private/server"
+	"storj.io/storj/private/settlementpb"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/abtesting"
 	"storj.io/storj/satellite/accounting"
@@ -323,11 +325,17 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, err
 		}
 
-		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Overlay.Service, peer.DB.PeerIdentities(), peer.Dialer, authority, config.Contact)
+		peer.Contact.Service, err = contact.NewService(peer.Log.Named("contact:service"), peer.Overlay.Service, peer.DB.PeerIdentities(), peer.Dialer, authority, config.Contact)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"), peer.Contact.Service)
 		if err := pb.DRPCRegisterNode(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := maintenancepb.DRPCRegisterMaintenance(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "contact:service",
@@ -353,6 +361,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.ProjectAccounting(),
 			peer.LiveAccounting.Cache,
 			peer.ProjectLimits.Cache,
+			accounting.NewBucketLimitCache(peer.DB.Buckets(), config.ProjectLimit),
 			*metabaseDB,
 			config.LiveAccounting.BandwidthCacheTTL,
 			config.LiveAccounting.AsOfSystemInterval,
@@ -404,6 +413,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterOrders(peer.Server.DRPC(), peer.Orders.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := settlementpb.DRPCRegisterSettlement(peer.Server.DRPC(), peer.Orders.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup analytics service
@@ -487,21 +499,6 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 	{ // setup payments
 		pc := config.Payments
 
-		var stripeClient stripe.Client
-		switch pc.Provider {
-		case "": // just new mock, only used in testing binaries
-			stripeClient = stripe.NewStripeMock(
-				peer.DB.StripeCoinPayments().Customers(),
-				peer.DB.Console().Users(),
-			)
-		case "mock":
-			stripeClient = pc.MockProvider
-		case "stripecoinpayments":
-			stripeClient = stripe.NewStripeClient(log, pc.StripeCoinPayments)
-		default:
-			return nil, errs.New("invalid stripe coin payments provider %q", pc.Provider)
-		}
-
 		prices, err := pc.UsagePrice.ToModel()
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -512,29 +509,58 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Payments.StripeService, err = stripe.NewService(
-			peer.Log.Named("payments.stripe:service"),
-			stripeClient,
-			pc.StripeCoinPayments,
-			peer.DB.StripeCoinPayments(),
-			peer.DB.Wallets(),
-			peer.DB.Billing(),
-			peer.DB.Console().Projects(),
-			peer.DB.Console().Users(),
-			peer.DB.ProjectAccounting(),
-			prices,
-			priceOverrides,
-			pc.PackagePlans.Packages,
-			pc.BonusRate,
-			peer.Analytics.Service,
-		)
-
+		placementPriceOverrides, err := pc.PlacementPriceOverrides.ToModels()
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Payments.StripeClient = stripeClient
-		peer.Payments.Accounts = peer.Payments.StripeService.Accounts()
+		if pc.Provider == "offline" {
+			offlineService, err := NewOfflinePayments(peer.Log, peer.DB, pc)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Payments.Accounts = offlineService.Accounts()
+		} else {
+			var stripeClient stripe.Client
+			switch pc.Provider {
+			case "": // just new mock, only used in testing binaries
+				stripeClient = stripe.NewStripeMock(
+					peer.DB.StripeCoinPayments().Customers(),
+					peer.DB.Console().Users(),
+				)
+			case "mock":
+				stripeClient = pc.MockProvider
+			case "stripecoinpayments":
+				stripeClient = stripe.NewStripeClient(log, pc.StripeCoinPayments)
+			default:
+				return nil, errs.New("invalid stripe coin payments provider %q", pc.Provider)
+			}
+
+			peer.Payments.StripeService, err = stripe.NewService(
+				peer.Log.Named("payments.stripe:service"),
+				stripeClient,
+				pc.StripeCoinPayments,
+				peer.DB.StripeCoinPayments(),
+				peer.DB.Wallets(),
+				peer.DB.Billing(),
+				peer.DB.Console().Projects(),
+				peer.DB.Console().Users(),
+				peer.DB.ProjectAccounting(),
+				prices,
+				priceOverrides,
+				placementPriceOverrides,
+				pc.PackagePlans.Packages,
+				pc.BonusRate,
+				peer.Analytics.Service,
+			)
+
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			peer.Payments.StripeClient = stripeClient
+			peer.Payments.Accounts = peer.Payments.StripeService.Accounts()
+		}
 
 		peer.Payments.StorjscanClient = storjscan.NewClient(
 			pc.Storjscan.Endpoint,
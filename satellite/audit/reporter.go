@@ -74,7 +74,7 @@ func (reporter *reporter) RecordAudits(ctx context.Context, req Report) {
 	successes := req.Successes
 	fails := req.Fails
 	unknowns := req.Unknown
-	offlines := req.Offlines
+	offlines := reporter.withoutMaintenance(ctx, req.Offlines)
 	pendingAudits := req.PendingAudits
 
 	logger := reporter.log
@@ -122,6 +122,38 @@ func (reporter *reporter) RecordAudits(ctx context.Context, req Report) {
 	}
 }
 
+// withoutMaintenance removes the nodes which are in a planned maintenance window, as they are
+// expected to be offline and shouldn't be penalized for it.
+func (reporter *reporter) withoutMaintenance(ctx context.Context, offlines storj.NodeIDList) storj.NodeIDList {
+	if len(offlines) == 0 || reporter.overlay == nil {
+		return offlines
+	}
+
+	inMaintenance, err := reporter.overlay.GetNodesInMaintenance(ctx, offlines)
+	if err != nil {
+		reporter.log.Warn("failed to check nodes in maintenance", zap.Error(err))
+		return offlines
+	}
+	if len(inMaintenance) == 0 {
+		return offlines
+	}
+
+	skip := make(map[storj.NodeID]struct{}, len(inMaintenance))
+	for _, id := range inMaintenance {
+		skip[id] = struct{}{}
+	}
+
+	filtered := make(storj.NodeIDList, 0, len(offlines))
+	for _, id := range offlines {
+		if _, ok := skip[id]; ok {
+			mon.Event("audit_offline_skipped_maintenance")
+			continue
+		}
+		filtered = append(filtered, id)
+	}
+	return filtered
+}
+
 func (reporter *reporter) recordAuditStatus(ctx context.Context, nodeIDs storj.NodeIDList, nodesReputation map[storj.NodeID]overlay.ReputationStatus, auditOutcome reputation.AuditType) (failed storj.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
 
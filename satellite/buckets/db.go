@@ -43,6 +43,24 @@ type Bucket struct {
 	DefaultEncryptionParameters storj.EncryptionParameters
 	Placement                   storj.PlacementConstraint
 	Versioning                  Versioning
+	Limits                      Limits
+}
+
+// Limits contains the usage limits of a bucket, which are enforced in
+// addition to the project limits. Nil value means that only the project
+// limit applies.
+type Limits struct {
+	// Storage is the maximum number of bytes stored in the bucket.
+	Storage *int64 `json:"storage"`
+	// Bandwidth is the maximum monthly egress of the bucket in bytes.
+	Bandwidth *int64 `json:"bandwidth"`
+	// Segments is the maximum number of segments stored in the bucket.
+	Segments *int64 `json:"segments"`
+}
+
+// IsZero returns true when no limit is set.
+func (limits Limits) IsZero() bool {
+	return limits.Storage == nil && limits.Bandwidth == nil && limits.Segments == nil
 }
 
 // ListDirection specifies listing direction.
@@ -111,6 +129,10 @@ type DB interface {
 	GetBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bucket Bucket, err error)
 	// GetBucketPlacement returns with the placement constraint identifier.
 	GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (placement storj.PlacementConstraint, err error)
+	// GetBucketLimits returns the usage limits of the bucket.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (limits Limits, err error)
+	// UpdateBucketLimits sets the usage limits of the bucket.
+	UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits Limits) (err error)
 	// GetBucketVersioningState returns with the versioning state of the bucket.
 	GetBucketVersioningState(ctx context.Context, bucketName []byte, projectID uuid.UUID) (versioningState Versioning, err error)
 	// EnableBucketVersioning enables versioning for a bucket.
@@ -98,6 +98,30 @@ func TestBasicBucketOperations(t *testing.T) {
 		_, err = bucketsDB.GetBucketPlacement(ctx, []byte("not-existing-bucket"), project.ID)
 		require.True(t, buckets.ErrBucketNotFound.Has(err), err)
 
+		// GetBucketLimits and UpdateBucketLimits
+		limits, err := bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, limits.IsZero())
+
+		storageLimit, segmentLimit := int64(1000), int64(10)
+		expectedLimits := buckets.Limits{Storage: &storageLimit, Segments: &segmentLimit}
+		err = bucketsDB.UpdateBucketLimits(ctx, []byte("testbucket"), project.ID, expectedLimits)
+		require.NoError(t, err)
+
+		limits, err = bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expectedLimits, limits)
+
+		bucket, err = bucketsDB.GetBucket(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expectedLimits, bucket.Limits)
+
+		err = bucketsDB.UpdateBucketLimits(ctx, []byte("not-existing-bucket"), project.ID, expectedLimits)
+		require.True(t, buckets.ErrBucketNotFound.Has(err), err)
+
+		_, err = bucketsDB.GetBucketLimits(ctx, []byte("not-existing-bucket"), project.ID)
+		require.True(t, buckets.ErrBucketNotFound.Has(err), err)
+
 		// CountBuckets
 		count, err = bucketsDB.CountBuckets(ctx, project.ID)
 		require.NoError(t, err)
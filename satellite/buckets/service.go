@@ -8,6 +8,7 @@ import (
 
 	"github.com/zeebo/errs"
 
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -55,3 +56,21 @@ func (buckets *Service) UpdateBucket(ctx context.Context, bucket Bucket) (Bucket
 
 	return buckets.DB.UpdateBucket(ctx, bucket)
 }
+
+// DeleteEmptyBucket deletes the bucket only if it doesn't contain any object. It returns an
+// ErrBucketNotEmpty error otherwise.
+func (buckets *Service) DeleteEmptyBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) error {
+	ok, err := buckets.metabase.BucketEmpty(ctx, metabase.BucketEmpty{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+	})
+
+	switch {
+	case err != nil:
+		return err
+	case !ok:
+		return ErrBucketNotEmpty.New("cannot delete non-empty bucket")
+	}
+
+	return buckets.DB.DeleteBucket(ctx, bucketName, projectID)
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestValidateName(t *testing.T) {
+	require.True(t, buckets.ErrNoBucket.Has(buckets.ValidateName(nil)))
+
+	for _, name := range []string{
+		"abc", "a-b-c", "a.b.c", "1bucket", "bucket1", "127.0.0.bucket",
+		"a123456789012345678901234567890123456789012345678901234567890bc",
+	} {
+		require.NoError(t, buckets.ValidateName([]byte(name)), name)
+	}
+
+	for _, name := range []string{
+		"a", "ab", "Bucket", "bucket_name", "-bucket", "bucket-", "a..b", ".bucket",
+		"192.168.1.1",
+		"a123456789012345678901234567890123456789012345678901234567890bcd",
+	} {
+		require.Error(t, buckets.ValidateName([]byte(name)), name)
+	}
+}
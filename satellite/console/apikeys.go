@@ -7,7 +7,9 @@ import (
 	"context"
 	"time"
 
+	"storj.io/common/macaroon"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
 )
 
 // APIKeys is interface for working with api keys store.
@@ -43,6 +45,58 @@ type RESTKeys interface {
 type CreateAPIKeyRequest struct {
 	ProjectID string `json:"projectID"`
 	Name      string `json:"name"`
+	// Restrictions are applied to the created API key when they aren't nil.
+	Restrictions *APIKeyRestrictions `json:"restrictions,omitempty"`
+}
+
+// APIKeyRestrictions holds the restrictions applied to an API key when it's created.
+//
+// Path prefixes can't be restricted because they are encrypted with a key that
+// the satellite doesn't know; they can only be restricted by the clients.
+type APIKeyRestrictions struct {
+	DisallowReads   bool `json:"disallowReads"`
+	DisallowWrites  bool `json:"disallowWrites"`
+	DisallowLists   bool `json:"disallowLists"`
+	DisallowDeletes bool `json:"disallowDeletes"`
+	// Buckets are the names of the only buckets that the API key can access. When
+	// empty, the API key can access all the buckets.
+	Buckets   []string   `json:"buckets,omitempty"`
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	NotAfter  *time.Time `json:"notAfter,omitempty"`
+}
+
+// Validate checks that the restrictions are valid.
+func (r APIKeyRestrictions) Validate() error {
+	for _, bucket := range r.Buckets {
+		if err := buckets.ValidateName([]byte(bucket)); err != nil {
+			return ErrValidation.Wrap(err)
+		}
+	}
+
+	if r.NotBefore != nil && r.NotAfter != nil && !r.NotBefore.Before(*r.NotAfter) {
+		return ErrValidation.New("notBefore must be before notAfter")
+	}
+
+	return nil
+}
+
+// Caveat returns the macaroon caveat that applies the restrictions.
+func (r APIKeyRestrictions) Caveat() macaroon.Caveat {
+	caveat := macaroon.Caveat{
+		DisallowReads:   r.DisallowReads,
+		DisallowWrites:  r.DisallowWrites,
+		DisallowLists:   r.DisallowLists,
+		DisallowDeletes: r.DisallowDeletes,
+		NotBefore:       r.NotBefore,
+		NotAfter:        r.NotAfter,
+	}
+	for _, bucket := range r.Buckets {
+		caveat.AllowedPaths = append(caveat.AllowedPaths, &macaroon.Caveat_Path{
+			Bucket: []byte(bucket),
+		})
+	}
+
+	return caveat
 }
 
 // CreateAPIKeyResponse holds macaroon.APIKey and APIKeyInfo.
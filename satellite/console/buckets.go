@@ -0,0 +1,218 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/buckets"
+)
+
+// BucketInfo holds the information of a bucket exposed through the API.
+type BucketInfo struct {
+	Name      string                    `json:"name"`
+	Placement storj.PlacementConstraint `json:"placement"`
+	CreatedAt time.Time                 `json:"createdAt"`
+}
+
+// CreateBucketRequest holds the information for creating a bucket.
+type CreateBucketRequest struct {
+	Name string `json:"name"`
+	// Placement is the placement constraint of the bucket. When nil, the default
+	// placement of the project is used, which is also the only one allowed.
+	Placement *storj.PlacementConstraint `json:"placement,omitempty"`
+}
+
+// GetBuckets returns all the buckets of a project.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) GetBuckets(ctx context.Context, projectID uuid.UUID) (_ []BucketInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get buckets", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	list := []BucketInfo{}
+	listOptions := buckets.ListOptions{
+		Direction: buckets.DirectionForward,
+	}
+	for {
+		page, err := s.buckets.ListBuckets(ctx, isMember.project.ID, listOptions, macaroon.AllowedBuckets{All: true})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, bucket := range page.Items {
+			list = append(list, BucketInfo{
+				Name:      bucket.Name,
+				Placement: bucket.Placement,
+				CreatedAt: bucket.Created,
+			})
+		}
+
+		if !page.More {
+			return list, nil
+		}
+		listOptions = listOptions.NextPage(page)
+	}
+}
+
+// CreateBucket creates a bucket in a project.
+// projectID here may be Project.ID or Project.PublicID.
+//
+// Buckets are always created with the default placement of the project, so a
+// requested placement is only accepted when it is the default one.
+func (s *Service) CreateBucket(ctx context.Context, projectID uuid.UUID, request CreateBucketRequest) (_ *BucketInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create bucket", zap.String("projectID", projectID.String()), zap.String("bucket", request.Name))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+	project := isMember.project
+
+	if err := buckets.ValidateName([]byte(request.Name)); err != nil {
+		return nil, ErrValidation.Wrap(err)
+	}
+
+	if request.Placement != nil && *request.Placement != project.DefaultPlacement {
+		return nil, ErrValidation.New(bucketPlacementErrMsg)
+	}
+
+	bucketsLimit, err := s.store.Projects().GetMaxBuckets(ctx, project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if bucketsLimit == nil {
+		bucketsLimit = &s.maxProjectBuckets
+	}
+
+	bucketsUsed, err := s.buckets.CountBuckets(ctx, project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if bucketsUsed >= *bucketsLimit {
+		return nil, ErrUsage.New(bucketLimitExceededErrMsg, *bucketsLimit)
+	}
+
+	bucketID, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	bucket, err := s.buckets.CreateBucket(ctx, buckets.Bucket{
+		ID:        bucketID,
+		Name:      request.Name,
+		ProjectID: project.ID,
+		UserAgent: project.UserAgent,
+		Placement: project.DefaultPlacement,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &BucketInfo{
+		Name:      bucket.Name,
+		Placement: bucket.Placement,
+		CreatedAt: bucket.Created,
+	}, nil
+}
+
+// DeleteBucket deletes an empty bucket of a project.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) DeleteBucket(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete bucket", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	return Error.Wrap(s.buckets.DeleteEmptyBucket(ctx, []byte(bucketName), isMember.project.ID))
+}
+
+// GenGetBuckets returns all the buckets of a project for generated api.
+func (s *Service) GenGetBuckets(ctx context.Context, projectID uuid.UUID) (list []BucketInfo, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	list, err = s.GetBuckets(ctx, projectID)
+	if err != nil {
+		return nil, bucketHTTPError(err)
+	}
+
+	return list, httpError
+}
+
+// GenCreateBucket creates a bucket in a project for generated api.
+func (s *Service) GenCreateBucket(ctx context.Context, projectID uuid.UUID, request CreateBucketRequest) (info *BucketInfo, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	info, err = s.CreateBucket(ctx, projectID, request)
+	if err != nil {
+		return nil, bucketHTTPError(err)
+	}
+
+	return info, httpError
+}
+
+// GenDeleteBucket deletes an empty bucket of a project for generated api.
+func (s *Service) GenDeleteBucket(ctx context.Context, projectID uuid.UUID, name string) (httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.DeleteBucket(ctx, projectID, name)
+	if err != nil {
+		return bucketHTTPError(err)
+	}
+
+	return httpError
+}
+
+// bucketHTTPError converts an error returned by the bucket operations into an api.HTTPError.
+func bucketHTTPError(err error) api.HTTPError {
+	status := http.StatusInternalServerError
+	switch {
+	case ErrUnauthorized.Has(err):
+		status = http.StatusUnauthorized
+	case ErrValidation.Has(err):
+		status = http.StatusBadRequest
+	case ErrUsage.Has(err):
+		status = http.StatusForbidden
+	case buckets.ErrBucketNotFound.Has(err):
+		status = http.StatusNotFound
+	case buckets.ErrBucketAlreadyExists.Has(err), buckets.ErrBucketNotEmpty.Has(err):
+		status = http.StatusConflict
+	}
+
+	return api.HTTPError{
+		Status: status,
+		Err:    Error.Wrap(err),
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 )
 
@@ -164,6 +165,126 @@ func (b *Buckets) GetBucketTotals(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetBucketLimits returns the storage, bandwidth and segment limits of a bucket.
+func (b *Buckets) GetBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.parseBucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	limits, err := b.service.GetBucketLimits(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveJSONError(ctx, w, bucketLimitsErrorStatus(err), err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(limits)
+	if err != nil {
+		b.log.Error("failed to write json bucket limits response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// UpdateBucketLimits sets the storage, bandwidth and segment limits of a
+// bucket. Omitted or null limits are removed.
+func (b *Buckets) UpdateBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, ok := b.parseBucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var limits buckets.Limits
+	err = json.NewDecoder(r.Body).Decode(&limits)
+	if err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.UpdateBucketLimits(ctx, projectID, bucketName, limits)
+	if err != nil {
+		b.serveJSONError(ctx, w, bucketLimitsErrorStatus(err), err)
+	}
+}
+
+// GetBucketObjectStats returns the latest object, prefix and object size
+// statistics of a bucket.
+func (b *Buckets) GetBucketObjectStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.parseBucketParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	stats, err := b.service.GetBucketObjectStats(ctx, projectID, bucketName)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			status = http.StatusUnauthorized
+		case accounting.ErrObjectStatsNotFound.Has(err):
+			status = http.StatusNotFound
+		}
+		b.serveJSONError(ctx, w, status, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(stats)
+	if err != nil {
+		b.log.Error("failed to write json bucket object stats response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// parseBucketParams parses the projectID and bucket query parameters. It
+// writes the error response and returns false when they're invalid.
+func (b *Buckets) parseBucketParams(ctx context.Context, w http.ResponseWriter, r *http.Request) (projectID uuid.UUID, bucketName string, ok bool) {
+	projectIDString := r.URL.Query().Get("projectID")
+	if projectIDString == "" {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(missingParamErrMsg, "projectID"))
+		return uuid.UUID{}, "", false
+	}
+	projectID, err := uuid.FromString(projectIDString)
+	if err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(invalidParamErrMsg, projectIDString, "projectID", err))
+		return uuid.UUID{}, "", false
+	}
+
+	bucketName = r.URL.Query().Get("bucket")
+	if bucketName == "" {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(missingParamErrMsg, "bucket"))
+		return uuid.UUID{}, "", false
+	}
+
+	return projectID, bucketName, true
+}
+
+// bucketLimitsErrorStatus returns the HTTP status of a bucket limits error.
+func bucketLimitsErrorStatus(err error) int {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		return http.StatusUnauthorized
+	case console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	case buckets.ErrBucketNotFound.Has(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(ctx, b.log, w, status, err)
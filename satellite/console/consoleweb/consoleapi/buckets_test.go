@@ -6,6 +6,7 @@ package consoleapi_test
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -82,3 +83,76 @@ func Test_AllBucketNames(t *testing.T) {
 		testRequest("?publicID=" + project.PublicID.String())
 	})
 }
+
+func Test_BucketLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Jack-bucket",
+			Email:    "bucketlimits@test.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "bucketlimits")
+		require.NoError(t, err)
+
+		_, err = sat.API.Buckets.Service.CreateBucket(ctx, buckets.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "limited",
+			ProjectID: project.ID,
+		})
+		require.NoError(t, err)
+
+		endpoint := "buckets/limits?projectID=" + project.ID.String() + "&bucket="
+
+		_, status, err := doRequestWithAuth(ctx, t, sat, user, http.MethodPatch, endpoint+"limited", strings.NewReader(`{"storage":1000,"segments":10}`))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, status)
+
+		body, status, err := doRequestWithAuth(ctx, t, sat, user, http.MethodGet, endpoint+"limited", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, status)
+
+		var limits buckets.Limits
+		require.NoError(t, json.Unmarshal(body, &limits))
+		require.NotNil(t, limits.Storage)
+		require.EqualValues(t, 1000, *limits.Storage)
+		require.Nil(t, limits.Bandwidth)
+		require.NotNil(t, limits.Segments)
+		require.EqualValues(t, 10, *limits.Segments)
+
+		_, status, err = doRequestWithAuth(ctx, t, sat, user, http.MethodPatch, endpoint+"limited", strings.NewReader(`{"storage":-1}`))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		_, status, err = doRequestWithAuth(ctx, t, sat, user, http.MethodGet, endpoint+"missing", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, status)
+
+		member, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Jill-bucket",
+			Email:    "bucketlimits-member@test.test",
+		}, 1)
+		require.NoError(t, err)
+
+		_, err = sat.DB.Console().ProjectMembers().Insert(ctx, member.ID, project.ID)
+		require.NoError(t, err)
+
+		_, status, err = doRequestWithAuth(ctx, t, sat, member, http.MethodGet, endpoint+"limited", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, status)
+
+		_, status, err = doRequestWithAuth(ctx, t, sat, member, http.MethodPatch, endpoint+"limited", strings.NewReader(`{"storage":1}`))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, status)
+	})
+}
@@ -0,0 +1,309 @@
+// AUTOGENERATED BY private/apigen
+// DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+)
+
+const dateLayout = "2006-01-02T15:04:05.999Z"
+
+// ProjectManagementClient is a client of the ProjectManagement API endpoints.
+type ProjectManagementClient struct {
+	client *api.Client
+}
+
+// NewProjectManagementClient creates a new client of the ProjectManagement API endpoints.
+func NewProjectManagementClient(client *api.Client) *ProjectManagementClient {
+	return &ProjectManagementClient{client: client}
+}
+
+// GenCreateProject calls the "Create new Project" endpoint.
+//
+// Creates new Project with given info.
+func (c *ProjectManagementClient) GenCreateProject(ctx context.Context, request console.UpsertProjectInfo) (*console.Project, error) {
+	var response console.Project
+	err := c.client.Do(ctx, http.MethodPost, "/api/v0/projects/create", nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenUpdateProject calls the "Update Project" endpoint.
+//
+// Updates project with given info.
+func (c *ProjectManagementClient) GenUpdateProject(ctx context.Context, id uuid.UUID, request console.UpsertProjectInfo) (*console.Project, error) {
+	var response console.Project
+	err := c.client.Do(ctx, http.MethodPatch, "/api/v0/projects/update/"+url.PathEscape(id.String()), nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenDeleteProject calls the "Delete Project" endpoint.
+//
+// Deletes project by id.
+func (c *ProjectManagementClient) GenDeleteProject(ctx context.Context, id uuid.UUID) error {
+	return c.client.Do(ctx, http.MethodDelete, "/api/v0/projects/delete/"+url.PathEscape(id.String()), nil, nil, nil)
+}
+
+// GenGetUsersProjects calls the "Get Projects" endpoint.
+//
+// Gets all projects user has.
+func (c *ProjectManagementClient) GenGetUsersProjects(ctx context.Context) ([]console.Project, error) {
+	var response []console.Project
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/projects/", nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GenGetSingleBucketUsageRollup calls the "Get Project's Single Bucket Usage" endpoint.
+//
+// Gets project's single bucket usage by bucket ID.
+func (c *ProjectManagementClient) GenGetSingleBucketUsageRollup(ctx context.Context, projectID uuid.UUID, bucket string, since time.Time, before time.Time) (*accounting.BucketUsageRollup, error) {
+	query := url.Values{}
+	query.Set("projectID", projectID.String())
+	query.Set("bucket", bucket)
+	query.Set("since", since.UTC().Format(dateLayout))
+	query.Set("before", before.UTC().Format(dateLayout))
+
+	var response accounting.BucketUsageRollup
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/projects/bucket-rollup", query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenGetBucketUsageRollups calls the "Get Project's All Buckets Usage" endpoint.
+//
+// Gets project's all buckets usage.
+func (c *ProjectManagementClient) GenGetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since time.Time, before time.Time) ([]accounting.BucketUsageRollup, error) {
+	query := url.Values{}
+	query.Set("projectID", projectID.String())
+	query.Set("since", since.UTC().Format(dateLayout))
+	query.Set("before", before.UTC().Format(dateLayout))
+
+	var response []accounting.BucketUsageRollup
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/projects/bucket-rollups", query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GenGetUsageExport calls the "Get Project's Daily Bucket Usage" endpoint.
+//
+// Gets a page of the usage and cost of the buckets of a project per day.
+func (c *ProjectManagementClient) GenGetUsageExport(ctx context.Context, projectID uuid.UUID, since time.Time, before time.Time, limit uint, page uint) (*console.UsageExportPage, error) {
+	query := url.Values{}
+	query.Set("projectID", projectID.String())
+	query.Set("since", since.UTC().Format(dateLayout))
+	query.Set("before", before.UTC().Format(dateLayout))
+	query.Set("limit", strconv.FormatUint(uint64(limit), 10))
+	query.Set("page", strconv.FormatUint(uint64(page), 10))
+
+	var response console.UsageExportPage
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/projects/usage-export", query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenGetAPIKeys calls the "Get Project's API Keys" endpoint.
+//
+// Gets API keys by project ID.
+func (c *ProjectManagementClient) GenGetAPIKeys(ctx context.Context, projectID uuid.UUID, search string, limit uint, page uint, order console.APIKeyOrder, orderDirection console.OrderDirection) (*console.APIKeyPage, error) {
+	query := url.Values{}
+	query.Set("search", search)
+	query.Set("limit", strconv.FormatUint(uint64(limit), 10))
+	query.Set("page", strconv.FormatUint(uint64(page), 10))
+	query.Set("order", strconv.FormatUint(uint64(order), 10))
+	query.Set("orderDirection", strconv.FormatUint(uint64(orderDirection), 10))
+
+	var response console.APIKeyPage
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/projects/apikeys/"+url.PathEscape(projectID.String()), query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenGetProjectMembersAndInvitations calls the "Get Project's Members and Invitations" endpoint.
+//
+// Gets the members and invitations of a project, ordered by email.
+func (c *ProjectManagementClient) GenGetProjectMembersAndInvitations(ctx context.Context, projectID uuid.UUID, search string, limit uint, page uint) (*console.ProjectMembersAndInvitationsPage, error) {
+	query := url.Values{}
+	query.Set("search", search)
+	query.Set("limit", strconv.FormatUint(uint64(limit), 10))
+	query.Set("page", strconv.FormatUint(uint64(page), 10))
+
+	var response console.ProjectMembersAndInvitationsPage
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/projects/members/"+url.PathEscape(projectID.String()), query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenInviteProjectMember calls the "Invite Project Member" endpoint.
+//
+// Invites a user by email to a project.
+func (c *ProjectManagementClient) GenInviteProjectMember(ctx context.Context, projectID uuid.UUID, request console.InviteProjectMemberRequest) (*console.ProjectInvitationInfo, error) {
+	var response console.ProjectInvitationInfo
+	err := c.client.Do(ctx, http.MethodPost, "/api/v0/projects/invite/"+url.PathEscape(projectID.String()), nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenDeleteProjectMembersAndInvitations calls the "Delete Project's Members and Invitations" endpoint.
+//
+// Removes the members and invitations with the comma separated emails from a project.
+func (c *ProjectManagementClient) GenDeleteProjectMembersAndInvitations(ctx context.Context, projectID uuid.UUID, emails string) error {
+	query := url.Values{}
+	query.Set("emails", emails)
+
+	return c.client.Do(ctx, http.MethodDelete, "/api/v0/projects/members/"+url.PathEscape(projectID.String()), query, nil, nil)
+}
+
+// GenGetProjectUsageLimits calls the "Get Project's Usage Limits" endpoint.
+//
+// Gets the limits and current usage of a project.
+func (c *ProjectManagementClient) GenGetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (*console.ProjectUsageLimits, error) {
+	var response console.ProjectUsageLimits
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/projects/usage-limits/"+url.PathEscape(projectID.String()), nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenGetUsageReport calls the "Get Project's Usage Report" endpoint.
+//
+// Gets the usage of every bucket of a project for a given period.
+func (c *ProjectManagementClient) GenGetUsageReport(ctx context.Context, projectID uuid.UUID, since time.Time, before time.Time) ([]accounting.ProjectReportItem, error) {
+	query := url.Values{}
+	query.Set("since", since.UTC().Format(dateLayout))
+	query.Set("before", before.UTC().Format(dateLayout))
+
+	var response []accounting.ProjectReportItem
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/projects/usage-report/"+url.PathEscape(projectID.String()), query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GenGetBuckets calls the "Get Project's Buckets" endpoint.
+//
+// Gets all the buckets of a project.
+func (c *ProjectManagementClient) GenGetBuckets(ctx context.Context, projectID uuid.UUID) ([]console.BucketInfo, error) {
+	var response []console.BucketInfo
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/projects/buckets/"+url.PathEscape(projectID.String()), nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GenCreateBucket calls the "Create Bucket" endpoint.
+//
+// Creates a bucket in a project with the project's default placement.
+func (c *ProjectManagementClient) GenCreateBucket(ctx context.Context, projectID uuid.UUID, request console.CreateBucketRequest) (*console.BucketInfo, error) {
+	var response console.BucketInfo
+	err := c.client.Do(ctx, http.MethodPost, "/api/v0/projects/buckets/"+url.PathEscape(projectID.String()), nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenDeleteBucket calls the "Delete Bucket" endpoint.
+//
+// Deletes an empty bucket of a project.
+func (c *ProjectManagementClient) GenDeleteBucket(ctx context.Context, projectID uuid.UUID, name string) error {
+	return c.client.Do(ctx, http.MethodDelete, "/api/v0/projects/buckets/"+url.PathEscape(projectID.String())+"/"+url.PathEscape(name), nil, nil, nil)
+}
+
+// APIKeyManagementClient is a client of the APIKeyManagement API endpoints.
+type APIKeyManagementClient struct {
+	client *api.Client
+}
+
+// NewAPIKeyManagementClient creates a new client of the APIKeyManagement API endpoints.
+func NewAPIKeyManagementClient(client *api.Client) *APIKeyManagementClient {
+	return &APIKeyManagementClient{client: client}
+}
+
+// GenCreateAPIKey calls the "Create new macaroon API key" endpoint.
+//
+// Creates new macaroon API key with given info.
+func (c *APIKeyManagementClient) GenCreateAPIKey(ctx context.Context, request console.CreateAPIKeyRequest) (*console.CreateAPIKeyResponse, error) {
+	var response console.CreateAPIKeyResponse
+	err := c.client.Do(ctx, http.MethodPost, "/api/v0/apikeys/create", nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GenDeleteAPIKey calls the "Delete API Key" endpoint.
+//
+// Deletes macaroon API key by id.
+func (c *APIKeyManagementClient) GenDeleteAPIKey(ctx context.Context, id uuid.UUID) error {
+	return c.client.Do(ctx, http.MethodDelete, "/api/v0/apikeys/delete/"+url.PathEscape(id.String()), nil, nil, nil)
+}
+
+// UserManagementClient is a client of the UserManagement API endpoints.
+type UserManagementClient struct {
+	client *api.Client
+}
+
+// NewUserManagementClient creates a new client of the UserManagement API endpoints.
+func NewUserManagementClient(client *api.Client) *UserManagementClient {
+	return &UserManagementClient{client: client}
+}
+
+// GenGetUser calls the "Get User" endpoint.
+//
+// Gets User by request context.
+func (c *UserManagementClient) GenGetUser(ctx context.Context) (*console.ResponseUser, error) {
+	var response console.ResponseUser
+	err := c.client.Do(ctx, http.MethodGet, "/api/v0/users/", nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
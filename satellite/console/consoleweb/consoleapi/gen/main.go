@@ -102,6 +102,21 @@ func main() {
 			},
 		})
 
+		g.Get("/usage-export", &apigen.Endpoint{
+			Name:           "Get Project's Daily Bucket Usage",
+			Description:    "Gets a page of the usage and cost of the buckets of a project per day",
+			GoName:         "GenGetUsageExport",
+			TypeScriptName: "getUsageExport",
+			Response:       console.UsageExportPage{},
+			QueryParams: []apigen.Param{
+				apigen.NewParam("projectID", uuid.UUID{}),
+				apigen.NewParam("since", time.Time{}),
+				apigen.NewParam("before", time.Time{}),
+				apigen.NewParam("limit", uint(0)),
+				apigen.NewParam("page", uint(0)),
+			},
+		})
+
 		g.Get("/apikeys/{projectID}", &apigen.Endpoint{
 			Name:           "Get Project's API Keys",
 			Description:    "Gets API keys by project ID",
@@ -119,6 +134,107 @@ func main() {
 				apigen.NewParam("orderDirection", console.OrderDirection(0)),
 			},
 		})
+
+		g.Get("/members/{projectID}", &apigen.Endpoint{
+			Name:           "Get Project's Members and Invitations",
+			Description:    "Gets the members and invitations of a project, ordered by email",
+			GoName:         "GenGetProjectMembersAndInvitations",
+			TypeScriptName: "getMembersAndInvitations",
+			Response:       console.ProjectMembersAndInvitationsPage{},
+			PathParams: []apigen.Param{
+				apigen.NewParam("projectID", uuid.UUID{}),
+			},
+			QueryParams: []apigen.Param{
+				apigen.NewParam("search", ""),
+				apigen.NewParam("limit", uint(0)),
+				apigen.NewParam("page", uint(0)),
+			},
+		})
+
+		g.Post("/invite/{projectID}", &apigen.Endpoint{
+			Name:           "Invite Project Member",
+			Description:    "Invites a user by email to a project",
+			GoName:         "GenInviteProjectMember",
+			TypeScriptName: "inviteMember",
+			Response:       console.ProjectInvitationInfo{},
+			Request:        console.InviteProjectMemberRequest{},
+			PathParams: []apigen.Param{
+				apigen.NewParam("projectID", uuid.UUID{}),
+			},
+		})
+
+		g.Delete("/members/{projectID}", &apigen.Endpoint{
+			Name:           "Delete Project's Members and Invitations",
+			Description:    "Removes the members and invitations with the comma separated emails from a project",
+			GoName:         "GenDeleteProjectMembersAndInvitations",
+			TypeScriptName: "deleteMembersAndInvitations",
+			PathParams: []apigen.Param{
+				apigen.NewParam("projectID", uuid.UUID{}),
+			},
+			QueryParams: []apigen.Param{
+				apigen.NewParam("emails", ""),
+			},
+		})
+
+		g.Get("/usage-limits/{projectID}", &apigen.Endpoint{
+			Name:           "Get Project's Usage Limits",
+			Description:    "Gets the limits and current usage of a project",
+			GoName:         "GenGetProjectUsageLimits",
+			TypeScriptName: "getUsageLimits",
+			Response:       console.ProjectUsageLimits{},
+			PathParams: []apigen.Param{
+				apigen.NewParam("projectID", uuid.UUID{}),
+			},
+		})
+
+		g.Get("/usage-report/{projectID}", &apigen.Endpoint{
+			Name:           "Get Project's Usage Report",
+			Description:    "Gets the usage of every bucket of a project for a given period",
+			GoName:         "GenGetUsageReport",
+			TypeScriptName: "getUsageReport",
+			Response:       []accounting.ProjectReportItem{},
+			PathParams: []apigen.Param{
+				apigen.NewParam("projectID", uuid.UUID{}),
+			},
+			QueryParams: []apigen.Param{
+				apigen.NewParam("since", time.Time{}),
+				apigen.NewParam("before", time.Time{}),
+			},
+		})
+
+		g.Get("/buckets/{projectID}", &apigen.Endpoint{
+			Name:           "Get Project's Buckets",
+			Description:    "Gets all the buckets of a project",
+			GoName:         "GenGetBuckets",
+			TypeScriptName: "getBuckets",
+			Response:       []console.BucketInfo{},
+			PathParams: []apigen.Param{
+				apigen.NewParam("projectID", uuid.UUID{}),
+			},
+		})
+
+		g.Post("/buckets/{projectID}", &apigen.Endpoint{
+			Name:           "Create Bucket",
+			Description:    "Creates a bucket in a project with the project's default placement",
+			GoName:         "GenCreateBucket",
+			TypeScriptName: "createBucket",
+			Response:       console.BucketInfo{},
+			Request:        console.CreateBucketRequest{},
+			PathParams: []apigen.Param{
+				apigen.NewParam("projectID", uuid.UUID{}),
+			},
+		})
+
+		g.Delete("/buckets/{projectID}/{name}", &apigen.Endpoint{
+			Name:           "Delete Bucket",
+			Description:    "Deletes an empty bucket of a project",
+			GoName:         "GenDeleteBucket",
+			TypeScriptName: "deleteBucket",
+			PathParams: []apigen.Param{
+				apigen.NewParam("projectID", uuid.UUID{}),
+				apigen.NewParam("name", ""),
+			},
+		})
 	}
 
 	{
@@ -160,8 +276,10 @@ func main() {
 
 	modroot := findModuleRootDir()
 	a.MustWriteGo(filepath.Join(modroot, "satellite", "console", "consoleweb", "consoleapi", "api.gen.go"))
+	a.MustWriteGoClient(filepath.Join(modroot, "satellite", "console", "consoleweb", "consoleapi", "client", "client.gen.go"), "client")
 	a.MustWriteTS(filepath.Join(modroot, "web", "satellite", "src", "api", a.Version+".gen.ts"))
 	a.MustWriteDocs(filepath.Join(modroot, "satellite", "console", "consoleweb", "consoleapi", "apidocs.gen.md"))
+	a.MustWriteOpenAPI(filepath.Join(modroot, "satellite", "console", "consoleweb", "consoleapi", "openapi.gen.json"))
 }
 
 func findModuleRootDir() string {
@@ -216,7 +334,37 @@ func (a AuthMiddleware) Generate(api *apigen.API, group *apigen.EndpointGroup, e
 	}`, !nocookie, !noapikey)
 }
 
-var _ apigen.Middleware = AuthMiddleware{}
+// SecuritySchemes satisfies the apigen.SecurityMiddleware.
+func (a AuthMiddleware) SecuritySchemes() map[string]apigen.SecurityScheme {
+	return map[string]apigen.SecurityScheme{
+		"apiKey": {
+			Type:        "http",
+			Scheme:      "bearer",
+			Description: "API key sent through the Authorization header as 'Bearer <key>'",
+		},
+		"cookie": {
+			Type:        "apiKey",
+			In:          "cookie",
+			Name:        "_tokenKey",
+			Description: "Session token",
+		},
+	}
+}
+
+// Security satisfies the apigen.SecurityMiddleware.
+func (a AuthMiddleware) Security(api *apigen.API, group *apigen.EndpointGroup, ep *apigen.FullEndpoint) []string {
+	var schemes []string
+	if !apigen.LoadSetting(NoAPIKey, ep, false) {
+		schemes = append(schemes, "apiKey")
+	}
+	if !apigen.LoadSetting(NoCookie, ep, false) {
+		schemes = append(schemes, "cookie")
+	}
+
+	return schemes
+}
+
+var _ apigen.SecurityMiddleware = AuthMiddleware{}
 
 type (
 	tagNoAPIKey struct{}
@@ -16,15 +16,18 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
+	"storj.io/storj/satellite/console/consoleweb/consoleapi/client"
 )
 
 func createTestMembers(ctx context.Context, t *testing.T, db console.DB, p uuid.UUID, owner *uuid.UUID) (_ map[uuid.UUID]console.User, _ map[string]console.User) {
@@ -468,3 +471,245 @@ func TestEdgeURLOverrides(t *testing.T) {
 		}
 	})
 }
+
+func TestGeneratedAPIClient(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.GeneratedAPIEnabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "test@mail.test",
+		}, 2)
+		require.NoError(t, err)
+
+		apiKey, _, err := sat.API.REST.Keys.Create(ctx, user.ID, time.Hour)
+		require.NoError(t, err)
+
+		apiClient := &api.Client{
+			BaseURL: sat.ConsoleURL(),
+			Auth:    api.BearerAuth(apiKey),
+		}
+
+		users := client.NewUserManagementClient(apiClient)
+		projects := client.NewProjectManagementClient(apiClient)
+
+		responseUser, err := users.GenGetUser(ctx)
+		require.NoError(t, err)
+		require.Equal(t, user.ID, responseUser.ID)
+		require.Equal(t, user.Email, responseUser.Email)
+
+		created, err := projects.GenCreateProject(ctx, console.UpsertProjectInfo{
+			Name:        "generated client",
+			Description: "created through the generated client",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "generated client", created.Name)
+		require.Equal(t, user.ID, created.OwnerID)
+
+		stored, err := sat.DB.Console().Projects().Get(ctx, created.ID)
+		require.NoError(t, err)
+		require.Equal(t, stored.PublicID, created.PublicID)
+		require.Equal(t, stored.Description, created.Description)
+
+		list, err := projects.GenGetUsersProjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, created.ID, list[0].ID)
+
+		// errors of the server are returned as api.HTTPError.
+		unauthorized := client.NewUserManagementClient(&api.Client{
+			BaseURL: sat.ConsoleURL(),
+			Auth:    api.BearerAuth("invalid"),
+		})
+		_, err = unauthorized.GenGetUser(ctx)
+		var httpErr api.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		require.Equal(t, http.StatusUnauthorized, httpErr.Status)
+	})
+}
+
+func TestGeneratedAPIProjectResources(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.GeneratedAPIEnabled = true
+				config.Console.FreeTierInvitesEnabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "test@mail.test",
+		}, 2)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "generated resources")
+		require.NoError(t, err)
+
+		apiKey, _, err := sat.API.REST.Keys.Create(ctx, user.ID, time.Hour)
+		require.NoError(t, err)
+
+		projects := client.NewProjectManagementClient(&api.Client{
+			BaseURL: sat.ConsoleURL(),
+			Auth:    api.BearerAuth(apiKey),
+		})
+
+		requireStatus := func(t *testing.T, err error, status int) {
+			var httpErr api.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			require.Equal(t, status, httpErr.Status)
+		}
+
+		t.Run("buckets", func(t *testing.T) {
+			bucket, err := projects.GenCreateBucket(ctx, project.PublicID, console.CreateBucketRequest{
+				Name: "generated-bucket",
+			})
+			require.NoError(t, err)
+			require.Equal(t, "generated-bucket", bucket.Name)
+			require.Equal(t, project.DefaultPlacement, bucket.Placement)
+
+			_, err = projects.GenCreateBucket(ctx, project.PublicID, console.CreateBucketRequest{
+				Name: "generated-bucket",
+			})
+			requireStatus(t, err, http.StatusConflict)
+
+			_, err = projects.GenCreateBucket(ctx, project.PublicID, console.CreateBucketRequest{
+				Name: "Invalid_Name",
+			})
+			requireStatus(t, err, http.StatusBadRequest)
+
+			placement := project.DefaultPlacement + 1
+			_, err = projects.GenCreateBucket(ctx, project.PublicID, console.CreateBucketRequest{
+				Name:      "other-placement",
+				Placement: &placement,
+			})
+			requireStatus(t, err, http.StatusBadRequest)
+
+			list, err := projects.GenGetBuckets(ctx, project.PublicID)
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+			require.Equal(t, "generated-bucket", list[0].Name)
+
+			limits, err := projects.GenGetProjectUsageLimits(ctx, project.PublicID)
+			require.NoError(t, err)
+			require.EqualValues(t, 1, limits.BucketsUsed)
+
+			require.NoError(t, projects.GenDeleteBucket(ctx, project.PublicID, "generated-bucket"))
+			requireStatus(t, projects.GenDeleteBucket(ctx, project.PublicID, "generated-bucket"), http.StatusNotFound)
+
+			list, err = projects.GenGetBuckets(ctx, project.PublicID)
+			require.NoError(t, err)
+			require.Empty(t, list)
+		})
+
+		t.Run("members and invitations", func(t *testing.T) {
+			invite, err := projects.GenInviteProjectMember(ctx, project.PublicID, console.InviteProjectMemberRequest{
+				Email: "invited@mail.test",
+			})
+			require.NoError(t, err)
+			require.Equal(t, "invited@mail.test", invite.Email)
+			require.False(t, invite.Expired)
+
+			_, err = projects.GenInviteProjectMember(ctx, project.PublicID, console.InviteProjectMemberRequest{
+				Email: "invited@mail.test",
+			})
+			requireStatus(t, err, http.StatusConflict)
+
+			page, err := projects.GenGetProjectMembersAndInvitations(ctx, project.PublicID, "", 10, 1)
+			require.NoError(t, err)
+			require.Len(t, page.Members, 1)
+			require.Equal(t, user.ID, page.Members[0].ID)
+			require.Equal(t, user.Email, page.Members[0].Email)
+			require.Equal(t, user.FullName, page.Members[0].FullName)
+			require.Len(t, page.Invitations, 1)
+			require.Equal(t, "invited@mail.test", page.Invitations[0].Email)
+
+			_, err = projects.GenGetProjectMembersAndInvitations(ctx, project.PublicID, "", 0, 1)
+			requireStatus(t, err, http.StatusBadRequest)
+
+			// the page size is capped.
+			page, err = projects.GenGetProjectMembersAndInvitations(ctx, project.PublicID, "", 1000, 1)
+			require.NoError(t, err)
+			require.EqualValues(t, 50, page.Limit)
+
+			require.NoError(t, projects.GenDeleteProjectMembersAndInvitations(ctx, project.PublicID, "invited@mail.test"))
+			requireStatus(t, projects.GenDeleteProjectMembersAndInvitations(ctx, project.PublicID, user.Email), http.StatusBadRequest)
+
+			page, err = projects.GenGetProjectMembersAndInvitations(ctx, project.PublicID, "", 10, 1)
+			require.NoError(t, err)
+			require.Len(t, page.Members, 1)
+			require.Empty(t, page.Invitations)
+		})
+
+		t.Run("usage report", func(t *testing.T) {
+			now := time.Now()
+			report, err := projects.GenGetUsageReport(ctx, project.PublicID, now.Add(-time.Hour), now)
+			require.NoError(t, err)
+			require.Empty(t, report)
+		})
+
+		t.Run("API key restrictions", func(t *testing.T) {
+			apiKeys := client.NewAPIKeyManagementClient(&api.Client{
+				BaseURL: sat.ConsoleURL(),
+				Auth:    api.BearerAuth(apiKey),
+			})
+
+			notAfter := time.Now().Add(time.Hour)
+			created, err := apiKeys.GenCreateAPIKey(ctx, console.CreateAPIKeyRequest{
+				ProjectID: project.PublicID.String(),
+				Name:      "restricted",
+				Restrictions: &console.APIKeyRestrictions{
+					DisallowDeletes: true,
+					Buckets:         []string{"allowed-bucket"},
+					NotAfter:        &notAfter,
+				},
+			})
+			require.NoError(t, err)
+
+			key, err := macaroon.ParseAPIKey(created.Key)
+			require.NoError(t, err)
+
+			info, err := sat.DB.Console().APIKeys().GetByHead(ctx, key.Head())
+			require.NoError(t, err)
+			require.Equal(t, created.KeyInfo.ID, info.ID)
+
+			allowed, err := key.GetAllowedBuckets(ctx, macaroon.Action{Op: macaroon.ActionRead, Time: time.Now()})
+			require.NoError(t, err)
+			require.False(t, allowed.All)
+			require.Contains(t, allowed.Buckets, "allowed-bucket")
+
+			err = key.Check(ctx, info.Secret, macaroon.Action{
+				Op:     macaroon.ActionRead,
+				Bucket: []byte("allowed-bucket"),
+				Time:   time.Now(),
+			}, nil)
+			require.NoError(t, err)
+
+			err = key.Check(ctx, info.Secret, macaroon.Action{
+				Op:     macaroon.ActionDelete,
+				Bucket: []byte("allowed-bucket"),
+				Time:   time.Now(),
+			}, nil)
+			require.True(t, macaroon.ErrUnauthorized.Has(err))
+
+			_, err = apiKeys.GenCreateAPIKey(ctx, console.CreateAPIKeyRequest{
+				ProjectID: project.PublicID.String(),
+				Name:      "invalid restrictions",
+				Restrictions: &console.APIKeyRestrictions{
+					Buckets: []string{"Invalid_Name"},
+				},
+			})
+			requireStatus(t, err, http.StatusBadRequest)
+		})
+	})
+}
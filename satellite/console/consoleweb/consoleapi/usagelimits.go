@@ -225,6 +225,186 @@ func (ul *UsageLimits) DailyUsage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UsageExport streams the daily usage and cost of every bucket of a project as CSV or newline delimited JSON.
+func (ul *UsageLimits) UsageExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var ok bool
+	var idParam string
+
+	if idParam, ok = mux.Vars(r)["id"]; !ok {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("before"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	since := time.Unix(sinceStamp, 0).UTC()
+	before := time.Unix(beforeStamp, 0).UTC()
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = usageExportCSV
+	}
+	var export usageExportWriter
+	switch format {
+	case usageExportCSV:
+		export = &usageExportCSVWriter{w: csv.NewWriter(w)}
+	case usageExportNDJSON:
+		export = &usageExportNDJSONWriter{enc: json.NewEncoder(w)}
+	default:
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("unknown format %q", format))
+		return
+	}
+
+	dateFormat := "2006-01-02"
+	fileName := "storj-usage-" + idParam + "-" + since.Format(dateFormat) + "-to-" + before.Format(dateFormat) + "." + format
+
+	// the response is only started with the first item, so that errors
+	// which occur before can still be served as JSON.
+	started := false
+	start := func() error {
+		if started {
+			return nil
+		}
+		started = true
+
+		w.Header().Set("Content-Type", usageExportContentTypes[format])
+		w.Header().Set("Content-Disposition", "attachment;filename="+fileName)
+		w.WriteHeader(http.StatusOK)
+		return export.Begin()
+	}
+
+	flusher, _ := w.(http.Flusher)
+	err = ul.service.ExportProjectUsage(ctx, projectID, since, before, func(ctx context.Context, item console.UsageExportItem) error {
+		if err := start(); err != nil {
+			return err
+		}
+		if err := export.Write(item); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = start()
+	}
+	if err == nil {
+		err = export.End()
+	}
+	if err != nil {
+		if started {
+			// the status has already been sent, so the export can only be cut short.
+			ul.log.Error("error writing usage export", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+			return
+		}
+
+		switch {
+		case console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err):
+			ul.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+		case console.ErrInvalidUsageExportPeriod.Has(err):
+			ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		default:
+			ul.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		}
+	}
+}
+
+const (
+	usageExportCSV    = "csv"
+	usageExportNDJSON = "ndjson"
+)
+
+var usageExportContentTypes = map[string]string{
+	usageExportCSV:    "text/csv",
+	usageExportNDJSON: "application/x-ndjson",
+}
+
+// usageExportWriter writes usage export items in a specific format.
+type usageExportWriter interface {
+	Begin() error
+	Write(item console.UsageExportItem) error
+	End() error
+}
+
+type usageExportCSVWriter struct {
+	w *csv.Writer
+}
+
+func (export *usageExportCSVWriter) Begin() error {
+	return export.w.Write([]string{
+		"project_id", "project_name", "bucket_name", "day",
+		"storage_byte_hours", "segment_hours", "object_hours",
+		"get_egress_bytes", "repair_egress_bytes", "audit_egress_bytes",
+		"storage_cents", "egress_cents", "segment_cents", "total_cents",
+	})
+}
+
+func (export *usageExportCSVWriter) Write(item console.UsageExportItem) error {
+	formatFloat := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	err := export.w.Write([]string{
+		item.ProjectID.String(),
+		item.ProjectName,
+		item.BucketName,
+		item.Day.Format("2006-01-02"),
+		formatFloat(item.StorageByteHours),
+		formatFloat(item.SegmentHours),
+		formatFloat(item.ObjectHours),
+		strconv.FormatInt(item.GetEgress, 10),
+		strconv.FormatInt(item.RepairEgress, 10),
+		strconv.FormatInt(item.AuditEgress, 10),
+		formatFloat(item.StorageCents),
+		formatFloat(item.EgressCents),
+		formatFloat(item.SegmentCents),
+		formatFloat(item.TotalCents),
+	})
+	if err != nil {
+		return err
+	}
+	// flush every row, so that it reaches the client right away.
+	export.w.Flush()
+	return export.w.Error()
+}
+
+func (export *usageExportCSVWriter) End() error {
+	export.w.Flush()
+	return export.w.Error()
+}
+
+type usageExportNDJSONWriter struct {
+	enc *json.Encoder
+}
+
+func (export *usageExportNDJSONWriter) Begin() error { return nil }
+
+// Write writes the item as single line, since json.Encoder terminates every value with a newline.
+func (export *usageExportNDJSONWriter) Write(item console.UsageExportItem) error {
+	return export.enc.Encode(item)
+}
+
+func (export *usageExportNDJSONWriter) End() error { return nil }
+
 // serveJSONError writes JSON error to response output stream.
 func (ul *UsageLimits) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(ctx, ul.log, w, status, err)
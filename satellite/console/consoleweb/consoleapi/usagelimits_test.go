@@ -17,6 +17,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/memory"
+	"storj.io/common/pb"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
@@ -280,3 +281,74 @@ func Test_TotalUsageReport(t *testing.T) {
 		}
 	})
 }
+
+func Test_UsageExport(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satelliteSys := planet.Satellites[0]
+		now := time.Now().UTC()
+		since := now.Add(-time.Hour)
+		before := now.Add(time.Hour)
+
+		user, err := satelliteSys.AddUser(ctx, console.CreateUser{
+			FullName: "Usage Export Test",
+			Email:    "ue@test.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := satelliteSys.AddProject(ctx, user.ID, "testProject")
+		require.NoError(t, err)
+
+		const bucketName = "bucket"
+		egress := 10 * memory.MB.Int64()
+		err = satelliteSys.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte(bucketName), pb.PieceAction_GET, egress, 0, now)
+		require.NoError(t, err)
+
+		endpoint := fmt.Sprintf("projects/%s/usage-export?since=%d&before=%d", project.PublicID, since.Unix(), before.Unix())
+
+		body, status, err := doRequestWithAuth(ctx, t, satelliteSys, user, http.MethodGet, endpoint, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, status)
+
+		records, err := csv.NewReader(strings.NewReader(string(body))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		require.Equal(t, "project_id", records[0][0])
+		require.Equal(t, project.PublicID.String(), records[1][0])
+		require.Equal(t, project.Name, records[1][1])
+		require.Equal(t, bucketName, records[1][2])
+		require.Equal(t, now.Format("2006-01-02"), records[1][3])
+		require.Equal(t, strconv.FormatInt(egress, 10), records[1][7])
+
+		body, status, err = doRequestWithAuth(ctx, t, satelliteSys, user, http.MethodGet, endpoint+"&format=ndjson", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, status)
+
+		lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+		require.Len(t, lines, 1)
+
+		var item console.UsageExportItem
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &item))
+		require.Equal(t, project.PublicID, item.ProjectID)
+		require.Equal(t, bucketName, item.BucketName)
+		require.Equal(t, egress, item.GetEgress)
+		require.Positive(t, item.EgressCents)
+		require.Equal(t, item.StorageCents+item.EgressCents+item.SegmentCents, item.TotalCents)
+
+		_, status, err = doRequestWithAuth(ctx, t, satelliteSys, user, http.MethodGet, endpoint+"&format=xml", nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		endpoint = fmt.Sprintf("projects/%s/usage-export?since=%d&before=%d", project.PublicID, before.Unix(), since.Unix())
+		_, status, err = doRequestWithAuth(ctx, t, satelliteSys, user, http.MethodGet, endpoint, nil)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, status)
+	})
+}
@@ -57,12 +57,12 @@ func TestGraphqlMutation(t *testing.T) {
 		require.NoError(t, err)
 		defer ctx.Check(redis.Close)
 
-		cache, err := live.OpenCache(ctx, log.Named("cache"), live.Config{StorageBackend: "redis://" + redis.Addr() + "?db=0"})
+		cache, err := live.OpenCache(ctx, log.Named("cache"), live.Config{StorageBackend: "redis://" + redis.Addr() + "?db=0"}, nil)
 		require.NoError(t, err)
 
 		projectLimitCache := accounting.NewProjectLimitCache(db.ProjectAccounting(), 0, 0, 0, accounting.ProjectLimitConfig{CacheCapacity: 100})
 
-		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, *sat.Metabase.DB, 5*time.Minute, -10*time.Second)
+		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, nil, *sat.Metabase.DB, 5*time.Minute, -10*time.Second)
 
 		// TODO maybe switch this test to testplanet to avoid defining config and Stripe service
 		pc := paymentsconfig.Config{
@@ -79,6 +79,9 @@ func TestGraphqlMutation(t *testing.T) {
 		priceOverrides, err := pc.UsagePriceOverrides.ToModels()
 		require.NoError(t, err)
 
+		placementPriceOverrides, err := pc.PlacementPriceOverrides.ToModels()
+		require.NoError(t, err)
+
 		paymentsService, err := stripe.NewService(
 			log.Named("payments.stripe:service"),
 			stripe.NewStripeMock(
@@ -94,6 +97,7 @@ func TestGraphqlMutation(t *testing.T) {
 			db.ProjectAccounting(),
 			prices,
 			priceOverrides,
+			placementPriceOverrides,
 			pc.PackagePlans.Packages,
 			pc.BonusRate,
 			nil,
@@ -58,6 +58,43 @@ type ProjectMembersPage struct {
 	TotalCount     uint64
 }
 
+// ProjectMemberInfo holds the information of a project member exposed through the API.
+type ProjectMemberInfo struct {
+	ID        uuid.UUID `json:"id"`
+	FullName  string    `json:"fullName"`
+	ShortName string    `json:"shortName"`
+	Email     string    `json:"email"`
+	JoinedAt  time.Time `json:"joinedAt"`
+}
+
+// ProjectInvitationInfo holds the information of a project invitation exposed through the API.
+type ProjectInvitationInfo struct {
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt"`
+	Expired   bool      `json:"expired"`
+}
+
+// ProjectMembersAndInvitationsPage represents a page of project members and invitations
+// exposed through the API.
+type ProjectMembersAndInvitationsPage struct {
+	Members     []ProjectMemberInfo     `json:"members"`
+	Invitations []ProjectInvitationInfo `json:"invitations"`
+
+	Search         string             `json:"search"`
+	Limit          uint               `json:"limit"`
+	Order          ProjectMemberOrder `json:"order"`
+	OrderDirection OrderDirection     `json:"orderDirection"`
+	Offset         uint64             `json:"offset"`
+	PageCount      uint               `json:"pageCount"`
+	CurrentPage    uint               `json:"currentPage"`
+	TotalCount     uint64             `json:"totalCount"`
+}
+
+// InviteProjectMemberRequest holds the information for inviting a user to a project.
+type InviteProjectMemberRequest struct {
+	Email string `json:"email"`
+}
+
 // ProjectMemberOrder is used for querying project members in specified order.
 type ProjectMemberOrder int8
 
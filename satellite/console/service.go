@@ -84,6 +84,9 @@ const (
 	projInviteDoesntExistErrMsg          = "An invitation for '%s' does not exist"
 	newInviteLimitErrMsg                 = "Only one new invitation can be sent at a time"
 	paidTierInviteErrMsg                 = "Only paid tier users can invite project members"
+	bucketLimitNegativeErrMsg            = "Bucket limits can not be negative"
+	bucketPlacementErrMsg                = "Buckets can only be created with the default placement of the project"
+	bucketLimitExceededErrMsg            = "The project has reached its limit of %d buckets"
 )
 
 var (
@@ -162,6 +165,9 @@ var (
 
 	// ErrNotPaidTier occurs when a user must be paid tier in order to complete an operation.
 	ErrNotPaidTier = errs.Class("user is not paid tier")
+
+	// ErrInvalidUsageExportPeriod occurs when the requested period of a usage export is empty or too long.
+	ErrInvalidUsageExportPeriod = errs.Class("invalid usage export period")
 )
 
 // Service is handling accounts related logic.
@@ -173,7 +179,7 @@ type Service struct {
 	restKeys                   RESTKeys
 	projectAccounting          accounting.ProjectAccounting
 	projectUsage               *accounting.Service
-	buckets                    buckets.DB
+	buckets                    *buckets.Service
 	accounts                   payments.Accounts
 	depositWallets             payments.DepositWallets
 	billing                    billing.TransactionsDB
@@ -210,7 +216,7 @@ type Payments struct {
 }
 
 // NewService returns new instance of Service.
-func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets buckets.DB, accounts payments.Accounts, depositWallets payments.DepositWallets, billing billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service, satelliteAddress string, satelliteName string, maxProjectBuckets int, config Config) (*Service, error) {
+func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets *buckets.Service, accounts payments.Accounts, depositWallets payments.DepositWallets, billing billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service, satelliteAddress string, satelliteName string, maxProjectBuckets int, config Config) (*Service, error) {
 	if store == nil {
 		return nil, errs.New("store can't be nil")
 	}
@@ -2291,7 +2297,7 @@ func (s *Service) GetProjectMembersAndInvitations(ctx context.Context, projectID
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2300,7 +2306,7 @@ func (s *Service) GetProjectMembersAndInvitations(ctx context.Context, projectID
 		cursor.Limit = maxLimit
 	}
 
-	pmp, err = s.store.ProjectMembers().GetPagedWithInvitationsByProjectID(ctx, projectID, cursor)
+	pmp, err = s.store.ProjectMembers().GetPagedWithInvitationsByProjectID(ctx, isMember.project.ID, cursor)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2308,6 +2314,164 @@ func (s *Service) GetProjectMembersAndInvitations(ctx context.Context, projectID
 	return
 }
 
+// GenGetProjectMembersAndInvitations returns the project members and invitations for a given project,
+// ordered by email, for generated api.
+func (s *Service) GenGetProjectMembersAndInvitations(ctx context.Context, projectID uuid.UUID, search string, limit, page uint) (_ *ProjectMembersAndInvitationsPage, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if limit == 0 || page == 0 {
+		return nil, api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    ErrValidation.New("limit and page must be greater than 0"),
+		}
+	}
+
+	pmp, err := s.GetProjectMembersAndInvitations(ctx, projectID, ProjectMembersCursor{
+		Search:         search,
+		Limit:          limit,
+		Page:           page,
+		Order:          Email,
+		OrderDirection: Ascending,
+	})
+	if err != nil {
+		status := http.StatusInternalServerError
+		if ErrUnauthorized.Has(err) || ErrNoMembership.Has(err) {
+			status = http.StatusUnauthorized
+		}
+
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	result := &ProjectMembersAndInvitationsPage{
+		Members:        []ProjectMemberInfo{},
+		Invitations:    []ProjectInvitationInfo{},
+		Search:         pmp.Search,
+		Limit:          pmp.Limit,
+		Order:          pmp.Order,
+		OrderDirection: pmp.OrderDirection,
+		Offset:         pmp.Offset,
+		PageCount:      pmp.PageCount,
+		CurrentPage:    pmp.CurrentPage,
+		TotalCount:     pmp.TotalCount,
+	}
+
+	memberIDs := make([]uuid.UUID, 0, len(pmp.ProjectMembers))
+	for _, m := range pmp.ProjectMembers {
+		memberIDs = append(memberIDs, m.MemberID)
+	}
+
+	members, err := s.store.Users().GetNamesByIDs(ctx, memberIDs)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	membersByID := make(map[uuid.UUID]*User, len(members))
+	for _, member := range members {
+		membersByID[member.ID] = member
+	}
+
+	for _, m := range pmp.ProjectMembers {
+		// the member may have been deleted since the page was loaded.
+		member, ok := membersByID[m.MemberID]
+		if !ok {
+			continue
+		}
+
+		result.Members = append(result.Members, ProjectMemberInfo{
+			ID:        member.ID,
+			FullName:  member.FullName,
+			ShortName: member.ShortName,
+			Email:     member.Email,
+			JoinedAt:  m.CreatedAt,
+		})
+	}
+
+	for i, invite := range pmp.ProjectInvitations {
+		result.Invitations = append(result.Invitations, ProjectInvitationInfo{
+			Email:     invite.Email,
+			CreatedAt: invite.CreatedAt,
+			Expired:   s.IsProjectInvitationExpired(&pmp.ProjectInvitations[i]),
+		})
+	}
+
+	return result, httpError
+}
+
+// GenInviteProjectMember invites a user by email to the project for generated api.
+func (s *Service) GenInviteProjectMember(ctx context.Context, projectID uuid.UUID, request InviteProjectMemberRequest) (_ *ProjectInvitationInfo, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	invite, err := s.InviteNewProjectMember(ctx, projectID, request.Email)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case ErrUnauthorized.Has(err), ErrNoMembership.Has(err):
+			status = http.StatusUnauthorized
+		case ErrNotPaidTier.Has(err):
+			status = http.StatusForbidden
+		case ErrAlreadyInvited.Has(err), ErrAlreadyMember.Has(err):
+			status = http.StatusConflict
+		}
+
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	return &ProjectInvitationInfo{
+		Email:     invite.Email,
+		CreatedAt: invite.CreatedAt,
+		Expired:   s.IsProjectInvitationExpired(invite),
+	}, httpError
+}
+
+// GenDeleteProjectMembersAndInvitations removes users and invitations by comma separated emails
+// from the project for generated api.
+func (s *Service) GenDeleteProjectMembersAndInvitations(ctx context.Context, projectID uuid.UUID, emails string) (httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var emailList []string
+	for _, email := range strings.Split(emails, ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			emailList = append(emailList, email)
+		}
+	}
+	if len(emailList) == 0 {
+		return api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    ErrValidation.New("emails cannot be empty"),
+		}
+	}
+
+	err = s.DeleteProjectMembersAndInvitations(ctx, projectID, emailList)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case ErrUnauthorized.Has(err), ErrNoMembership.Has(err):
+			status = http.StatusUnauthorized
+		case ErrValidation.Has(err):
+			status = http.StatusBadRequest
+		}
+
+		return api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	return httpError
+}
+
 // CreateAPIKey creates new api key.
 // projectID here may be project.PublicID or project.ID.
 func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string) (_ *APIKeyInfo, _ *macaroon.APIKey, err error) {
@@ -2392,6 +2556,15 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 		}
 	}
 
+	if requestInfo.Restrictions != nil {
+		if err := requestInfo.Restrictions.Validate(); err != nil {
+			return nil, api.HTTPError{
+				Status: http.StatusBadRequest,
+				Err:    Error.Wrap(err),
+			}
+		}
+	}
+
 	secret, err := macaroon.NewSecret()
 	if err != nil {
 		return nil, api.HTTPError{
@@ -2423,6 +2596,18 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 		}
 	}
 
+	// the restricted key has the same head as the stored one, so it's still
+	// recognized as the created API key.
+	if requestInfo.Restrictions != nil {
+		key, err = key.Restrict(requestInfo.Restrictions.Caveat())
+		if err != nil {
+			return nil, api.HTTPError{
+				Status: http.StatusInternalServerError,
+				Err:    Error.Wrap(err),
+			}
+		}
+	}
+
 	// in case the project ID from the request is the public ID, replace projectID with reqProjectID
 	info.ProjectID = reqProjectID
 
@@ -2772,6 +2957,82 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 	return list, nil
 }
 
+// GetBucketLimits retrieves the storage, bandwidth and segment limits of a
+// bucket. Unset limits are nil. Only the project owner can access them.
+func (s *Service) GetBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string) (_ buckets.Limits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket limits", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return buckets.Limits{}, Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return buckets.Limits{}, ErrUnauthorized.Wrap(err)
+	}
+
+	limits, err := s.buckets.GetBucketLimits(ctx, []byte(bucketName), project.ID)
+	if err != nil {
+		return buckets.Limits{}, Error.Wrap(err)
+	}
+
+	return limits, nil
+}
+
+// UpdateBucketLimits sets the storage, bandwidth and segment limits of a
+// bucket. Nil limits remove the corresponding limit. Only the project owner
+// can change them.
+func (s *Service) UpdateBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string, limits buckets.Limits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update bucket limits", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	for _, limit := range []*int64{limits.Storage, limits.Bandwidth, limits.Segments} {
+		if limit != nil && *limit < 0 {
+			return ErrValidation.New(bucketLimitNegativeErrMsg)
+		}
+	}
+
+	err = s.buckets.UpdateBucketLimits(ctx, []byte(bucketName), project.ID, limits)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetBucketObjectStats retrieves the latest object statistics of a bucket,
+// collected by the object stats ranged loop observer.
+func (s *Service) GetBucketObjectStats(ctx context.Context, projectID uuid.UUID, bucketName string) (_ accounting.BucketObjectStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket object stats", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return accounting.BucketObjectStats{}, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return accounting.BucketObjectStats{}, ErrUnauthorized.Wrap(err)
+	}
+
+	stats, err := s.projectAccounting.GetBucketObjectStats(ctx, isMember.project.ID, []byte(bucketName))
+	if err != nil {
+		return accounting.BucketObjectStats{}, Error.Wrap(err)
+	}
+
+	return stats, nil
+}
+
 // GetUsageReport retrieves usage rollups for every bucket of a single or all the user owned projects for a given period.
 func (s *Service) GetUsageReport(ctx context.Context, since, before time.Time, projectID uuid.UUID) ([]accounting.ProjectReportItem, error) {
 	var err error
@@ -2826,6 +3087,34 @@ func (s *Service) GetUsageReport(ctx context.Context, since, before time.Time, p
 	return usage, nil
 }
 
+// GenGetUsageReport retrieves usage rollups for every bucket of a project for a given period for generated api.
+func (s *Service) GenGetUsageReport(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.ProjectReportItem, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if projectID.IsZero() {
+		return nil, api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    ErrValidation.New("project ID cannot be empty"),
+		}
+	}
+
+	usage, err := s.GetUsageReport(ctx, since, before, projectID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if ErrUnauthorized.Has(err) {
+			status = http.StatusUnauthorized
+		}
+
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	return usage, httpError
+}
+
 // GenGetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period for generated api.
 func (s *Service) GenGetBucketUsageRollups(ctx context.Context, reqProjectID uuid.UUID, since, before time.Time) (rollups []accounting.BucketUsageRollup, httpError api.HTTPError) {
 	var err error
@@ -2968,6 +3257,27 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 	}, nil
 }
 
+// GenGetProjectUsageLimits returns project limits and current usage for generated api.
+func (s *Service) GenGetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (_ *ProjectUsageLimits, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := s.GetProjectUsageLimits(ctx, projectID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if ErrUnauthorized.Has(err) || ErrNoMembership.Has(err) {
+			status = http.StatusUnauthorized
+		}
+
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	return limits, httpError
+}
+
 // GetTotalUsageLimits returns total limits and current usage for all the projects.
 func (s *Service) GetTotalUsageLimits(ctx context.Context) (_ *ProjectUsageLimits, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"sort"
 	"strings"
 	"testing"
@@ -2516,3 +2517,59 @@ func TestProjectInvitations(t *testing.T) {
 		})
 	})
 }
+
+func TestGenGetUsageExport(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		project, err := sat.DB.Console().Projects().Get(ctx, planet.Uplinks[0].Projects[0].ID)
+		require.NoError(t, err)
+		userCtx, err := sat.UserContext(ctx, project.OwnerID)
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		since, before := now.Add(-time.Hour), now.Add(time.Hour)
+
+		bucketNames := []string{"bucket-a", "bucket-b", "bucket-c"}
+		for _, bucketName := range bucketNames {
+			err := sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte(bucketName), pb.PieceAction_GET, memory.MB.Int64(), 0, now)
+			require.NoError(t, err)
+		}
+
+		_, httpErr := service.GenGetUsageExport(userCtx, project.PublicID, since, before, 0, 1)
+		require.Equal(t, http.StatusBadRequest, httpErr.Status)
+
+		_, httpErr = service.GenGetUsageExport(userCtx, project.PublicID, since, before, 2, 0)
+		require.Equal(t, http.StatusBadRequest, httpErr.Status)
+
+		var exported []string
+		for page := uint(1); page <= 2; page++ {
+			usagePage, httpErr := service.GenGetUsageExport(userCtx, project.PublicID, since, before, 2, page)
+			require.NoError(t, httpErr.Err)
+			require.EqualValues(t, 2, usagePage.PageCount)
+			require.EqualValues(t, len(bucketNames), usagePage.TotalCount)
+			require.Equal(t, page, usagePage.CurrentPage)
+
+			for _, item := range usagePage.Items {
+				require.Equal(t, project.PublicID, item.ProjectID)
+				require.Equal(t, memory.MB.Int64(), item.GetEgress)
+				exported = append(exported, item.BucketName)
+			}
+		}
+		require.Equal(t, bucketNames, exported)
+
+		// the pages after the last one are empty.
+		usagePage, httpErr := service.GenGetUsageExport(userCtx, project.PublicID, since, before, 2, 3)
+		require.NoError(t, httpErr.Err)
+		require.Empty(t, usagePage.Items)
+
+		// the number of buckets of a page is capped.
+		usagePage, httpErr = service.GenGetUsageExport(userCtx, project.PublicID, since, before, console.MaxUsageExportPageBuckets+1, 1)
+		require.NoError(t, httpErr.Err)
+		require.EqualValues(t, console.MaxUsageExportPageBuckets, usagePage.Limit)
+		require.Len(t, usagePage.Items, len(bucketNames))
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"storj.io/common/useragent"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/accounting"
+)
+
+// MaxUsageExportPeriod is the longest period a single usage export may cover.
+const MaxUsageExportPeriod = 366 * 24 * time.Hour
+
+// MaxUsageExportPageBuckets is the largest number of buckets a single page of the usage export contains.
+const MaxUsageExportPageBuckets = 100
+
+// hoursPerMonth is the number of hours in a billing month, which is always 30 days.
+const hoursPerMonth = 24 * 30
+
+// UsageExportItem is the usage and cost of a bucket during a single UTC day.
+//
+// Costs are computed with the base prices of the bucket's partner and placement.
+// Volume tiers and egress discounts depend on the usage of the whole billing
+// period, so they are only applied to invoices.
+type UsageExportItem struct {
+	ProjectID   uuid.UUID `json:"projectID"`
+	ProjectName string    `json:"projectName"`
+	BucketName  string    `json:"bucketName"`
+	Day         time.Time `json:"day"`
+
+	StorageByteHours float64 `json:"storageByteHours"`
+	SegmentHours     float64 `json:"segmentHours"`
+	ObjectHours      float64 `json:"objectHours"`
+
+	GetEgress    int64 `json:"getEgress"`
+	RepairEgress int64 `json:"repairEgress"`
+	AuditEgress  int64 `json:"auditEgress"`
+
+	StorageCents float64 `json:"storageCents"`
+	EgressCents  float64 `json:"egressCents"`
+	SegmentCents float64 `json:"segmentCents"`
+	TotalCents   float64 `json:"totalCents"`
+}
+
+// UsageExportPage is a page of the usage export, which contains the usage of up to Limit buckets.
+type UsageExportPage struct {
+	Items []UsageExportItem `json:"items"`
+
+	Limit  uint   `json:"limit"`
+	Offset uint64 `json:"offset"`
+
+	PageCount   uint   `json:"pageCount"`
+	CurrentPage uint   `json:"currentPage"`
+	TotalCount  uint64 `json:"totalCount"`
+}
+
+// ExportProjectUsage calls fn with the daily usage and cost of every bucket of a project
+// within the given period. Buckets are exported in name order and days in chronological order.
+func (s *Service) ExportProjectUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time, fn func(context.Context, UsageExportItem) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.exportProjectUsage(ctx, projectID, since, before, accounting.BucketDailyUsageCursor{}, fn)
+	return err
+}
+
+// exportProjectUsage calls fn with the daily usage and cost of the buckets of a project selected by the cursor
+// and returns the number of buckets with usage within the given period.
+func (s *Service) exportProjectUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time, cursor accounting.BucketDailyUsageCursor, fn func(context.Context, UsageExportItem) error) (bucketCount uint64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "export project usage", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return 0, ErrUnauthorized.Wrap(err)
+	}
+
+	if !since.Before(before) {
+		return 0, ErrInvalidUsageExportPeriod.New("since must be before before")
+	}
+	if before.Sub(since) > MaxUsageExportPeriod {
+		return 0, ErrInvalidUsageExportPeriod.New("period must not be longer than %s", MaxUsageExportPeriod)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return 0, ErrUnauthorized.Wrap(err)
+	}
+	project := isMember.project
+
+	return s.projectAccounting.IterateBucketDailyUsage(ctx, project.ID, since, before, cursor, func(ctx context.Context, usage accounting.BucketDailyUsage) error {
+		item := UsageExportItem{
+			ProjectID:        project.PublicID,
+			ProjectName:      project.Name,
+			BucketName:       usage.BucketName,
+			Day:              usage.Day,
+			StorageByteHours: usage.StorageByteHours,
+			SegmentHours:     usage.SegmentHours,
+			ObjectHours:      usage.ObjectHours,
+			GetEgress:        usage.GetEgress,
+			RepairEgress:     usage.RepairEgress,
+			AuditEgress:      usage.AuditEgress,
+		}
+
+		var partner string
+		if entries, err := useragent.ParseEntries(usage.UserAgent); err == nil && len(entries) > 0 {
+			partner = entries[0].Product
+		}
+		prices := s.accounts.GetProjectUsagePriceModel(partner).ForPlacement(usage.Placement)
+
+		// storage and segments are charged per month, egress per MB.
+		storageMBMonths := decimal.NewFromFloat(usage.StorageByteHours).Shift(-6).Div(decimal.NewFromInt(hoursPerMonth))
+		egressMB := decimal.NewFromInt(usage.GetEgress).Shift(-6)
+		segmentMonths := decimal.NewFromFloat(usage.SegmentHours).Div(decimal.NewFromInt(hoursPerMonth))
+
+		item.StorageCents, _ = prices.StorageMBMonthCents.Mul(storageMBMonths).Float64()
+		item.EgressCents, _ = prices.EgressMBCents.Mul(egressMB).Float64()
+		item.SegmentCents, _ = prices.SegmentMonthCents.Mul(segmentMonths).Float64()
+		item.TotalCents = item.StorageCents + item.EgressCents + item.SegmentCents
+
+		return fn(ctx, item)
+	})
+}
+
+// GenGetUsageExport returns a page of the daily usage and cost of the buckets of a project within the given period
+// for generated api.
+func (s *Service) GenGetUsageExport(ctx context.Context, projectID uuid.UUID, since, before time.Time, limit, page uint) (_ *UsageExportPage, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if limit == 0 || page == 0 {
+		return nil, api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    ErrValidation.New("limit and page must be greater than 0"),
+		}
+	}
+	if limit > MaxUsageExportPageBuckets {
+		limit = MaxUsageExportPageBuckets
+	}
+
+	usagePage := &UsageExportPage{
+		Items:       []UsageExportItem{},
+		Limit:       limit,
+		Offset:      uint64(page-1) * uint64(limit),
+		CurrentPage: page,
+	}
+
+	cursor := accounting.BucketDailyUsageCursor{
+		Offset: usagePage.Offset,
+		Limit:  limit,
+	}
+	usagePage.TotalCount, err = s.exportProjectUsage(ctx, projectID, since, before, cursor, func(ctx context.Context, item UsageExportItem) error {
+		usagePage.Items = append(usagePage.Items, item)
+		return nil
+	})
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case ErrUnauthorized.Has(err):
+			status = http.StatusUnauthorized
+		case ErrInvalidUsageExportPeriod.Has(err):
+			status = http.StatusBadRequest
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	usagePage.PageCount = uint(usagePage.TotalCount / uint64(limit))
+	if usagePage.TotalCount%uint64(limit) != 0 {
+		usagePage.PageCount++
+	}
+
+	return usagePage, httpError
+}
@@ -22,6 +22,9 @@ import (
 type Users interface {
 	// Get is a method for querying user from the database by id.
 	Get(ctx context.Context, id uuid.UUID) (*User, error)
+	// GetNamesByIDs gets the ID, email, full name and short name of the users with the given IDs.
+	// The IDs of users which don't exist are ignored.
+	GetNamesByIDs(ctx context.Context, ids []uuid.UUID) ([]*User, error)
 	// GetUnverifiedNeedingReminder gets unverified users needing a reminder to verify their email.
 	GetUnverifiedNeedingReminder(ctx context.Context, firstReminder, secondReminder, cutoff time.Time) ([]*User, error)
 	// UpdateVerificationReminders increments verification_reminders.
@@ -6,6 +6,8 @@ package console_test
 import (
 	"context"
 	"database/sql"
+	"sort"
+	"strconv"
 	"testing"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"storj.io/common/memory"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
@@ -383,6 +386,42 @@ func TestGetUsersByStatus(t *testing.T) {
 	})
 }
 
+func TestGetNamesByIDs(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		users := db.Console().Users()
+
+		var inserted []*console.User
+		for i := 0; i < 3; i++ {
+			user, err := users.Insert(ctx, &console.User{
+				ID:           testrand.UUID(),
+				FullName:     "User " + strconv.Itoa(i),
+				ShortName:    "User",
+				Email:        "user" + strconv.Itoa(i) + "@mail.test",
+				PasswordHash: []byte("password"),
+			})
+			require.NoError(t, err)
+			inserted = append(inserted, user)
+		}
+
+		found, err := users.GetNamesByIDs(ctx, nil)
+		require.NoError(t, err)
+		require.Empty(t, found)
+
+		// the users which don't exist are ignored.
+		found, err = users.GetNamesByIDs(ctx, []uuid.UUID{inserted[0].ID, inserted[2].ID, testrand.UUID()})
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+
+		sort.Slice(found, func(i, k int) bool { return found[i].Email < found[k].Email })
+		for i, user := range []*console.User{inserted[0], inserted[2]} {
+			require.Equal(t, user.ID, found[i].ID)
+			require.Equal(t, user.Email, found[i].Email)
+			require.Equal(t, user.FullName, found[i].FullName)
+			require.Equal(t, user.ShortName, found[i].ShortName)
+		}
+	})
+}
+
 func TestGetUnverifiedNeedingReminder(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		Reconfigure: testplanet.Reconfigure{
@@ -8,9 +8,12 @@ import (
 	"crypto/x509"
 	"net"
 	"sort"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/identity/testidentity"
 	"storj.io/common/nodetag"
@@ -21,6 +24,8 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/contact"
 )
@@ -322,3 +327,95 @@ func TestSatelliteContactEndpoint_WithWrongNodeTags(t *testing.T) {
 		require.Len(t, tags, 0)
 	})
 }
+
+func TestSatelliteContactEndpoint_NodeTagsCleanup(t *testing.T) {
+	revokedSigner := testidentity.MustPregeneratedSignedIdentity(98, storj.LatestIDVersion())
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Contact.RevokedTagSigners = []string{revokedSigner.ID.String()}
+				config.Contact.RevokedTagNames = []string{"revoked"}
+			},
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Server.DisableQUIC = true
+				config.Contact.Tags = contact.SignedTags(pb.SignedNodeTagSets{
+					Tags: []*pb.SignedNodeTagSet{},
+				})
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		nodeInfo := planet.StorageNodes[0].Contact.Service.Local()
+		ident := planet.StorageNodes[0].Identity
+		satelliteSigner := signing.SignerFromFullIdentity(planet.Satellites[0].Identity)
+
+		peerCtx := rpcpeer.NewContext(ctx, &rpcpeer.Peer{
+			Addr: &net.TCPAddr{
+				IP:   net.ParseIP(nodeInfo.Address),
+				Port: 5,
+			},
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{ident.Leaf, ident.CA},
+			},
+		})
+
+		sign := func(signer signing.Signer, tags ...*pb.Tag) *pb.SignedNodeTagSet {
+			signed, err := nodetag.Sign(ctx, &pb.NodeTagSet{
+				NodeId:   ident.ID.Bytes(),
+				SignedAt: time.Now().Unix(),
+				Tags:     tags,
+			}, signer)
+			require.NoError(t, err)
+			return signed
+		}
+
+		checkIn := func(sets ...*pb.SignedNodeTagSet) []string {
+			_, err := planet.Satellites[0].Contact.Endpoint.CheckIn(peerCtx, &pb.CheckInRequest{
+				Address:    nodeInfo.Address,
+				Version:    &nodeInfo.Version,
+				Capacity:   &nodeInfo.Capacity,
+				Operator:   &nodeInfo.Operator,
+				SignedTags: &pb.SignedNodeTagSets{Tags: sets},
+			})
+			require.NoError(t, err)
+
+			tags, err := planet.Satellites[0].DB.OverlayCache().GetNodeTags(ctx, ident.ID)
+			require.NoError(t, err)
+			var names []string
+			for _, tag := range tags {
+				names = append(names, tag.Name)
+			}
+			sort.Strings(names)
+			return names
+		}
+
+		expires := func(at time.Time) *pb.Tag {
+			return &pb.Tag{Name: nodeselection.ExpiresTag, Value: []byte(strconv.FormatInt(at.Unix(), 10))}
+		}
+
+		names := checkIn(
+			sign(satelliteSigner, &pb.Tag{Name: "foo", Value: []byte("bar")}, &pb.Tag{Name: "revoked", Value: []byte("1")}),
+			sign(satelliteSigner, &pb.Tag{Name: "valid", Value: []byte("1")}, expires(time.Now().Add(time.Hour))),
+			sign(satelliteSigner, &pb.Tag{Name: "expired", Value: []byte("1")}, expires(time.Now().Add(-time.Hour))),
+			sign(signing.SignerFromFullIdentity(revokedSigner), &pb.Tag{Name: "signer", Value: []byte("1")}),
+		)
+		require.Equal(t, []string{"foo", "valid"}, names)
+
+		// tags which fail verification keep the stored tags of the signer.
+		wrongNode, err := nodetag.Sign(ctx, &pb.NodeTagSet{
+			NodeId:   testidentity.MustPregeneratedIdentity(99, storj.LatestIDVersion()).ID.Bytes(),
+			SignedAt: time.Now().Unix(),
+			Tags:     []*pb.Tag{{Name: "valid", Value: []byte("2")}},
+		}, satelliteSigner)
+		require.NoError(t, err)
+		names = checkIn(wrongNode)
+		require.Equal(t, []string{"foo", "valid"}, names)
+
+		// tags which are not sent anymore are removed.
+		names = checkIn(sign(satelliteSigner, &pb.Tag{Name: "foo", Value: []byte("bar")}))
+		require.Equal(t, []string{"foo"}, names)
+
+		names = checkIn()
+		require.Empty(t, names)
+	})
+}
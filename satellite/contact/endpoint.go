@@ -18,6 +18,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/drpc/drpcctx"
+	"storj.io/storj/private/maintenancepb"
 	"storj.io/storj/private/nodeoperator"
 	"storj.io/storj/satellite/overlay"
 )
@@ -202,6 +203,30 @@ func (endpoint *Endpoint) GetTime(ctx context.Context, req *pb.GetTimeRequest) (
 	}, nil
 }
 
+// ScheduleWindow is called by storage nodes to announce a planned maintenance window. A window,
+// which isn't allowed by the satellite, is rejected with InvalidArgument and shouldn't be sent again.
+func (endpoint *Endpoint) ScheduleWindow(ctx context.Context, req *maintenancepb.ScheduleWindowRequest) (_ *maintenancepb.ScheduleWindowResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		endpoint.log.Info("failed to get node ID from context", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, errCheckInIdentity.New("failed to get ID from context: %v", err).Error())
+	}
+
+	err = endpoint.service.overlay.ScheduleMaintenance(ctx, peerID.ID, req.Start, req.End)
+	if err != nil {
+		if overlay.ErrMaintenanceNotAllowed.Has(err) {
+			endpoint.log.Info("rejected maintenance window", zap.Stringer("Node ID", peerID.ID), zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Info("failed to schedule maintenance window", zap.Stringer("Node ID", peerID.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, Error.Wrap(err).Error())
+	}
+
+	return &maintenancepb.ScheduleWindowResponse{}, nil
+}
+
 // PingMe is called by storage node to request a pingBack from the satellite to confirm they can
 // successfully connect to the node.
 func (endpoint *Endpoint) PingMe(ctx context.Context, req *pb.PingMeRequest) (_ *pb.PingMeResponse, err error) {
@@ -4,8 +4,10 @@
 package contact
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -30,6 +32,9 @@ type Config struct {
 	RateLimitInterval  time.Duration `help:"the amount of time that should happen between contact attempts usually" releaseDefault:"10m0s" devDefault:"1ns"`
 	RateLimitBurst     int           `help:"the maximum burst size for the contact rate limit token bucket" releaseDefault:"2" devDefault:"1000"`
 	RateLimitCacheSize int           `help:"the number of nodes or addresses to keep token buckets for" default:"1000"`
+
+	RevokedTagSigners []string `help:"list of signer node IDs, whose node tags are not accepted anymore" default:""`
+	RevokedTagNames   []string `help:"list of node tag names, which are not accepted anymore from any signer" default:""`
 }
 
 // Service is the contact service between storage nodes and satellites.
@@ -48,21 +53,39 @@ type Service struct {
 	idLimiter      *RateLimiter
 	allowPrivateIP bool
 
-	nodeTagAuthority nodetag.Authority
+	nodeTagAuthority  nodetag.Authority
+	revokedTagSigners map[storj.NodeID]struct{}
+	revokedTagNames   map[string]struct{}
 }
 
 // NewService creates a new contact service.
-func NewService(log *zap.Logger, overlay *overlay.Service, peerIDs overlay.PeerIdentities, dialer rpc.Dialer, authority nodetag.Authority, config Config) *Service {
-	return &Service{
-		log:              log,
-		overlay:          overlay,
-		peerIDs:          peerIDs,
-		dialer:           dialer,
-		timeout:          config.Timeout,
-		idLimiter:        NewRateLimiter(config.RateLimitInterval, config.RateLimitBurst, config.RateLimitCacheSize),
-		allowPrivateIP:   config.AllowPrivateIP,
-		nodeTagAuthority: authority,
+func NewService(log *zap.Logger, overlay *overlay.Service, peerIDs overlay.PeerIdentities, dialer rpc.Dialer, authority nodetag.Authority, config Config) (*Service, error) {
+	revokedTagSigners := make(map[storj.NodeID]struct{}, len(config.RevokedTagSigners))
+	for _, signer := range config.RevokedTagSigners {
+		signerID, err := storj.NodeIDFromString(strings.TrimSpace(signer))
+		if err != nil {
+			return nil, Error.New("invalid revoked tag signer %q: %v", signer, err)
+		}
+		revokedTagSigners[signerID] = struct{}{}
+	}
+
+	revokedTagNames := make(map[string]struct{}, len(config.RevokedTagNames))
+	for _, name := range config.RevokedTagNames {
+		revokedTagNames[strings.TrimSpace(name)] = struct{}{}
 	}
+
+	return &Service{
+		log:               log,
+		overlay:           overlay,
+		peerIDs:           peerIDs,
+		dialer:            dialer,
+		timeout:           config.Timeout,
+		idLimiter:         NewRateLimiter(config.RateLimitInterval, config.RateLimitBurst, config.RateLimitCacheSize),
+		allowPrivateIP:    config.AllowPrivateIP,
+		nodeTagAuthority:  authority,
+		revokedTagSigners: revokedTagSigners,
+		revokedTagNames:   revokedTagNames,
+	}, nil
 }
 
 // Close closes resources.
@@ -145,18 +168,55 @@ func (service *Service) pingNodeQUIC(ctx context.Context, nodeurl storj.NodeURL)
 	return nil
 }
 
-func (service *Service) processNodeTags(ctx context.Context, nodeID storj.NodeID, req *pb.SignedNodeTagSets) error {
+// processNodeTags replaces the tags of the node with the valid tags of the latest check-in.
+// Tags which are not sent anymore, expired or revoked are removed. When a tag set
+// can't be verified, the previously stored tags of its signer are kept.
+func (service *Service) processNodeTags(ctx context.Context, nodeID storj.NodeID, req *pb.SignedNodeTagSets) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stored, err := service.overlay.GetNodeTags(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := time.Now()
+	tags := nodeselection.NodeTags{}
 	if req != nil {
-		tags := nodeselection.NodeTags{}
 		for _, t := range req.Tags {
 			verifiedTags, signerID, err := verifyTags(ctx, service.nodeTagAuthority, nodeID, t)
+			if err != nil {
+				service.log.Info("Failed to verify tags.", zap.Error(err), zap.Stringer("NodeID", nodeID))
+				if signerID.IsZero() {
+					// without a signer we can't tell which of the stored tags to keep.
+					return nil
+				}
+				tags = append(tags, service.keptNodeTags(stored, signerID)...)
+				continue
+			}
+
+			if _, revoked := service.revokedTagSigners[signerID]; revoked {
+				service.log.Debug("Ignoring tags of revoked signer.", zap.Stringer("NodeID", nodeID), zap.Stringer("Signer", signerID))
+				continue
+			}
+
+			expiresAt, err := nodeselection.TagSetExpiration(verifiedTags)
 			if err != nil {
 				service.log.Info("Failed to verify tags.", zap.Error(err), zap.Stringer("NodeID", nodeID))
 				continue
 			}
+			if !expiresAt.IsZero() && !now.Before(expiresAt) {
+				service.log.Debug("Ignoring expired tags.", zap.Stringer("NodeID", nodeID), zap.Stringer("Signer", signerID), zap.Time("Expired", expiresAt))
+				continue
+			}
 
 			ts := time.Unix(verifiedTags.SignedAt, 0)
 			for _, vt := range verifiedTags.Tags {
+				if vt.Name == nodeselection.ExpiresTag {
+					continue
+				}
+				if _, revoked := service.revokedTagNames[vt.Name]; revoked {
+					continue
+				}
 				tags = append(tags, nodeselection.NodeTag{
 					NodeID:   nodeID,
 					Name:     vt.Name,
@@ -166,14 +226,52 @@ func (service *Service) processNodeTags(ctx context.Context, nodeID storj.NodeID
 				})
 			}
 		}
-		if len(tags) > 0 {
-			err := service.overlay.UpdateNodeTags(ctx, tags)
-			if err != nil {
-				return Error.Wrap(err)
-			}
+	}
+
+	if equalNodeTags(stored, tags) {
+		return nil
+	}
+	return Error.Wrap(service.overlay.ReplaceNodeTags(ctx, nodeID, tags))
+}
+
+// keptNodeTags returns the stored tags of the signer, which are still valid.
+func (service *Service) keptNodeTags(stored nodeselection.NodeTags, signerID storj.NodeID) (tags nodeselection.NodeTags) {
+	if _, revoked := service.revokedTagSigners[signerID]; revoked {
+		return nil
+	}
+	for _, tag := range stored {
+		if tag.Signer != signerID {
+			continue
 		}
+		if _, revoked := service.revokedTagNames[tag.Name]; revoked {
+			continue
+		}
+		tags = append(tags, tag)
 	}
-	return nil
+	return tags
+}
+
+// equalNodeTags checks whether both lists contain the same tags, regardless of their order.
+func equalNodeTags(a, b nodeselection.NodeTags) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	type tagKey struct {
+		signer storj.NodeID
+		name   string
+	}
+	index := make(map[tagKey]nodeselection.NodeTag, len(a))
+	for _, tag := range a {
+		index[tagKey{signer: tag.Signer, name: tag.Name}] = tag
+	}
+	for _, tag := range b {
+		other, ok := index[tagKey{signer: tag.Signer, name: tag.Name}]
+		if !ok || !bytes.Equal(other.Value, tag.Value) || !other.SignedAt.Equal(tag.SignedAt) {
+			return false
+		}
+	}
+	return true
 }
 
 func verifyTags(ctx context.Context, authority nodetag.Authority, nodeID storj.NodeID, t *pb.SignedNodeTagSet) (*pb.NodeTagSet, storj.NodeID, error) {
@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/private/lifecycle"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/objectstats"
 	"storj.io/storj/satellite/accounting/projectbwcleanup"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/rolluparchive"
@@ -125,6 +126,7 @@ type Core struct {
 		Rollup                *rollup.Service
 		RollupArchiveChore    *rolluparchive.Chore
 		ProjectBWCleanupChore *projectbwcleanup.Chore
+		ObjectStatsChore      *objectstats.Chore
 	}
 
 	LiveAccounting struct {
@@ -431,6 +433,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		} else {
 			peer.Log.Named("rolluparchive").Info("disabled")
 		}
+
+		if config.ObjectStats.Enabled {
+			peer.Accounting.ObjectStatsChore = objectstats.NewChore(peer.Log.Named("accounting:objectstats"), peer.DB.ProjectAccounting(), peer.Metainfo.Metabase, config.ObjectStats)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "accounting:objectstats",
+				Run:   peer.Accounting.ObjectStatsChore.Run,
+				Close: peer.Accounting.ObjectStatsChore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Accounting Object Stats", peer.Accounting.ObjectStatsChore.Loop))
+		}
 	}
 
 	{ // setup analytics service
@@ -447,21 +460,6 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 	{ // setup payments
 		pc := config.Payments
 
-		var stripeClient stripe.Client
-		switch pc.Provider {
-		case "": // just new mock, only used in testing binaries
-			stripeClient = stripe.NewStripeMock(
-				peer.DB.StripeCoinPayments().Customers(),
-				peer.DB.Console().Users(),
-			)
-		case "mock":
-			stripeClient = pc.MockProvider
-		case "stripecoinpayments":
-			stripeClient = stripe.NewStripeClient(log, pc.StripeCoinPayments)
-		default:
-			return nil, errs.New("invalid stripe coin payments provider %q", pc.Provider)
-		}
-
 		prices, err := pc.UsagePrice.ToModel()
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -472,27 +470,56 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		service, err := stripe.NewService(
-			peer.Log.Named("payments.stripe:service"),
-			stripeClient,
-			pc.StripeCoinPayments,
-			peer.DB.StripeCoinPayments(),
-			peer.DB.Wallets(),
-			peer.DB.Billing(),
-			peer.DB.Console().Projects(),
-			peer.DB.Console().Users(),
-			peer.DB.ProjectAccounting(),
-			prices,
-			priceOverrides,
-			pc.PackagePlans.Packages,
-			pc.BonusRate,
-			peer.Analytics.Service,
-		)
+		placementPriceOverrides, err := pc.PlacementPriceOverrides.ToModels()
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Payments.Accounts = service.Accounts()
+		if pc.Provider == "offline" {
+			offlineService, err := NewOfflinePayments(peer.Log, peer.DB, pc)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Payments.Accounts = offlineService.Accounts()
+		} else {
+			var stripeClient stripe.Client
+			switch pc.Provider {
+			case "": // just new mock, only used in testing binaries
+				stripeClient = stripe.NewStripeMock(
+					peer.DB.StripeCoinPayments().Customers(),
+					peer.DB.Console().Users(),
+				)
+			case "mock":
+				stripeClient = pc.MockProvider
+			case "stripecoinpayments":
+				stripeClient = stripe.NewStripeClient(log, pc.StripeCoinPayments)
+			default:
+				return nil, errs.New("invalid stripe coin payments provider %q", pc.Provider)
+			}
+
+			service, err := stripe.NewService(
+				peer.Log.Named("payments.stripe:service"),
+				stripeClient,
+				pc.StripeCoinPayments,
+				peer.DB.StripeCoinPayments(),
+				peer.DB.Wallets(),
+				peer.DB.Billing(),
+				peer.DB.Console().Projects(),
+				peer.DB.Console().Users(),
+				peer.DB.ProjectAccounting(),
+				prices,
+				priceOverrides,
+				placementPriceOverrides,
+				pc.PackagePlans.Packages,
+				pc.BonusRate,
+				peer.Analytics.Service,
+			)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			peer.Payments.Accounts = service.Accounts()
+		}
 
 		peer.Payments.StorjscanClient = storjscan.NewClient(
 			pc.Storjscan.Endpoint,
@@ -0,0 +1,49 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package durability
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrReportNotFound is returned when there is no report for a class.
+var ErrReportNotFound = errs.Class("durability report not found")
+
+// DB stores the latest durability report of each class.
+//
+// architecture: Database
+type DB interface {
+	// ReplaceReport replaces the stored report of summary.Class with the given summary and stats.
+	ReplaceReport(ctx context.Context, summary ReportSummary, stats []ClassStat) error
+	// GetReports returns the summaries of the latest reports of all classes.
+	GetReports(ctx context.Context) ([]ReportSummary, error)
+	// GetReport returns the summary of the latest report of a class.
+	GetReport(ctx context.Context, class string) (ReportSummary, error)
+	// GetWorstStats returns the stats of a class with the lowest number of healthy pieces first.
+	GetWorstStats(ctx context.Context, class string, limit int) ([]ClassStat, error)
+}
+
+// ReportSummary is the class level result of a durability report.
+type ReportSummary struct {
+	Class      string    `json:"class"`
+	ReportTime time.Time `json:"reportTime"`
+	// BusFactor is the lowest number of class values, which together control enough pieces
+	// of a segment to make it unhealthy. It's 0, when it wasn't computed.
+	BusFactor         int    `json:"busFactor"`
+	BusFactorExemplar string `json:"busFactorExemplar"`
+}
+
+// ClassStat is the health of segments without the nodes of a single class value
+// (for example the nodes of one country).
+type ClassStat struct {
+	Class string `json:"class"`
+	Value string `json:"value"`
+	// MinHealthyPieces is the lowest number of healthy pieces any segment has without the nodes of the class value.
+	MinHealthyPieces int `json:"minHealthyPieces"`
+	// Exemplar is a segment (stream_id/position) with MinHealthyPieces healthy pieces.
+	Exemplar string `json:"exemplar"`
+}
@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/jtolio/eventkit"
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
 
 	"storj.io/common/storj"
@@ -19,7 +21,12 @@ import (
 	"storj.io/storj/satellite/overlay"
 )
 
-var ek = eventkit.Package()
+var (
+	ek = eventkit.Package()
+
+	// Error is the default error class for durability reports.
+	Error = errs.Class("durability")
+)
 
 // HealthStat collects the availability conditions for one class (for example: nodes with the same owner).
 type HealthStat struct {
@@ -61,7 +68,8 @@ type NodeClassifier func(node *nodeselection.SelectedNode) string
 
 // ReportConfig configures durability report.
 type ReportConfig struct {
-	Enabled bool `help:"whether to enable durability report (rangedloop observer)" default:"true"`
+	Enabled        bool `help:"whether to enable durability report (rangedloop observer)" default:"true"`
+	AlertThreshold int  `help:"raise an alert when a segment would keep fewer healthy pieces than this after losing the nodes of any class value. 0 means the repair threshold" default:"0"`
 }
 
 // Report  is a calculator (rangloop.Observer) which checks the availability of pieces without certain nodes.
@@ -73,6 +81,8 @@ type ReportConfig struct {
 //
 //	in this case this reporter will return 38 for the class "country:DE" (assuming all the other segments are more lucky).
 type Report struct {
+	log                *zap.Logger
+	reportDB           DB
 	healthStat         map[string]*HealthStat
 	busFactor          HealthStat
 	classifier         NodeClassifier
@@ -83,6 +93,7 @@ type Report struct {
 	reporter           func(n time.Time, class string, value string, stat *HealthStat)
 	reportThreshold    int
 	busFactorThreshold int
+	alertThreshold     int
 	asOfSystemInterval time.Duration
 
 	// map between classes (like "country:hu" and integer IDs)
@@ -96,15 +107,18 @@ type Report struct {
 	class         string
 }
 
-// NewDurability creates the new instance.
-func NewDurability(db overlay.DB, metabaseDB *metabase.DB, class string, classifier NodeClassifier, maxPieceCount int, reportThreshold int, busFactorThreshold int, asOfSystemInterval time.Duration) *Report {
+// NewDurability creates the new instance. The finished reports are stored in reportDB, when it's not nil.
+func NewDurability(log *zap.Logger, db overlay.DB, metabaseDB *metabase.DB, reportDB DB, class string, classifier NodeClassifier, maxPieceCount int, reportThreshold int, busFactorThreshold int, alertThreshold int, asOfSystemInterval time.Duration) *Report {
 	return &Report{
+		log:                log,
 		class:              class,
 		db:                 db,
 		metabaseDB:         metabaseDB,
+		reportDB:           reportDB,
 		classifier:         classifier,
 		reportThreshold:    reportThreshold,
 		busFactorThreshold: busFactorThreshold,
+		alertThreshold:     alertThreshold,
 		asOfSystemInterval: asOfSystemInterval,
 		nodes:              make(map[storj.NodeID]*nodeselection.SelectedNode),
 		healthStat:         make(map[string]*HealthStat),
@@ -170,6 +184,7 @@ func (c *Report) Fork(ctx context.Context) (rangedloop.Partial, error) {
 		nodes:                  c.nodes,
 		classifierCache:        make([][]string, c.aliasMap.Max()+1),
 		reportThreshold:        c.reportThreshold,
+		busFactorThreshold:     c.busFactorThreshold,
 		healthStat:             make([]HealthStat, len(c.classID)),
 		controlledByClassCache: make([]int32, len(c.classID)),
 		busFactorCache:         make([]int32, 0, c.maxPieceCount),
@@ -198,17 +213,89 @@ func (c *Report) Join(ctx context.Context, partial rangedloop.Partial) (err erro
 		}
 
 	}
-	c.busFactor.Update(fork.busFactor.Min(), fork.busFactor.Exemplar)
+	if !fork.busFactor.Unused() {
+		c.busFactor.Update(fork.busFactor.Min(), fork.busFactor.Exemplar)
+	}
 	return nil
 }
 
 // Finish implements rangedloop.Observer.
-func (c *Report) Finish(ctx context.Context) error {
+func (c *Report) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	reportTime := time.Now()
 	for name, stat := range c.healthStat {
 		c.reporter(reportTime, c.class, name, stat)
 	}
-	return nil
+
+	c.alert()
+
+	if c.reportDB == nil {
+		return nil
+	}
+
+	summary := ReportSummary{
+		Class:      c.class,
+		ReportTime: reportTime,
+	}
+	if !c.busFactor.Unused() {
+		summary.BusFactor = c.busFactor.Min()
+		summary.BusFactorExemplar = c.busFactor.Exemplar
+	}
+	stats := make([]ClassStat, 0, len(c.healthStat))
+	for name, stat := range c.healthStat {
+		stats = append(stats, ClassStat{
+			Class:            c.class,
+			Value:            name,
+			MinHealthyPieces: stat.Min(),
+			Exemplar:         stat.Exemplar,
+		})
+	}
+	return Error.Wrap(c.reportDB.ReplaceReport(ctx, summary, stats))
+}
+
+// alert reports the class values, which would leave segments with fewer healthy pieces than the alert threshold.
+func (c *Report) alert() {
+	worst := -1
+	for name, stat := range c.healthStat {
+		if stat.Unused() {
+			continue
+		}
+
+		if worst < 0 || stat.Min() < worst {
+			worst = stat.Min()
+		}
+
+		if stat.Min() >= c.alertThreshold {
+			continue
+		}
+
+		c.log.Warn("segments would become unhealthy without the nodes of a class",
+			zap.String("class", c.class),
+			zap.String("value", name),
+			zap.Int("min healthy pieces", stat.Min()),
+			zap.Int("threshold", c.alertThreshold),
+			zap.String("exemplar", stat.Exemplar))
+
+		mon.Event("durability_below_threshold",
+			monkit.NewSeriesTag("class", c.class),
+			monkit.NewSeriesTag("value", name))
+
+		ek.Event("durability-alert",
+			eventkit.String("class", c.class),
+			eventkit.String("value", name),
+			eventkit.String("exemplar", stat.Exemplar),
+			eventkit.Int64("min", int64(stat.Min())),
+			eventkit.Int64("threshold", int64(c.alertThreshold)),
+		)
+	}
+
+	if worst >= 0 {
+		mon.IntVal("durability_min_healthy_pieces", monkit.NewSeriesTag("class", c.class)).Observe(int64(worst))
+	}
+	if !c.busFactor.Unused() {
+		mon.IntVal("durability_bus_factor", monkit.NewSeriesTag("class", c.class)).Observe(int64(c.busFactor.Min()))
+	}
 }
 
 // TestChangeReporter modifies the reporter for unit tests.
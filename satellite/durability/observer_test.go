@@ -7,10 +7,14 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
 
 	"storj.io/common/identity/testidentity"
 	"storj.io/common/storj"
@@ -64,9 +68,9 @@ func TestDurability(t *testing.T) {
 	}
 
 	ctx := testcontext.New(t)
-	c := NewDurability(nil, nil, "net", func(node *nodeselection.SelectedNode) string {
+	c := NewDurability(zaptest.NewLogger(t), nil, nil, nil, "net", func(node *nodeselection.SelectedNode) string {
 		return node.LastNet
-	}, 110, 0, 0, 0)
+	}, 110, 0, 0, 0, 0)
 
 	c.aliasMap = metabase.NewNodeAliasMap(aliases)
 	for _, node := range storageNodes {
@@ -130,9 +134,9 @@ func TestDurabilityUnknownNode(t *testing.T) {
 	})
 
 	ctx := testcontext.New(t)
-	c := NewDurability(nil, nil, "net", func(node *nodeselection.SelectedNode) string {
+	c := NewDurability(zaptest.NewLogger(t), nil, nil, nil, "net", func(node *nodeselection.SelectedNode) string {
 		return node.LastNet
-	}, 110, 0, 0, 0)
+	}, 110, 0, 0, 0, 0)
 
 	c.aliasMap = metabase.NewNodeAliasMap(aliases)
 	for _, node := range storageNodes {
@@ -174,6 +178,76 @@ func TestDurabilityUnknownNode(t *testing.T) {
 	require.Equal(t, 0, c.healthStat["127.0.0.1"].Min())
 }
 
+func TestDurabilityFinish(t *testing.T) {
+	ctx := testcontext.New(t)
+	reportDB := &memoryReportDB{}
+	observedZapCore, observedLogs := observer.New(zap.WarnLevel)
+	c := NewDurability(zap.New(observedZapCore), nil, nil, reportDB, "net", func(node *nodeselection.SelectedNode) string {
+		return node.LastNet
+	}, 110, 0, 0, 30, 0)
+	c.TestChangeReporter(func(n time.Time, class string, name string, stat *HealthStat) {})
+	c.resetStat()
+
+	c.healthStat["127.0.0.0"] = &HealthStat{}
+	c.healthStat["127.0.0.0"].Update(25, "segment1")
+	c.healthStat["127.0.1.0"] = &HealthStat{}
+	c.healthStat["127.0.1.0"].Update(40, "segment2")
+	c.busFactor.Update(3, "segment3")
+
+	require.NoError(t, c.Finish(ctx))
+
+	require.Equal(t, "net", reportDB.summary.Class)
+	require.Equal(t, 3, reportDB.summary.BusFactor)
+	require.Equal(t, "segment3", reportDB.summary.BusFactorExemplar)
+	require.False(t, reportDB.summary.ReportTime.IsZero())
+
+	sort.Slice(reportDB.stats, func(i, j int) bool {
+		return reportDB.stats[i].Value < reportDB.stats[j].Value
+	})
+	require.Equal(t, []ClassStat{
+		{Class: "net", Value: "127.0.0.0", MinHealthyPieces: 25, Exemplar: "segment1"},
+		{Class: "net", Value: "127.0.1.0", MinHealthyPieces: 40, Exemplar: "segment2"},
+	}, reportDB.stats)
+
+	// only the class value below the alert threshold is alerted.
+	alerts := observedLogs.FilterMessage("segments would become unhealthy without the nodes of a class").All()
+	require.Len(t, alerts, 1)
+	require.Equal(t, "127.0.0.0", alerts[0].ContextMap()["value"])
+
+	// without any segment the bus factor isn't computed and nothing is alerted.
+	c.resetStat()
+	observedLogs.TakeAll()
+
+	require.NoError(t, c.Finish(ctx))
+	require.Equal(t, 0, reportDB.summary.BusFactor)
+	require.Empty(t, reportDB.summary.BusFactorExemplar)
+	require.Empty(t, reportDB.stats)
+	require.Zero(t, observedLogs.Len())
+}
+
+// memoryReportDB keeps the last replaced report in memory.
+type memoryReportDB struct {
+	summary ReportSummary
+	stats   []ClassStat
+}
+
+func (db *memoryReportDB) ReplaceReport(ctx context.Context, summary ReportSummary, stats []ClassStat) error {
+	db.summary, db.stats = summary, stats
+	return nil
+}
+
+func (db *memoryReportDB) GetReports(ctx context.Context) ([]ReportSummary, error) {
+	return []ReportSummary{db.summary}, nil
+}
+
+func (db *memoryReportDB) GetReport(ctx context.Context, class string) (ReportSummary, error) {
+	return db.summary, nil
+}
+
+func (db *memoryReportDB) GetWorstStats(ctx context.Context, class string, limit int) ([]ClassStat, error) {
+	return db.stats, nil
+}
+
 func TestBusFactor(t *testing.T) {
 	ctx := testcontext.New(t)
 	f := ObserverFork{}
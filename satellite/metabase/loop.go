@@ -23,6 +23,12 @@ type IterateLoopObjects struct {
 
 	AsOfSystemTime     time.Time
 	AsOfSystemInterval time.Duration
+
+	// StartProjectID and EndProjectID limit the iteration to the objects of the
+	// projects in the range [StartProjectID, EndProjectID). A zero EndProjectID
+	// doesn't limit the iteration.
+	StartProjectID uuid.UUID
+	EndProjectID   uuid.UUID
 }
 
 // Verify verifies get object request fields.
@@ -68,7 +74,8 @@ func (db *DB) IterateLoopObjects(ctx context.Context, opts IterateLoopObjects, f
 		batchSize: opts.BatchSize,
 
 		curIndex:           0,
-		cursor:             loopIterateCursor{},
+		cursor:             loopIterateCursor{ProjectID: opts.StartProjectID},
+		endProjectID:       opts.EndProjectID,
 		asOfSystemTime:     opts.AsOfSystemTime,
 		asOfSystemInterval: opts.AsOfSystemInterval,
 	}
@@ -98,9 +105,10 @@ type loopIterator struct {
 	asOfSystemTime     time.Time
 	asOfSystemInterval time.Duration
 
-	curIndex int
-	curRows  tagsql.Rows
-	cursor   loopIterateCursor
+	curIndex     int
+	curRows      tagsql.Rows
+	cursor       loopIterateCursor
+	endProjectID uuid.UUID
 
 	// failErr is set when either scan or next query fails during iteration.
 	failErr error
@@ -161,6 +169,18 @@ func (it *loopIterator) Next(ctx context.Context, item *LoopObjectEntry) bool {
 func (it *loopIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	args := []interface{}{
+		it.cursor.ProjectID, []byte(it.cursor.BucketName),
+		[]byte(it.cursor.ObjectKey), int(it.cursor.Version),
+		it.batchSize,
+	}
+
+	endCondition := ""
+	if !it.endProjectID.IsZero() {
+		endCondition = "AND project_id < $6"
+		args = append(args, it.endProjectID)
+	}
+
 	return it.db.db.QueryContext(ctx, `
 		SELECT
 			project_id, bucket_name,
@@ -172,11 +192,10 @@ func (it *loopIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows, err err
 		FROM objects
 		`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
 		WHERE (project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
+		`+endCondition+`
 		ORDER BY project_id ASC, bucket_name ASC, object_key ASC, version ASC
 		LIMIT $5
-		`, it.cursor.ProjectID, []byte(it.cursor.BucketName),
-		[]byte(it.cursor.ObjectKey), int(it.cursor.Version),
-		it.batchSize,
+		`, args...,
 	)
 }
 
@@ -229,6 +248,10 @@ type IterateLoopSegments struct {
 	AsOfSystemInterval time.Duration
 	StartStreamID      uuid.UUID
 	EndStreamID        uuid.UUID
+
+	// SkipPieces skips resolving AliasPieces into Pieces, which fails the
+	// iteration when a node alias doesn't exist.
+	SkipPieces bool
 }
 
 // Verify verifies segments request fields.
@@ -264,6 +287,7 @@ func (db *DB) IterateLoopSegments(ctx context.Context, opts IterateLoopSegments,
 		asOfSystemInterval: opts.AsOfSystemInterval,
 		batchSize:          opts.BatchSize,
 		batchPieces:        make([]Pieces, opts.BatchSize),
+		skipPieces:         opts.SkipPieces,
 
 		curIndex: 0,
 		cursor: loopSegmentIteratorCursor{
@@ -302,6 +326,7 @@ type loopSegmentIterator struct {
 	batchSize int
 	// batchPieces are reused between result pages to reduce memory consumption
 	batchPieces []Pieces
+	skipPieces  bool
 
 	asOfSystemTime     time.Time
 	asOfSystemInterval time.Duration
@@ -403,6 +428,11 @@ func (it *loopSegmentIterator) scanItem(ctx context.Context, item *LoopSegmentEn
 		return Error.New("failed to scan segments: %w", err)
 	}
 
+	if it.skipPieces {
+		item.Pieces = nil
+		return nil
+	}
+
 	// allocate new Pieces only if existing have not enough capacity
 	if cap(it.batchPieces[it.curIndex]) < len(item.AliasPieces) {
 		it.batchPieces[it.curIndex] = make(Pieces, len(item.AliasPieces))
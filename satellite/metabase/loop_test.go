@@ -265,6 +265,47 @@ func TestIterateLoopObjects(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("project range", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			projects := []uuid.UUID{}
+			for i := 0; i < 10; i++ {
+				p := testrand.UUID()
+				p[0] = byte(i)
+				projects = append(projects, p)
+			}
+			bucketNames := strings.Split("abcde", "")
+
+			expected := make([]metabase.LoopObjectEntry, 0, len(projects)*len(bucketNames))
+			for _, projectID := range projects {
+				for _, bucketName := range bucketNames {
+					rawObjects := createObjects(ctx, t, db, 1, projectID, bucketName)
+					for _, obj := range rawObjects {
+						expected = append(expected, loopObjectEntryFromRaw(obj))
+					}
+				}
+			}
+
+			start, end := uuid.UUID{3}, uuid.UUID{7}
+
+			metabasetest.IterateLoopObjects{
+				Opts: metabase.IterateLoopObjects{
+					BatchSize:      3,
+					StartProjectID: start,
+					EndProjectID:   end,
+				},
+				Result: expected[3*len(bucketNames) : 7*len(bucketNames)],
+			}.Check(ctx, t, db)
+
+			metabasetest.IterateLoopObjects{
+				Opts: metabase.IterateLoopObjects{
+					BatchSize:      3,
+					StartProjectID: end,
+				},
+				Result: expected[7*len(bucketNames):],
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("multiple projects multiple versions", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -96,7 +96,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "key length is too big, got %v, maximum allowed is %v", objectKeyLength, endpoint.config.MaxEncryptedObjectKeyLength)
 	}
 
-	err = endpoint.checkUploadLimits(ctx, keyInfo.ProjectID)
+	err = endpoint.checkUploadLimits(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Bucket)})
 	if err != nil {
 		return nil, err
 	}
@@ -506,6 +506,11 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Bucket)}
+	if err := endpoint.checkBucketBandwidthLimit(ctx, bucket); err != nil {
+		return nil, err
+	}
+
 	var object metabase.Object
 	if len(req.ObjectVersion) == 0 {
 		object, err = endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
@@ -598,6 +603,10 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			)
 		}
 
+		if err := endpoint.addToBucketBandwidthUsage(ctx, bucket, downloadSizes.encryptedSize); err != nil {
+			return nil, err
+		}
+
 		encryptedKeyNonce, err := storj.NonceFromBytes(segment.EncryptedKeyNonce)
 		if err != nil {
 			endpoint.log.Error("unable to get encryption key nonce from metadata", zap.Error(err))
@@ -1644,6 +1653,13 @@ func (endpoint *Endpoint) DeleteCommittedObject(
 		return nil, Error.Wrap(err)
 	}
 
+	var deletedSize, deletedSegments int64
+	for _, object := range result.Removed {
+		deletedSize += object.TotalEncryptedSize
+		deletedSegments += int64(object.SegmentCount)
+	}
+	endpoint.removeFromBucketUploadLimits(ctx, req.Bucket(), deletedSize, deletedSegments)
+
 	deletedObjects, err = endpoint.deleteObjectResultToProto(ctx, result)
 	if err != nil {
 		endpoint.log.Error("failed to convert delete object result",
@@ -1668,14 +1684,66 @@ func (endpoint *Endpoint) DeletePendingObject(ctx context.Context, stream metaba
 		ObjectStream: stream,
 	}
 
+	// pending objects don't have their totals yet, hence the usage of the
+	// uploaded segments is collected before they're deleted.
+	deletedSize, deletedSegments, err := endpoint.pendingObjectBucketUsage(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := endpoint.metabase.DeletePendingObject(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
+	endpoint.removeFromBucketUploadLimits(ctx, stream.Location().Bucket(), deletedSize, deletedSegments)
+
 	return endpoint.deleteObjectResultToProto(ctx, result)
 }
 
+// pendingObjectBucketUsage returns the storage and segments of the uploaded
+// segments of the pending object. It doesn't list the segments of buckets
+// without limits, because their usage isn't tracked.
+func (endpoint *Endpoint) pendingObjectBucketUsage(ctx context.Context, stream metabase.ObjectStream) (size int64, segmentCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	hasLimits, err := endpoint.projectUsage.HasBucketLimits(ctx, stream.Location().Bucket())
+	if err != nil {
+		// log it and continue, the only thing that will be affected is our
+		// per-bucket storage and segment limits.
+		endpoint.log.Error("Could not get the bucket's limits",
+			zap.Stringer("Project ID", stream.ProjectID),
+			zap.String("Bucket", stream.BucketName),
+			zap.Error(err),
+		)
+		return 0, 0, nil
+	}
+	if !hasLimits {
+		return 0, 0, nil
+	}
+
+	cursor := metabase.SegmentPosition{}
+	for {
+		result, err := endpoint.metabase.ListSegments(ctx, metabase.ListSegments{
+			StreamID: stream.StreamID,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, segment := range result.Segments {
+			size += int64(segment.EncryptedSize)
+			segmentCount++
+			cursor = segment.Position
+		}
+
+		if !result.More {
+			return size, segmentCount, nil
+		}
+	}
+}
+
 func (endpoint *Endpoint) deleteObjectResultToProto(ctx context.Context, result metabase.DeleteObjectResult) (deletedObjects []*pb.Object, err error) {
 	deletedObjects = make([]*pb.Object, 0, len(result.Removed)+len(result.Markers))
 	for _, object := range result.Removed {
@@ -2002,7 +2070,8 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
 		},
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
-			return endpoint.checkUploadLimitsForNewObject(ctx, keyInfo.ProjectID, encryptedObjectSize, nSegments)
+			newBucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.NewBucket)}
+			return endpoint.checkUploadLimitsForNewObject(ctx, newBucket, encryptedObjectSize, nSegments)
 		},
 	})
 	if err != nil {
@@ -2125,7 +2194,8 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		NewDisallowDelete: false,
 
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
-			return endpoint.addStorageUsageUpToLimit(ctx, keyInfo.ProjectID, encryptedObjectSize, nSegments)
+			newBucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.NewBucket)}
+			return endpoint.addStorageUsageUpToLimit(ctx, newBucket, encryptedObjectSize, nSegments)
 		},
 	})
 	if err != nil {
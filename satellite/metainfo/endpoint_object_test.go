@@ -2273,6 +2273,68 @@ func TestEndpoint_CopyObject(t *testing.T) {
 	})
 }
 
+func TestEndpoint_BucketLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		setLimits := func(bucketName string, limits buckets.Limits) {
+			require.NoError(t, uplink.CreateBucket(ctx, sat, bucketName))
+			require.NoError(t, sat.DB.Buckets().UpdateBucketLimits(ctx, []byte(bucketName), projectID, limits))
+		}
+		limit := func(value int64) *int64 { return &value }
+
+		setLimits("storage", buckets.Limits{Storage: limit(1000)})
+		setLimits("segments", buckets.Limits{Segments: limit(1)})
+		setLimits("bandwidth", buckets.Limits{Bandwidth: limit(1000)})
+		require.NoError(t, uplink.CreateBucket(ctx, sat, "unlimited"))
+
+		{ // storage limit
+			require.NoError(t, uplink.Upload(ctx, sat, "storage", "first", testrand.Bytes(1500)))
+
+			err := uplink.Upload(ctx, sat, "storage", "second", testrand.Bytes(10))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "Exceeded Bucket Storage Limit")
+		}
+
+		{ // segment limit
+			require.NoError(t, uplink.Upload(ctx, sat, "segments", "first", testrand.Bytes(10)))
+
+			err := uplink.Upload(ctx, sat, "segments", "second", testrand.Bytes(10))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "Exceeded Bucket Segments Limit")
+
+			// deleting the object frees its segment.
+			require.NoError(t, uplink.DeleteObject(ctx, sat, "segments", "first"))
+			require.NoError(t, uplink.Upload(ctx, sat, "segments", "second", testrand.Bytes(10)))
+		}
+
+		{ // bandwidth limit
+			require.NoError(t, uplink.Upload(ctx, sat, "bandwidth", "object", testrand.Bytes(1500)))
+
+			_, err := uplink.Download(ctx, sat, "bandwidth", "object")
+			require.NoError(t, err)
+
+			_, err = uplink.Download(ctx, sat, "bandwidth", "object")
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "Exceeded Bucket Usage Limit")
+		}
+
+		{ // the other buckets aren't limited
+			for i := 0; i < 3; i++ {
+				require.NoError(t, uplink.Upload(ctx, sat, "unlimited", "object"+strconv.Itoa(i), testrand.Bytes(1500)))
+			}
+			for i := 0; i < 3; i++ {
+				_, err := uplink.Download(ctx, sat, "unlimited", "object0")
+				require.NoError(t, err)
+			}
+		}
+	})
+}
+
 func TestEndpoint_ParallelDeletes(t *testing.T) {
 	t.Skip("to be fixed - creating deadlocks")
 	testplanet.Run(t, testplanet.Config{
@@ -4,10 +4,8 @@
 package metainfo
 
 import (
-	"bytes"
 	"context"
 	"crypto/subtle"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -34,10 +32,6 @@ import (
 
 const encryptedKeySize = 48
 
-var (
-	ipRegexp = regexp.MustCompile(`^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$`)
-)
-
 var ek = eventkit.Package()
 
 func getAPIKey(ctx context.Context, header *pb.RequestHeader) (key *macaroon.APIKey, err error) {
@@ -260,47 +254,7 @@ func (endpoint *Endpoint) validateBucketNameLength(bucket []byte) (err error) {
 }
 
 func (endpoint *Endpoint) validateBucketName(bucket []byte) error {
-	if err := endpoint.validateBucketNameLength(bucket); err != nil {
-		return err
-	}
-
-	// Regexp not used because benchmark shows it will be slower for valid bucket names
-	// https://gist.github.com/mniewrzal/49de3af95f36e63e88fac24f565e444c
-	labels := bytes.Split(bucket, []byte("."))
-	for _, label := range labels {
-		err := validateBucketLabel(label)
-		if err != nil {
-			return err
-		}
-	}
-
-	if ipRegexp.MatchString(string(bucket)) {
-		return Error.New("bucket name cannot be formatted as an IP address")
-	}
-
-	return nil
-}
-
-func validateBucketLabel(label []byte) error {
-	if len(label) == 0 {
-		return Error.New("bucket label cannot be empty")
-	}
-
-	if !isLowerLetter(label[0]) && !isDigit(label[0]) {
-		return Error.New("bucket label must start with a lowercase letter or number")
-	}
-
-	if !isLowerLetter(label[len(label)-1]) && !isDigit(label[len(label)-1]) {
-		return Error.New("bucket label must end with a lowercase letter or number")
-	}
-
-	for i := 1; i < len(label)-1; i++ {
-		if !isLowerLetter(label[i]) && !isDigit(label[i]) && (label[i] != '-') && (label[i] != '.') {
-			return Error.New("bucket name must contain only lowercase letters, numbers or hyphens")
-		}
-	}
-
-	return nil
+	return Error.Wrap(buckets.ValidateName(bucket))
 }
 
 func validateObjectVersion(version []byte) error {
@@ -310,14 +264,6 @@ func validateObjectVersion(version []byte) error {
 	return nil
 }
 
-func isLowerLetter(r byte) bool {
-	return r >= 'a' && r <= 'z'
-}
-
-func isDigit(r byte) bool {
-	return r >= '0' && r <= '9'
-}
-
 func (endpoint *Endpoint) validateRemoteSegment(ctx context.Context, commitRequest metabase.CommitSegment, originalLimits []*pb.OrderLimit) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -391,13 +337,14 @@ func (endpoint *Endpoint) validateRemoteSegment(ctx context.Context, commitReque
 	return nil
 }
 
-func (endpoint *Endpoint) checkUploadLimits(ctx context.Context, projectID uuid.UUID) error {
-	return endpoint.checkUploadLimitsForNewObject(ctx, projectID, 1, 1)
+func (endpoint *Endpoint) checkUploadLimits(ctx context.Context, bucket metabase.BucketLocation) error {
+	return endpoint.checkUploadLimitsForNewObject(ctx, bucket, 1, 1)
 }
 
 func (endpoint *Endpoint) checkUploadLimitsForNewObject(
-	ctx context.Context, projectID uuid.UUID, newObjectSize int64, newObjectSegmentCount int64,
+	ctx context.Context, bucket metabase.BucketLocation, newObjectSize int64, newObjectSegmentCount int64,
 ) error {
+	projectID := bucket.ProjectID
 	if limit, err := endpoint.projectUsage.ExceedsUploadLimits(ctx, projectID, newObjectSize, newObjectSegmentCount); err != nil {
 		if errs2.IsCanceled(err) {
 			return rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -426,14 +373,52 @@ func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 		}
 	}
 
+	return endpoint.checkBucketUploadLimits(ctx, bucket, newObjectSize, newObjectSegmentCount)
+}
+
+func (endpoint *Endpoint) checkBucketUploadLimits(
+	ctx context.Context, bucket metabase.BucketLocation, newObjectSize int64, newObjectSegmentCount int64,
+) error {
+	if limit, err := endpoint.projectUsage.ExceedsBucketUploadLimits(ctx, bucket, newObjectSize, newObjectSegmentCount); err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving bucket upload limit failed; limit won't be enforced",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+			zap.Error(err),
+		)
+	} else {
+		if limit.ExceedsSegments {
+			endpoint.log.Warn("Bucket segment limit exceeded",
+				zap.String("Limit", strconv.Itoa(int(limit.SegmentsLimit))),
+				zap.Stringer("Project ID", bucket.ProjectID),
+				zap.String("Bucket", bucket.BucketName),
+			)
+			return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Segments Limit")
+		}
+
+		if limit.ExceedsStorage {
+			endpoint.log.Warn("Bucket storage limit exceeded",
+				zap.String("Limit", strconv.Itoa(limit.StorageLimit.Int())),
+				zap.Stringer("Project ID", bucket.ProjectID),
+				zap.String("Bucket", bucket.BucketName),
+			)
+			return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Storage Limit")
+		}
+	}
+
 	return nil
 }
 
-func (endpoint *Endpoint) addSegmentToUploadLimits(ctx context.Context, projectID uuid.UUID, segmentSize int64) error {
-	return endpoint.addToUploadLimits(ctx, projectID, segmentSize, 1)
+func (endpoint *Endpoint) addSegmentToUploadLimits(ctx context.Context, bucket metabase.BucketLocation, segmentSize int64) error {
+	return endpoint.addToUploadLimits(ctx, bucket, segmentSize, 1)
 }
 
-func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, projectID uuid.UUID, size int64, segmentCount int64) error {
+func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, bucket metabase.BucketLocation, size int64, segmentCount int64) error {
+	projectID := bucket.ProjectID
 	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, projectID, size); err != nil {
 		if errs2.IsCanceled(err) {
 			return rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -464,10 +449,56 @@ func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, projectID uuid.
 		)
 	}
 
+	return endpoint.addToBucketUploadLimits(ctx, bucket, size, segmentCount)
+}
+
+func (endpoint *Endpoint) addToBucketUploadLimits(ctx context.Context, bucket metabase.BucketLocation, size int64, segmentCount int64) error {
+	if err := endpoint.projectUsage.AddBucketUsage(ctx, bucket, size, segmentCount); err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		// log it and continue. it's most likely our own fault that we couldn't
+		// track it, and the only thing that will be affected is our per-bucket
+		// storage and segment limits.
+		endpoint.log.Error("Could not track the bucket's storage and segment usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+			zap.Error(err),
+		)
+	}
+
 	return nil
 }
 
-func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, projectID uuid.UUID, storage int64, segments int64) (err error) {
+// removeFromBucketUploadLimits removes the storage and segments of deleted
+// objects from the bucket usage. Failures are only logged, because the objects
+// are already deleted.
+func (endpoint *Endpoint) removeFromBucketUploadLimits(ctx context.Context, bucket metabase.BucketLocation, size int64, segmentCount int64) {
+	if size == 0 && segmentCount == 0 {
+		return
+	}
+
+	if err := endpoint.projectUsage.RemoveBucketUsage(ctx, bucket, size, segmentCount); err != nil {
+		// the counters are seeded again from the tally the next day, the only
+		// thing that will be affected until then is our per-bucket storage and
+		// segment limits.
+		endpoint.log.Error("Could not remove deleted objects from the bucket's storage and segment usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+			zap.Error(err),
+		)
+	}
+}
+
+func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, bucket metabase.BucketLocation, storage int64, segments int64) (err error) {
+	// bucket limits aren't enforced atomically, hence they're checked before
+	// the project usage is increased.
+	if err := endpoint.checkBucketUploadLimits(ctx, bucket, storage, segments); err != nil {
+		return err
+	}
+
+	projectID := bucket.ProjectID
 	err = endpoint.projectUsage.AddProjectUsageUpToLimit(ctx, projectID, storage, segments)
 
 	if err != nil {
@@ -490,6 +521,49 @@ func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, projectI
 		)
 	}
 
+	return endpoint.addToBucketUploadLimits(ctx, bucket, storage, segments)
+}
+
+func (endpoint *Endpoint) checkBucketBandwidthLimit(ctx context.Context, bucket metabase.BucketLocation) error {
+	if exceeded, limit, err := endpoint.projectUsage.ExceedsBucketBandwidthUsage(ctx, bucket); err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving bucket bandwidth total failed; bandwidth limit won't be enforced",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+			zap.Error(err),
+		)
+	} else if exceeded {
+		endpoint.log.Warn("Monthly bucket bandwidth limit exceeded",
+			zap.Stringer("Limit", limit),
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Usage Limit")
+	}
+
+	return nil
+}
+
+func (endpoint *Endpoint) addToBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, size int64) error {
+	if err := endpoint.projectUsage.UpdateBucketBandwidthUsage(ctx, bucket, size); err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		// log it and continue. it's most likely our own fault that we couldn't
+		// track it, and the only thing that will be affected is our per-bucket
+		// bandwidth limits.
+		endpoint.log.Error("Could not track the bucket's bandwidth usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.String("Bucket", bucket.BucketName),
+			zap.Error(err),
+		)
+	}
+
 	return nil
 }
 
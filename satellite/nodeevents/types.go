@@ -25,6 +25,8 @@ const (
 	OfflineUnsuspended Type = 6
 	// BelowMinVersion indicates that the node's software is below the minimum version.
 	BelowMinVersion Type = 7
+	// MaintenanceScheduled indicates that a planned maintenance window of the node was accepted.
+	MaintenanceScheduled Type = 8
 
 	onlineName                  = "online"
 	offlineName                 = "offline"
@@ -34,6 +36,7 @@ const (
 	offlineSuspendedName        = "offline suspended"
 	offlineUnsuspendedName      = "offline unsuspended"
 	belowMinVersionName         = "below minimum version"
+	maintenanceScheduledName    = "maintenance scheduled"
 )
 
 // Name returns the name of the node event Type.
@@ -55,6 +58,8 @@ func (t Type) Name() (name string, err error) {
 		name = offlineUnsuspendedName
 	case BelowMinVersion:
 		name = belowMinVersionName
+	case MaintenanceScheduled:
+		name = maintenanceScheduledName
 	default:
 		err = errs.New("invalid Type")
 	}
@@ -4,6 +4,7 @@
 package nodeselection
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -36,6 +37,25 @@ func (n NodeTags) FindBySignerAndName(signer storj.NodeID, name string) (NodeTag
 	return NodeTag{}, errs.New("tags not found")
 }
 
+// ExpiresTag is the name of the reserved tag, which holds the expiration time (unix seconds) of a signed tag set.
+// It's not stored as a node tag.
+const ExpiresTag = "_expires"
+
+// TagSetExpiration returns the expiration time of the tag set, or zero time if it never expires.
+func TagSetExpiration(tagSet *pb.NodeTagSet) (time.Time, error) {
+	for _, tag := range tagSet.Tags {
+		if tag.Name != ExpiresTag {
+			continue
+		}
+		expires, err := strconv.ParseInt(string(tag.Value), 10, 64)
+		if err != nil {
+			return time.Time{}, errs.New("invalid %s tag value %q: %v", ExpiresTag, tag.Value, err)
+		}
+		return time.Unix(expires, 0), nil
+	}
+	return time.Time{}, nil
+}
+
 // SelectedNode is used as a result for creating orders limits.
 type SelectedNode struct {
 	ID          storj.NodeID
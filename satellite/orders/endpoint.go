@@ -22,6 +22,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/date"
+	"storj.io/storj/private/settlementpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeapiversion"
 )
@@ -48,6 +49,8 @@ type DB interface {
 	GetBucketBandwidth(ctx context.Context, projectID uuid.UUID, bucketName []byte, from, to time.Time) (int64, error)
 	// GetStorageNodeBandwidth gets total storage node bandwidth from period of time
 	GetStorageNodeBandwidth(ctx context.Context, nodeID storj.NodeID, from, to time.Time) (int64, error)
+	// GetStorageNodeSettledWindows gets the amounts settled by the storage node for the windows starting in [from, to)
+	GetStorageNodeSettledWindows(ctx context.Context, nodeID storj.NodeID, from, to time.Time) ([]SettledWindow, error)
 }
 
 type noopDB struct {
@@ -85,6 +88,10 @@ func (noopDB) GetStorageNodeBandwidth(ctx context.Context, nodeID storj.NodeID,
 	return 0, nil
 }
 
+func (noopDB) GetStorageNodeSettledWindows(ctx context.Context, nodeID storj.NodeID, from, to time.Time) ([]SettledWindow, error) {
+	return nil, nil
+}
+
 // NewNoopDB creates noop orders DB.
 func NewNoopDB() DB {
 	return &noopDB{}
@@ -142,6 +149,12 @@ type StoragenodeBandwidthRollup struct {
 	Settled   int64
 }
 
+// SettledWindow contains the amounts settled by a storage node for the orders created in an hour window.
+type SettledWindow struct {
+	Window        time.Time
+	ActionSettled map[int32]int64
+}
+
 // SortStoragenodeBandwidthRollups sorts the rollups.
 func SortStoragenodeBandwidthRollups(rollups []StoragenodeBandwidthRollup) {
 	sort.SliceStable(rollups, func(i, j int) bool {
@@ -161,6 +174,9 @@ func SortStoragenodeBandwidthRollups(rollups []StoragenodeBandwidthRollup) {
 	})
 }
 
+// maxSettledWindowsRange is the maximum time range of the settled windows returned to a storage node.
+const maxSettledWindowsRange = 31 * 24 * time.Hour
+
 // Endpoint for orders receiving.
 //
 // architecture: Endpoint
@@ -407,6 +423,38 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 	})
 }
 
+// SettledWindows returns the amounts settled for the requesting storage node, so it can
+// reconcile them with the orders it sent.
+func (endpoint *Endpoint) SettledWindows(ctx context.Context, req *settlementpb.SettledWindowsRequest) (_ *settlementpb.SettledWindowsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		endpoint.log.Debug("err peer identity from context", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	if !req.From.Before(req.To) || req.To.Sub(req.From) > maxSettledWindowsRange {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "invalid time range from %s to %s, it must not be longer than %s",
+			req.From.Format(time.RFC3339), req.To.Format(time.RFC3339), maxSettledWindowsRange)
+	}
+
+	windows, err := endpoint.DB.GetStorageNodeSettledWindows(ctx, peer.ID, req.From, req.To)
+	if err != nil {
+		endpoint.log.Error("err getting settled windows", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	response := &settlementpb.SettledWindowsResponse{}
+	for _, window := range windows {
+		response.Windows = append(response.Windows, &settlementpb.SettledWindow{
+			Window:        window.Window,
+			ActionSettled: window.ActionSettled,
+		})
+	}
+	return response, nil
+}
+
 func (endpoint *Endpoint) isValid(ctx context.Context, log *zap.Logger, order *pb.Order,
 	orderLimit *pb.OrderLimit, peerID storj.NodeID, window int64) bool {
 	if orderLimit.StorageNodeId != peerID {
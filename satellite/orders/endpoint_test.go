@@ -9,11 +9,14 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/errs2"
 	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/settlementpb"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
@@ -426,3 +429,69 @@ func TestSettlementWithWindowEndpointErrors(t *testing.T) {
 		}
 	})
 }
+
+func TestSettledWindowsEndpoint(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		ordersDB := satellite.Orders.DB
+		storagenode := planet.StorageNodes[0]
+		window1 := time.Now().Add(-2 * time.Hour).UTC().Truncate(time.Hour)
+		window2 := window1.Add(time.Hour)
+
+		settle := func(nodeID storj.NodeID, window time.Time, actionAmounts map[int32]int64) {
+			status, alreadyProcessed, err := ordersDB.UpdateStoragenodeBandwidthSettleWithWindow(ctx, nodeID, actionAmounts, window)
+			require.NoError(t, err)
+			require.False(t, alreadyProcessed)
+			require.Equal(t, pb.SettlementWithWindowResponse_ACCEPTED, status)
+		}
+		settle(storagenode.ID(), window1, map[int32]int64{
+			int32(pb.PieceAction_PUT): 100,
+			int32(pb.PieceAction_GET): 200,
+		})
+		settle(storagenode.ID(), window2, map[int32]int64{
+			int32(pb.PieceAction_GET_AUDIT): 300,
+		})
+		// the amounts of the other nodes are not returned.
+		settle(planet.StorageNodes[1].ID(), window1, map[int32]int64{
+			int32(pb.PieceAction_PUT): 400,
+		})
+
+		conn, err := storagenode.Dialer.DialNodeURL(ctx, storj.NodeURL{ID: satellite.ID(), Address: satellite.Addr()})
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := settlementpb.NewDRPCSettlementClient(conn)
+
+		resp, err := client.SettledWindows(ctx, &settlementpb.SettledWindowsRequest{
+			From: window1,
+			To:   window2.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Windows, 2)
+		require.True(t, window1.Equal(resp.Windows[0].Window))
+		require.Equal(t, map[int32]int64{
+			int32(pb.PieceAction_PUT): 100,
+			int32(pb.PieceAction_GET): 200,
+		}, resp.Windows[0].ActionSettled)
+		require.True(t, window2.Equal(resp.Windows[1].Window))
+		require.Equal(t, map[int32]int64{
+			int32(pb.PieceAction_GET_AUDIT): 300,
+		}, resp.Windows[1].ActionSettled)
+
+		// the end of the range is excluded.
+		resp, err = client.SettledWindows(ctx, &settlementpb.SettledWindowsRequest{
+			From: window1,
+			To:   window2,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Windows, 1)
+
+		// the range is too long.
+		_, err = client.SettledWindows(ctx, &settlementpb.SettledWindowsRequest{
+			From: window1.Add(-365 * 24 * time.Hour),
+			To:   window2,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
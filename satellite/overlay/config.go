@@ -30,6 +30,15 @@ type Config struct {
 	SendNodeEmails                  bool          `help:"whether to send emails to nodes" default:"false"`
 	MinimumNewNodeIDDifficulty      int           `help:"the minimum node id difficulty required for new nodes. existing nodes remain allowed" devDefault:"0" releaseDefault:"36"`
 	AsOfSystemTime                  time.Duration `help:"default AS OF SYSTEM TIME for service" default:"-10s" testDefault:"0"`
+	Maintenance                     MaintenanceConfig
+}
+
+// MaintenanceConfig limits the planned maintenance windows, which storage nodes can request.
+type MaintenanceConfig struct {
+	MaxDuration     time.Duration `help:"the maximum duration of a planned maintenance window" default:"12h"`
+	MaxLeadTime     time.Duration `help:"how far in the future a planned maintenance window may start" default:"168h"`
+	MaxClockSkew    time.Duration `help:"how far in the past a planned maintenance window may start, to tolerate clock differences of the nodes" default:"5m"`
+	WindowsPerMonth int           `help:"the maximum number of planned maintenance windows a node may start in a calendar month. 0 disables maintenance windows" default:"2"`
 }
 
 // AsOfSystemTimeConfig is a configuration struct to enable 'AS OF SYSTEM TIME' for CRDB queries.
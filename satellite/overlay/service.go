@@ -37,6 +37,9 @@ var ErrNodeDisqualified = errs.Class("node is disqualified")
 // ErrNodeFinishedGE is returned if a node has finished graceful exit.
 var ErrNodeFinishedGE = errs.Class("node finished graceful exit")
 
+// ErrMaintenanceNotAllowed is returned when a planned maintenance window is rejected.
+var ErrMaintenanceNotAllowed = errs.Class("maintenance not allowed")
+
 // ErrNotEnoughNodes is when selecting nodes failed with the given parameters.
 var ErrNotEnoughNodes = errs.Class("not enough nodes")
 
@@ -141,8 +144,19 @@ type DB interface {
 	// UpdateNodeTags insert (or refresh) node tags.
 	UpdateNodeTags(ctx context.Context, tags nodeselection.NodeTags) error
 
+	// ReplaceNodeTags replaces all tags of a node with the given tags.
+	ReplaceNodeTags(ctx context.Context, id storj.NodeID, tags nodeselection.NodeTags) error
 	// GetNodeTags returns all nodes for a specific node.
 	GetNodeTags(ctx context.Context, id storj.NodeID) (nodeselection.NodeTags, error)
+	// ListNodeTags returns the tags with the given name and signer. Empty name or zero signer matches all tags.
+	ListNodeTags(ctx context.Context, name string, signer storj.NodeID) (nodeselection.NodeTags, error)
+
+	// InsertMaintenanceWindow inserts a maintenance window. It returns false, if the node already has a window with the same start.
+	InsertMaintenanceWindow(ctx context.Context, window MaintenanceWindow) (inserted bool, err error)
+	// GetMaintenanceWindows returns the maintenance windows of the node, which end after the given time.
+	GetMaintenanceWindows(ctx context.Context, id storj.NodeID, endAfter time.Time) ([]MaintenanceWindow, error)
+	// GetNodesInMaintenance returns the nodes from the list, which are in a maintenance window at the given time.
+	GetNodesInMaintenance(ctx context.Context, ids storj.NodeIDList, at time.Time) (storj.NodeIDList, error)
 }
 
 // DisqualificationReason is disqualification reason enum type.
@@ -262,6 +276,15 @@ type NodeDossier struct {
 	CountryCode             location.CountryCode
 }
 
+// MaintenanceWindow is a planned maintenance of a node. During the window the node is not selected for
+// uploads, offline audits are not penalized and its pieces are considered healthy by the checker.
+type MaintenanceWindow struct {
+	NodeID    storj.NodeID
+	Start     time.Time
+	End       time.Time
+	CreatedAt time.Time
+}
+
 // NodeStats contains statistics about a node.
 type NodeStats struct {
 	Latency90          int64
@@ -718,6 +741,102 @@ func (service *Service) UpdateNodeTags(ctx context.Context, tags []nodeselection
 	return service.db.UpdateNodeTags(ctx, tags)
 }
 
+// ReplaceNodeTags replaces all tags of the node, tags which are not in the list are removed.
+func (service *Service) ReplaceNodeTags(ctx context.Context, id storj.NodeID, tags nodeselection.NodeTags) error {
+	return service.db.ReplaceNodeTags(ctx, id, tags)
+}
+
+// ScheduleMaintenance accepts a planned maintenance window of a node, if it's within the configured limits.
+// The window may start in the past only by the allowed clock skew. Sending the same window again is a no-op.
+func (service *Service) ScheduleMaintenance(ctx context.Context, nodeID storj.NodeID, start, end time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config := service.config.Maintenance
+	now := time.Now()
+
+	switch {
+	case config.WindowsPerMonth <= 0:
+		return ErrMaintenanceNotAllowed.New("maintenance windows are disabled")
+	case !end.After(start):
+		return ErrMaintenanceNotAllowed.New("end %s is not after start %s", end, start)
+	case end.Sub(start) > config.MaxDuration:
+		return ErrMaintenanceNotAllowed.New("duration %s is longer than %s", end.Sub(start), config.MaxDuration)
+	case start.Before(now.Add(-config.MaxClockSkew)):
+		return ErrMaintenanceNotAllowed.New("start %s is in the past", start)
+	case start.After(now.Add(config.MaxLeadTime)):
+		return ErrMaintenanceNotAllowed.New("start %s is more than %s in the future", start, config.MaxLeadTime)
+	}
+
+	start, end = start.UTC(), end.UTC()
+	monthStart := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+
+	windows, err := service.db.GetMaintenanceWindows(ctx, nodeID, monthStart)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	count := 0
+	for _, window := range windows {
+		if window.Start.Equal(start) {
+			return nil
+		}
+		// only the windows starting in the same month count into the limit.
+		if !window.Start.Before(monthStart) && window.Start.Before(monthEnd) {
+			count++
+		}
+	}
+	if count >= config.WindowsPerMonth {
+		return ErrMaintenanceNotAllowed.New("the node already scheduled %d maintenance windows in %s", count, monthStart.Format("2006-01"))
+	}
+
+	inserted, err := service.db.InsertMaintenanceWindow(ctx, MaintenanceWindow{
+		NodeID: nodeID,
+		Start:  start,
+		End:    end,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !inserted {
+		return nil
+	}
+
+	service.log.Info("planned maintenance scheduled",
+		zap.Stringer("Node ID", nodeID),
+		zap.Time("start", start),
+		zap.Time("end", end))
+	mon.Event("node_maintenance_scheduled")
+
+	if service.config.SendNodeEmails {
+		node, err := service.db.Get(ctx, nodeID)
+		if err != nil {
+			service.log.Error("could not get node to insert maintenance into node events", zap.Error(err))
+			return nil
+		}
+		_, err = service.nodeEvents.Insert(ctx, node.Operator.Email, nodeID, nodeevents.MaintenanceScheduled)
+		if err != nil {
+			service.log.Error("could not insert node maintenance into node events", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// GetMaintenanceWindows returns the current and upcoming maintenance windows of a node.
+func (service *Service) GetMaintenanceWindows(ctx context.Context, nodeID storj.NodeID) (_ []MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.db.GetMaintenanceWindows(ctx, nodeID, time.Now())
+}
+
+// GetNodesInMaintenance returns the nodes from the list, which are currently in a maintenance window.
+func (service *Service) GetNodesInMaintenance(ctx context.Context, nodeIDs storj.NodeIDList) (_ storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+	return service.db.GetNodesInMaintenance(ctx, nodeIDs, time.Now())
+}
+
 // GetNodeTags returns the node tags of a node.
 func (service *Service) GetNodeTags(ctx context.Context, id storj.NodeID) (nodeselection.NodeTags, error) {
 	return service.db.GetNodeTags(ctx, id)
@@ -1003,3 +1003,86 @@ func TestUpdateCheckInBelowMinVersionEvent(t *testing.T) {
 		require.True(t, ne2.CreatedAt.After(ne1.CreatedAt))
 	})
 }
+
+func TestScheduleMaintenance(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.SendNodeEmails = true
+				config.Overlay.Maintenance = overlay.MaintenanceConfig{
+					MaxDuration:     4 * time.Hour,
+					MaxLeadTime:     24 * time.Hour,
+					MaxClockSkew:    5 * time.Minute,
+					WindowsPerMonth: 2,
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		service := planet.Satellites[0].Overlay.Service
+		node := planet.StorageNodes[0]
+		other := planet.StorageNodes[1]
+
+		now := time.Now().UTC().Truncate(time.Second)
+
+		// too long, too far in the future and already started.
+		err := service.ScheduleMaintenance(ctx, node.ID(), now, now.Add(5*time.Hour))
+		require.True(t, overlay.ErrMaintenanceNotAllowed.Has(err))
+		err = service.ScheduleMaintenance(ctx, node.ID(), now.Add(48*time.Hour), now.Add(49*time.Hour))
+		require.True(t, overlay.ErrMaintenanceNotAllowed.Has(err))
+		err = service.ScheduleMaintenance(ctx, node.ID(), now.Add(-time.Hour), now.Add(time.Hour))
+		require.True(t, overlay.ErrMaintenanceNotAllowed.Has(err))
+
+		require.NoError(t, service.ScheduleMaintenance(ctx, node.ID(), now.Add(-time.Minute), now.Add(time.Hour)))
+		// sending the same window again is fine and doesn't count into the limit.
+		require.NoError(t, service.ScheduleMaintenance(ctx, node.ID(), now.Add(-time.Minute), now.Add(time.Hour)))
+
+		ne, err := planet.Satellites[0].DB.NodeEvents().GetLatestByEmailAndEvent(ctx, node.Config.Operator.Email, nodeevents.MaintenanceScheduled)
+		require.NoError(t, err)
+		require.Equal(t, node.ID(), ne.NodeID)
+
+		windows, err := service.GetMaintenanceWindows(ctx, node.ID())
+		require.NoError(t, err)
+		require.Len(t, windows, 1)
+		require.True(t, windows[0].Start.Equal(now.Add(-time.Minute)))
+
+		inMaintenance, err := service.GetNodesInMaintenance(ctx, storj.NodeIDList{node.ID(), other.ID()})
+		require.NoError(t, err)
+		require.Equal(t, storj.NodeIDList{node.ID()}, inMaintenance)
+
+		// limit of windows per month.
+		monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		// the windows of the next month don't count into the limit of this month.
+		for i := 0; i < 2; i++ {
+			nextMonth := monthStart.AddDate(0, 1, i)
+			_, err = planet.Satellites[0].DB.OverlayCache().InsertMaintenanceWindow(ctx, overlay.MaintenanceWindow{
+				NodeID: other.ID(),
+				Start:  nextMonth,
+				End:    nextMonth.Add(time.Second),
+			})
+			require.NoError(t, err)
+		}
+		if now.Add(2 * time.Hour).Before(monthStart.AddDate(0, 1, 0)) {
+			require.NoError(t, service.ScheduleMaintenance(ctx, other.ID(), now.Add(2*time.Hour), now.Add(3*time.Hour)))
+		}
+
+		_, err = planet.Satellites[0].DB.OverlayCache().InsertMaintenanceWindow(ctx, overlay.MaintenanceWindow{
+			NodeID: node.ID(),
+			Start:  monthStart,
+			End:    monthStart.Add(time.Second),
+		})
+		require.NoError(t, err)
+		err = service.ScheduleMaintenance(ctx, node.ID(), now.Add(2*time.Hour), now.Add(3*time.Hour))
+		if now.Add(2 * time.Hour).Before(monthStart.AddDate(0, 1, 0)) {
+			require.True(t, overlay.ErrMaintenanceNotAllowed.Has(err))
+		}
+
+		// the node in maintenance is not selected for uploads.
+		require.NoError(t, service.UploadSelectionCache.Refresh(ctx))
+		nodes, err := service.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{RequestedCount: 1})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		require.Equal(t, other.ID(), nodes[0].ID)
+	})
+}
@@ -933,6 +933,31 @@ func (m *mockdb) UpdateNodeTags(ctx context.Context, tags nodeselection.NodeTags
 	panic("implement me")
 }
 
+// ReplaceNodeTags satisfies nodeevents.DB interface.
+func (m *mockdb) ReplaceNodeTags(ctx context.Context, id storj.NodeID, tags nodeselection.NodeTags) error {
+	panic("implement me")
+}
+
+// ListNodeTags satisfies nodeevents.DB interface.
+func (m *mockdb) ListNodeTags(ctx context.Context, name string, signer storj.NodeID) (nodeselection.NodeTags, error) {
+	panic("implement me")
+}
+
+// InsertMaintenanceWindow satisfies nodeevents.DB interface.
+func (m *mockdb) InsertMaintenanceWindow(ctx context.Context, window overlay.MaintenanceWindow) (bool, error) {
+	panic("implement me")
+}
+
+// GetMaintenanceWindows satisfies nodeevents.DB interface.
+func (m *mockdb) GetMaintenanceWindows(ctx context.Context, id storj.NodeID, endAfter time.Time) ([]overlay.MaintenanceWindow, error) {
+	panic("implement me")
+}
+
+// GetNodesInMaintenance satisfies nodeevents.DB interface.
+func (m *mockdb) GetNodesInMaintenance(ctx context.Context, ids storj.NodeIDList, at time.Time) (storj.NodeIDList, error) {
+	panic("implement me")
+}
+
 // GetNodeTags satisfies nodeevents.DB interface.
 func (m *mockdb) GetNodeTags(ctx context.Context, id storj.NodeID) (nodeselection.NodeTags, error) {
 	panic("implement me")
@@ -0,0 +1,42 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellite
+
+import (
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/payments/offline"
+	"storj.io/storj/satellite/payments/paymentsconfig"
+)
+
+// NewOfflinePayments creates the offline payments service from the payments config.
+func NewOfflinePayments(log *zap.Logger, db DB, config paymentsconfig.Config) (*offline.Service, error) {
+	prices, err := config.UsagePrice.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	priceOverrides, err := config.UsagePriceOverrides.ToModels()
+	if err != nil {
+		return nil, err
+	}
+
+	placementPriceOverrides, err := config.PlacementPriceOverrides.ToModels()
+	if err != nil {
+		return nil, err
+	}
+
+	return offline.NewService(
+		log.Named("payments.offline:service"),
+		config.Offline,
+		db.OfflineInvoices(),
+		db.StripeCoinPayments().Customers(),
+		db.Console().Users(),
+		db.Console().Projects(),
+		db.ProjectAccounting(),
+		prices,
+		priceOverrides,
+		placementPriceOverrides,
+	), nil
+}
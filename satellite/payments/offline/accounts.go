@@ -0,0 +1,248 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package offline
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/stripe"
+)
+
+// ensures that accounts implements payments.Accounts.
+var _ payments.Accounts = (*accounts)(nil)
+
+// accounts is an implementation of payments.Accounts.
+//
+// architecture: Service
+type accounts struct {
+	service *Service
+}
+
+// Setup creates a payment account for the user.
+// If account is already set up it will return nil.
+func (accounts *accounts) Setup(ctx context.Context, userID uuid.UUID, email string, signupPromoCode string) (_ payments.CouponType, err error) {
+	defer mon.Task()(&ctx, userID, email)(&err)
+
+	_, err = accounts.service.customers.GetCustomerID(ctx, userID)
+	if err == nil {
+		return payments.NoCoupon, nil
+	}
+	if !errors.Is(err, stripe.ErrNoCustomer) {
+		return payments.NoCoupon, Error.Wrap(err)
+	}
+
+	// the customer entry links invoices to users, e.g. for the account freeze chore.
+	return payments.NoCoupon, Error.Wrap(accounts.service.customers.Insert(ctx, userID, CustomerID(userID)))
+}
+
+// UpdatePackage updates a customer's package plan information.
+func (accounts *accounts) UpdatePackage(ctx context.Context, userID uuid.UUID, packagePlan *string, timestamp *time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = accounts.service.customers.UpdatePackage(ctx, userID, packagePlan, timestamp)
+	return Error.Wrap(err)
+}
+
+// GetPackageInfo returns the package plan and time of purchase for a user.
+func (accounts *accounts) GetPackageInfo(ctx context.Context, userID uuid.UUID) (packagePlan *string, purchaseTime *time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	packagePlan, purchaseTime, err = accounts.service.customers.GetPackageInfo(ctx, userID)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	return packagePlan, purchaseTime, nil
+}
+
+// Balances exposes functionality to manage account balances.
+func (accounts *accounts) Balances() payments.Balances {
+	return &balances{}
+}
+
+// ProjectCharges returns how much money current user will be charged for each project.
+func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID, since, before time.Time) (charges payments.ProjectChargesResponse, err error) {
+	defer mon.Task()(&ctx, userID, since, before)(&err)
+
+	charges = make(payments.ProjectChargesResponse)
+
+	projects, err := accounts.service.projectsDB.GetOwn(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the volume tiers are applied once to all projects of the user.
+	tiers := payments.NewTierUsage()
+
+	for _, project := range projects {
+		usages, err := accounts.service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, project.ID, accounts.service.partnerNames, since, before)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		charges[project.PublicID] = payments.ProjectUsageCharges(usages, since, before, accounts.service.priceModel, tiers)
+	}
+
+	return charges, nil
+}
+
+// GetProjectUsagePriceModel returns the project usage price model for a partner name.
+func (accounts *accounts) GetProjectUsagePriceModel(partner string) payments.ProjectUsagePriceModel {
+	return accounts.service.priceModel(partner)
+}
+
+// CheckProjectInvoicingStatus returns error if the project had usage during the previous month,
+// which has not been invoiced yet.
+func (accounts *accounts) CheckProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	year, month, _ := accounts.service.nowFn().UTC().Date()
+	firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	return accounts.checkBilled(ctx, projectID, firstOfMonth.AddDate(0, -1, 0), firstOfMonth)
+}
+
+// CheckProjectUsageStatus returns error if for the given project there is some usage for current or previous month.
+func (accounts *accounts) CheckProjectUsageStatus(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	year, month, _ := accounts.service.nowFn().UTC().Date()
+	firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	// check current month usage and do not allow deletion if usage exists
+	currentUsage, err := accounts.service.usageDB.GetProjectTotal(ctx, projectID, firstOfMonth, accounts.service.nowFn())
+	if err != nil {
+		return err
+	}
+	if currentUsage.Storage > 0 || currentUsage.Egress > 0 || currentUsage.SegmentCount > 0 {
+		return Error.New("usage for current month exists")
+	}
+
+	return accounts.checkBilled(ctx, projectID, firstOfMonth.AddDate(0, -1, 0), firstOfMonth)
+}
+
+// checkBilled returns an error if the project had usage in the period, but its owner has no invoice for it.
+func (accounts *accounts) checkBilled(ctx context.Context, projectID uuid.UUID, start, end time.Time) error {
+	usage, err := accounts.service.usageDB.GetProjectTotal(ctx, projectID, start, end)
+	if err != nil {
+		return err
+	}
+	if usage.Storage == 0 && usage.Egress == 0 && usage.SegmentCount == 0 {
+		return nil
+	}
+
+	project, err := accounts.service.projectsDB.Get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	billed, err := accounts.service.usagePeriodBilled(ctx, project.OwnerID, start)
+	if err != nil {
+		return err
+	}
+	if !billed {
+		return Error.New("usage for last month exist, but is not billed yet")
+	}
+	return nil
+}
+
+// Charges returns list of all credit card charges related to account.
+func (accounts *accounts) Charges(ctx context.Context, userID uuid.UUID) ([]payments.Charge, error) {
+	return nil, nil
+}
+
+// CreditCards exposes all needed functionality to manage account credit cards.
+func (accounts *accounts) CreditCards() payments.CreditCards {
+	return &creditCards{}
+}
+
+// StorjTokens exposes all storj token related functionality.
+func (accounts *accounts) StorjTokens() payments.StorjTokens {
+	return &storjTokens{}
+}
+
+// Invoices exposes all needed functionality to manage account invoices.
+func (accounts *accounts) Invoices() payments.Invoices {
+	return accounts.service.Invoices()
+}
+
+// Coupons exposes all needed functionality to manage coupons.
+func (accounts *accounts) Coupons() payments.Coupons {
+	return &coupons{}
+}
+
+// balances is an implementation of payments.Balances without any balance.
+type balances struct{}
+
+func (*balances) ApplyCredit(ctx context.Context, userID uuid.UUID, amount int64, desc string) (*payments.Balance, error) {
+	return nil, ErrUnsupported.New("balance credits")
+}
+
+func (*balances) Get(ctx context.Context, userID uuid.UUID) (payments.Balance, error) {
+	return payments.Balance{}, nil
+}
+
+func (*balances) ListTransactions(ctx context.Context, userID uuid.UUID) ([]payments.BalanceTransaction, error) {
+	return nil, nil
+}
+
+// creditCards is an implementation of payments.CreditCards without any credit cards.
+type creditCards struct{}
+
+func (*creditCards) List(ctx context.Context, userID uuid.UUID) ([]payments.CreditCard, error) {
+	return nil, nil
+}
+
+func (*creditCards) Add(ctx context.Context, userID uuid.UUID, cardToken string) (payments.CreditCard, error) {
+	return payments.CreditCard{}, ErrUnsupported.New("credit cards")
+}
+
+func (*creditCards) AddByPaymentMethodID(ctx context.Context, userID uuid.UUID, pmID string) (payments.CreditCard, error) {
+	return payments.CreditCard{}, ErrUnsupported.New("credit cards")
+}
+
+func (*creditCards) Remove(ctx context.Context, userID uuid.UUID, cardID string) error {
+	return ErrUnsupported.New("credit cards")
+}
+
+func (*creditCards) RemoveAll(ctx context.Context, userID uuid.UUID) error {
+	return nil
+}
+
+func (*creditCards) MakeDefault(ctx context.Context, userID uuid.UUID, cardID string) error {
+	return ErrUnsupported.New("credit cards")
+}
+
+// storjTokens is an implementation of payments.StorjTokens without any transactions.
+type storjTokens struct{}
+
+func (*storjTokens) ListTransactionInfos(ctx context.Context, userID uuid.UUID) ([]payments.TransactionInfo, error) {
+	return nil, nil
+}
+
+func (*storjTokens) ListDepositBonuses(ctx context.Context, userID uuid.UUID) ([]payments.DepositBonus, error) {
+	return nil, nil
+}
+
+// coupons is an implementation of payments.Coupons without any coupons.
+type coupons struct{}
+
+func (*coupons) GetByUserID(ctx context.Context, userID uuid.UUID) (*payments.Coupon, error) {
+	return nil, nil
+}
+
+func (*coupons) ApplyFreeTierCoupon(ctx context.Context, userID uuid.UUID) (*payments.Coupon, error) {
+	return nil, ErrUnsupported.New("coupons")
+}
+
+func (*coupons) ApplyCoupon(ctx context.Context, userID uuid.UUID, couponID string) (*payments.Coupon, error) {
+	return nil, ErrUnsupported.New("coupons")
+}
+
+func (*coupons) ApplyCouponCode(ctx context.Context, userID uuid.UUID, couponCode string) (*payments.Coupon, error) {
+	return nil, ErrUnsupported.New("coupons")
+}
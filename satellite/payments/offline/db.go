@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package offline
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrInvoiceExists is returned when an invoice for the same user and period is already stored.
+var ErrInvoiceExists = errs.Class("offline invoice already exists")
+
+// ErrInvoiceNotFound is returned when an invoice does not exist.
+var ErrInvoiceNotFound = errs.Class("offline invoice not found")
+
+// DB contains the invoices generated by the offline payments provider.
+//
+// architecture: Database
+type DB interface {
+	// Insert stores a new invoice. It returns ErrInvoiceExists if the user already has an invoice
+	// for the same period.
+	Insert(ctx context.Context, invoice Invoice) error
+	// Get returns the invoice with the given id.
+	Get(ctx context.Context, id uuid.UUID) (Invoice, error)
+	// ListByUser returns all invoices of a user, newest period first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Invoice, error)
+	// ListOverdue returns open invoices with a due date before the given time.
+	// When userID is not nil, only the invoices of that user are returned.
+	ListOverdue(ctx context.Context, userID *uuid.UUID, before time.Time) ([]Invoice, error)
+	// MarkPaid marks an open invoice as paid.
+	MarkPaid(ctx context.Context, id uuid.UUID, paidAt time.Time, reference string) (Invoice, error)
+	// Delete removes an invoice which has not been paid.
+	Delete(ctx context.Context, id uuid.UUID) (Invoice, error)
+}
+
+// Invoice is an invoice generated and stored by the satellite.
+type Invoice struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"userId"`
+
+	PeriodStart time.Time `json:"periodStart"`
+	PeriodEnd   time.Time `json:"periodEnd"`
+
+	Description string     `json:"description"`
+	Amount      int64      `json:"amount"`
+	Status      string     `json:"status"`
+	LineItems   []LineItem `json:"lineItems"`
+
+	DueDate          time.Time  `json:"dueDate"`
+	PaidAt           *time.Time `json:"paidAt,omitempty"`
+	PaymentReference string     `json:"paymentReference,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+// LineItem is a single charge of an invoice.
+type LineItem struct {
+	Description string          `json:"description"`
+	Quantity    int64           `json:"quantity"`
+	UnitCents   decimal.Decimal `json:"unitCents"`
+	Amount      int64           `json:"amount"`
+}
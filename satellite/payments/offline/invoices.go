@@ -0,0 +1,246 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package offline
+
+import (
+	"context"
+	"io"
+
+	"github.com/shopspring/decimal"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+)
+
+// ManualInvoices extends payments.Invoices with the operations needed for invoices,
+// which are paid outside of the satellite.
+type ManualInvoices interface {
+	payments.Invoices
+
+	// MarkPaid marks an open invoice as paid. reference describes the received payment.
+	MarkPaid(ctx context.Context, invoiceID, reference string) (*payments.Invoice, error)
+	// Render writes the invoice in the given format to w.
+	Render(ctx context.Context, w io.Writer, invoiceID string, format Format) error
+}
+
+// ensures that invoices implements ManualInvoices.
+var _ ManualInvoices = (*invoices)(nil)
+
+// invoices is an implementation of payments.Invoices.
+//
+// architecture: Service
+type invoices struct {
+	service *Service
+}
+
+// Create creates an invoice with price and description.
+func (invoices *invoices) Create(ctx context.Context, userID uuid.UUID, price int64, desc string) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	now := invoices.service.nowFn().UTC()
+	invoice := Invoice{
+		ID:          id,
+		UserID:      userID,
+		PeriodStart: now,
+		PeriodEnd:   now,
+		Description: desc,
+		Amount:      price,
+		Status:      payments.InvoiceStatusOpen,
+		LineItems: []LineItem{{
+			Description: desc,
+			Quantity:    1,
+			UnitCents:   decimal.NewFromInt(price),
+			Amount:      price,
+		}},
+		DueDate: now.AddDate(0, 0, invoices.service.config.DueDays),
+	}
+	if err := invoices.service.db.Insert(ctx, invoice); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	result := convertInvoice(invoice)
+	return &result, nil
+}
+
+// Get returns an invoice by invoiceID.
+func (invoices *invoices) Get(ctx context.Context, invoiceID string) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoice, err := invoices.service.get(ctx, invoiceID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	result := convertInvoice(invoice)
+	return &result, nil
+}
+
+// Pay is not supported, offline invoices are paid outside of the satellite and marked as paid by an administrator.
+func (invoices *invoices) Pay(ctx context.Context, invoiceID, paymentMethodID string) (*payments.Invoice, error) {
+	return nil, ErrUnsupported.New("paying invoices")
+}
+
+// MarkPaid marks an open invoice as paid. reference describes the received payment.
+func (invoices *invoices) MarkPaid(ctx context.Context, invoiceID, reference string) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoice, err := invoices.service.markPaid(ctx, invoiceID, reference)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	result := convertInvoice(invoice)
+	return &result, nil
+}
+
+// Render writes the invoice in the given format to w.
+func (invoices *invoices) Render(ctx context.Context, w io.Writer, invoiceID string, format Format) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoice, err := invoices.service.get(ctx, invoiceID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	user, err := invoices.service.usersDB.Get(ctx, invoice.UserID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(Render(w, format, invoices.service.config, user.Email, invoice))
+}
+
+// List returns a list of invoices for a given payment account.
+func (invoices *invoices) List(ctx context.Context, userID uuid.UUID) (_ []payments.Invoice, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	stored, err := invoices.service.db.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list := make([]payments.Invoice, 0, len(stored))
+	for _, invoice := range stored {
+		list = append(list, convertInvoice(invoice))
+	}
+	return list, nil
+}
+
+// ListPaged returns a paged list of invoices.
+func (invoices *invoices) ListPaged(ctx context.Context, userID uuid.UUID, cursor payments.InvoiceCursor) (_ *payments.InvoicePage, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	all, err := invoices.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := 0, len(all)
+	for i, invoice := range all {
+		if cursor.StartingAfter != "" && invoice.ID == cursor.StartingAfter {
+			start = i + 1
+		}
+		if cursor.EndingBefore != "" && invoice.ID == cursor.EndingBefore {
+			end = i
+		}
+	}
+	if cursor.Limit > 0 {
+		if cursor.EndingBefore != "" && end-cursor.Limit > start {
+			start = end - cursor.Limit
+		}
+		if cursor.EndingBefore == "" && start+cursor.Limit < end {
+			end = start + cursor.Limit
+		}
+	}
+	if start > end {
+		start = end
+	}
+
+	return &payments.InvoicePage{
+		Invoices: all[start:end],
+		Next:     end < len(all),
+		Previous: start > 0,
+	}, nil
+}
+
+// ListFailed returns a list of open invoices, which are past their due date.
+func (invoices *invoices) ListFailed(ctx context.Context, userID *uuid.UUID) (_ []payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	overdue, err := invoices.service.db.ListOverdue(ctx, userID, invoices.service.nowFn())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var list []payments.Invoice
+	for _, invoice := range overdue {
+		list = append(list, convertInvoice(invoice))
+	}
+	return list, nil
+}
+
+// ListWithDiscounts returns a list of invoices and coupon usages for a given payment account.
+func (invoices *invoices) ListWithDiscounts(ctx context.Context, userID uuid.UUID) (_ []payments.Invoice, _ []payments.CouponUsage, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	list, err := invoices.List(ctx, userID)
+	return list, nil, err
+}
+
+// CheckPendingItems returns if pending invoice items for a given payment account exist.
+// Offline invoices are created with all their items, so there are never pending items.
+func (invoices *invoices) CheckPendingItems(ctx context.Context, userID uuid.UUID) (existingItems bool, err error) {
+	return false, nil
+}
+
+// AttemptPayOverdueInvoices returns an error when the user has overdue invoices, since they
+// can only be paid outside of the satellite.
+func (invoices *invoices) AttemptPayOverdueInvoices(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	return invoices.checkOverdue(ctx, userID)
+}
+
+// AttemptPayOverdueInvoicesWithTokens returns an error when the user has overdue invoices, since they
+// can only be paid outside of the satellite.
+func (invoices *invoices) AttemptPayOverdueInvoicesWithTokens(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	return invoices.checkOverdue(ctx, userID)
+}
+
+func (invoices *invoices) checkOverdue(ctx context.Context, userID uuid.UUID) error {
+	overdue, err := invoices.service.db.ListOverdue(ctx, &userID, invoices.service.nowFn())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(overdue) > 0 {
+		return Error.New("%d overdue invoices have to be paid manually", len(overdue))
+	}
+	return nil
+}
+
+// Delete deletes an invoice which has not been paid.
+func (invoices *invoices) Delete(ctx context.Context, id string) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoiceID, err := uuid.FromString(id)
+	if err != nil {
+		return nil, Error.Wrap(ErrInvoiceNotFound.Wrap(err))
+	}
+
+	invoice, err := invoices.service.db.Delete(ctx, invoiceID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	result := convertInvoice(invoice)
+	result.Status = payments.InvoiceStatusVoid
+	return &result, nil
+}
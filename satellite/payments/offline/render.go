@@ -0,0 +1,218 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package offline
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Format is the format an invoice is rendered in.
+type Format string
+
+const (
+	// FormatJSON renders the invoice as JSON document.
+	FormatJSON Format = "json"
+	// FormatCSV renders the line items of the invoice as CSV.
+	FormatCSV Format = "csv"
+	// FormatPDF renders the invoice as printable PDF document.
+	FormatPDF Format = "pdf"
+)
+
+// ParseFormat parses an invoice format name.
+func ParseFormat(s string) (Format, error) {
+	switch format := Format(strings.ToLower(s)); format {
+	case FormatJSON, FormatCSV, FormatPDF:
+		return format, nil
+	default:
+		return "", Error.New("unknown invoice format %q", s)
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (format Format) ContentType() string {
+	switch format {
+	case FormatCSV:
+		return "text/csv"
+	case FormatPDF:
+		return "application/pdf"
+	default:
+		return "application/json"
+	}
+}
+
+// Render writes the invoice of the user with the given email in the given format to w.
+func Render(w io.Writer, format Format, config Config, email string, invoice Invoice) error {
+	switch format {
+	case FormatJSON:
+		return renderJSON(w, email, invoice)
+	case FormatCSV:
+		return renderCSV(w, invoice)
+	case FormatPDF:
+		return renderPDF(w, config, email, invoice)
+	default:
+		return Error.New("unknown invoice format %q", format)
+	}
+}
+
+func renderJSON(w io.Writer, email string, invoice Invoice) error {
+	return json.NewEncoder(w).Encode(struct {
+		Invoice
+		Email string `json:"email"`
+	}{invoice, email})
+}
+
+func renderCSV(w io.Writer, invoice Invoice) error {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{"invoice", "description", "quantity", "unit_cents", "amount_cents"}); err != nil {
+		return err
+	}
+	for _, item := range invoice.LineItems {
+		err := out.Write([]string{
+			invoice.ID.String(),
+			item.Description,
+			strconv.FormatInt(item.Quantity, 10),
+			item.UnitCents.String(),
+			strconv.FormatInt(item.Amount, 10),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := out.Write([]string{invoice.ID.String(), "Total", "", "", strconv.FormatInt(invoice.Amount, 10)}); err != nil {
+		return err
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// renderPDF writes a plain single font PDF document, which is good enough for printing
+// and archiving without depending on a PDF library.
+func renderPDF(w io.Writer, config Config, email string, invoice Invoice) error {
+	var lines []string
+	if config.IssuerName != "" {
+		lines = append(lines, config.IssuerName)
+	}
+	lines = append(lines, splitLines(config.IssuerAddress)...)
+	lines = append(lines,
+		"",
+		"INVOICE "+invoice.ID.String(),
+		"",
+		"Bill to:     "+email,
+		"Description: "+invoice.Description,
+		"Period:      "+invoice.PeriodStart.Format("2006-01-02")+" - "+invoice.PeriodEnd.Format("2006-01-02"),
+		"Due date:    "+invoice.DueDate.Format("2006-01-02"),
+		"Status:      "+invoice.Status,
+		"",
+		fmt.Sprintf("%-64s %12s %12s", "Item", "Quantity", "Amount"),
+		strings.Repeat("-", 90),
+	)
+	for _, item := range invoice.LineItems {
+		lines = append(lines, fmt.Sprintf("%-64s %12d %12s", item.Description, item.Quantity, formatCents(item.Amount)))
+	}
+	lines = append(lines,
+		strings.Repeat("-", 90),
+		fmt.Sprintf("%-64s %12s %12s", "Total", "", formatCents(invoice.Amount)),
+	)
+	if invoice.PaidAt != nil {
+		lines = append(lines, "", "Paid on "+invoice.PaidAt.Format("2006-01-02")+" "+invoice.PaymentReference)
+	} else if details := splitLines(config.PaymentDetails); len(details) > 0 {
+		lines = append(lines, "", "Payment details:")
+		lines = append(lines, details...)
+	}
+
+	const linesPerPage = 60
+	var pages [][]string
+	for len(lines) > linesPerPage {
+		pages = append(pages, lines[:linesPerPage])
+		lines = lines[linesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	return writePDF(w, pages)
+}
+
+// writePDF writes a PDF document with one page per entry of pages using a monospace font.
+func writePDF(w io.Writer, pages [][]string) error {
+	var buf bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// objects 1 and 2 are the catalog and the page tree, object 3 is the font,
+	// and every page consists of a page object followed by its content stream.
+	var kids []string
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier >>")
+
+	for i, page := range pages {
+		var content bytes.Buffer
+		content.WriteString("BT\n/F1 8 Tf\n10 TL\n40 800 Td\n")
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) Tj T*\n", escapePDF(line))
+		}
+		content.WriteString("ET")
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", 5+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// escapePDF escapes a string to be used as PDF string literal. Characters
+// outside of printable ASCII are replaced, since the standard fonts can't show them.
+func escapePDF(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, `\n`, "\n"), "\n")
+}
+
+// formatCents formats an amount in cents as dollars.
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s$%d.%02d", sign, cents/100, cents%100)
+}
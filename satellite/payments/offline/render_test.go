@@ -0,0 +1,118 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package offline_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/offline"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		format offline.Format
+		err    bool
+	}{
+		{name: "json", format: offline.FormatJSON},
+		{name: "CSV", format: offline.FormatCSV},
+		{name: "pdf", format: offline.FormatPDF},
+		{name: "xml", err: true},
+		{name: "", err: true},
+	} {
+		format, err := offline.ParseFormat(tt.name)
+		if tt.err {
+			require.Error(t, err, tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.format, format, tt.name)
+	}
+}
+
+func TestRender(t *testing.T) {
+	periodStart := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+	invoice := offline.Invoice{
+		ID:          testrand.UUID(),
+		UserID:      testrand.UUID(),
+		PeriodStart: periodStart,
+		PeriodEnd:   periodStart.AddDate(0, 1, 0),
+		Description: "Storj DCS usage for April 2023",
+		Amount:      1234,
+		Status:      payments.InvoiceStatusOpen,
+		LineItems: []offline.LineItem{
+			{Description: "Project (storage)", Quantity: 1000, UnitCents: decimal.NewFromFloat(0.4), Amount: 400},
+			{Description: "Project (egress)", Quantity: 1000, UnitCents: decimal.NewFromFloat(0.834), Amount: 834},
+		},
+		DueDate: periodStart.AddDate(0, 2, 0),
+	}
+	config := offline.Config{
+		IssuerName:     "Satellite Operator",
+		IssuerAddress:  `Street 1\nCity`,
+		PaymentDetails: "IBAN XX00 0000 (reference)",
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, offline.Render(&buf, offline.FormatJSON, config, "user@mail.test", invoice))
+
+		var decoded struct {
+			offline.Invoice
+			Email string `json:"email"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Equal(t, "user@mail.test", decoded.Email)
+		require.Equal(t, invoice.ID, decoded.ID)
+		require.Equal(t, invoice.Amount, decoded.Amount)
+		require.Len(t, decoded.LineItems, 2)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, offline.Render(&buf, offline.FormatCSV, config, "user@mail.test", invoice))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		require.Equal(t, []string{"invoice", "description", "quantity", "unit_cents", "amount_cents"}, records[0])
+		require.Equal(t, []string{invoice.ID.String(), "Project (storage)", "1000", "0.4", "400"}, records[1])
+		require.Equal(t, []string{invoice.ID.String(), "Total", "", "", "1234"}, records[3])
+	})
+
+	t.Run("pdf", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, offline.Render(&buf, offline.FormatPDF, config, "user@mail.test", invoice))
+
+		document := buf.String()
+		require.True(t, strings.HasPrefix(document, "%PDF-1.4\n"))
+		require.True(t, strings.HasSuffix(document, "%%EOF\n"))
+		require.Contains(t, document, "(Satellite Operator) Tj")
+		require.Contains(t, document, "(City) Tj")
+		require.Contains(t, document, `Project \(storage\)`)
+		require.Contains(t, document, "$12.34")
+		require.Contains(t, document, "IBAN XX00 0000 \\(reference\\)")
+	})
+
+	t.Run("pdf with many items", func(t *testing.T) {
+		invoice := invoice
+		invoice.LineItems = nil
+		for i := 0; i < 150; i++ {
+			invoice.LineItems = append(invoice.LineItems, offline.LineItem{Description: "item", Quantity: 1, Amount: 1})
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, offline.Render(&buf, offline.FormatPDF, config, "user@mail.test", invoice))
+		require.Contains(t, buf.String(), "/Count 3")
+	})
+}
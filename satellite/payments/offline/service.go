@@ -0,0 +1,314 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package offline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/stripe"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the offline payments provider.
+	Error = errs.Class("offline payments")
+
+	// ErrUnsupported is returned for operations which need an external payment provider.
+	ErrUnsupported = errs.Class("not supported by offline payments")
+)
+
+// customerIDPrefix is prepended to the user id to create the customer id of a user.
+const customerIDPrefix = "offline_"
+
+// Config contains configurable values for the offline payments provider.
+type Config struct {
+	DueDays        int    `help:"number of days an offline invoice has to be paid in, before it is considered overdue" default:"30"`
+	IssuerName     string `help:"name of the invoice issuer printed on offline invoices" default:""`
+	IssuerAddress  string `help:"address of the invoice issuer printed on offline invoices" default:""`
+	PaymentDetails string `help:"payment instructions printed on offline invoices, e.g. bank account details" default:""`
+}
+
+// Service generates invoices from project usage and stores them in the satellite database,
+// so billing works without any external payment provider.
+//
+// architecture: Service
+type Service struct {
+	log *zap.Logger
+
+	config     Config
+	db         DB
+	customers  stripe.CustomersDB
+	usersDB    console.Users
+	projectsDB console.Projects
+	usageDB    accounting.ProjectAccounting
+
+	usagePrices         payments.ProjectUsagePriceModel
+	usagePriceOverrides map[string]payments.ProjectUsagePriceModel
+	partnerNames        []string
+
+	listingLimit int
+	nowFn        func() time.Time
+}
+
+// NewService creates a new offline payments service.
+func NewService(log *zap.Logger, config Config, db DB, customers stripe.CustomersDB, usersDB console.Users, projectsDB console.Projects, usageDB accounting.ProjectAccounting, usagePrices payments.ProjectUsagePriceModel, usagePriceOverrides map[string]payments.ProjectUsagePriceModel, placementPriceOverrides map[storj.PlacementConstraint]payments.ProjectUsagePriceModel) *Service {
+	var partners []string
+	for partner := range usagePriceOverrides {
+		partners = append(partners, partner)
+	}
+
+	// placement prices replace both the default and the partner prices.
+	if len(placementPriceOverrides) > 0 {
+		usagePrices.Placements = placementPriceOverrides
+
+		overrides := make(map[string]payments.ProjectUsagePriceModel, len(usagePriceOverrides))
+		for partner, model := range usagePriceOverrides {
+			model.Placements = placementPriceOverrides
+			overrides[partner] = model
+		}
+		usagePriceOverrides = overrides
+	}
+
+	return &Service{
+		log:                 log,
+		config:              config,
+		db:                  db,
+		customers:           customers,
+		usersDB:             usersDB,
+		projectsDB:          projectsDB,
+		usageDB:             usageDB,
+		usagePrices:         usagePrices,
+		usagePriceOverrides: usagePriceOverrides,
+		partnerNames:        partners,
+		listingLimit:        100,
+		nowFn:               time.Now,
+	}
+}
+
+// Accounts exposes all needed functionality to manage payment accounts.
+func (service *Service) Accounts() payments.Accounts {
+	return &accounts{service: service}
+}
+
+// Invoices exposes the invoices of the offline provider, including the operations to settle them manually.
+func (service *Service) Invoices() ManualInvoices {
+	return &invoices{service: service}
+}
+
+// SetNow allows tests to have the Service act as if the current time is whatever they want.
+func (service *Service) SetNow(now func() time.Time) {
+	service.nowFn = now
+}
+
+// CustomerID returns the customer id used for the user by the offline provider.
+func CustomerID(userID uuid.UUID) string {
+	return customerIDPrefix + userID.String()
+}
+
+// GenerateInvoices generates invoices for the usage of all projects during the month of period.
+// Users which already have an invoice for the period are skipped, so it is safe to run it multiple times.
+func (service *Service) GenerateInvoices(ctx context.Context, period time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	utc := period.UTC()
+	start := time.Date(utc.Year(), utc.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	if end.After(service.nowFn().UTC()) {
+		return Error.New("allowed for past periods only")
+	}
+
+	var generated, skipped int
+	page := stripe.CustomersPage{Next: true}
+	for page.Next {
+		if err := ctx.Err(); err != nil {
+			return Error.Wrap(err)
+		}
+
+		page, err = service.customers.List(ctx, page.Cursor, service.listingLimit, end)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, customer := range page.Customers {
+			ok, err := service.generateInvoice(ctx, customer.UserID, start, end)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			if ok {
+				generated++
+			} else {
+				skipped++
+			}
+		}
+	}
+
+	service.log.Info("offline invoices generated", zap.Time("period", start), zap.Int("generated", generated), zap.Int("skipped", skipped))
+	return nil
+}
+
+// generateInvoice generates the invoice of a single user. It returns false when no invoice was created.
+func (service *Service) generateInvoice(ctx context.Context, userID uuid.UUID, start, end time.Time) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := service.usersDB.Get(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if user.Status != console.Active {
+		return false, nil
+	}
+
+	projects, err := service.projectsDB.GetOwn(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	sort.Slice(projects, func(i, k int) bool { return projects[i].Name < projects[k].Name })
+
+	// the volume tiers are applied once to all projects of the user.
+	tiers := payments.NewTierUsage()
+
+	var items []LineItem
+	hasUsage := false
+	for _, project := range projects {
+		usages, err := service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, project.ID, service.partnerNames, start, end)
+		if err != nil {
+			return false, err
+		}
+		for _, placementUsages := range usages {
+			for _, usage := range placementUsages {
+				hasUsage = hasUsage || usage.Storage > 0 || usage.Egress > 0 || usage.SegmentCount > 0
+			}
+		}
+		for _, item := range payments.ProjectUsageItems(project.Name, usages, false, service.priceModel, tiers) {
+			items = append(items, newLineItem(item.Description, item.Quantity, item.UnitCents))
+		}
+	}
+	if !hasUsage {
+		return false, nil
+	}
+
+	var amount int64
+	for _, item := range items {
+		amount += item.Amount
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return false, err
+	}
+
+	now := service.nowFn().UTC()
+	invoice := Invoice{
+		ID:          id,
+		UserID:      userID,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Description: fmt.Sprintf("Usage for %s", start.Format("2006-01")),
+		Amount:      amount,
+		Status:      payments.InvoiceStatusOpen,
+		LineItems:   items,
+		DueDate:     now.AddDate(0, 0, service.config.DueDays),
+	}
+	// usage, which rounds to nothing, is still invoiced to mark the period as billed,
+	// but there is nothing to pay.
+	if amount == 0 {
+		invoice.Status = payments.InvoiceStatusPaid
+		invoice.PaidAt = &now
+	}
+
+	err = service.db.Insert(ctx, invoice)
+	if ErrInvoiceExists.Has(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// priceModel returns the project usage price model for a partner name.
+func (service *Service) priceModel(partner string) payments.ProjectUsagePriceModel {
+	if override, ok := service.usagePriceOverrides[partner]; ok {
+		return override
+	}
+	return service.usagePrices
+}
+
+// usagePeriodBilled returns whether the owner of a project already has an invoice for the period.
+func (service *Service) usagePeriodBilled(ctx context.Context, ownerID uuid.UUID, periodStart time.Time) (bool, error) {
+	invoices, err := service.db.ListByUser(ctx, ownerID)
+	if err != nil {
+		return false, err
+	}
+	for _, invoice := range invoices {
+		if invoice.PeriodStart.Equal(periodStart) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// markPaid marks an invoice as paid.
+func (service *Service) markPaid(ctx context.Context, invoiceID, reference string) (_ Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromString(invoiceID)
+	if err != nil {
+		return Invoice{}, ErrInvoiceNotFound.Wrap(err)
+	}
+
+	invoice, err := service.db.MarkPaid(ctx, id, service.nowFn().UTC(), reference)
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	service.log.Info("offline invoice marked as paid",
+		zap.Stringer("invoiceID", invoice.ID),
+		zap.Stringer("userID", invoice.UserID),
+		zap.String("reference", reference))
+	return invoice, nil
+}
+
+// get returns an invoice by its string id.
+func (service *Service) get(ctx context.Context, invoiceID string) (Invoice, error) {
+	id, err := uuid.FromString(invoiceID)
+	if err != nil {
+		return Invoice{}, ErrInvoiceNotFound.Wrap(err)
+	}
+	return service.db.Get(ctx, id)
+}
+
+// convertInvoice converts a stored invoice to a payments.Invoice.
+func convertInvoice(invoice Invoice) payments.Invoice {
+	return payments.Invoice{
+		ID:          invoice.ID.String(),
+		CustomerID:  CustomerID(invoice.UserID),
+		Description: invoice.Description,
+		Amount:      invoice.Amount,
+		Status:      invoice.Status,
+		Start:       invoice.PeriodStart,
+		End:         invoice.PeriodEnd,
+	}
+}
+
+func newLineItem(desc string, quantity, unitCents decimal.Decimal) LineItem {
+	return LineItem{
+		Description: desc,
+		Quantity:    quantity.IntPart(),
+		UnitCents:   unitCents,
+		Amount:      quantity.Mul(unitCents).Round(0).IntPart(),
+	}
+}
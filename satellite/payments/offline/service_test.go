@@ -0,0 +1,218 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package offline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/offline"
+)
+
+func TestService_GenerateInvoices(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 3,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Payments.Provider = "offline"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		service, err := satellite.NewOfflinePayments(zap.L(), sat.DB, sat.Config.Payments)
+		require.NoError(t, err)
+
+		utc := time.Now().UTC()
+		periodStart := time.Date(utc.Year(), utc.Month(), 1, 0, 0, 0, 0, time.UTC)
+		now := periodStart.AddDate(0, 1, 1)
+
+		var userIDs []uuid.UUID
+		for _, uplink := range planet.Uplinks {
+			project, err := sat.DB.Console().Projects().Get(ctx, uplink.Projects[0].ID)
+			require.NoError(t, err)
+			userIDs = append(userIDs, project.OwnerID)
+
+			_, err = service.Accounts().Setup(ctx, project.OwnerID, uplink.User[sat.ID()].Email, "")
+			require.NoError(t, err)
+		}
+
+		// the first user has billable usage, the usage of the second user rounds to zero
+		// and the last user has no usage at all.
+		err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, planet.Uplinks[0].Projects[0].ID, []byte("testbucket"),
+			pb.PieceAction_GET, 10*memory.GB.Int64(), 0, periodStart)
+		require.NoError(t, err)
+		err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, planet.Uplinks[1].Projects[0].ID, []byte("testbucket"),
+			pb.PieceAction_GET, 100*memory.MB.Int64(), 0, periodStart)
+		require.NoError(t, err)
+
+		// invoices are generated for past periods only.
+		service.SetNow(func() time.Time { return periodStart.AddDate(0, 0, 1) })
+		require.Error(t, service.GenerateInvoices(ctx, periodStart))
+
+		service.SetNow(func() time.Time { return now })
+		require.NoError(t, service.GenerateInvoices(ctx, periodStart))
+
+		// running it again doesn't create any invoices.
+		require.NoError(t, service.GenerateInvoices(ctx, periodStart))
+
+		invoicesDB := sat.DB.OfflineInvoices()
+
+		billed, err := invoicesDB.ListByUser(ctx, userIDs[0])
+		require.NoError(t, err)
+		require.Len(t, billed, 1)
+		require.Equal(t, payments.InvoiceStatusOpen, billed[0].Status)
+		require.Equal(t, periodStart, billed[0].PeriodStart.UTC())
+		require.Equal(t, now.AddDate(0, 0, sat.Config.Payments.Offline.DueDays), billed[0].DueDate.UTC())
+		require.Positive(t, billed[0].Amount)
+
+		var amount int64
+		for _, item := range billed[0].LineItems {
+			amount += item.Amount
+		}
+		require.Equal(t, billed[0].Amount, amount)
+
+		rounded, err := invoicesDB.ListByUser(ctx, userIDs[1])
+		require.NoError(t, err)
+		require.Len(t, rounded, 1)
+		require.Zero(t, rounded[0].Amount)
+		require.Equal(t, payments.InvoiceStatusPaid, rounded[0].Status)
+		require.NotNil(t, rounded[0].PaidAt)
+
+		unused, err := invoicesDB.ListByUser(ctx, userIDs[2])
+		require.NoError(t, err)
+		require.Empty(t, unused)
+
+		// the period is billed, even when there is nothing to pay.
+		for _, uplink := range planet.Uplinks[:2] {
+			require.NoError(t, service.Accounts().CheckProjectInvoicingStatus(ctx, uplink.Projects[0].ID))
+		}
+	})
+}
+
+func TestService_ListFailed(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Payments.Provider = "offline"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "user@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		service, err := satellite.NewOfflinePayments(zap.L(), sat.DB, sat.Config.Payments)
+		require.NoError(t, err)
+
+		now := time.Now()
+		invoice := newOpenInvoice(user.ID, now.Add(time.Hour))
+		require.NoError(t, sat.DB.OfflineInvoices().Insert(ctx, invoice))
+
+		invoices := service.Invoices()
+
+		// the invoice hasn't failed before the due date.
+		service.SetNow(func() time.Time { return now })
+		failed, err := invoices.ListFailed(ctx, nil)
+		require.NoError(t, err)
+		require.Empty(t, failed)
+		require.NoError(t, invoices.AttemptPayOverdueInvoices(ctx, user.ID))
+
+		service.SetNow(func() time.Time { return now.Add(2 * time.Hour) })
+		failed, err = invoices.ListFailed(ctx, &user.ID)
+		require.NoError(t, err)
+		require.Len(t, failed, 1)
+		require.Equal(t, invoice.ID.String(), failed[0].ID)
+		require.Equal(t, offline.CustomerID(user.ID), failed[0].CustomerID)
+		require.Error(t, invoices.AttemptPayOverdueInvoices(ctx, user.ID))
+
+		_, err = invoices.MarkPaid(ctx, invoice.ID.String(), "wire transfer")
+		require.NoError(t, err)
+
+		failed, err = invoices.ListFailed(ctx, nil)
+		require.NoError(t, err)
+		require.Empty(t, failed)
+		require.NoError(t, invoices.AttemptPayOverdueInvoices(ctx, user.ID))
+	})
+}
+
+func TestService_AccountFreeze(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Payments.Provider = "offline"
+				config.AccountFreeze.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.Payments.AccountFreeze
+		chore.Loop.Pause()
+
+		freezeService := console.NewAccountFreezeService(sat.DB.Console(), sat.Core.Analytics.Service, sat.Config.Console.AccountFreeze)
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "user@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		invoice := newOpenInvoice(user.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, sat.DB.OfflineInvoices().Insert(ctx, invoice))
+
+		// the overdue invoice warns the user.
+		chore.Loop.TriggerWait()
+
+		freezes, err := freezeService.GetAll(ctx, user.ID)
+		require.NoError(t, err)
+		require.NotNil(t, freezes.BillingWarning)
+
+		invoices, ok := sat.Core.Payments.Accounts.Invoices().(offline.ManualInvoices)
+		require.True(t, ok)
+		_, err = invoices.MarkPaid(ctx, invoice.ID.String(), "wire transfer")
+		require.NoError(t, err)
+
+		// paying the invoice removes the warning.
+		chore.Loop.TriggerWait()
+
+		freezes, err = freezeService.GetAll(ctx, user.ID)
+		require.NoError(t, err)
+		require.Nil(t, freezes.BillingWarning)
+	})
+}
+
+func newOpenInvoice(userID uuid.UUID, dueDate time.Time) offline.Invoice {
+	return offline.Invoice{
+		ID:          testrand.UUID(),
+		UserID:      userID,
+		PeriodStart: time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC),
+		Description: "usage",
+		Amount:      100,
+		Status:      payments.InvoiceStatusOpen,
+		LineItems: []offline.LineItem{
+			{Description: "storage", Quantity: 10, UnitCents: decimal.NewFromInt(10), Amount: 100},
+		},
+		DueDate: dueDate,
+	}
+}
@@ -5,6 +5,7 @@ package paymentsconfig
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -12,9 +13,11 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/zeebo/errs"
 
+	"storj.io/common/storj"
 	"storj.io/common/useragent"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/offline"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
 )
@@ -24,16 +27,18 @@ var Error = errs.Class("payments config")
 
 // Config defines global payments config.
 type Config struct {
-	Provider     string        `help:"payments provider to use" default:""`
+	Provider     string        `help:"payments provider to use. \"offline\" generates and stores invoices on the satellite without an external payment provider" default:""`
 	MockProvider stripe.Client `internal:"true"`
 
-	BillingConfig       billing.Config
-	StripeCoinPayments  stripe.Config
-	Storjscan           storjscan.Config
-	UsagePrice          ProjectUsagePrice
-	BonusRate           int64                      `help:"amount of percents that user will earn as bonus credits by depositing in STORJ tokens" default:"10"`
-	UsagePriceOverrides ProjectUsagePriceOverrides `help:"semicolon-separated usage price overrides in the format partner:storage,egress,segment,egress_discount_ratio. The egress discount ratio is the ratio of free egress per unit-month of storage"`
-	PackagePlans        PackagePlans               `help:"semicolon-separated partner package plans in the format partner:price,credit. Price and credit are in cents USD."`
+	BillingConfig           billing.Config
+	StripeCoinPayments      stripe.Config
+	Storjscan               storjscan.Config
+	Offline                 offline.Config
+	UsagePrice              ProjectUsagePrice
+	BonusRate               int64                      `help:"amount of percents that user will earn as bonus credits by depositing in STORJ tokens" default:"10"`
+	UsagePriceOverrides     ProjectUsagePriceOverrides `help:"semicolon-separated usage price overrides in the format partner:storage,egress,segment,egress_discount_ratio. The egress discount ratio is the ratio of free egress per unit-month of storage"`
+	PlacementPriceOverrides PlacementPriceOverrides    `help:"semicolon-separated usage price overrides for buckets with a placement in the format placement:storage,egress,segment,egress_discount_ratio[,storage_tiers,egress_tiers]. Tiers have the same format as storage-tb-tiers"`
+	PackagePlans            PackagePlans               `help:"semicolon-separated partner package plans in the format partner:price,credit. Price and credit are in cents USD."`
 }
 
 // ProjectUsagePrice holds the configuration for the satellite's project usage price model.
@@ -41,6 +46,8 @@ type ProjectUsagePrice struct {
 	StorageTB           string  `help:"price user should pay for storage per month in dollars/TB" default:"4" testDefault:"10"`
 	EgressTB            string  `help:"price user should pay for egress in dollars/TB" default:"7" testDefault:"45"`
 	Segment             string  `help:"price user should pay for segments stored on network per month in dollars/segment" default:"0.0000088" testDefault:"0.0000022"`
+	StorageTBTiers      string  `help:"slash-separated volume tiers for storage in the format up_to_tb=dollars_per_tb, e.g. 100=3.5/1000=3. Storage above the last tier is charged at the storage price" default:""`
+	EgressTBTiers       string  `help:"slash-separated volume tiers for egress in the format up_to_tb=dollars_per_tb. Egress above the last tier is charged at the egress price" default:""`
 	EgressDiscountRatio float64 `internal:"true"`
 }
 
@@ -59,15 +66,56 @@ func (p ProjectUsagePrice) ToModel() (model payments.ProjectUsagePriceModel, err
 		return model, Error.Wrap(err)
 	}
 
+	storageTiers, err := parseTiers(p.StorageTBTiers)
+	if err != nil {
+		return model, err
+	}
+	egressTiers, err := parseTiers(p.EgressTBTiers)
+	if err != nil {
+		return model, err
+	}
+
 	// Shift is to change the precision from TB dollars to MB cents
 	return payments.ProjectUsagePriceModel{
 		StorageMBMonthCents: storageTBMonthDollars.Shift(-6).Shift(2),
 		EgressMBCents:       egressTBDollars.Shift(-6).Shift(2),
 		SegmentMonthCents:   segmentMonthDollars.Shift(2),
 		EgressDiscountRatio: p.EgressDiscountRatio,
+		StorageTiers:        storageTiers,
+		EgressTiers:         egressTiers,
 	}, nil
 }
 
+// parseTiers parses volume tiers in the format up_to_tb=dollars_per_tb/up_to_tb=dollars_per_tb.
+func parseTiers(s string) (tiers []payments.UsagePriceTier, err error) {
+	for _, tierStr := range strings.Split(s, "/") {
+		if tierStr == "" {
+			continue
+		}
+
+		values := strings.Split(tierStr, "=")
+		if len(values) != 2 {
+			return nil, Error.New("Invalid price tier (expected format up_to_tb=dollars_per_tb, got %s)", tierStr)
+		}
+
+		upToTB, err := decimal.NewFromString(values[0])
+		if err != nil {
+			return nil, Error.New("Invalid tier limit '%s' (%s)", values[0], err)
+		}
+		dollarsTB, err := decimal.NewFromString(values[1])
+		if err != nil {
+			return nil, Error.New("Invalid tier price '%s' (%s)", values[1], err)
+		}
+
+		// Shift is to change the precision from TB to MB and from TB dollars to MB cents
+		tiers = append(tiers, payments.UsagePriceTier{
+			UpTo:  upToTB.Shift(6),
+			Cents: dollarsTB.Shift(-6).Shift(2),
+		})
+	}
+	return tiers, nil
+}
+
 // Ensure that ProjectUsagePriceOverrides implements pflag.Value.
 var _ pflag.Value = (*ProjectUsagePriceOverrides)(nil)
 
@@ -161,6 +209,119 @@ func (p ProjectUsagePriceOverrides) ToModels() (map[string]payments.ProjectUsage
 	return models, nil
 }
 
+// Ensure that PlacementPriceOverrides implements pflag.Value.
+var _ pflag.Value = (*PlacementPriceOverrides)(nil)
+
+// PlacementPriceOverrides represents a mapping between bucket placements and project usage price overrides.
+type PlacementPriceOverrides struct {
+	overrideMap map[storj.PlacementConstraint]ProjectUsagePrice
+}
+
+// Type returns the type of the pflag.Value.
+func (PlacementPriceOverrides) Type() string { return "paymentsconfig.PlacementPriceOverrides" }
+
+// String returns the string representation of the price overrides.
+func (p *PlacementPriceOverrides) String() string {
+	if p == nil {
+		return ""
+	}
+	placements := make([]storj.PlacementConstraint, 0, len(p.overrideMap))
+	for placement := range p.overrideMap {
+		placements = append(placements, placement)
+	}
+	sort.Slice(placements, func(i, k int) bool { return placements[i] < placements[k] })
+
+	var s strings.Builder
+	for i, placement := range placements {
+		prices := p.overrideMap[placement]
+		egressDiscount := strconv.FormatFloat(prices.EgressDiscountRatio, 'f', -1, 64)
+		s.WriteString(fmt.Sprintf("%d:%s,%s,%s,%s", placement, prices.StorageTB, prices.EgressTB, prices.Segment, egressDiscount))
+		if prices.StorageTBTiers != "" || prices.EgressTBTiers != "" {
+			s.WriteString(fmt.Sprintf(",%s,%s", prices.StorageTBTiers, prices.EgressTBTiers))
+		}
+		if i < len(placements)-1 {
+			s.WriteRune(';')
+		}
+	}
+	return s.String()
+}
+
+// Set sets the list of price overrides to the parsed string.
+func (p *PlacementPriceOverrides) Set(s string) error {
+	overrideMap := make(map[storj.PlacementConstraint]ProjectUsagePrice)
+	for _, overrideStr := range strings.Split(s, ";") {
+		if overrideStr == "" {
+			continue
+		}
+
+		info := strings.Split(overrideStr, ":")
+		if len(info) != 2 {
+			return Error.New("Invalid placement price override (expected format placement:storage,egress,segment,egress_discount_ratio, got %s)", overrideStr)
+		}
+
+		placement, err := strconv.ParseUint(strings.TrimSpace(info[0]), 10, 16)
+		if err != nil {
+			return Error.New("Invalid placement '%s' (%s)", info[0], err)
+		}
+
+		valuesStr := info[1]
+		values := strings.Split(valuesStr, ",")
+		if len(values) != 4 && len(values) != 6 {
+			return Error.New("Invalid values (expected format storage,egress,segment,egress_discount_ratio[,storage_tiers,egress_tiers], got %s)", valuesStr)
+		}
+
+		for i := 0; i < 3; i++ {
+			if _, err := decimal.NewFromString(values[i]); err != nil {
+				return Error.New("Invalid price '%s' (%s)", values[i], err)
+			}
+		}
+
+		egressDiscount, err := strconv.ParseFloat(values[3], 64)
+		if err != nil {
+			return Error.New("Invalid egress discount ratio '%s' (%s)", values[3], err)
+		}
+
+		price := ProjectUsagePrice{
+			StorageTB:           values[0],
+			EgressTB:            values[1],
+			Segment:             values[2],
+			EgressDiscountRatio: egressDiscount,
+		}
+		if len(values) == 6 {
+			price.StorageTBTiers = values[4]
+			price.EgressTBTiers = values[5]
+			if _, err := parseTiers(price.StorageTBTiers); err != nil {
+				return err
+			}
+			if _, err := parseTiers(price.EgressTBTiers); err != nil {
+				return err
+			}
+		}
+
+		overrideMap[storj.PlacementConstraint(placement)] = price
+	}
+	p.overrideMap = overrideMap
+	return nil
+}
+
+// SetMap sets the internal mapping between placements and project usage prices.
+func (p *PlacementPriceOverrides) SetMap(overrides map[storj.PlacementConstraint]ProjectUsagePrice) {
+	p.overrideMap = overrides
+}
+
+// ToModels returns the price overrides represented as a mapping between placements and project usage price models.
+func (p PlacementPriceOverrides) ToModels() (map[storj.PlacementConstraint]payments.ProjectUsagePriceModel, error) {
+	models := make(map[storj.PlacementConstraint]payments.ProjectUsagePriceModel)
+	for placement, prices := range p.overrideMap {
+		model, err := prices.ToModel()
+		if err != nil {
+			return nil, err
+		}
+		models[placement] = model
+	}
+	return models, nil
+}
+
 // PackagePlans contains one time prices for partners.
 type PackagePlans struct {
 	Packages map[string]payments.PackagePlan
@@ -12,6 +12,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/storj"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 )
@@ -105,6 +106,106 @@ func TestProjectUsagePriceOverrides(t *testing.T) {
 	}
 }
 
+func TestPlacementPriceOverrides(t *testing.T) {
+	type Prices map[storj.PlacementConstraint]payments.ProjectUsagePriceModel
+
+	cases := []struct {
+		testID        string
+		configValue   string
+		expectedModel Prices
+	}{
+		{
+			testID:        "empty",
+			configValue:   "",
+			expectedModel: Prices{},
+		}, {
+			testID:      "invalid placement",
+			configValue: "eu:1,2,3,4",
+		}, {
+			testID:      "too few values",
+			configValue: "1:1,2,3",
+		}, {
+			testID:      "missing egress tiers",
+			configValue: "1:1,2,3,4,5",
+		}, {
+			testID:      "invalid tier",
+			configValue: "1:1,2,3,4,100,",
+		}, {
+			testID:      "single price override",
+			configValue: "1:1,2,3,4",
+			expectedModel: Prices{
+				1: payments.ProjectUsagePriceModel{
+					StorageMBMonthCents: decimal.NewFromInt(1).Shift(-4),
+					EgressMBCents:       decimal.NewFromInt(2).Shift(-4),
+					SegmentMonthCents:   decimal.NewFromInt(3).Shift(2),
+					EgressDiscountRatio: 4,
+				},
+			},
+		}, {
+			testID:      "price override with tiers",
+			configValue: "1:1,2,3,4;2:5,6,7,8,10=0.5/100=0.25,1=1",
+			expectedModel: Prices{
+				1: payments.ProjectUsagePriceModel{
+					StorageMBMonthCents: decimal.NewFromInt(1).Shift(-4),
+					EgressMBCents:       decimal.NewFromInt(2).Shift(-4),
+					SegmentMonthCents:   decimal.NewFromInt(3).Shift(2),
+					EgressDiscountRatio: 4,
+				},
+				2: payments.ProjectUsagePriceModel{
+					StorageMBMonthCents: decimal.NewFromInt(5).Shift(-4),
+					EgressMBCents:       decimal.NewFromInt(6).Shift(-4),
+					SegmentMonthCents:   decimal.NewFromInt(7).Shift(2),
+					EgressDiscountRatio: 8,
+					StorageTiers: []payments.UsagePriceTier{
+						{UpTo: decimal.NewFromInt(10).Shift(6), Cents: decimal.RequireFromString("0.5").Shift(-4)},
+						{UpTo: decimal.NewFromInt(100).Shift(6), Cents: decimal.RequireFromString("0.25").Shift(-4)},
+					},
+					EgressTiers: []payments.UsagePriceTier{
+						{UpTo: decimal.NewFromInt(1).Shift(6), Cents: decimal.NewFromInt(1).Shift(-4)},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.testID, func(t *testing.T) {
+			price := &paymentsconfig.PlacementPriceOverrides{}
+			err := price.Set(c.configValue)
+			if c.expectedModel == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.configValue, price.String())
+
+			models, err := price.ToModels()
+			require.NoError(t, err)
+			require.Len(t, models, len(c.expectedModel))
+			for placement, price := range c.expectedModel {
+				require.Contains(t, models, placement)
+				model := models[placement]
+				require.True(t, price.StorageMBMonthCents.Equal(model.StorageMBMonthCents))
+				require.True(t, price.EgressMBCents.Equal(model.EgressMBCents))
+				require.True(t, price.SegmentMonthCents.Equal(model.SegmentMonthCents))
+				require.Equal(t, price.EgressDiscountRatio, model.EgressDiscountRatio)
+
+				require.Len(t, model.StorageTiers, len(price.StorageTiers))
+				for i, tier := range price.StorageTiers {
+					require.True(t, tier.UpTo.Equal(model.StorageTiers[i].UpTo))
+					require.True(t, tier.Cents.Equal(model.StorageTiers[i].Cents))
+				}
+				require.Len(t, model.EgressTiers, len(price.EgressTiers))
+				for i, tier := range price.EgressTiers {
+					require.True(t, tier.UpTo.Equal(model.EgressTiers[i].UpTo))
+					require.True(t, tier.Cents.Equal(model.EgressTiers[i].Cents))
+				}
+			}
+		})
+	}
+}
+
 func TestPackagePlans(t *testing.T) {
 	type packages map[string]payments.PackagePlan
 
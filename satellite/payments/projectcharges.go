@@ -4,8 +4,13 @@
 package payments
 
 import (
+	"sort"
+	"strings"
+	"sync"
+
 	"github.com/shopspring/decimal"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 )
@@ -35,4 +40,137 @@ type ProjectUsagePriceModel struct {
 	EgressMBCents       decimal.Decimal `json:"egressMBCents"`
 	SegmentMonthCents   decimal.Decimal `json:"segmentMonthCents"`
 	EgressDiscountRatio float64         `json:"egressDiscountRatio"`
+
+	// StorageTiers and EgressTiers are volume tiers which are charged before
+	// the usage beyond the last tier is charged at the base price.
+	StorageTiers []UsagePriceTier `json:"storageTiers,omitempty"`
+	EgressTiers  []UsagePriceTier `json:"egressTiers,omitempty"`
+
+	// Placements contains price models which replace this one for buckets with a given placement.
+	Placements map[storj.PlacementConstraint]ProjectUsagePriceModel `json:"placements,omitempty"`
+}
+
+// ForPlacement returns the price model used for buckets with the given placement.
+func (model ProjectUsagePriceModel) ForPlacement(placement storj.PlacementConstraint) ProjectUsagePriceModel {
+	if override, ok := model.Placements[placement]; ok {
+		return override
+	}
+	return model
+}
+
+// UsagePriceTier represents a volume tier of a usage price.
+type UsagePriceTier struct {
+	// UpTo is the amount of usage, counted from zero, up to which Cents is charged per unit.
+	// It is in MB-months for storage and in MB for egress.
+	UpTo decimal.Decimal `json:"upTo"`
+	// Cents is the price per MB-month for storage or per MB for egress.
+	Cents decimal.Decimal `json:"cents"`
+}
+
+// TierCharge is the part of a usage quantity which is charged at a single price.
+type TierCharge struct {
+	// Tier is the 1-based index of the volume tier, or 0 for the base price.
+	Tier     int
+	Quantity decimal.Decimal
+	Cents    decimal.Decimal
+}
+
+// SplitByTiers splits quantity into the parts charged by each volume tier. The part
+// above the last tier is charged at basePrice and is always the last element.
+func SplitByTiers(quantity decimal.Decimal, tiers []UsagePriceTier, basePrice decimal.Decimal) []TierCharge {
+	return splitByTiers(decimal.Zero, quantity, tiers, basePrice)
+}
+
+// splitByTiers splits quantity, which is charged after the already charged quantity, into the
+// parts charged by each volume tier. The part above the last tier is charged at basePrice and is
+// always the last element.
+func splitByTiers(charged, quantity decimal.Decimal, tiers []UsagePriceTier, basePrice decimal.Decimal) []TierCharge {
+	sorted := append([]UsagePriceTier(nil), tiers...)
+	sort.SliceStable(sorted, func(i, k int) bool {
+		return sorted[i].UpTo.LessThan(sorted[k].UpTo)
+	})
+
+	end := charged.Add(quantity)
+
+	var charges []TierCharge
+	lower := decimal.Zero
+	for i, tier := range sorted {
+		if !end.GreaterThan(lower) {
+			break
+		}
+		amount := decimal.Min(end, tier.UpTo).Sub(decimal.Max(charged, lower))
+		if amount.IsPositive() {
+			charges = append(charges, TierCharge{Tier: i + 1, Quantity: amount, Cents: tier.Cents})
+		}
+		lower = decimal.Max(lower, tier.UpTo)
+	}
+
+	remaining := decimal.Zero
+	if end.GreaterThan(lower) {
+		remaining = end.Sub(decimal.Max(charged, lower))
+	}
+	return append(charges, TierCharge{Quantity: remaining, Cents: basePrice})
+}
+
+// TieredPrice returns the total price of quantity, charged by volume tiers and then basePrice.
+func TieredPrice(quantity decimal.Decimal, tiers []UsagePriceTier, basePrice decimal.Decimal) decimal.Decimal {
+	return chargesPrice(SplitByTiers(quantity, tiers, basePrice))
+}
+
+// chargesPrice returns the total price of the tier charges.
+func chargesPrice(charges []TierCharge) decimal.Decimal {
+	total := decimal.Zero
+	for _, charge := range charges {
+		total = total.Add(charge.Quantity.Mul(charge.Cents))
+	}
+	return total
+}
+
+// TierUsage keeps track of the quantities charged by volume tiers, so the tiers are applied
+// once to the combined usage of several projects and placements, instead of granting every
+// tier to each of them. The quantities are tracked separately for every kind of usage and
+// price. TierUsage is safe for concurrent use.
+type TierUsage struct {
+	mu      sync.Mutex
+	charged map[string]decimal.Decimal
+}
+
+// NewTierUsage creates a TierUsage without any charged quantities.
+func NewTierUsage() *TierUsage {
+	return &TierUsage{charged: make(map[string]decimal.Decimal)}
+}
+
+// Split splits quantity of the kind of usage into the parts charged by each volume tier, after
+// the quantities split before with the same tiers and base price. The part above the last tier
+// is charged at basePrice and is always the last element.
+func (usage *TierUsage) Split(kind string, quantity decimal.Decimal, tiers []UsagePriceTier, basePrice decimal.Decimal) []TierCharge {
+	if len(tiers) == 0 {
+		return SplitByTiers(quantity, tiers, basePrice)
+	}
+
+	key := tierUsageKey(kind, tiers, basePrice)
+
+	usage.mu.Lock()
+	defer usage.mu.Unlock()
+
+	charged := usage.charged[key]
+	usage.charged[key] = charged.Add(quantity)
+	return splitByTiers(charged, quantity, tiers, basePrice)
+}
+
+// Price returns the total price of quantity of the kind of usage, charged by volume tiers after
+// the quantities charged before and then basePrice.
+func (usage *TierUsage) Price(kind string, quantity decimal.Decimal, tiers []UsagePriceTier, basePrice decimal.Decimal) decimal.Decimal {
+	return chargesPrice(usage.Split(kind, quantity, tiers, basePrice))
+}
+
+// tierUsageKey returns the key of the charged quantity of the kind of usage with the tiers and base price.
+func tierUsageKey(kind string, tiers []UsagePriceTier, basePrice decimal.Decimal) string {
+	var key strings.Builder
+	key.WriteString(kind)
+	for _, tier := range tiers {
+		key.WriteString(":" + tier.UpTo.String() + "=" + tier.Cents.String())
+	}
+	key.WriteString(":" + basePrice.String())
+	return key.String()
 }
@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/payments"
+)
+
+func TestSplitByTiers(t *testing.T) {
+	tiers := []payments.UsagePriceTier{
+		{UpTo: decimal.NewFromInt(100), Cents: decimal.NewFromInt(3)},
+		{UpTo: decimal.NewFromInt(10), Cents: decimal.NewFromInt(4)},
+	}
+	base := decimal.NewFromInt(2)
+
+	for _, tt := range []struct {
+		name     string
+		quantity int64
+		expected []payments.TierCharge
+		total    int64
+	}{
+		{"zero", 0, []payments.TierCharge{{Tier: 0, Quantity: decimal.Zero, Cents: base}}, 0},
+		{"first tier", 5, []payments.TierCharge{
+			{Tier: 1, Quantity: decimal.NewFromInt(5), Cents: decimal.NewFromInt(4)},
+			{Tier: 0, Quantity: decimal.Zero, Cents: base},
+		}, 20},
+		{"second tier", 50, []payments.TierCharge{
+			{Tier: 1, Quantity: decimal.NewFromInt(10), Cents: decimal.NewFromInt(4)},
+			{Tier: 2, Quantity: decimal.NewFromInt(40), Cents: decimal.NewFromInt(3)},
+			{Tier: 0, Quantity: decimal.Zero, Cents: base},
+		}, 160},
+		{"above tiers", 150, []payments.TierCharge{
+			{Tier: 1, Quantity: decimal.NewFromInt(10), Cents: decimal.NewFromInt(4)},
+			{Tier: 2, Quantity: decimal.NewFromInt(90), Cents: decimal.NewFromInt(3)},
+			{Tier: 0, Quantity: decimal.NewFromInt(50), Cents: base},
+		}, 410},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			quantity := decimal.NewFromInt(tt.quantity)
+			charges := payments.SplitByTiers(quantity, tiers, base)
+			require.Len(t, charges, len(tt.expected))
+			for i, charge := range charges {
+				require.Equal(t, tt.expected[i].Tier, charge.Tier)
+				require.True(t, tt.expected[i].Quantity.Equal(charge.Quantity), charge.Quantity.String())
+				require.True(t, tt.expected[i].Cents.Equal(charge.Cents))
+			}
+			require.EqualValues(t, tt.total, payments.TieredPrice(quantity, tiers, base).IntPart())
+		})
+	}
+
+	// without tiers everything is charged at the base price.
+	require.True(t, decimal.NewFromInt(20).Equal(payments.TieredPrice(decimal.NewFromInt(10), nil, base)))
+}
+
+func TestTierUsage(t *testing.T) {
+	tiers := []payments.UsagePriceTier{
+		{UpTo: decimal.NewFromInt(10), Cents: decimal.NewFromInt(4)},
+		{UpTo: decimal.NewFromInt(100), Cents: decimal.NewFromInt(3)},
+	}
+	base := decimal.NewFromInt(2)
+
+	usage := payments.NewTierUsage()
+
+	// the first quantity gets the first tier.
+	charges := usage.Split("storage", decimal.NewFromInt(5), tiers, base)
+	require.Len(t, charges, 2)
+	require.Equal(t, 1, charges[0].Tier)
+	require.True(t, decimal.NewFromInt(5).Equal(charges[0].Quantity))
+
+	// the next quantity continues where the previous one ended.
+	charges = usage.Split("storage", decimal.NewFromInt(100), tiers, base)
+	require.Len(t, charges, 3)
+	require.Equal(t, 1, charges[0].Tier)
+	require.True(t, decimal.NewFromInt(5).Equal(charges[0].Quantity))
+	require.Equal(t, 2, charges[1].Tier)
+	require.True(t, decimal.NewFromInt(90).Equal(charges[1].Quantity))
+	require.Equal(t, 0, charges[2].Tier)
+	require.True(t, decimal.NewFromInt(5).Equal(charges[2].Quantity))
+
+	// all the tiers are used up.
+	require.EqualValues(t, 20, usage.Price("storage", decimal.NewFromInt(10), tiers, base).IntPart())
+
+	// other kinds of usage and prices have their own tiers.
+	require.EqualValues(t, 40, usage.Price("egress", decimal.NewFromInt(10), tiers, base).IntPart())
+	require.EqualValues(t, 40, usage.Price("storage", decimal.NewFromInt(10), tiers, decimal.NewFromInt(1)).IntPart())
+
+	// without tiers everything is charged at the base price.
+	require.EqualValues(t, 20, usage.Price("storage", decimal.NewFromInt(10), nil, base).IntPart())
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/uuid"
-	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/payments"
 )
 
@@ -136,36 +135,16 @@ func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID,
 		return nil, Error.Wrap(err)
 	}
 
+	// the volume tiers are applied once to all projects of the user.
+	tiers := payments.NewTierUsage()
+
 	for _, project := range projects {
-		usages, err := accounts.service.usageDB.GetProjectTotalByPartner(ctx, project.ID, accounts.service.partnerNames, since, before)
+		usages, err := accounts.service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, project.ID, accounts.service.partnerNames, since, before)
 		if err != nil {
 			return nil, Error.Wrap(err)
 		}
 
-		partnerCharges := make(map[string]payments.ProjectCharge)
-
-		for partner, usage := range usages {
-			priceModel := accounts.GetProjectUsagePriceModel(partner)
-			usage.Egress = applyEgressDiscount(usage, priceModel)
-			price := accounts.service.calculateProjectUsagePrice(usage, priceModel)
-
-			partnerCharges[partner] = payments.ProjectCharge{
-				ProjectUsage: usage,
-
-				EgressMBCents:       price.Egress.IntPart(),
-				SegmentMonthCents:   price.Segments.IntPart(),
-				StorageMBMonthCents: price.Storage.IntPart(),
-			}
-		}
-
-		// to return unpartnered empty charge if there's no usage
-		if len(partnerCharges) == 0 {
-			partnerCharges[""] = payments.ProjectCharge{
-				ProjectUsage: accounting.ProjectUsage{Since: since, Before: before},
-			}
-		}
-
-		charges[project.PublicID] = partnerCharges
+		charges[project.PublicID] = payments.ProjectUsageCharges(usages, since, before, accounts.GetProjectUsagePriceModel, tiers)
 	}
 
 	return charges, nil
@@ -36,12 +36,12 @@ func TestSignupCouponCodes(t *testing.T) {
 		require.NoError(t, err)
 		defer ctx.Check(redis.Close)
 
-		cache, err := live.OpenCache(ctx, log.Named("cache"), live.Config{StorageBackend: "redis://" + redis.Addr() + "?db=0"})
+		cache, err := live.OpenCache(ctx, log.Named("cache"), live.Config{StorageBackend: "redis://" + redis.Addr() + "?db=0"}, nil)
 		require.NoError(t, err)
 
 		projectLimitCache := accounting.NewProjectLimitCache(db.ProjectAccounting(), 0, 0, 0, accounting.ProjectLimitConfig{CacheCapacity: 100})
 
-		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, *sat.API.Metainfo.Metabase, 5*time.Minute, -10*time.Second)
+		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, nil, *sat.API.Metainfo.Metabase, 5*time.Minute, -10*time.Second)
 
 		pc := paymentsconfig.Config{
 			UsagePrice: paymentsconfig.ProjectUsagePrice{
@@ -57,6 +57,9 @@ func TestSignupCouponCodes(t *testing.T) {
 		priceOverrides, err := pc.UsagePriceOverrides.ToModels()
 		require.NoError(t, err)
 
+		placementPriceOverrides, err := pc.PlacementPriceOverrides.ToModels()
+		require.NoError(t, err)
+
 		paymentsService, err := stripe.NewService(
 			log.Named("payments.stripe:service"),
 			stripe.NewStripeMock(
@@ -72,6 +75,7 @@ func TestSignupCouponCodes(t *testing.T) {
 			db.ProjectAccounting(),
 			prices,
 			priceOverrides,
+			placementPriceOverrides,
 			pc.PackagePlans.Packages,
 			pc.BonusRate,
 			nil,
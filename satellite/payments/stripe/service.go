@@ -8,20 +8,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/shopspring/decimal"
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/stripe/stripe-go/v75"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/currency"
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
@@ -40,12 +39,9 @@ var (
 )
 
 const (
-	// hoursPerMonth is the number of months in a billing month. For the purpose of billing, the billing month is always 30 days.
-	hoursPerMonth = 24 * 30
-
-	storageInvoiceItemDesc = " - Segment Storage (MB-Month)"
-	egressInvoiceItemDesc  = " - Egress Bandwidth (MB)"
-	segmentInvoiceItemDesc = " - Segment Fee (Segment-Month)"
+	storageInvoiceItemDesc = payments.StorageItemDesc
+	egressInvoiceItemDesc  = payments.EgressItemDesc
+	segmentInvoiceItemDesc = payments.SegmentItemDesc
 )
 
 // Config stores needed information for payment service initialization.
@@ -98,12 +94,24 @@ type Service struct {
 }
 
 // NewService creates a Service instance.
-func NewService(log *zap.Logger, stripeClient Client, config Config, db DB, walletsDB storjscan.WalletsDB, billingDB billing.TransactionsDB, projectsDB console.Projects, usersDB console.Users, usageDB accounting.ProjectAccounting, usagePrices payments.ProjectUsagePriceModel, usagePriceOverrides map[string]payments.ProjectUsagePriceModel, packagePlans map[string]payments.PackagePlan, bonusRate int64, analyticsService *analytics.Service) (*Service, error) {
+func NewService(log *zap.Logger, stripeClient Client, config Config, db DB, walletsDB storjscan.WalletsDB, billingDB billing.TransactionsDB, projectsDB console.Projects, usersDB console.Users, usageDB accounting.ProjectAccounting, usagePrices payments.ProjectUsagePriceModel, usagePriceOverrides map[string]payments.ProjectUsagePriceModel, placementPriceOverrides map[storj.PlacementConstraint]payments.ProjectUsagePriceModel, packagePlans map[string]payments.PackagePlan, bonusRate int64, analyticsService *analytics.Service) (*Service, error) {
 	var partners []string
 	for partner := range usagePriceOverrides {
 		partners = append(partners, partner)
 	}
 
+	// placement prices replace both the default and the partner prices.
+	if len(placementPriceOverrides) > 0 {
+		usagePrices.Placements = placementPriceOverrides
+
+		overrides := make(map[string]payments.ProjectUsagePriceModel, len(usagePriceOverrides))
+		for partner, model := range usagePriceOverrides {
+			model.Placements = placementPriceOverrides
+			overrides[partner] = model
+		}
+		usagePriceOverrides = overrides
+	}
+
 	return &Service{
 		log:                    log,
 		db:                     db,
@@ -276,30 +284,26 @@ func (service *Service) InvoiceApplyProjectRecords(ctx context.Context, period t
 		return Error.New("allowed for past periods only")
 	}
 
-	var totalRecords int
-	var totalSkipped int
-
-	for {
+	// the records of all projects of a customer are needed at once to apply the volume tiers,
+	// hence they are listed before being applied.
+	var records []ProjectRecord
+	recordsPage := ProjectRecordsPage{Next: true}
+	for recordsPage.Next {
 		if err = ctx.Err(); err != nil {
 			return Error.Wrap(err)
 		}
 
-		// we are always starting from offset 0 because applyProjectRecords is changing project record state to applied
-		recordsPage, err := service.db.ProjectRecords().ListUnapplied(ctx, uuid.UUID{}, service.listingLimit, start, end)
-		if err != nil {
-			return Error.Wrap(err)
-		}
-		totalRecords += len(recordsPage.Records)
-
-		skipped, err := service.applyProjectRecords(ctx, recordsPage.Records)
+		recordsPage, err = service.db.ProjectRecords().ListUnapplied(ctx, recordsPage.Cursor, service.listingLimit, start, end)
 		if err != nil {
 			return Error.Wrap(err)
 		}
-		totalSkipped += skipped
+		records = append(records, recordsPage.Records...)
+	}
 
-		if !recordsPage.Next {
-			break
-		}
+	totalRecords := len(records)
+	totalSkipped, err := service.applyProjectRecords(ctx, records, start, end)
+	if err != nil {
+		return Error.Wrap(err)
 	}
 
 	service.log.Info("Processed regular project records.",
@@ -323,30 +327,26 @@ func (service *Service) InvoiceApplyToBeAggregatedProjectRecords(ctx context.Con
 		return Error.New("allowed for past periods only")
 	}
 
-	var totalRecords int
-	var totalSkipped int
-
-	for {
+	// the records of all projects of a customer are needed at once to apply the volume tiers,
+	// hence they are listed before being applied.
+	var records []ProjectRecord
+	recordsPage := ProjectRecordsPage{Next: true}
+	for recordsPage.Next {
 		if err = ctx.Err(); err != nil {
 			return Error.Wrap(err)
 		}
 
-		// we are always starting from offset 0 because applyProjectRecords is changing project record state to applied
-		recordsPage, err := service.db.ProjectRecords().ListToBeAggregated(ctx, uuid.UUID{}, service.listingLimit, start, end)
+		recordsPage, err = service.db.ProjectRecords().ListToBeAggregated(ctx, recordsPage.Cursor, service.listingLimit, start, end)
 		if err != nil {
 			return Error.Wrap(err)
 		}
-		totalRecords += len(recordsPage.Records)
-
-		skipped, err := service.applyToBeAggregatedProjectRecords(ctx, recordsPage.Records)
-		if err != nil {
-			return Error.Wrap(err)
-		}
-		totalSkipped += skipped
+		records = append(records, recordsPage.Records...)
+	}
 
-		if !recordsPage.Next {
-			break
-		}
+	totalRecords := len(records)
+	totalSkipped, err := service.applyToBeAggregatedProjectRecords(ctx, records, start, end)
+	if err != nil {
+		return Error.Wrap(err)
 	}
 
 	service.log.Info("Processed aggregated project records.",
@@ -489,9 +489,16 @@ func (service *Service) createTokenPaymentBillingTransaction(ctx context.Context
 }
 
 // applyProjectRecords applies invoice intents as invoice line items to stripe customer.
-func (service *Service) applyProjectRecords(ctx context.Context, records []ProjectRecord) (skipCount int, err error) {
+// The customers are processed concurrently, while the records of a customer are applied
+// one at a time.
+func (service *Service) applyProjectRecords(ctx context.Context, records []ProjectRecord, start, end time.Time) (skipCount int, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	customers, skipCount, err := service.groupProjectRecords(ctx, records)
+	if err != nil {
+		return 0, err
+	}
+
 	var mu sync.Mutex
 	var errGrp errs.Group
 	limiter := sync2.NewLimiter(service.maxParallelCalls)
@@ -501,51 +508,20 @@ func (service *Service) applyProjectRecords(ctx context.Context, records []Proje
 		limiter.Wait()
 	}()
 
-	for _, record := range records {
+	for _, customer := range customers {
 		if err = ctx.Err(); err != nil {
 			return 0, errs.Wrap(err)
 		}
 
-		proj, err := service.projectsDB.Get(ctx, record.ProjectID)
-		if err != nil {
-			// This should never happen, but be sure to log info to further troubleshoot before exiting.
-			service.log.Error("project ID for corresponding project record not found", zap.Stringer("Record ID", record.ID), zap.Stringer("Project ID", record.ProjectID))
-			return 0, errs.Wrap(err)
-		}
-
-		if inactive, err := service.isUserInactive(ctx, proj.OwnerID); err != nil {
-			return 0, errs.Wrap(err)
-		} else if inactive {
-			mu.Lock()
-			skipCount++
-			mu.Unlock()
-			continue
-		}
-
-		cusID, err := service.db.Customers().GetCustomerID(ctx, proj.OwnerID)
-		if err != nil {
-			if errors.Is(err, ErrNoCustomer) {
-				service.log.Warn("Stripe customer does not exist for project owner.", zap.Stringer("Owner ID", proj.OwnerID), zap.Stringer("Project ID", proj.ID))
-				continue
-			}
-
-			return 0, errs.Wrap(err)
-		}
-
-		record := record
+		customer := customer
 		limiter.Go(ctx, func() {
-			skipped, err := service.createInvoiceItems(ctx, cusID, proj.Name, record)
+			skipped, err := service.applyCustomerRecords(ctx, customer, false, start, end, service.createInvoiceItems)
+			mu.Lock()
+			defer mu.Unlock()
 			if err != nil {
-				mu.Lock()
 				errGrp.Add(errs.Wrap(err))
-				mu.Unlock()
-				return
-			}
-			if skipped {
-				mu.Lock()
-				skipCount++
-				mu.Unlock()
 			}
+			skipCount += skipped
 		})
 	}
 
@@ -555,22 +531,63 @@ func (service *Service) applyProjectRecords(ctx context.Context, records []Proje
 }
 
 // applyToBeAggregatedProjectRecords applies to be aggregated invoice intents as invoice line items to stripe customer.
-func (service *Service) applyToBeAggregatedProjectRecords(ctx context.Context, records []ProjectRecord) (skipCount int, err error) {
+func (service *Service) applyToBeAggregatedProjectRecords(ctx context.Context, records []ProjectRecord, start, end time.Time) (skipCount int, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	for _, record := range records {
+	customers, skipCount, err := service.groupProjectRecords(ctx, records)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, customer := range customers {
 		if err = ctx.Err(); err != nil {
 			return 0, errs.Wrap(err)
 		}
 
+		skipped, err := service.applyCustomerRecords(ctx, customer, true, start, end, service.processProjectRecord)
+		skipCount += skipped
+		if err != nil {
+			return 0, errs.Wrap(err)
+		}
+	}
+
+	return skipCount, nil
+}
+
+// customerRecords are the unapplied project records of the projects owned by a customer.
+type customerRecords struct {
+	id      string
+	ownerID uuid.UUID
+	// records are the records by project ID.
+	records map[uuid.UUID]ProjectRecord
+}
+
+// groupProjectRecords groups the records by the customers owning the projects, ordered by the
+// customer ID. The records of inactive users are skipped, as well as the records of users
+// without a stripe customer.
+func (service *Service) groupProjectRecords(ctx context.Context, records []ProjectRecord) (customers []*customerRecords, skipCount int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	byOwner := make(map[uuid.UUID]*customerRecords)
+	for _, record := range records {
+		if err = ctx.Err(); err != nil {
+			return nil, 0, errs.Wrap(err)
+		}
+
 		proj, err := service.projectsDB.Get(ctx, record.ProjectID)
 		if err != nil {
+			// This should never happen, but be sure to log info to further troubleshoot before exiting.
 			service.log.Error("project ID for corresponding project record not found", zap.Stringer("Record ID", record.ID), zap.Stringer("Project ID", record.ProjectID))
-			return 0, errs.Wrap(err)
+			return nil, 0, errs.Wrap(err)
+		}
+
+		if customer, ok := byOwner[proj.OwnerID]; ok {
+			customer.records[record.ProjectID] = record
+			continue
 		}
 
 		if inactive, err := service.isUserInactive(ctx, proj.OwnerID); err != nil {
-			return 0, errs.Wrap(err)
+			return nil, 0, errs.Wrap(err)
 		} else if inactive {
 			skipCount++
 			continue
@@ -583,24 +600,78 @@ func (service *Service) applyToBeAggregatedProjectRecords(ctx context.Context, r
 				continue
 			}
 
-			return 0, errs.Wrap(err)
+			return nil, 0, errs.Wrap(err)
+		}
+
+		customer := &customerRecords{
+			id:      cusID,
+			ownerID: proj.OwnerID,
+			records: map[uuid.UUID]ProjectRecord{record.ProjectID: record},
+		}
+		byOwner[proj.OwnerID] = customer
+		customers = append(customers, customer)
+	}
+
+	sort.Slice(customers, func(i, k int) bool {
+		return customers[i].id < customers[k].id
+	})
+
+	return customers, skipCount, nil
+}
+
+// applyCustomerRecords applies the records of the customer one at a time, in the order of the
+// project IDs, so the volume tiers are split between the projects deterministically. The
+// usage of the projects, whose records have already been applied by an earlier run, is charged
+// to the tiers in the same order, so rerunning the invoicing doesn't grant the tiers again.
+func (service *Service) applyCustomerRecords(ctx context.Context, customer *customerRecords, aggregated bool, start, end time.Time,
+	apply func(ctx context.Context, cusID, projName string, record ProjectRecord, tiers *payments.TierUsage) (skipped bool, err error),
+) (skipCount int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projects, err := service.projectsDB.GetOwn(ctx, customer.ownerID)
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(projects, func(i, k int) bool {
+		return projects[i].ID.Less(projects[k].ID)
+	})
+
+	tiers := payments.NewTierUsage()
+	for _, project := range projects {
+		if record, ok := customer.records[project.ID]; ok {
+			skipped, err := apply(ctx, customer.id, project.Name, record, tiers)
+			if err != nil {
+				return skipCount, err
+			}
+			if skipped {
+				skipCount++
+			}
+			continue
 		}
 
-		record := record
-		skipped, err := service.processProjectRecord(ctx, cusID, proj.Name, record)
+		// the records of all projects of a customer are created in the same state, hence the
+		// existing records of the other projects have already been applied.
+		record, err := service.db.ProjectRecords().Get(ctx, project.ID, start, end)
 		if err != nil {
-			return 0, errs.Wrap(err)
+			return skipCount, err
 		}
-		if skipped {
-			skipCount++
+		if record == nil {
+			continue
+		}
+
+		usages, err := service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, project.ID, service.partnerNames, record.PeriodStart, record.PeriodEnd)
+		if err != nil {
+			return skipCount, err
 		}
+		// only the usage charged by the tiers is needed, the items have already been created.
+		_ = payments.ProjectUsageItems(project.Name, usages, aggregated, service.Accounts().GetProjectUsagePriceModel, tiers)
 	}
 
 	return skipCount, nil
 }
 
 // createInvoiceItems creates invoice line items for stripe customer.
-func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName string, record ProjectRecord) (skipped bool, err error) {
+func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName string, record ProjectRecord, tiers *payments.TierUsage) (skipped bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err = service.db.ProjectRecords().Consume(ctx, record.ID); err != nil {
@@ -611,12 +682,12 @@ func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName
 		return true, nil
 	}
 
-	usages, err := service.usageDB.GetProjectTotalByPartner(ctx, record.ProjectID, service.partnerNames, record.PeriodStart, record.PeriodEnd)
+	usages, err := service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, record.ProjectID, service.partnerNames, record.PeriodStart, record.PeriodEnd)
 	if err != nil {
 		return false, err
 	}
 
-	items := service.InvoiceItemsFromProjectUsage(projName, usages, false)
+	items := service.invoiceItemsFromProjectUsage(projName, usages, false, tiers)
 	for _, item := range items {
 		item.Params = stripe.Params{Context: ctx}
 		item.Currency = stripe.String(string(stripe.CurrencyUSD))
@@ -632,16 +703,8 @@ func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName
 	return false, nil
 }
 
-type usage int
-
-const (
-	storage usage = 0
-	egress  usage = 1
-	segment usage = 2
-)
-
 // processProjectRecord creates or updates invoice line items for stripe customer.
-func (service *Service) processProjectRecord(ctx context.Context, cusID, projName string, record ProjectRecord) (skipped bool, err error) {
+func (service *Service) processProjectRecord(ctx context.Context, cusID, projName string, record ProjectRecord, tiers *payments.TierUsage) (skipped bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err = service.db.ProjectRecords().Consume(ctx, record.ID); err != nil {
@@ -652,144 +715,100 @@ func (service *Service) processProjectRecord(ctx context.Context, cusID, projNam
 		return true, nil
 	}
 
-	usages, err := service.usageDB.GetProjectTotalByPartner(ctx, record.ProjectID, service.partnerNames, record.PeriodStart, record.PeriodEnd)
+	usages, err := service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, record.ProjectID, service.partnerNames, record.PeriodStart, record.PeriodEnd)
 	if err != nil {
 		return false, err
 	}
 
-	newItems := service.InvoiceItemsFromProjectUsage(projName, usages, true)
+	newItems := service.invoiceItemsFromProjectUsage(projName, usages, true, tiers)
 
 	existingItems, err := service.getExistingInvoiceItems(ctx, cusID)
 	if err != nil {
 		return false, err
 	}
 
-	if existingItems[segment] == nil || existingItems[storage] == nil || existingItems[egress] == nil {
-		for _, item := range newItems {
-			item.Params = stripe.Params{Context: ctx}
-			item.Currency = stripe.String(string(stripe.CurrencyUSD))
-			item.Customer = stripe.String(cusID)
-			item.AddMetadata("projectID", record.ProjectID.String())
-
-			_, err = service.stripeClient.InvoiceItems().New(item)
+	for _, item := range newItems {
+		if existing, ok := existingItems[*item.Description]; ok {
+			existing.Quantity += *item.Quantity
+			_, err = service.stripeClient.InvoiceItems().Update(existing.ID, &stripe.InvoiceItemParams{
+				Params:   stripe.Params{Context: ctx},
+				Quantity: stripe.Int64(existing.Quantity),
+			})
 			if err != nil {
 				return false, err
 			}
+			continue
 		}
-	} else {
-		err = service.updateExistingInvoiceItems(ctx, existingItems, newItems)
+
+		item.Params = stripe.Params{Context: ctx}
+		item.Currency = stripe.String(string(stripe.CurrencyUSD))
+		item.Customer = stripe.String(cusID)
+		item.AddMetadata("projectID", record.ProjectID.String())
+
+		created, err := service.stripeClient.InvoiceItems().New(item)
 		if err != nil {
 			return false, err
 		}
+		if created != nil {
+			existingItems[created.Description] = created
+		}
 	}
 
 	return false, nil
 }
 
-// getExistingInvoiceItems lists 3 existing pending invoice line items for stripe customer.
-func (service *Service) getExistingInvoiceItems(ctx context.Context, cusID string) (map[usage]*stripe.InvoiceItem, error) {
+// getExistingInvoiceItems lists existing pending invoice line items for stripe customer by description.
+func (service *Service) getExistingInvoiceItems(ctx context.Context, cusID string) (map[string]*stripe.InvoiceItem, error) {
 	existingItemsIter := service.stripeClient.InvoiceItems().List(&stripe.InvoiceItemListParams{
 		Customer: &cusID,
 		Pending:  stripe.Bool(true),
 		ListParams: stripe.ListParams{
 			Context: ctx,
-			Limit:   stripe.Int64(3),
 		},
 	})
 
-	items := map[usage]*stripe.InvoiceItem{
-		storage: nil,
-		egress:  nil,
-		segment: nil,
-	}
-
+	items := make(map[string]*stripe.InvoiceItem)
 	for existingItemsIter.Next() {
 		item := existingItemsIter.InvoiceItem()
-		if strings.Contains(item.Description, storageInvoiceItemDesc) {
-			items[storage] = item
-		} else if strings.Contains(item.Description, egressInvoiceItemDesc) {
-			items[egress] = item
-		} else if strings.Contains(item.Description, segmentInvoiceItemDesc) {
-			items[segment] = item
+		if strings.Contains(item.Description, storageInvoiceItemDesc) ||
+			strings.Contains(item.Description, egressInvoiceItemDesc) ||
+			strings.Contains(item.Description, segmentInvoiceItemDesc) {
+			items[item.Description] = item
 		}
 	}
 
 	return items, existingItemsIter.Err()
 }
 
-// updateExistingInvoiceItems updates 3 existing pending invoice line items for stripe customer.
-func (service *Service) updateExistingInvoiceItems(ctx context.Context, existingItems map[usage]*stripe.InvoiceItem, newItems []*stripe.InvoiceItemParams) (err error) {
-	for _, item := range newItems {
-		if strings.Contains(*item.Description, storageInvoiceItemDesc) {
-			existingItems[storage].Quantity += *item.Quantity
-		} else if strings.Contains(*item.Description, egressInvoiceItemDesc) {
-			existingItems[egress].Quantity += *item.Quantity
-		} else if strings.Contains(*item.Description, segmentInvoiceItemDesc) {
-			existingItems[segment].Quantity += *item.Quantity
-		}
-	}
-
-	for _, item := range existingItems {
-		_, err = service.stripeClient.InvoiceItems().Update(item.ID, &stripe.InvoiceItemParams{
-			Params:   stripe.Params{Context: ctx},
-			Quantity: stripe.Int64(item.Quantity),
-		})
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // InvoiceItemsFromProjectUsage calculates Stripe invoice item from project usage.
 func (service *Service) InvoiceItemsFromProjectUsage(projName string, partnerUsages map[string]accounting.ProjectUsage, aggregated bool) (result []*stripe.InvoiceItemParams) {
-	var partners []string
-	if len(partnerUsages) == 0 {
-		partners = []string{""}
-		partnerUsages = map[string]accounting.ProjectUsage{"": {}}
-	} else {
-		for partner := range partnerUsages {
-			partners = append(partners, partner)
-		}
-		sort.Strings(partners)
+	usages := make(map[string]map[storj.PlacementConstraint]accounting.ProjectUsage, len(partnerUsages))
+	for partner, usage := range partnerUsages {
+		usages[partner] = map[storj.PlacementConstraint]accounting.ProjectUsage{storj.DefaultPlacement: usage}
 	}
+	return service.InvoiceItemsFromProjectUsageByPlacement(projName, usages, aggregated)
+}
 
-	for _, partner := range partners {
-		priceModel := service.Accounts().GetProjectUsagePriceModel(partner)
-
-		usage := partnerUsages[partner]
-		usage.Egress = applyEgressDiscount(usage, priceModel)
-
-		prefix := "Project " + projName
-		if partner != "" {
-			prefix += " (" + partner + ")"
-		}
-
-		if aggregated {
-			prefix = "All projects"
-		}
-
-		projectItem := &stripe.InvoiceItemParams{}
-		projectItem.Description = stripe.String(prefix + storageInvoiceItemDesc)
-		projectItem.Quantity = stripe.Int64(storageMBMonthDecimal(usage.Storage).IntPart())
-		storagePrice, _ := priceModel.StorageMBMonthCents.Float64()
-		projectItem.UnitAmountDecimal = stripe.Float64(storagePrice)
-		result = append(result, projectItem)
+// InvoiceItemsFromProjectUsageByPlacement calculates Stripe invoice items from project usage split by
+// partner and bucket placement. Usage charged by volume tiers is put into separate line items.
+func (service *Service) InvoiceItemsFromProjectUsageByPlacement(projName string, usages map[string]map[storj.PlacementConstraint]accounting.ProjectUsage, aggregated bool) (result []*stripe.InvoiceItemParams) {
+	return service.invoiceItemsFromProjectUsage(projName, usages, aggregated, payments.NewTierUsage())
+}
 
-		projectItem = &stripe.InvoiceItemParams{}
-		projectItem.Description = stripe.String(prefix + egressInvoiceItemDesc)
-		projectItem.Quantity = stripe.Int64(egressMBDecimal(usage.Egress).IntPart())
-		egressPrice, _ := priceModel.EgressMBCents.Float64()
-		projectItem.UnitAmountDecimal = stripe.Float64(egressPrice)
-		result = append(result, projectItem)
+// invoiceItemsFromProjectUsage calculates Stripe invoice items from project usage split by partner
+// and bucket placement. The volume tiers are applied after the usage already charged by tiers.
+func (service *Service) invoiceItemsFromProjectUsage(projName string, usages map[string]map[storj.PlacementConstraint]accounting.ProjectUsage, aggregated bool, tiers *payments.TierUsage) (result []*stripe.InvoiceItemParams) {
+	if len(usages) == 0 {
+		usages = map[string]map[storj.PlacementConstraint]accounting.ProjectUsage{"": {storj.DefaultPlacement: {}}}
+	}
 
-		projectItem = &stripe.InvoiceItemParams{}
-		projectItem.Description = stripe.String(prefix + segmentInvoiceItemDesc)
-		projectItem.Quantity = stripe.Int64(segmentMonthDecimal(usage.SegmentCount).IntPart())
-		segmentPrice, _ := priceModel.SegmentMonthCents.Float64()
-		projectItem.UnitAmountDecimal = stripe.Float64(segmentPrice)
-		result = append(result, projectItem)
+	for _, usageItem := range payments.ProjectUsageItems(projName, usages, aggregated, service.Accounts().GetProjectUsagePriceModel, tiers) {
+		item := &stripe.InvoiceItemParams{}
+		item.Description = stripe.String(usageItem.Description)
+		item.Quantity = stripe.Int64(usageItem.Quantity.IntPart())
+		price, _ := usageItem.UnitCents.Float64()
+		item.UnitAmountDecimal = stripe.Float64(price)
+		result = append(result, item)
 	}
 
 	service.log.Info("invoice items", zap.Any("result", result))
@@ -1550,68 +1569,14 @@ func (service *Service) isUserInactive(ctx context.Context, userID uuid.UUID) (b
 	return user.Status != console.Active, nil
 }
 
-// projectUsagePrice represents pricing for project usage.
-type projectUsagePrice struct {
-	Storage  decimal.Decimal
-	Egress   decimal.Decimal
-	Segments decimal.Decimal
-}
-
-// Total returns project usage price total.
-func (price projectUsagePrice) Total() decimal.Decimal {
-	return price.Storage.Add(price.Egress).Add(price.Segments)
-}
-
-// Total returns project usage price total.
-func (price projectUsagePrice) TotalInt64() int64 {
-	return price.Storage.Add(price.Egress).Add(price.Segments).IntPart()
-}
-
-// calculateProjectUsagePrice calculate project usage price.
-func (service *Service) calculateProjectUsagePrice(usage accounting.ProjectUsage, pricing payments.ProjectUsagePriceModel) projectUsagePrice {
-	return projectUsagePrice{
-		Storage:  pricing.StorageMBMonthCents.Mul(storageMBMonthDecimal(usage.Storage)).Round(0),
-		Egress:   pricing.EgressMBCents.Mul(egressMBDecimal(usage.Egress)).Round(0),
-		Segments: pricing.SegmentMonthCents.Mul(segmentMonthDecimal(usage.SegmentCount)).Round(0),
-	}
-}
-
 // SetNow allows tests to have the Service act as if the current time is whatever
 // they want. This avoids races and sleeping, making tests more reliable and efficient.
 func (service *Service) SetNow(now func() time.Time) {
 	service.nowFn = now
 }
 
-// storageMBMonthDecimal converts storage usage from Byte-Hours to Megabyte-Months.
-// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
-func storageMBMonthDecimal(storage float64) decimal.Decimal {
-	return decimal.NewFromFloat(storage).Shift(-6).Div(decimal.NewFromInt(hoursPerMonth)).Round(0)
-}
-
-// egressMBDecimal converts egress usage from bytes to Megabytes
-// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
-func egressMBDecimal(egress int64) decimal.Decimal {
-	return decimal.NewFromInt(egress).Shift(-6).Round(0)
-}
-
-// segmentMonthDecimal converts segments usage from Segment-Hours to Segment-Months.
-// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
-func segmentMonthDecimal(segments float64) decimal.Decimal {
-	return decimal.NewFromFloat(segments).Div(decimal.NewFromInt(hoursPerMonth)).Round(0)
-}
-
 // doesProjectRecordHaveNoUsage returns true if the given project record
 // represents a billing cycle where there was no usage.
 func doesProjectRecordHaveNoUsage(record ProjectRecord) bool {
 	return record.Storage == 0 && record.Egress == 0 && record.Segments == 0
 }
-
-// applyEgressDiscount returns the amount of egress that we should charge for by subtracting
-// the discounted amount.
-func applyEgressDiscount(usage accounting.ProjectUsage, model payments.ProjectUsagePriceModel) int64 {
-	egress := usage.Egress - int64(math.Round(usage.Storage/hoursPerMonth*model.EgressDiscountRatio))
-	if egress < 0 {
-		egress = 0
-	}
-	return egress
-}
@@ -20,6 +20,7 @@ import (
 	"storj.io/common/currency"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
@@ -825,6 +826,90 @@ func TestService_InvoiceItemsFromProjectUsage(t *testing.T) {
 	})
 }
 
+func TestService_InvoiceItemsFromProjectUsageByPlacement(t *testing.T) {
+	const (
+		projectName = "my-project"
+		placement   = storj.PlacementConstraint(1)
+	)
+
+	var (
+		defaultPrice = paymentsconfig.ProjectUsagePrice{
+			StorageTB: "1",
+			EgressTB:  "2",
+			Segment:   "3",
+		}
+		placementPrice = paymentsconfig.ProjectUsagePrice{
+			StorageTB:      "4",
+			EgressTB:       "5",
+			Segment:        "6",
+			StorageTBTiers: "0.001=8",
+		}
+	)
+	placementModel, err := placementPrice.ToModel()
+	require.NoError(t, err)
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Payments.UsagePrice = defaultPrice
+				config.Payments.PlacementPriceOverrides.SetMap(map[storj.PlacementConstraint]paymentsconfig.ProjectUsagePrice{
+					placement: placementPrice,
+				})
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		usage := map[string]map[storj.PlacementConstraint]accounting.ProjectUsage{
+			"": {
+				storj.DefaultPlacement: {
+					Storage:      10000000000,             // Byte-hours
+					Egress:       123 * memory.GB.Int64(), // Bytes
+					SegmentCount: 200000,                  // Segment-Hours
+				},
+				placement: {
+					Storage:      3 * memory.GB.Float64() * 24 * 30, // 3000 MB-months
+					Egress:       456 * memory.GB.Int64(),
+					SegmentCount: 400000,
+				},
+			},
+			"partner": {
+				placement: {
+					Storage: 3 * memory.GB.Float64() * 24 * 30,
+				},
+			},
+		}
+
+		items := planet.Satellites[0].API.Payments.StripeService.InvoiceItemsFromProjectUsageByPlacement(projectName, usage, false)
+		// default placement has 3 items, placement override has an additional item for the storage tier.
+		// The storage tier is used up by then, so the partner's usage with the same placement has 3 items.
+		require.Len(t, items, 10)
+
+		prefix := "Project " + projectName
+		require.Equal(t, prefix+" - Segment Storage (MB-Month)", *items[0].Description)
+
+		prefix += " (placement 1)"
+		require.Equal(t, prefix+" - Segment Storage (MB-Month) (tier 1)", *items[3].Description)
+		require.EqualValues(t, 1000, *items[3].Quantity)
+		tierPrice, _ := placementModel.StorageTiers[0].Cents.Float64()
+		require.Equal(t, tierPrice, *items[3].UnitAmountDecimal)
+
+		require.Equal(t, prefix+" - Segment Storage (MB-Month)", *items[4].Description)
+		require.EqualValues(t, 2000, *items[4].Quantity)
+		storagePrice, _ := placementModel.StorageMBMonthCents.Float64()
+		require.Equal(t, storagePrice, *items[4].UnitAmountDecimal)
+
+		require.Equal(t, prefix+" - Egress Bandwidth (MB)", *items[5].Description)
+		require.Equal(t, prefix+" - Segment Fee (Segment-Month)", *items[6].Description)
+
+		require.Equal(t, "Project "+projectName+" (partner) (placement 1) - Segment Storage (MB-Month)", *items[7].Description)
+		require.EqualValues(t, 3000, *items[7].Quantity)
+		require.Equal(t, storagePrice, *items[7].UnitAmountDecimal)
+
+		pricing := planet.Satellites[0].API.Payments.Accounts.GetProjectUsagePriceModel("")
+		require.Contains(t, pricing.Placements, placement)
+	})
+}
+
 func TestService_PayInvoiceFromTokenBalance(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
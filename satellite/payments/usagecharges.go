@@ -0,0 +1,186 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/accounting"
+)
+
+const (
+	// HoursPerMonth is the number of hours in a billing month. For the purpose of billing, the billing month is always 30 days.
+	HoursPerMonth = 24 * 30
+
+	// StorageItemDesc is the description suffix of the storage usage items.
+	StorageItemDesc = " - Segment Storage (MB-Month)"
+	// EgressItemDesc is the description suffix of the egress usage items.
+	EgressItemDesc = " - Egress Bandwidth (MB)"
+	// SegmentItemDesc is the description suffix of the segment usage items.
+	SegmentItemDesc = " - Segment Fee (Segment-Month)"
+
+	// storageTierKind and egressTierKind separate the usage charged by volume tiers.
+	storageTierKind = "storage"
+	egressTierKind  = "egress"
+)
+
+// PriceModelFunc returns the project usage price model for a partner name.
+type PriceModelFunc func(partner string) ProjectUsagePriceModel
+
+// UsageItem is a charged part of project usage, e.g. an invoice line item.
+type UsageItem struct {
+	Description string
+	Quantity    decimal.Decimal
+	UnitCents   decimal.Decimal
+}
+
+// ProjectUsageItems returns the items charged for project usage split by partner and bucket placement.
+// Usage charged by volume tiers is put into separate items. The volume tiers are applied after the
+// usage already charged by tiers. When aggregated is true, the items are described as the usage of all
+// projects.
+func ProjectUsageItems(projName string, usages map[string]map[storj.PlacementConstraint]accounting.ProjectUsage, aggregated bool, priceModel PriceModelFunc, tiers *TierUsage) (items []UsageItem) {
+	forEachUsage(usages, priceModel, func(partner string, placement storj.PlacementConstraint, usage accounting.ProjectUsage, model ProjectUsagePriceModel) {
+		prefix := "Project " + projName
+		if partner != "" {
+			prefix += " (" + partner + ")"
+		}
+
+		if aggregated {
+			prefix = "All projects"
+		}
+
+		if placement != storj.DefaultPlacement {
+			prefix += fmt.Sprintf(" (placement %d)", placement)
+		}
+
+		items = append(items, tieredUsageItems(prefix+StorageItemDesc, tiers.Split(storageTierKind,
+			StorageMBMonthDecimal(usage.Storage), model.StorageTiers, model.StorageMBMonthCents))...)
+
+		items = append(items, tieredUsageItems(prefix+EgressItemDesc, tiers.Split(egressTierKind,
+			EgressMBDecimal(usage.Egress), model.EgressTiers, model.EgressMBCents))...)
+
+		items = append(items, UsageItem{
+			Description: prefix + SegmentItemDesc,
+			Quantity:    SegmentMonthDecimal(usage.SegmentCount),
+			UnitCents:   model.SegmentMonthCents,
+		})
+	})
+
+	return items
+}
+
+// ProjectUsageCharges returns how much the project usage split by partner and bucket placement costs,
+// summed up per partner. The volume tiers are applied after the usage already charged by tiers.
+// There's an empty unpartnered charge when there's no usage.
+func ProjectUsageCharges(usages map[string]map[storj.PlacementConstraint]accounting.ProjectUsage, since, before time.Time, priceModel PriceModelFunc, tiers *TierUsage) map[string]ProjectCharge {
+	charges := make(map[string]ProjectCharge)
+
+	forEachUsage(usages, priceModel, func(partner string, placement storj.PlacementConstraint, usage accounting.ProjectUsage, model ProjectUsagePriceModel) {
+		charge, ok := charges[partner]
+		if !ok {
+			charge.ProjectUsage = accounting.ProjectUsage{Since: since, Before: before}
+		}
+
+		charge.Storage += usage.Storage
+		charge.Egress += usage.Egress
+		charge.SegmentCount += usage.SegmentCount
+		charge.ObjectCount += usage.ObjectCount
+		charge.Since, charge.Before = usage.Since, usage.Before
+
+		charge.StorageMBMonthCents += tiers.Price(storageTierKind, StorageMBMonthDecimal(usage.Storage), model.StorageTiers, model.StorageMBMonthCents).Round(0).IntPart()
+		charge.EgressMBCents += tiers.Price(egressTierKind, EgressMBDecimal(usage.Egress), model.EgressTiers, model.EgressMBCents).Round(0).IntPart()
+		charge.SegmentMonthCents += model.SegmentMonthCents.Mul(SegmentMonthDecimal(usage.SegmentCount)).Round(0).IntPart()
+
+		charges[partner] = charge
+	})
+
+	if len(charges) == 0 {
+		charges[""] = ProjectCharge{
+			ProjectUsage: accounting.ProjectUsage{Since: since, Before: before},
+		}
+	}
+
+	return charges
+}
+
+// forEachUsage calls fn for the usage of every partner and placement with the price model of the
+// usage and the egress discount already applied. The usage is iterated in a stable order, because
+// the order decides which usage is charged by the volume tiers.
+func forEachUsage(usages map[string]map[storj.PlacementConstraint]accounting.ProjectUsage, priceModel PriceModelFunc, fn func(partner string, placement storj.PlacementConstraint, usage accounting.ProjectUsage, model ProjectUsagePriceModel)) {
+	var partners []string
+	for partner := range usages {
+		partners = append(partners, partner)
+	}
+	sort.Strings(partners)
+
+	for _, partner := range partners {
+		partnerModel := priceModel(partner)
+
+		var placements []storj.PlacementConstraint
+		for placement := range usages[partner] {
+			placements = append(placements, placement)
+		}
+		sort.Slice(placements, func(i, k int) bool { return placements[i] < placements[k] })
+
+		for _, placement := range placements {
+			model := partnerModel.ForPlacement(placement)
+
+			usage := usages[partner][placement]
+			usage.Egress = ApplyEgressDiscount(usage, model)
+
+			fn(partner, placement, usage, model)
+		}
+	}
+}
+
+// tieredUsageItems returns an item for every volume tier charge, followed by the item for the
+// quantity charged at the base price.
+func tieredUsageItems(desc string, charges []TierCharge) (items []UsageItem) {
+	for _, charge := range charges {
+		description := desc
+		if charge.Tier > 0 {
+			description += fmt.Sprintf(" (tier %d)", charge.Tier)
+		}
+		items = append(items, UsageItem{
+			Description: description,
+			Quantity:    charge.Quantity,
+			UnitCents:   charge.Cents,
+		})
+	}
+	return items
+}
+
+// StorageMBMonthDecimal converts storage usage from Byte-Hours to Megabyte-Months.
+// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
+func StorageMBMonthDecimal(storage float64) decimal.Decimal {
+	return decimal.NewFromFloat(storage).Shift(-6).Div(decimal.NewFromInt(HoursPerMonth)).Round(0)
+}
+
+// EgressMBDecimal converts egress usage from bytes to Megabytes
+// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
+func EgressMBDecimal(egress int64) decimal.Decimal {
+	return decimal.NewFromInt(egress).Shift(-6).Round(0)
+}
+
+// SegmentMonthDecimal converts segments usage from Segment-Hours to Segment-Months.
+// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
+func SegmentMonthDecimal(segments float64) decimal.Decimal {
+	return decimal.NewFromFloat(segments).Div(decimal.NewFromInt(HoursPerMonth)).Round(0)
+}
+
+// ApplyEgressDiscount returns the amount of egress that we should charge for by subtracting
+// the discounted amount.
+func ApplyEgressDiscount(usage accounting.ProjectUsage, model ProjectUsagePriceModel) int64 {
+	egress := usage.Egress - int64(math.Round(usage.Storage/HoursPerMonth*model.EgressDiscountRatio))
+	if egress < 0 {
+		egress = 0
+	}
+	return egress
+}
@@ -5,6 +5,7 @@ package satellite
 
 import (
 	"context"
+	"io"
 	"net"
 	"net/mail"
 	"net/smtp"
@@ -15,13 +16,13 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/identity"
-	"storj.io/storj/private/migrate"
 	"storj.io/storj/private/post"
 	"storj.io/storj/private/post/oauth2"
 	"storj.io/storj/private/server"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/accounting/live"
+	"storj.io/storj/satellite/accounting/objectstats"
 	"storj.io/storj/satellite/accounting/projectbwcleanup"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/rolluparchive"
@@ -60,6 +61,7 @@ import (
 	"storj.io/storj/satellite/overlay/straynodes"
 	"storj.io/storj/satellite/payments/accountfreeze"
 	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/offline"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
@@ -70,6 +72,7 @@ import (
 	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/snopayouts"
 	"storj.io/storj/shared/debug"
+	"storj.io/storj/shared/migrate"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -85,6 +88,10 @@ func init() {
 type DB interface {
 	// MigrateToLatest initializes the database
 	MigrateToLatest(ctx context.Context) error
+	// MigrateToVersion migrates or rolls back the database to the specified version
+	MigrateToVersion(ctx context.Context, version int) error
+	// DryRunMigration writes the statements, which would migrate the database, without running them
+	DryRunMigration(ctx context.Context, w io.Writer, version int) error
 	// CheckVersion checks the database is the correct version
 	CheckVersion(ctx context.Context) error
 	// Close closes the database
@@ -104,6 +111,8 @@ type DB interface {
 	StoragenodeAccounting() accounting.StoragenodeAccounting
 	// ProjectAccounting returns database for storing information about project data use
 	ProjectAccounting() accounting.ProjectAccounting
+	// LiveAccounting returns database for storing live accounting counters
+	LiveAccounting() accounting.LiveAccountingDB
 	// RepairQueue returns queue for segments that need repairing
 	RepairQueue() queue.RepairQueue
 	// VerifyQueue returns queue for segments chosen for verification
@@ -124,6 +133,10 @@ type DB interface {
 	GracefulExit() gracefulexit.DB
 	// StripeCoinPayments returns stripecoinpayments database.
 	StripeCoinPayments() stripe.DB
+	// DurabilityReports returns database for the latest durability reports.
+	DurabilityReports() durability.DB
+	// OfflineInvoices returns database for invoices of the offline payments provider.
+	OfflineInvoices() offline.DB
 	// Billing returns storjscan transactions database.
 	Billing() billing.TransactionsDB
 	// Wallets returns storjscan wallets database.
@@ -197,6 +210,7 @@ type Config struct {
 	RollupArchive    rolluparchive.Config
 	LiveAccounting   live.Config
 	ProjectBWCleanup projectbwcleanup.Config
+	ObjectStats      objectstats.Config
 
 	Mail mailservice.Config
 
@@ -161,8 +161,12 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 				return node.CountryCode.String()
 			},
 		}
+		alertThreshold := config.DurabilityReport.AlertThreshold
+		if alertThreshold == 0 {
+			alertThreshold = config.Metainfo.RS.Repair
+		}
 		for class, f := range classes {
-			peer.DurabilityReport.Observer = append(peer.DurabilityReport.Observer, durability.NewDurability(db.OverlayCache(), metabaseDB, class, f, config.Metainfo.RS.Total, config.Metainfo.RS.Repair, config.Metainfo.RS.Repair-config.Metainfo.RS.Min, config.RangedLoop.AsOfSystemInterval))
+			peer.DurabilityReport.Observer = append(peer.DurabilityReport.Observer, durability.NewDurability(peer.Log.Named("durability-report"), db.OverlayCache(), metabaseDB, db.DurabilityReports(), class, f, config.Metainfo.RS.Total, config.Metainfo.RS.Repair, config.Metainfo.RS.Repair-config.Metainfo.RS.Min, alertThreshold, config.RangedLoop.AsOfSystemInterval))
 		}
 	}
 
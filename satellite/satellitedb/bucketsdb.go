@@ -36,6 +36,9 @@ func (db *bucketsDB) CreateBucket(ctx context.Context, bucket buckets.Bucket) (_
 		}
 	}
 	optionalFields.Placement = dbx.BucketMetainfo_Placement(int(bucket.Placement))
+	optionalFields.StorageLimit = dbx.BucketMetainfo_StorageLimit_Raw(bucket.Limits.Storage)
+	optionalFields.BandwidthLimit = dbx.BucketMetainfo_BandwidthLimit_Raw(bucket.Limits.Bandwidth)
+	optionalFields.SegmentLimit = dbx.BucketMetainfo_SegmentLimit_Raw(bucket.Limits.Segments)
 
 	row, err := db.db.Create_BucketMetainfo(ctx,
 		dbx.BucketMetainfo_Id(bucket.ID[:]),
@@ -104,6 +107,48 @@ func (db *bucketsDB) GetBucketPlacement(ctx context.Context, bucketName []byte,
 	return placement, nil
 }
 
+// GetBucketLimits returns the usage limits of the bucket.
+func (db *bucketsDB) GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (limits buckets.Limits, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxBucket, err := db.db.Get_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Limits{}, buckets.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return buckets.Limits{}, buckets.ErrBucket.Wrap(err)
+	}
+
+	return buckets.Limits{
+		Storage:   dbxBucket.StorageLimit,
+		Bandwidth: dbxBucket.BandwidthLimit,
+		Segments:  dbxBucket.SegmentLimit,
+	}, nil
+}
+
+// UpdateBucketLimits sets the usage limits of the bucket.
+func (db *bucketsDB) UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits buckets.Limits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			StorageLimit:   dbx.BucketMetainfo_StorageLimit_Raw(limits.Storage),
+			BandwidthLimit: dbx.BucketMetainfo_BandwidthLimit_Raw(limits.Bandwidth),
+			SegmentLimit:   dbx.BucketMetainfo_SegmentLimit_Raw(limits.Segments),
+		})
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return buckets.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
 // GetBucketVersioningState returns with the versioning state of the bucket.
 func (db *bucketsDB) GetBucketVersioningState(ctx context.Context, bucketName []byte, projectID uuid.UUID) (versioningState buckets.Versioning, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -362,6 +407,11 @@ func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket buckets.Bucket, e
 			BlockSize:   int32(dbxBucket.DefaultEncryptionBlockSize),
 		},
 		Versioning: buckets.Versioning(dbxBucket.Versioning),
+		Limits: buckets.Limits{
+			Storage:   dbxBucket.StorageLimit,
+			Bandwidth: dbxBucket.BandwidthLimit,
+			Segments:  dbxBucket.SegmentLimit,
+		},
 	}
 
 	if dbxBucket.Placement != nil {
@@ -5,13 +5,13 @@ package satellitedb
 
 import (
 	"context"
+	"io"
 	"sync"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/lrucache"
-	"storj.io/storj/private/migrate"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/attribution"
@@ -19,6 +19,7 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/durability"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
@@ -26,6 +27,7 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/payments/offline"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
 	"storj.io/storj/satellite/repair/queue"
@@ -35,6 +37,7 @@ import (
 	"storj.io/storj/satellite/snopayouts"
 	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/migrate"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -214,6 +217,11 @@ func (dbc *satelliteDBCollection) ProjectAccounting() accounting.ProjectAccounti
 	return &ProjectAccounting{db: dbc.getByName("projectaccounting")}
 }
 
+// LiveAccounting returns database for storing live accounting counters.
+func (dbc *satelliteDBCollection) LiveAccounting() accounting.LiveAccountingDB {
+	return &liveAccounting{db: dbc.getByName("liveaccounting")}
+}
+
 // Revocation returns the database to deal with macaroon revocation.
 func (dbc *satelliteDBCollection) Revocation() revocation.DB {
 	db := dbc.getByName("revocation")
@@ -274,6 +282,16 @@ func (dbc *satelliteDBCollection) StripeCoinPayments() stripe.DB {
 	return &stripeCoinPaymentsDB{db: dbc.getByName("stripecoinpayments")}
 }
 
+// DurabilityReports returns database for the latest durability reports.
+func (dbc *satelliteDBCollection) DurabilityReports() durability.DB {
+	return &durabilityReports{db: dbc.getByName("durabilityreports")}
+}
+
+// OfflineInvoices returns database for invoices of the offline payments provider.
+func (dbc *satelliteDBCollection) OfflineInvoices() offline.DB {
+	return &offlineInvoices{db: dbc.getByName("offlineinvoices")}
+}
+
 // Billing returns database for billing and payment transactions.
 func (dbc *satelliteDBCollection) Billing() billing.TransactionsDB {
 	return &billingDB{db: dbc.getByName("billing")}
@@ -327,6 +345,26 @@ func (dbc *satelliteDBCollection) MigrateToLatest(ctx context.Context) error {
 	return eg.Err()
 }
 
+// MigrateToVersion migrates or rolls back all databases to the specified version.
+func (dbc *satelliteDBCollection) MigrateToVersion(ctx context.Context, version int) error {
+	var eg errs.Group
+	for _, db := range dbc.dbs {
+		eg.Add(db.MigrateToVersion(ctx, version))
+	}
+	return eg.Err()
+}
+
+// DryRunMigration writes the statements, which would migrate all databases to
+// the specified version, to w. Negative version means the latest version.
+func (dbc *satelliteDBCollection) DryRunMigration(ctx context.Context, w io.Writer, version int) error {
+	for _, db := range dbc.dbs {
+		if err := db.DryRunMigration(ctx, w, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Close closes all satellite dbs.
 func (dbc *satelliteDBCollection) Close() error {
 	var eg errs.Group
@@ -381,6 +381,24 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_object_stats (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	collected_at timestamp with time zone NOT NULL,
+	object_count bigint NOT NULL,
+	pending_object_count bigint NOT NULL,
+	total_bytes bigint NOT NULL,
+	size_histogram bytea NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_prefix_stats (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	prefix bytea NOT NULL,
+	object_count bigint NOT NULL,
+	total_bytes bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, prefix )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -407,6 +425,20 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE durability_reports (
+	class text NOT NULL,
+	report_time timestamp with time zone NOT NULL,
+	bus_factor integer NOT NULL,
+	bus_factor_exemplar text NOT NULL,
+	PRIMARY KEY ( class )
+);
+CREATE TABLE durability_report_stats (
+	class text NOT NULL,
+	value text NOT NULL,
+	min_healthy_pieces integer NOT NULL,
+	exemplar text NOT NULL,
+	PRIMARY KEY ( class, value )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -431,6 +463,13 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE live_accounting_counters (
+	project_id bytea NOT NULL,
+	name text NOT NULL,
+	value bigint NOT NULL,
+	expires_at timestamp with time zone,
+	PRIMARY KEY ( project_id, name )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -490,6 +529,13 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_maintenance_windows (
+	node_id bytea NOT NULL,
+	start_at timestamp with time zone NOT NULL,
+	end_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id, start_at )
+);
 CREATE TABLE node_tags (
 	node_id bytea NOT NULL,
 	name text NOT NULL,
@@ -530,6 +576,22 @@ CREATE TABLE oauth_tokens (
 	expires_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( token )
 );
+CREATE TABLE offline_invoices (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	description text NOT NULL,
+	amount bigint NOT NULL,
+	status text NOT NULL,
+	line_items jsonb NOT NULL,
+	due_date timestamp with time zone NOT NULL,
+	paid_at timestamp with time zone,
+	payment_reference text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, period_start )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -852,6 +914,9 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
 	PRIMARY KEY ( project_id, name )
 );
 CREATE TABLE project_invitations (
@@ -881,6 +946,7 @@ CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index O
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
 CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_tallies ( interval_start ) ;
+CREATE INDEX durability_report_stats_class_min_healthy_pieces_index ON durability_report_stats ( class, min_healthy_pieces ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
@@ -892,6 +958,8 @@ CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE INDEX offline_invoices_user_id_index ON offline_invoices ( user_id ) ;
+CREATE INDEX offline_invoices_status_due_date_index ON offline_invoices ( status, due_date ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX projects_owner_id_index ON projects ( owner_id ) ;
 CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day ) ;
@@ -1077,6 +1145,24 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_object_stats (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	collected_at timestamp with time zone NOT NULL,
+	object_count bigint NOT NULL,
+	pending_object_count bigint NOT NULL,
+	total_bytes bigint NOT NULL,
+	size_histogram bytea NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_prefix_stats (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	prefix bytea NOT NULL,
+	object_count bigint NOT NULL,
+	total_bytes bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, prefix )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1103,6 +1189,20 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE durability_reports (
+	class text NOT NULL,
+	report_time timestamp with time zone NOT NULL,
+	bus_factor integer NOT NULL,
+	bus_factor_exemplar text NOT NULL,
+	PRIMARY KEY ( class )
+);
+CREATE TABLE durability_report_stats (
+	class text NOT NULL,
+	value text NOT NULL,
+	min_healthy_pieces integer NOT NULL,
+	exemplar text NOT NULL,
+	PRIMARY KEY ( class, value )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -1127,6 +1227,13 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE live_accounting_counters (
+	project_id bytea NOT NULL,
+	name text NOT NULL,
+	value bigint NOT NULL,
+	expires_at timestamp with time zone,
+	PRIMARY KEY ( project_id, name )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -1186,6 +1293,13 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_maintenance_windows (
+	node_id bytea NOT NULL,
+	start_at timestamp with time zone NOT NULL,
+	end_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id, start_at )
+);
 CREATE TABLE node_tags (
 	node_id bytea NOT NULL,
 	name text NOT NULL,
@@ -1226,6 +1340,22 @@ CREATE TABLE oauth_tokens (
 	expires_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( token )
 );
+CREATE TABLE offline_invoices (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	description text NOT NULL,
+	amount bigint NOT NULL,
+	status text NOT NULL,
+	line_items jsonb NOT NULL,
+	due_date timestamp with time zone NOT NULL,
+	paid_at timestamp with time zone,
+	payment_reference text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, period_start )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -1548,6 +1678,9 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
 	PRIMARY KEY ( project_id, name )
 );
 CREATE TABLE project_invitations (
@@ -1577,6 +1710,7 @@ CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index O
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
 CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_tallies ( interval_start ) ;
+CREATE INDEX durability_report_stats_class_min_healthy_pieces_index ON durability_report_stats ( class, min_healthy_pieces ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
@@ -1588,6 +1722,8 @@ CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE INDEX offline_invoices_user_id_index ON offline_invoices ( user_id ) ;
+CREATE INDEX offline_invoices_status_due_date_index ON offline_invoices ( status, due_date ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX projects_owner_id_index ON projects ( owner_id ) ;
 CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day ) ;
@@ -2712,6 +2848,268 @@ func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
 
 func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
+type BucketObjectStat struct {
+	ProjectId          []byte
+	BucketName         []byte
+	CollectedAt        time.Time
+	ObjectCount        int64
+	PendingObjectCount int64
+	TotalBytes         int64
+	SizeHistogram      []byte
+}
+
+func (BucketObjectStat) _Table() string { return "bucket_object_stats" }
+
+type BucketObjectStat_Create_Fields struct {
+}
+
+type BucketObjectStat_Update_Fields struct {
+}
+
+type BucketObjectStat_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketObjectStat_ProjectId(v []byte) BucketObjectStat_ProjectId_Field {
+	return BucketObjectStat_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketObjectStat_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketObjectStat_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketObjectStat_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketObjectStat_BucketName(v []byte) BucketObjectStat_BucketName_Field {
+	return BucketObjectStat_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketObjectStat_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketObjectStat_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketObjectStat_CollectedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketObjectStat_CollectedAt(v time.Time) BucketObjectStat_CollectedAt_Field {
+	return BucketObjectStat_CollectedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketObjectStat_CollectedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketObjectStat_CollectedAt_Field) _Column() string { return "collected_at" }
+
+type BucketObjectStat_ObjectCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketObjectStat_ObjectCount(v int64) BucketObjectStat_ObjectCount_Field {
+	return BucketObjectStat_ObjectCount_Field{_set: true, _value: v}
+}
+
+func (f BucketObjectStat_ObjectCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketObjectStat_ObjectCount_Field) _Column() string { return "object_count" }
+
+type BucketObjectStat_PendingObjectCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketObjectStat_PendingObjectCount(v int64) BucketObjectStat_PendingObjectCount_Field {
+	return BucketObjectStat_PendingObjectCount_Field{_set: true, _value: v}
+}
+
+func (f BucketObjectStat_PendingObjectCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketObjectStat_PendingObjectCount_Field) _Column() string { return "pending_object_count" }
+
+type BucketObjectStat_TotalBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketObjectStat_TotalBytes(v int64) BucketObjectStat_TotalBytes_Field {
+	return BucketObjectStat_TotalBytes_Field{_set: true, _value: v}
+}
+
+func (f BucketObjectStat_TotalBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketObjectStat_TotalBytes_Field) _Column() string { return "total_bytes" }
+
+type BucketObjectStat_SizeHistogram_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketObjectStat_SizeHistogram(v []byte) BucketObjectStat_SizeHistogram_Field {
+	return BucketObjectStat_SizeHistogram_Field{_set: true, _value: v}
+}
+
+func (f BucketObjectStat_SizeHistogram_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketObjectStat_SizeHistogram_Field) _Column() string { return "size_histogram" }
+
+type BucketPrefixStat struct {
+	ProjectId   []byte
+	BucketName  []byte
+	Prefix      []byte
+	ObjectCount int64
+	TotalBytes  int64
+}
+
+func (BucketPrefixStat) _Table() string { return "bucket_prefix_stats" }
+
+type BucketPrefixStat_Create_Fields struct {
+}
+
+type BucketPrefixStat_Update_Fields struct {
+}
+
+type BucketPrefixStat_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketPrefixStat_ProjectId(v []byte) BucketPrefixStat_ProjectId_Field {
+	return BucketPrefixStat_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketPrefixStat_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPrefixStat_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketPrefixStat_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketPrefixStat_BucketName(v []byte) BucketPrefixStat_BucketName_Field {
+	return BucketPrefixStat_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketPrefixStat_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPrefixStat_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketPrefixStat_Prefix_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketPrefixStat_Prefix(v []byte) BucketPrefixStat_Prefix_Field {
+	return BucketPrefixStat_Prefix_Field{_set: true, _value: v}
+}
+
+func (f BucketPrefixStat_Prefix_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPrefixStat_Prefix_Field) _Column() string { return "prefix" }
+
+type BucketPrefixStat_ObjectCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketPrefixStat_ObjectCount(v int64) BucketPrefixStat_ObjectCount_Field {
+	return BucketPrefixStat_ObjectCount_Field{_set: true, _value: v}
+}
+
+func (f BucketPrefixStat_ObjectCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPrefixStat_ObjectCount_Field) _Column() string { return "object_count" }
+
+type BucketPrefixStat_TotalBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketPrefixStat_TotalBytes(v int64) BucketPrefixStat_TotalBytes_Field {
+	return BucketPrefixStat_TotalBytes_Field{_set: true, _value: v}
+}
+
+func (f BucketPrefixStat_TotalBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPrefixStat_TotalBytes_Field) _Column() string { return "total_bytes" }
+
 type BucketStorageTally struct {
 	BucketName          []byte
 	ProjectId           []byte
@@ -3135,26 +3533,211 @@ func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
 
 func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type GracefulExitProgress struct {
-	NodeId            []byte
-	BytesTransferred  int64
-	PiecesTransferred int64
-	PiecesFailed      int64
-	UpdatedAt         time.Time
+type DurabilityReport struct {
+	Class             string
+	ReportTime        time.Time
+	BusFactor         int
+	BusFactorExemplar string
 }
 
-func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+func (DurabilityReport) _Table() string { return "durability_reports" }
 
-type GracefulExitProgress_Update_Fields struct {
-	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
-	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
-	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+type DurabilityReport_Create_Fields struct {
 }
 
-type GracefulExitProgress_NodeId_Field struct {
+type DurabilityReport_Update_Fields struct {
+	ReportTime        DurabilityReport_ReportTime_Field
+	BusFactor         DurabilityReport_BusFactor_Field
+	BusFactorExemplar DurabilityReport_BusFactorExemplar_Field
+}
+
+type DurabilityReport_Class_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
+}
+
+func DurabilityReport_Class(v string) DurabilityReport_Class_Field {
+	return DurabilityReport_Class_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_Class_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_Class_Field) _Column() string { return "class" }
+
+type DurabilityReport_ReportTime_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func DurabilityReport_ReportTime(v time.Time) DurabilityReport_ReportTime_Field {
+	return DurabilityReport_ReportTime_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_ReportTime_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_ReportTime_Field) _Column() string { return "report_time" }
+
+type DurabilityReport_BusFactor_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func DurabilityReport_BusFactor(v int) DurabilityReport_BusFactor_Field {
+	return DurabilityReport_BusFactor_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_BusFactor_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_BusFactor_Field) _Column() string { return "bus_factor" }
+
+type DurabilityReport_BusFactorExemplar_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func DurabilityReport_BusFactorExemplar(v string) DurabilityReport_BusFactorExemplar_Field {
+	return DurabilityReport_BusFactorExemplar_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_BusFactorExemplar_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_BusFactorExemplar_Field) _Column() string { return "bus_factor_exemplar" }
+
+type DurabilityReportStat struct {
+	Class            string
+	Value            string
+	MinHealthyPieces int
+	Exemplar         string
+}
+
+func (DurabilityReportStat) _Table() string { return "durability_report_stats" }
+
+type DurabilityReportStat_Create_Fields struct {
+}
+
+type DurabilityReportStat_Update_Fields struct {
+}
+
+type DurabilityReportStat_Class_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func DurabilityReportStat_Class(v string) DurabilityReportStat_Class_Field {
+	return DurabilityReportStat_Class_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReportStat_Class_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReportStat_Class_Field) _Column() string { return "class" }
+
+type DurabilityReportStat_Value_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func DurabilityReportStat_Value(v string) DurabilityReportStat_Value_Field {
+	return DurabilityReportStat_Value_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReportStat_Value_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReportStat_Value_Field) _Column() string { return "value" }
+
+type DurabilityReportStat_MinHealthyPieces_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func DurabilityReportStat_MinHealthyPieces(v int) DurabilityReportStat_MinHealthyPieces_Field {
+	return DurabilityReportStat_MinHealthyPieces_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReportStat_MinHealthyPieces_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReportStat_MinHealthyPieces_Field) _Column() string { return "min_healthy_pieces" }
+
+type DurabilityReportStat_Exemplar_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func DurabilityReportStat_Exemplar(v string) DurabilityReportStat_Exemplar_Field {
+	return DurabilityReportStat_Exemplar_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReportStat_Exemplar_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReportStat_Exemplar_Field) _Column() string { return "exemplar" }
+
+type GracefulExitProgress struct {
+	NodeId            []byte
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+}
+
+type GracefulExitProgress_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
 func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
@@ -3623,6 +4206,115 @@ func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
 	return "order_limit_send_count"
 }
 
+type LiveAccountingCounter struct {
+	ProjectId []byte
+	Name      string
+	Value     int64
+	ExpiresAt *time.Time
+}
+
+func (LiveAccountingCounter) _Table() string { return "live_accounting_counters" }
+
+type LiveAccountingCounter_Create_Fields struct {
+	ExpiresAt LiveAccountingCounter_ExpiresAt_Field
+}
+
+type LiveAccountingCounter_Update_Fields struct {
+	Value     LiveAccountingCounter_Value_Field
+	ExpiresAt LiveAccountingCounter_ExpiresAt_Field
+}
+
+type LiveAccountingCounter_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func LiveAccountingCounter_ProjectId(v []byte) LiveAccountingCounter_ProjectId_Field {
+	return LiveAccountingCounter_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f LiveAccountingCounter_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LiveAccountingCounter_ProjectId_Field) _Column() string { return "project_id" }
+
+type LiveAccountingCounter_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func LiveAccountingCounter_Name(v string) LiveAccountingCounter_Name_Field {
+	return LiveAccountingCounter_Name_Field{_set: true, _value: v}
+}
+
+func (f LiveAccountingCounter_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LiveAccountingCounter_Name_Field) _Column() string { return "name" }
+
+type LiveAccountingCounter_Value_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func LiveAccountingCounter_Value(v int64) LiveAccountingCounter_Value_Field {
+	return LiveAccountingCounter_Value_Field{_set: true, _value: v}
+}
+
+func (f LiveAccountingCounter_Value_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LiveAccountingCounter_Value_Field) _Column() string { return "value" }
+
+type LiveAccountingCounter_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func LiveAccountingCounter_ExpiresAt(v time.Time) LiveAccountingCounter_ExpiresAt_Field {
+	return LiveAccountingCounter_ExpiresAt_Field{_set: true, _value: &v}
+}
+
+func LiveAccountingCounter_ExpiresAt_Raw(v *time.Time) LiveAccountingCounter_ExpiresAt_Field {
+	if v == nil {
+		return LiveAccountingCounter_ExpiresAt_Null()
+	}
+	return LiveAccountingCounter_ExpiresAt(*v)
+}
+
+func LiveAccountingCounter_ExpiresAt_Null() LiveAccountingCounter_ExpiresAt_Field {
+	return LiveAccountingCounter_ExpiresAt_Field{_set: true, _null: true}
+}
+
+func (f LiveAccountingCounter_ExpiresAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f LiveAccountingCounter_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (LiveAccountingCounter_ExpiresAt_Field) _Column() string { return "expires_at" }
+
 type Node struct {
 	Id                      []byte
 	Address                 string
@@ -4965,6 +5657,98 @@ func (f NodeEvent_EmailSent_Field) value() interface{} {
 
 func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
 
+type NodeMaintenanceWindow struct {
+	NodeId    []byte
+	StartAt   time.Time
+	EndAt     time.Time
+	CreatedAt time.Time
+}
+
+func (NodeMaintenanceWindow) _Table() string { return "node_maintenance_windows" }
+
+type NodeMaintenanceWindow_Create_Fields struct {
+	CreatedAt NodeMaintenanceWindow_CreatedAt_Field
+}
+
+type NodeMaintenanceWindow_Update_Fields struct {
+}
+
+type NodeMaintenanceWindow_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeMaintenanceWindow_NodeId(v []byte) NodeMaintenanceWindow_NodeId_Field {
+	return NodeMaintenanceWindow_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeMaintenanceWindow_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeMaintenanceWindow_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeMaintenanceWindow_StartAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeMaintenanceWindow_StartAt(v time.Time) NodeMaintenanceWindow_StartAt_Field {
+	return NodeMaintenanceWindow_StartAt_Field{_set: true, _value: v}
+}
+
+func (f NodeMaintenanceWindow_StartAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeMaintenanceWindow_StartAt_Field) _Column() string { return "start_at" }
+
+type NodeMaintenanceWindow_EndAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeMaintenanceWindow_EndAt(v time.Time) NodeMaintenanceWindow_EndAt_Field {
+	return NodeMaintenanceWindow_EndAt_Field{_set: true, _value: v}
+}
+
+func (f NodeMaintenanceWindow_EndAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeMaintenanceWindow_EndAt_Field) _Column() string { return "end_at" }
+
+type NodeMaintenanceWindow_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeMaintenanceWindow_CreatedAt(v time.Time) NodeMaintenanceWindow_CreatedAt_Field {
+	return NodeMaintenanceWindow_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeMaintenanceWindow_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeMaintenanceWindow_CreatedAt_Field) _Column() string { return "created_at" }
+
 type NodeTags struct {
 	NodeId   []byte
 	Name     string
@@ -5495,90 +6279,374 @@ func OauthToken_Scope(v string) OauthToken_Scope_Field {
 	return OauthToken_Scope_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Scope_Field) value() interface{} {
+func (f OauthToken_Scope_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_Scope_Field) _Column() string { return "scope" }
+
+type OauthToken_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func OauthToken_Kind(v int) OauthToken_Kind_Field {
+	return OauthToken_Kind_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_Kind_Field) _Column() string { return "kind" }
+
+type OauthToken_Token_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthToken_Token(v []byte) OauthToken_Token_Field {
+	return OauthToken_Token_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_Token_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_Token_Field) _Column() string { return "token" }
+
+type OauthToken_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
+	return OauthToken_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
+
+type OauthToken_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
+	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f OauthToken_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type OfflineInvoice struct {
+	Id               []byte
+	UserId           []byte
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	Description      string
+	Amount           int64
+	Status           string
+	LineItems        []byte
+	DueDate          time.Time
+	PaidAt           *time.Time
+	PaymentReference *string
+	CreatedAt        time.Time
+}
+
+func (OfflineInvoice) _Table() string { return "offline_invoices" }
+
+type OfflineInvoice_Create_Fields struct {
+	PaidAt           OfflineInvoice_PaidAt_Field
+	PaymentReference OfflineInvoice_PaymentReference_Field
+}
+
+type OfflineInvoice_Update_Fields struct {
+	Status           OfflineInvoice_Status_Field
+	PaidAt           OfflineInvoice_PaidAt_Field
+	PaymentReference OfflineInvoice_PaymentReference_Field
+}
+
+type OfflineInvoice_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OfflineInvoice_Id(v []byte) OfflineInvoice_Id_Field {
+	return OfflineInvoice_Id_Field{_set: true, _value: v}
+}
+
+func (f OfflineInvoice_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OfflineInvoice_Id_Field) _Column() string { return "id" }
+
+type OfflineInvoice_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OfflineInvoice_UserId(v []byte) OfflineInvoice_UserId_Field {
+	return OfflineInvoice_UserId_Field{_set: true, _value: v}
+}
+
+func (f OfflineInvoice_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OfflineInvoice_UserId_Field) _Column() string { return "user_id" }
+
+type OfflineInvoice_PeriodStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OfflineInvoice_PeriodStart(v time.Time) OfflineInvoice_PeriodStart_Field {
+	return OfflineInvoice_PeriodStart_Field{_set: true, _value: v}
+}
+
+func (f OfflineInvoice_PeriodStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OfflineInvoice_PeriodStart_Field) _Column() string { return "period_start" }
+
+type OfflineInvoice_PeriodEnd_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OfflineInvoice_PeriodEnd(v time.Time) OfflineInvoice_PeriodEnd_Field {
+	return OfflineInvoice_PeriodEnd_Field{_set: true, _value: v}
+}
+
+func (f OfflineInvoice_PeriodEnd_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OfflineInvoice_PeriodEnd_Field) _Column() string { return "period_end" }
+
+type OfflineInvoice_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OfflineInvoice_Description(v string) OfflineInvoice_Description_Field {
+	return OfflineInvoice_Description_Field{_set: true, _value: v}
+}
+
+func (f OfflineInvoice_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OfflineInvoice_Description_Field) _Column() string { return "description" }
+
+type OfflineInvoice_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OfflineInvoice_Amount(v int64) OfflineInvoice_Amount_Field {
+	return OfflineInvoice_Amount_Field{_set: true, _value: v}
+}
+
+func (f OfflineInvoice_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OfflineInvoice_Amount_Field) _Column() string { return "amount" }
+
+type OfflineInvoice_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OfflineInvoice_Status(v string) OfflineInvoice_Status_Field {
+	return OfflineInvoice_Status_Field{_set: true, _value: v}
+}
+
+func (f OfflineInvoice_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OfflineInvoice_Status_Field) _Column() string { return "status" }
+
+type OfflineInvoice_LineItems_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OfflineInvoice_LineItems(v []byte) OfflineInvoice_LineItems_Field {
+	return OfflineInvoice_LineItems_Field{_set: true, _value: v}
+}
+
+func (f OfflineInvoice_LineItems_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Scope_Field) _Column() string { return "scope" }
+func (OfflineInvoice_LineItems_Field) _Column() string { return "line_items" }
 
-type OauthToken_Kind_Field struct {
+type OfflineInvoice_DueDate_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func OauthToken_Kind(v int) OauthToken_Kind_Field {
-	return OauthToken_Kind_Field{_set: true, _value: v}
+func OfflineInvoice_DueDate(v time.Time) OfflineInvoice_DueDate_Field {
+	return OfflineInvoice_DueDate_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Kind_Field) value() interface{} {
+func (f OfflineInvoice_DueDate_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Kind_Field) _Column() string { return "kind" }
+func (OfflineInvoice_DueDate_Field) _Column() string { return "due_date" }
 
-type OauthToken_Token_Field struct {
+type OfflineInvoice_PaidAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func OauthToken_Token(v []byte) OauthToken_Token_Field {
-	return OauthToken_Token_Field{_set: true, _value: v}
+func OfflineInvoice_PaidAt(v time.Time) OfflineInvoice_PaidAt_Field {
+	return OfflineInvoice_PaidAt_Field{_set: true, _value: &v}
 }
 
-func (f OauthToken_Token_Field) value() interface{} {
+func OfflineInvoice_PaidAt_Raw(v *time.Time) OfflineInvoice_PaidAt_Field {
+	if v == nil {
+		return OfflineInvoice_PaidAt_Null()
+	}
+	return OfflineInvoice_PaidAt(*v)
+}
+
+func OfflineInvoice_PaidAt_Null() OfflineInvoice_PaidAt_Field {
+	return OfflineInvoice_PaidAt_Field{_set: true, _null: true}
+}
+
+func (f OfflineInvoice_PaidAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f OfflineInvoice_PaidAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Token_Field) _Column() string { return "token" }
+func (OfflineInvoice_PaidAt_Field) _Column() string { return "paid_at" }
 
-type OauthToken_CreatedAt_Field struct {
+type OfflineInvoice_PaymentReference_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *string
 }
 
-func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
-	return OauthToken_CreatedAt_Field{_set: true, _value: v}
+func OfflineInvoice_PaymentReference(v string) OfflineInvoice_PaymentReference_Field {
+	return OfflineInvoice_PaymentReference_Field{_set: true, _value: &v}
 }
 
-func (f OauthToken_CreatedAt_Field) value() interface{} {
+func OfflineInvoice_PaymentReference_Raw(v *string) OfflineInvoice_PaymentReference_Field {
+	if v == nil {
+		return OfflineInvoice_PaymentReference_Null()
+	}
+	return OfflineInvoice_PaymentReference(*v)
+}
+
+func OfflineInvoice_PaymentReference_Null() OfflineInvoice_PaymentReference_Field {
+	return OfflineInvoice_PaymentReference_Field{_set: true, _null: true}
+}
+
+func (f OfflineInvoice_PaymentReference_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f OfflineInvoice_PaymentReference_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (OfflineInvoice_PaymentReference_Field) _Column() string { return "payment_reference" }
 
-type OauthToken_ExpiresAt_Field struct {
+type OfflineInvoice_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
-	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
+func OfflineInvoice_CreatedAt(v time.Time) OfflineInvoice_CreatedAt_Field {
+	return OfflineInvoice_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_ExpiresAt_Field) value() interface{} {
+func (f OfflineInvoice_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (OfflineInvoice_CreatedAt_Field) _Column() string { return "created_at" }
 
 type PeerIdentity struct {
 	NodeId           []byte
@@ -11119,14 +12187,20 @@ type BucketMetainfo struct {
 	DefaultRedundancyOptimalShares  int
 	DefaultRedundancyTotalShares    int
 	Placement                       *int
+	StorageLimit                    *int64
+	BandwidthLimit                  *int64
+	SegmentLimit                    *int64
 }
 
 func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
 
 type BucketMetainfo_Create_Fields struct {
-	UserAgent  BucketMetainfo_UserAgent_Field
-	Versioning BucketMetainfo_Versioning_Field
-	Placement  BucketMetainfo_Placement_Field
+	UserAgent      BucketMetainfo_UserAgent_Field
+	Versioning     BucketMetainfo_Versioning_Field
+	Placement      BucketMetainfo_Placement_Field
+	StorageLimit   BucketMetainfo_StorageLimit_Field
+	BandwidthLimit BucketMetainfo_BandwidthLimit_Field
+	SegmentLimit   BucketMetainfo_SegmentLimit_Field
 }
 
 type BucketMetainfo_Update_Fields struct {
@@ -11142,6 +12216,9 @@ type BucketMetainfo_Update_Fields struct {
 	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
 	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
 	Placement                       BucketMetainfo_Placement_Field
+	StorageLimit                    BucketMetainfo_StorageLimit_Field
+	BandwidthLimit                  BucketMetainfo_BandwidthLimit_Field
+	SegmentLimit                    BucketMetainfo_SegmentLimit_Field
 }
 
 type BucketMetainfo_Id_Field struct {
@@ -11509,6 +12586,108 @@ func (f BucketMetainfo_Placement_Field) value() interface{} {
 
 func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
 
+type BucketMetainfo_StorageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func BucketMetainfo_StorageLimit(v int64) BucketMetainfo_StorageLimit_Field {
+	return BucketMetainfo_StorageLimit_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_StorageLimit_Raw(v *int64) BucketMetainfo_StorageLimit_Field {
+	if v == nil {
+		return BucketMetainfo_StorageLimit_Null()
+	}
+	return BucketMetainfo_StorageLimit(*v)
+}
+
+func BucketMetainfo_StorageLimit_Null() BucketMetainfo_StorageLimit_Field {
+	return BucketMetainfo_StorageLimit_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_StorageLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_StorageLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_StorageLimit_Field) _Column() string { return "storage_limit" }
+
+type BucketMetainfo_BandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func BucketMetainfo_BandwidthLimit(v int64) BucketMetainfo_BandwidthLimit_Field {
+	return BucketMetainfo_BandwidthLimit_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_BandwidthLimit_Raw(v *int64) BucketMetainfo_BandwidthLimit_Field {
+	if v == nil {
+		return BucketMetainfo_BandwidthLimit_Null()
+	}
+	return BucketMetainfo_BandwidthLimit(*v)
+}
+
+func BucketMetainfo_BandwidthLimit_Null() BucketMetainfo_BandwidthLimit_Field {
+	return BucketMetainfo_BandwidthLimit_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_BandwidthLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_BandwidthLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+
+type BucketMetainfo_SegmentLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func BucketMetainfo_SegmentLimit(v int64) BucketMetainfo_SegmentLimit_Field {
+	return BucketMetainfo_SegmentLimit_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_SegmentLimit_Raw(v *int64) BucketMetainfo_SegmentLimit_Field {
+	if v == nil {
+		return BucketMetainfo_SegmentLimit_Null()
+	}
+	return BucketMetainfo_SegmentLimit(*v)
+}
+
+func BucketMetainfo_SegmentLimit_Null() BucketMetainfo_SegmentLimit_Field {
+	return BucketMetainfo_SegmentLimit_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_SegmentLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_SegmentLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_SegmentLimit_Field) _Column() string { return "segment_limit" }
+
 type ProjectInvitation struct {
 	ProjectId []byte
 	Email     string
@@ -13285,15 +14464,18 @@ func (obj *pgxImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
 	__placement_val := optional.Placement.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, project_id, name, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, project_id, name, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement, storage_limit, bandwidth_limit, segment_limit")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_metainfos "), __clause, __sqlbundle_Literal(" RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_metainfos "), __clause, __sqlbundle_Literal(" RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -13316,7 +14498,7 @@ func (obj *pgxImpl) Create_BucketMetainfo(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -16469,7 +17651,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -16478,7 +17660,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -16607,7 +17789,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -16627,7 +17809,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 				if err != nil {
 					return nil, err
 				}
@@ -16657,7 +17839,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -16677,7 +17859,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 				if err != nil {
 					return nil, err
 				}
@@ -18996,7 +20178,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -19062,6 +20244,21 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -19075,7 +20272,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -19094,7 +20291,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name_And_Versioning_G
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? AND bucket_metainfos.versioning >= ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? AND bucket_metainfos.versioning >= ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -19160,6 +20357,21 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name_And_Versioning_G
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -19173,7 +20385,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name_And_Versioning_G
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -20443,6 +21655,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM offline_invoices;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20483,6 +21705,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_maintenance_windows;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20513,6 +21745,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM live_accounting_counters;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20533,6 +21775,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_report_stats;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_reports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -21703,15 +22965,18 @@ func (obj *pgxcockroachImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
 	__placement_val := optional.Placement.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, project_id, name, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, project_id, name, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement, storage_limit, bandwidth_limit, segment_limit")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_metainfos "), __clause, __sqlbundle_Literal(" RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_metainfos "), __clause, __sqlbundle_Literal(" RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -21734,7 +22999,7 @@ func (obj *pgxcockroachImpl) Create_BucketMetainfo(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -24887,7 +26152,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -24896,7 +26161,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -25025,7 +26290,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -25045,7 +26310,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 				if err != nil {
 					return nil, err
 				}
@@ -25075,7 +26340,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -25095,7 +26360,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 				if err != nil {
 					return nil, err
 				}
@@ -27414,7 +28679,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -27480,6 +28745,21 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -27493,7 +28773,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -27512,7 +28792,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name_And_Ver
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? AND bucket_metainfos.versioning >= ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? AND bucket_metainfos.versioning >= ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.versioning, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.segment_limit")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -27578,6 +28858,21 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name_And_Ver
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -27591,7 +28886,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name_And_Ver
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.Versioning, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.SegmentLimit)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -28861,6 +30156,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM offline_invoices;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28901,6 +30206,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_maintenance_windows;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28931,6 +30246,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM live_accounting_counters;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28951,6 +30276,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_report_stats;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_reports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -67,6 +67,24 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_object_stats (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	collected_at timestamp with time zone NOT NULL,
+	object_count bigint NOT NULL,
+	pending_object_count bigint NOT NULL,
+	total_bytes bigint NOT NULL,
+	size_histogram bytea NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_prefix_stats (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	prefix bytea NOT NULL,
+	object_count bigint NOT NULL,
+	total_bytes bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, prefix )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -93,6 +111,20 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE durability_reports (
+	class text NOT NULL,
+	report_time timestamp with time zone NOT NULL,
+	bus_factor integer NOT NULL,
+	bus_factor_exemplar text NOT NULL,
+	PRIMARY KEY ( class )
+);
+CREATE TABLE durability_report_stats (
+	class text NOT NULL,
+	value text NOT NULL,
+	min_healthy_pieces integer NOT NULL,
+	exemplar text NOT NULL,
+	PRIMARY KEY ( class, value )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -117,6 +149,13 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE live_accounting_counters (
+	project_id bytea NOT NULL,
+	name text NOT NULL,
+	value bigint NOT NULL,
+	expires_at timestamp with time zone,
+	PRIMARY KEY ( project_id, name )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -176,6 +215,13 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_maintenance_windows (
+	node_id bytea NOT NULL,
+	start_at timestamp with time zone NOT NULL,
+	end_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id, start_at )
+);
 CREATE TABLE node_tags (
 	node_id bytea NOT NULL,
 	name text NOT NULL,
@@ -216,6 +262,22 @@ CREATE TABLE oauth_tokens (
 	expires_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( token )
 );
+CREATE TABLE offline_invoices (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	description text NOT NULL,
+	amount bigint NOT NULL,
+	status text NOT NULL,
+	line_items jsonb NOT NULL,
+	due_date timestamp with time zone NOT NULL,
+	paid_at timestamp with time zone,
+	payment_reference text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, period_start )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -538,6 +600,9 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
 	PRIMARY KEY ( project_id, name )
 );
 CREATE TABLE project_invitations (
@@ -567,6 +632,7 @@ CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index O
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
 CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_tallies ( interval_start ) ;
+CREATE INDEX durability_report_stats_class_min_healthy_pieces_index ON durability_report_stats ( class, min_healthy_pieces ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
@@ -578,6 +644,8 @@ CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE INDEX offline_invoices_user_id_index ON offline_invoices ( user_id ) ;
+CREATE INDEX offline_invoices_status_due_date_index ON offline_invoices ( status, due_date ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX projects_owner_id_index ON projects ( owner_id ) ;
 CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day ) ;
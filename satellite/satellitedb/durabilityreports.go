@@ -0,0 +1,137 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/durability"
+	"storj.io/storj/satellite/satellitedb/dbx"
+	"storj.io/storj/shared/dbutil/pgutil"
+)
+
+// ensures that durabilityReports implements durability.DB.
+var _ durability.DB = (*durabilityReports)(nil)
+
+// durabilityReports implements durability.DB.
+type durabilityReports struct {
+	db *satelliteDB
+}
+
+// ReplaceReport replaces the stored report of summary.Class with the given summary and stats.
+func (reports *durabilityReports) ReplaceReport(ctx context.Context, summary durability.ReportSummary, stats []durability.ClassStat) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	values := make([]string, len(stats))
+	minHealthyPieces := make([]int32, len(stats))
+	exemplars := make([]string, len(stats))
+	for i, stat := range stats {
+		values[i] = stat.Value
+		minHealthyPieces[i] = int32(stat.MinHealthyPieces)
+		exemplars[i] = stat.Exemplar
+	}
+
+	return Error.Wrap(reports.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `
+			INSERT INTO durability_reports (class, report_time, bus_factor, bus_factor_exemplar)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (class) DO UPDATE SET
+				report_time = EXCLUDED.report_time,
+				bus_factor = EXCLUDED.bus_factor,
+				bus_factor_exemplar = EXCLUDED.bus_factor_exemplar
+		`, summary.Class, summary.ReportTime, summary.BusFactor, summary.BusFactorExemplar)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `DELETE FROM durability_report_stats WHERE class = $1`, summary.Class)
+		if err != nil {
+			return err
+		}
+
+		if len(stats) == 0 {
+			return nil
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO durability_report_stats (class, value, min_healthy_pieces, exemplar)
+			SELECT $1, value, min_healthy_pieces, exemplar FROM unnest(
+				$2::text[], $3::int4[], $4::text[]
+			) AS stats(value, min_healthy_pieces, exemplar)
+		`, summary.Class, pgutil.TextArray(values), pgutil.Int4Array(minHealthyPieces), pgutil.TextArray(exemplars))
+		return err
+	}))
+}
+
+// GetReports returns the summaries of the latest reports of all classes.
+func (reports *durabilityReports) GetReports(ctx context.Context) (_ []durability.ReportSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reports.db.QueryContext(ctx, `
+		SELECT class, report_time, bus_factor, bus_factor_exemplar
+		FROM durability_reports
+		ORDER BY class
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var summaries []durability.ReportSummary
+	for rows.Next() {
+		var summary durability.ReportSummary
+		if err := rows.Scan(&summary.Class, &summary.ReportTime, &summary.BusFactor, &summary.BusFactorExemplar); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, Error.Wrap(rows.Err())
+}
+
+// GetReport returns the summary of the latest report of a class.
+func (reports *durabilityReports) GetReport(ctx context.Context, class string) (_ durability.ReportSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	summary := durability.ReportSummary{Class: class}
+	err = reports.db.QueryRowContext(ctx, `
+		SELECT report_time, bus_factor, bus_factor_exemplar
+		FROM durability_reports
+		WHERE class = $1
+	`, class).Scan(&summary.ReportTime, &summary.BusFactor, &summary.BusFactorExemplar)
+	if errors.Is(err, sql.ErrNoRows) {
+		return durability.ReportSummary{}, durability.ErrReportNotFound.New("%s", class)
+	}
+	return summary, Error.Wrap(err)
+}
+
+// GetWorstStats returns the stats of a class with the lowest number of healthy pieces first.
+func (reports *durabilityReports) GetWorstStats(ctx context.Context, class string, limit int) (_ []durability.ClassStat, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reports.db.QueryContext(ctx, `
+		SELECT value, min_healthy_pieces, exemplar
+		FROM durability_report_stats
+		WHERE class = $1
+		ORDER BY min_healthy_pieces, value
+		LIMIT $2
+	`, class, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var stats []durability.ClassStat
+	for rows.Next() {
+		stat := durability.ClassStat{Class: class}
+		if err := rows.Scan(&stat.Value, &stat.MinHealthyPieces, &stat.Exemplar); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		stats = append(stats, stat)
+	}
+	return stats, Error.Wrap(rows.Err())
+}
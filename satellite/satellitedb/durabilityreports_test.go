@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/durability"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDurabilityReports(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		reports := db.DurabilityReports()
+
+		_, err := reports.GetReport(ctx, "country")
+		require.True(t, durability.ErrReportNotFound.Has(err))
+
+		now := time.Now().UTC().Truncate(time.Second)
+		summary := durability.ReportSummary{Class: "country", ReportTime: now, BusFactor: 3, BusFactorExemplar: "a/0"}
+		require.NoError(t, reports.ReplaceReport(ctx, summary, []durability.ClassStat{
+			{Class: "country", Value: "DE", MinHealthyPieces: 50, Exemplar: "b/0"},
+			{Class: "country", Value: "US", MinHealthyPieces: 40, Exemplar: "c/0"},
+			{Class: "country", Value: "FR", MinHealthyPieces: 60, Exemplar: "d/0"},
+		}))
+		require.NoError(t, reports.ReplaceReport(ctx, durability.ReportSummary{Class: "last_net", ReportTime: now, BusFactor: 5}, nil))
+
+		got, err := reports.GetReport(ctx, "country")
+		require.NoError(t, err)
+		require.Equal(t, summary.BusFactor, got.BusFactor)
+		require.Equal(t, summary.BusFactorExemplar, got.BusFactorExemplar)
+		require.WithinDuration(t, now, got.ReportTime, time.Second)
+
+		all, err := reports.GetReports(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		require.Equal(t, "country", all[0].Class)
+		require.Equal(t, "last_net", all[1].Class)
+
+		worst, err := reports.GetWorstStats(ctx, "country", 2)
+		require.NoError(t, err)
+		require.Equal(t, []durability.ClassStat{
+			{Class: "country", Value: "US", MinHealthyPieces: 40, Exemplar: "c/0"},
+			{Class: "country", Value: "DE", MinHealthyPieces: 50, Exemplar: "b/0"},
+		}, worst)
+
+		// a new report replaces all stats of the class.
+		summary.BusFactor = 2
+		require.NoError(t, reports.ReplaceReport(ctx, summary, []durability.ClassStat{
+			{Class: "country", Value: "FR", MinHealthyPieces: 30, Exemplar: "e/0"},
+		}))
+
+		got, err = reports.GetReport(ctx, "country")
+		require.NoError(t, err)
+		require.Equal(t, 2, got.BusFactor)
+
+		worst, err = reports.GetWorstStats(ctx, "country", 10)
+		require.NoError(t, err)
+		require.Len(t, worst, 1)
+		require.Equal(t, "FR", worst[0].Value)
+	})
+}
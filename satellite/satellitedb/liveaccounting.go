@@ -0,0 +1,226 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/shared/dbutil/pgutil"
+)
+
+// ensures that liveAccounting implements accounting.LiveAccountingDB.
+var _ accounting.LiveAccountingDB = (*liveAccounting)(nil)
+
+// liveAccounting implements accounting.LiveAccountingDB.
+type liveAccounting struct {
+	db *satelliteDB
+}
+
+// GetCounter returns the value of a counter, which didn't expire before now.
+func (live *liveAccounting) GetCounter(ctx context.Context, projectID uuid.UUID, name string, now time.Time) (value int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = live.db.QueryRowContext(ctx, `
+		SELECT value FROM live_accounting_counters
+		WHERE project_id = $1 AND name = $2
+			AND (expires_at IS NULL OR expires_at > $3)
+	`, projectID, name, now).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, accounting.ErrKeyNotFound.New("%s:%s", projectID, name)
+	}
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return value, nil
+}
+
+// IncrementCounters adds the increments to the counters. Missing and expired
+// counters are created with the increment and its expiration.
+func (live *liveAccounting) IncrementCounters(ctx context.Context, increments []accounting.LiveAccountingIncrement, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(increments) == 0 {
+		return nil
+	}
+
+	// a single statement can't update the same row twice, hence the
+	// increments of the same counter are merged.
+	type counterKey struct {
+		projectID uuid.UUID
+		name      string
+	}
+	merged := make(map[counterKey]int, len(increments))
+
+	var projectIDs []uuid.UUID
+	var names []string
+	var values []int64
+	var expiresAt []*time.Time
+	for _, increment := range increments {
+		key := counterKey{increment.ProjectID, increment.Name}
+		if i, ok := merged[key]; ok {
+			values[i] += increment.Value
+			if expiresAt[i] == nil && !increment.ExpiresAt.IsZero() {
+				expires := increment.ExpiresAt
+				expiresAt[i] = &expires
+			}
+			continue
+		}
+
+		merged[key] = len(values)
+		projectIDs = append(projectIDs, increment.ProjectID)
+		names = append(names, increment.Name)
+		values = append(values, increment.Value)
+		if increment.ExpiresAt.IsZero() {
+			expiresAt = append(expiresAt, nil)
+		} else {
+			expires := increment.ExpiresAt
+			expiresAt = append(expiresAt, &expires)
+		}
+	}
+
+	_, err = live.db.ExecContext(ctx, `
+		INSERT INTO live_accounting_counters (project_id, name, value, expires_at)
+		SELECT project_id, name, value, expires_at FROM unnest(
+			$1::bytea[], $2::text[], $3::int8[], $4::timestamptz[]
+		) AS increments(project_id, name, value, expires_at)
+		ON CONFLICT (project_id, name) DO UPDATE SET
+			value = CASE
+				WHEN live_accounting_counters.expires_at <= $5 THEN EXCLUDED.value
+				ELSE live_accounting_counters.value + EXCLUDED.value
+			END,
+			expires_at = CASE
+				WHEN live_accounting_counters.expires_at <= $5 THEN EXCLUDED.expires_at
+				ELSE live_accounting_counters.expires_at
+			END
+	`, pgutil.UUIDArray(projectIDs), pgutil.TextArray(names), pgutil.Int8Array(values), pgutil.NullTimestampTZArray(expiresAt), now)
+	return Error.Wrap(err)
+}
+
+// IncrementExistingCounters adds the increments to the counters, which exist
+// and didn't expire before now. The other increments are ignored.
+func (live *liveAccounting) IncrementExistingCounters(ctx context.Context, increments []accounting.LiveAccountingIncrement, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(increments) == 0 {
+		return nil
+	}
+
+	projectIDs := make([]uuid.UUID, len(increments))
+	names := make([]string, len(increments))
+	values := make([]int64, len(increments))
+	for i, increment := range increments {
+		projectIDs[i] = increment.ProjectID
+		names[i] = increment.Name
+		values[i] = increment.Value
+	}
+
+	_, err = live.db.ExecContext(ctx, `
+		UPDATE live_accounting_counters SET value = live_accounting_counters.value + increments.value
+		FROM unnest($1::bytea[], $2::text[], $3::int8[]) AS increments(project_id, name, value)
+		WHERE live_accounting_counters.project_id = increments.project_id
+			AND live_accounting_counters.name = increments.name
+			AND (live_accounting_counters.expires_at IS NULL OR live_accounting_counters.expires_at > $4)
+	`, pgutil.UUIDArray(projectIDs), pgutil.TextArray(names), pgutil.Int8Array(values), now)
+	return Error.Wrap(err)
+}
+
+// IncrementCounterUpToLimit adds the increment to the counter, unless the new value exceeds the limit.
+func (live *liveAccounting) IncrementCounterUpToLimit(ctx context.Context, projectID uuid.UUID, name string, increment, limit int64) (incremented bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var value int64
+	err = live.db.QueryRowContext(ctx, `
+		INSERT INTO live_accounting_counters (project_id, name, value)
+		SELECT $1::bytea, $2::text, $3::int8 WHERE $3::int8 <= $4::int8
+		ON CONFLICT (project_id, name) DO UPDATE SET
+			value = live_accounting_counters.value + EXCLUDED.value
+		WHERE live_accounting_counters.value + EXCLUDED.value <= $4::int8
+		RETURNING value
+	`, projectID, name, increment, limit).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return true, nil
+}
+
+// InsertCounter inserts a counter, if it doesn't exist or it's expired.
+func (live *liveAccounting) InsertCounter(ctx context.Context, projectID uuid.UUID, name string, value int64, expiresAt, now time.Time) (inserted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := live.db.ExecContext(ctx, `
+		INSERT INTO live_accounting_counters (project_id, name, value, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id, name) DO UPDATE SET
+			value = EXCLUDED.value,
+			expires_at = EXCLUDED.expires_at
+		WHERE live_accounting_counters.expires_at <= $5
+	`, projectID, name, value, expiresAt, now)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return affected > 0, nil
+}
+
+// GetProjectTotals returns the storage and segment counters of all projects.
+func (live *liveAccounting) GetProjectTotals(ctx context.Context) (_ map[uuid.UUID]accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := live.db.QueryContext(ctx, `
+		SELECT project_id, name, value FROM live_accounting_counters
+		WHERE name IN ('storage', 'segment')
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	totals := map[uuid.UUID]accounting.Usage{}
+	for rows.Next() {
+		var projectID uuid.UUID
+		var name string
+		var value int64
+		if err := rows.Scan(&projectID, &name, &value); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		usage := totals[projectID]
+		if name == "storage" {
+			usage.Storage = value
+		} else {
+			usage.Segments = value
+		}
+		totals[projectID] = usage
+	}
+	return totals, Error.Wrap(rows.Err())
+}
+
+// DeleteExpiredCounters deletes the counters, which expired before the given time.
+func (live *liveAccounting) DeleteExpiredCounters(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := live.db.ExecContext(ctx, `
+		DELETE FROM live_accounting_counters WHERE expires_at < $1
+	`, before)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	deleted, err = result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestLiveAccountingCounters(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		live := db.LiveAccounting()
+
+		projectID := testrand.UUID()
+		now := time.Now()
+
+		_, err := live.GetCounter(ctx, projectID, "storage", now)
+		require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+		// the increments of the same counter are merged.
+		require.NoError(t, live.IncrementCounters(ctx, []accounting.LiveAccountingIncrement{
+			{ProjectID: projectID, Name: "storage", Value: 10},
+			{ProjectID: projectID, Name: "storage", Value: 5},
+			{ProjectID: projectID, Name: "segment", Value: 2},
+			{ProjectID: projectID, Name: "bandwidth:1:2", Value: 7, ExpiresAt: now.Add(time.Hour)},
+		}, now))
+
+		value, err := live.GetCounter(ctx, projectID, "storage", now)
+		require.NoError(t, err)
+		require.EqualValues(t, 15, value)
+
+		// the limit is checked against the new value.
+		incremented, err := live.IncrementCounterUpToLimit(ctx, projectID, "storage", 10, 20)
+		require.NoError(t, err)
+		require.False(t, incremented)
+
+		incremented, err = live.IncrementCounterUpToLimit(ctx, projectID, "storage", 5, 20)
+		require.NoError(t, err)
+		require.True(t, incremented)
+
+		incremented, err = live.IncrementCounterUpToLimit(ctx, testrand.UUID(), "segment", 5, 4)
+		require.NoError(t, err)
+		require.False(t, incremented)
+
+		totals, err := live.GetProjectTotals(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[uuid.UUID]accounting.Usage{
+			projectID: {Storage: 20, Segments: 2},
+		}, totals)
+
+		// the counter exists, hence it's not inserted.
+		inserted, err := live.InsertCounter(ctx, projectID, "bandwidth:1:2", 100, now.Add(time.Hour), now)
+		require.NoError(t, err)
+		require.False(t, inserted)
+
+		// expired counters are not returned and they are reset by the increments.
+		later := now.Add(2 * time.Hour)
+		_, err = live.GetCounter(ctx, projectID, "bandwidth:1:2", later)
+		require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+		require.NoError(t, live.IncrementCounters(ctx, []accounting.LiveAccountingIncrement{
+			{ProjectID: projectID, Name: "bandwidth:1:2", Value: 3, ExpiresAt: later.Add(time.Hour)},
+		}, later))
+
+		value, err = live.GetCounter(ctx, projectID, "bandwidth:1:2", later)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, value)
+
+		// expired counters are replaced by inserts.
+		evenLater := later.Add(2 * time.Hour)
+		inserted, err = live.InsertCounter(ctx, projectID, "bandwidth:1:2", 100, evenLater.Add(time.Hour), evenLater)
+		require.NoError(t, err)
+		require.True(t, inserted)
+
+		value, err = live.GetCounter(ctx, projectID, "bandwidth:1:2", evenLater)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, value)
+
+		deleted, err := live.DeleteExpiredCounters(ctx, evenLater.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+
+		value, err = live.GetCounter(ctx, projectID, "storage", evenLater)
+		require.NoError(t, err)
+		require.EqualValues(t, 20, value)
+	})
+}
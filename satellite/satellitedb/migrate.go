@@ -6,15 +6,16 @@ package satellitedb
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
-	"storj.io/storj/private/migrate"
 	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/cockroachutil"
 	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/migrate"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -83,6 +84,58 @@ func (db *satelliteDB) MigrateToLatest(ctx context.Context) error {
 	}
 }
 
+// MigrateToVersion migrates the database forward or rolls it back to the
+// specified version. Rolling back is only possible when all the reverted steps
+// have a Revert action.
+func (db *satelliteDB) MigrateToVersion(ctx context.Context, version int) error {
+	switch db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		migration := db.ProductionMigration()
+		dbVersion, err := migration.CurrentVersion(ctx, db.log, db.DB)
+		if err != nil {
+			return errs.New("error current version: %+v", err)
+		}
+		if dbVersion < 0 {
+			return ErrMigrate.New("database is not initialized, it can only be migrated to the latest version")
+		}
+
+		if version < dbVersion {
+			return migration.Rollback(ctx, db.log.Named("migrate"), version)
+		}
+		return migration.TargetVersion(version).Run(ctx, db.log.Named("migrate"))
+	default:
+		return ErrMigrate.New("migrating to a specific version is not supported for %s", db.impl)
+	}
+}
+
+// DryRunMigration writes the statements, which would migrate the database to
+// the specified version, to w without running them. Negative version means the
+// latest version.
+func (db *satelliteDB) DryRunMigration(ctx context.Context, w io.Writer, version int) error {
+	switch db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		migration := db.ProductionMigration()
+		if version < 0 {
+			return migration.DryRun(ctx, w)
+		}
+
+		dbVersion, err := migration.CurrentVersion(ctx, db.log, db.DB)
+		if err != nil {
+			return errs.New("error current version: %+v", err)
+		}
+		if dbVersion < 0 {
+			return ErrMigrate.New("database is not initialized, it can only be migrated to the latest version")
+		}
+
+		if version < dbVersion {
+			return migration.DryRunRollback(ctx, w, version)
+		}
+		return migration.TargetVersion(version).DryRun(ctx, w)
+	default:
+		return ErrMigrate.New("dry run is not supported for %s", db.impl)
+	}
+}
+
 // TestMigrateToLatest is a method for creating all tables for database for testing.
 func (db *satelliteDBTesting) TestMigrateToLatest(ctx context.Context) error {
 	switch db.impl {
@@ -2579,6 +2632,131 @@ func (db *satelliteDB) ProductionMigration() *migrate.Migration {
 					`ALTER TABLE projects ADD COLUMN default_versioning INTEGER NOT NULL DEFAULT 0;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add offline_invoices table",
+				Version:     253,
+				Action: migrate.SQL{
+					`CREATE TABLE offline_invoices (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						period_start timestamp with time zone NOT NULL,
+						period_end timestamp with time zone NOT NULL,
+						description text NOT NULL,
+						amount bigint NOT NULL,
+						status text NOT NULL,
+						line_items jsonb NOT NULL,
+						due_date timestamp with time zone NOT NULL,
+						paid_at timestamp with time zone,
+						payment_reference text,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( user_id, period_start )
+					);`,
+					`CREATE INDEX offline_invoices_user_id_index ON offline_invoices ( user_id );`,
+					`CREATE INDEX offline_invoices_status_due_date_index ON offline_invoices ( status, due_date );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add durability_reports and durability_report_stats tables",
+				Version:     254,
+				Action: migrate.SQL{
+					`CREATE TABLE durability_reports (
+						class text NOT NULL,
+						report_time timestamp with time zone NOT NULL,
+						bus_factor integer NOT NULL,
+						bus_factor_exemplar text NOT NULL,
+						PRIMARY KEY ( class )
+					);`,
+					`CREATE TABLE durability_report_stats (
+						class text NOT NULL,
+						value text NOT NULL,
+						min_healthy_pieces integer NOT NULL,
+						exemplar text NOT NULL,
+						PRIMARY KEY ( class, value )
+					);`,
+					`CREATE INDEX durability_report_stats_class_min_healthy_pieces_index ON durability_report_stats ( class, min_healthy_pieces );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add node_maintenance_windows table",
+				Version:     255,
+				Action: migrate.SQL{
+					`CREATE TABLE node_maintenance_windows (
+						node_id bytea NOT NULL,
+						start_at timestamp with time zone NOT NULL,
+						end_at timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( node_id, start_at )
+					);`,
+				},
+				Revert: migrate.SQL{
+					`DROP TABLE node_maintenance_windows;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add live_accounting_counters table",
+				Version:     256,
+				Action: migrate.SQL{
+					`CREATE TABLE live_accounting_counters (
+						project_id bytea NOT NULL,
+						name text NOT NULL,
+						value bigint NOT NULL,
+						expires_at timestamp with time zone,
+						PRIMARY KEY ( project_id, name )
+					);`,
+				},
+				Revert: migrate.SQL{
+					`DROP TABLE live_accounting_counters;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add storage, bandwidth and segment limits to bucket_metainfos",
+				Version:     257,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN storage_limit bigint;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN bandwidth_limit bigint;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN segment_limit bigint;`,
+				},
+				Revert: migrate.SQL{
+					`ALTER TABLE bucket_metainfos DROP COLUMN storage_limit;`,
+					`ALTER TABLE bucket_metainfos DROP COLUMN bandwidth_limit;`,
+					`ALTER TABLE bucket_metainfos DROP COLUMN segment_limit;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_object_stats and bucket_prefix_stats tables",
+				Version:     258,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_object_stats (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						collected_at timestamp with time zone NOT NULL,
+						object_count bigint NOT NULL,
+						pending_object_count bigint NOT NULL,
+						total_bytes bigint NOT NULL,
+						size_histogram bytea NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+					`CREATE TABLE bucket_prefix_stats (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						prefix bytea NOT NULL,
+						object_count bigint NOT NULL,
+						total_bytes bigint NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name, prefix )
+					);`,
+				},
+				Revert: migrate.SQL{
+					`DROP TABLE bucket_prefix_stats;`,
+					`DROP TABLE bucket_object_stats;`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
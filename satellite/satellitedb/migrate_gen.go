@@ -77,7 +77,7 @@ var testMigrationFormat = `// AUTOGENERATED BY migrategen.go
 
 package satellitedb
 
-import "storj.io/storj/private/migrate"
+import "storj.io/storj/shared/migrate"
 
 // testMigration returns migration that can be used for testing.
 func (db *satelliteDB) testMigration() *migrate.Migration {
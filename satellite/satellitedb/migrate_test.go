@@ -25,13 +25,13 @@ import (
 
 	"storj.io/common/sync2"
 	"storj.io/common/testcontext"
-	"storj.io/storj/private/migrate"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/shared/dbutil/dbschema"
 	"storj.io/storj/shared/dbutil/pgtest"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/tempdb"
+	"storj.io/storj/shared/migrate"
 )
 
 const maxMigrationsToTest = 10
@@ -246,10 +246,32 @@ func migrateTest(t *testing.T, connStr string) {
 			require.NoError(t, err, tag)
 		}
 
+		// load the schema for verifying the rollback of the step
+		var previousSchema *dbschema.Schema
+		if step.Revert != nil {
+			previousSchema, err = pgutil.QuerySchema(ctx, rawdb)
+			require.NoError(t, err, tag)
+			previousSchema.DropTable("versions")
+		}
+
 		// run migration up to a specific version
 		err := migrations.TargetVersion(step.Version).Run(ctx, log.Named("migrate"))
 		require.NoError(t, err, tag)
 
+		// verify that the step can be reverted and applied again
+		if step.Revert != nil {
+			err = migrations.Rollback(ctx, log.Named("rollback"), migrations.Steps[stepIndex+i].Version)
+			require.NoError(t, err, tag)
+
+			revertedSchema, err := pgutil.QuerySchema(ctx, rawdb)
+			require.NoError(t, err, tag)
+			revertedSchema.DropTable("versions")
+			require.Equal(t, previousSchema, revertedSchema, tag)
+
+			err = migrations.TargetVersion(step.Version).Run(ctx, log.Named("migrate"))
+			require.NoError(t, err, tag)
+		}
+
 		// insert data for new tables
 		if newData := expected.LookupSection(dbschema.NewData); newData != "" {
 			_, err = rawdb.ExecContext(ctx, newData)
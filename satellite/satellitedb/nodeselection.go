@@ -170,6 +170,11 @@ func nodeSelectionCondition(ctx context.Context, criteria *overlay.NodeCriteria,
 
 	conds.add(`free_disk >= ?`, criteria.FreeDisk)
 	conds.add(`last_contact_success > ?`, time.Now().UTC().Add(-criteria.OnlineWindow))
+	conds.add(`NOT EXISTS (
+		SELECT 1 FROM node_maintenance_windows
+		WHERE node_maintenance_windows.node_id = nodes.id
+			AND start_at <= ? AND end_at > ?
+	)`, time.Now().UTC(), time.Now().UTC())
 
 	if isNewNodeQuery {
 		conds.add(
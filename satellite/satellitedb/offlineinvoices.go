@@ -0,0 +1,166 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/offline"
+	"storj.io/storj/shared/dbutil/pgutil/pgerrcode"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ensures that offlineInvoices implements offline.DB.
+var _ offline.DB = (*offlineInvoices)(nil)
+
+// offlineInvoices implements offline.DB.
+type offlineInvoices struct {
+	db *satelliteDB
+}
+
+const offlineInvoiceColumns = `id, user_id, period_start, period_end, description, amount, status,
+	line_items, due_date, paid_at, payment_reference, created_at`
+
+// Insert stores a new invoice.
+func (invoices *offlineInvoices) Insert(ctx context.Context, invoice offline.Invoice) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lineItems, err := json.Marshal(invoice.LineItems)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = invoices.db.ExecContext(ctx, `
+		INSERT INTO offline_invoices (
+			id, user_id, period_start, period_end, description, amount, status,
+			line_items, due_date, paid_at, payment_reference, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now())
+	`, invoice.ID, invoice.UserID, invoice.PeriodStart, invoice.PeriodEnd, invoice.Description, invoice.Amount, invoice.Status,
+		lineItems, invoice.DueDate, invoice.PaidAt, invoice.PaymentReference)
+	if pgerrcode.IsConstraintViolation(err) {
+		return offline.ErrInvoiceExists.New("user %s, period %s", invoice.UserID, invoice.PeriodStart)
+	}
+	return Error.Wrap(err)
+}
+
+// Get returns the invoice with the given id.
+func (invoices *offlineInvoices) Get(ctx context.Context, id uuid.UUID) (_ offline.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := invoices.db.QueryRowContext(ctx, `SELECT `+offlineInvoiceColumns+` FROM offline_invoices WHERE id = $1`, id)
+	invoice, err := scanOfflineInvoice(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return offline.Invoice{}, offline.ErrInvoiceNotFound.New("%s", id)
+	}
+	return invoice, Error.Wrap(err)
+}
+
+// ListByUser returns all invoices of a user, newest period first.
+func (invoices *offlineInvoices) ListByUser(ctx context.Context, userID uuid.UUID) (_ []offline.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := invoices.db.QueryContext(ctx, `
+		SELECT `+offlineInvoiceColumns+` FROM offline_invoices
+		WHERE user_id = $1
+		ORDER BY period_start DESC, id
+	`, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanOfflineInvoices(rows)
+}
+
+// ListOverdue returns open invoices with a due date before the given time.
+func (invoices *offlineInvoices) ListOverdue(ctx context.Context, userID *uuid.UUID, before time.Time) (_ []offline.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var user []byte
+	if userID != nil {
+		user = userID.Bytes()
+	}
+
+	rows, err := invoices.db.QueryContext(ctx, `
+		SELECT `+offlineInvoiceColumns+` FROM offline_invoices
+		WHERE status = $1
+			AND due_date < $2
+			AND ($3::BYTEA IS NULL OR user_id = $3)
+		ORDER BY due_date, id
+	`, payments.InvoiceStatusOpen, before, user)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanOfflineInvoices(rows)
+}
+
+// MarkPaid marks an open invoice as paid.
+func (invoices *offlineInvoices) MarkPaid(ctx context.Context, id uuid.UUID, paidAt time.Time, reference string) (_ offline.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := invoices.db.QueryRowContext(ctx, `
+		UPDATE offline_invoices
+		SET status = $2, paid_at = $3, payment_reference = $4
+		WHERE id = $1 AND status = $5
+		RETURNING `+offlineInvoiceColumns,
+		id, payments.InvoiceStatusPaid, paidAt, reference, payments.InvoiceStatusOpen)
+	invoice, err := scanOfflineInvoice(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return offline.Invoice{}, offline.ErrInvoiceNotFound.New("no open invoice %s", id)
+	}
+	return invoice, Error.Wrap(err)
+}
+
+// Delete removes an invoice which has not been paid.
+func (invoices *offlineInvoices) Delete(ctx context.Context, id uuid.UUID) (_ offline.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := invoices.db.QueryRowContext(ctx, `
+		DELETE FROM offline_invoices
+		WHERE id = $1 AND status <> $2
+		RETURNING `+offlineInvoiceColumns,
+		id, payments.InvoiceStatusPaid)
+	invoice, err := scanOfflineInvoice(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return offline.Invoice{}, offline.ErrInvoiceNotFound.New("no unpaid invoice %s", id)
+	}
+	return invoice, Error.Wrap(err)
+}
+
+type offlineInvoiceScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanOfflineInvoice(row offlineInvoiceScanner) (invoice offline.Invoice, err error) {
+	var lineItems []byte
+	var reference *string
+	err = row.Scan(&invoice.ID, &invoice.UserID, &invoice.PeriodStart, &invoice.PeriodEnd, &invoice.Description,
+		&invoice.Amount, &invoice.Status, &lineItems, &invoice.DueDate, &invoice.PaidAt, &reference, &invoice.CreatedAt)
+	if err != nil {
+		return offline.Invoice{}, err
+	}
+	if reference != nil {
+		invoice.PaymentReference = *reference
+	}
+	return invoice, json.Unmarshal(lineItems, &invoice.LineItems)
+}
+
+func scanOfflineInvoices(rows tagsql.Rows) (invoices []offline.Invoice, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		invoice, err := scanOfflineInvoice(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		invoices = append(invoices, invoice)
+	}
+	return invoices, Error.Wrap(rows.Err())
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/offline"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestOfflineInvoices(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		invoicesDB := db.OfflineInvoices()
+
+		userID := testrand.UUID()
+		periodStart := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+		now := periodStart.AddDate(0, 3, 0)
+
+		newInvoice := func(periodStart time.Time) offline.Invoice {
+			return offline.Invoice{
+				ID:          testrand.UUID(),
+				UserID:      userID,
+				PeriodStart: periodStart,
+				PeriodEnd:   periodStart.AddDate(0, 1, 0),
+				Description: "usage",
+				Amount:      100,
+				Status:      payments.InvoiceStatusOpen,
+				LineItems: []offline.LineItem{
+					{Description: "storage", Quantity: 10, UnitCents: decimal.NewFromInt(10), Amount: 100},
+				},
+				DueDate: periodStart.AddDate(0, 2, 0),
+			}
+		}
+
+		april := newInvoice(periodStart)
+		may := newInvoice(periodStart.AddDate(0, 1, 0))
+		require.NoError(t, invoicesDB.Insert(ctx, april))
+		require.NoError(t, invoicesDB.Insert(ctx, may))
+
+		// only one invoice per user and period.
+		err := invoicesDB.Insert(ctx, newInvoice(periodStart))
+		require.True(t, offline.ErrInvoiceExists.Has(err))
+
+		got, err := invoicesDB.Get(ctx, april.ID)
+		require.NoError(t, err)
+		require.Equal(t, april.UserID, got.UserID)
+		require.Equal(t, april.LineItems[0].Description, got.LineItems[0].Description)
+		require.True(t, april.LineItems[0].UnitCents.Equal(got.LineItems[0].UnitCents))
+		require.Nil(t, got.PaidAt)
+
+		_, err = invoicesDB.Get(ctx, testrand.UUID())
+		require.True(t, offline.ErrInvoiceNotFound.Has(err))
+
+		list, err := invoicesDB.ListByUser(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, may.ID, list[0].ID)
+		require.Equal(t, april.ID, list[1].ID)
+
+		overdue, err := invoicesDB.ListOverdue(ctx, nil, now)
+		require.NoError(t, err)
+		require.Len(t, overdue, 1)
+		require.Equal(t, april.ID, overdue[0].ID)
+
+		otherUser := testrand.UUID()
+		overdue, err = invoicesDB.ListOverdue(ctx, &otherUser, now)
+		require.NoError(t, err)
+		require.Empty(t, overdue)
+
+		paid, err := invoicesDB.MarkPaid(ctx, april.ID, now, "wire transfer")
+		require.NoError(t, err)
+		require.Equal(t, payments.InvoiceStatusPaid, paid.Status)
+		require.Equal(t, "wire transfer", paid.PaymentReference)
+		require.NotNil(t, paid.PaidAt)
+
+		// paid invoices can't be paid again or deleted.
+		_, err = invoicesDB.MarkPaid(ctx, april.ID, now, "wire transfer")
+		require.True(t, offline.ErrInvoiceNotFound.Has(err))
+		_, err = invoicesDB.Delete(ctx, april.ID)
+		require.True(t, offline.ErrInvoiceNotFound.Has(err))
+
+		overdue, err = invoicesDB.ListOverdue(ctx, &userID, now)
+		require.NoError(t, err)
+		require.Empty(t, overdue)
+
+		deleted, err := invoicesDB.Delete(ctx, may.ID)
+		require.NoError(t, err)
+		require.Equal(t, may.ID, deleted.ID)
+
+		list, err = invoicesDB.ListByUser(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+
+		// invoices without an amount are inserted as paid.
+		june := newInvoice(periodStart.AddDate(0, 2, 0))
+		june.Amount = 0
+		june.Status = payments.InvoiceStatusPaid
+		june.PaidAt = &now
+		require.NoError(t, invoicesDB.Insert(ctx, june))
+
+		got, err = invoicesDB.Get(ctx, june.ID)
+		require.NoError(t, err)
+		require.Equal(t, payments.InvoiceStatusPaid, got.Status)
+		require.NotNil(t, got.PaidAt)
+		require.WithinDuration(t, now, *got.PaidAt, time.Second)
+
+		overdue, err = invoicesDB.ListOverdue(ctx, &userID, now.AddDate(1, 0, 0))
+		require.NoError(t, err)
+		require.Empty(t, overdue)
+	})
+}
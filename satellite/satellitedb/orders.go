@@ -224,6 +224,43 @@ func (db *ordersDB) GetStorageNodeBandwidth(ctx context.Context, nodeID storj.No
 	return sum1 + sum2, nil
 }
 
+// GetStorageNodeSettledWindows gets the amounts settled by the storage node, by action, for the windows starting in [from, to).
+func (db *ordersDB) GetStorageNodeSettledWindows(ctx context.Context, nodeID storj.NodeID, from, to time.Time) (windows []orders.SettledWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT interval_start, action, settled
+		FROM storagenode_bandwidth_rollups
+		WHERE storagenode_id = ?
+		  AND interval_start >= ?
+		  AND interval_start < ?
+		ORDER BY interval_start, action
+	`), nodeID.Bytes(), from.UTC(), to.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var intervalStart time.Time
+		var action int32
+		var settled int64
+		if err := rows.Scan(&intervalStart, &action, &settled); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		if len(windows) == 0 || !windows[len(windows)-1].Window.Equal(intervalStart) {
+			windows = append(windows, orders.SettledWindow{
+				Window:        intervalStart,
+				ActionSettled: map[int32]int64{},
+			})
+		}
+		windows[len(windows)-1].ActionSettled[action] += settled
+	}
+
+	return windows, Error.Wrap(rows.Err())
+}
+
 // UpdateBandwidthBatch updates bucket and project bandwidth rollups in the database.
 func (db *ordersDB) UpdateBandwidthBatch(ctx context.Context, rollups []orders.BucketBandwidthRollup) (err error) {
 	defer mon.Task()(&ctx)(&err)
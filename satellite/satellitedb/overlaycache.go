@@ -73,21 +73,29 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 			AND exit_initiated_at IS NULL
 			AND free_disk >= $1
 			AND last_contact_success > $2
+			AND NOT EXISTS (
+				SELECT 1 FROM node_maintenance_windows
+				WHERE node_maintenance_windows.node_id = nodes.id
+					AND start_at <= $3 AND end_at > $3
+			)
 	`
+	now := time.Now()
 	args := []interface{}{
 		// $1
 		selectionCfg.MinimumDiskSpace.Int64(),
 		// $2
-		time.Now().Add(-selectionCfg.OnlineWindow),
+		now.Add(-selectionCfg.OnlineWindow),
+		// $3
+		now,
 	}
 	if selectionCfg.MinimumVersion != "" {
 		version, err := version.NewSemVer(selectionCfg.MinimumVersion)
 		if err != nil {
 			return nil, nil, err
 		}
-		query += `AND (major > $3 OR (major = $4 AND (minor > $5 OR (minor = $6 AND patch >= $7)))) AND release`
+		query += `AND (major > $4 OR (major = $5 AND (minor > $6 OR (minor = $7 AND patch >= $8)))) AND release`
 		args = append(args,
-			// $3 - $7
+			// $4 - $8
 			version.Major, version.Major, version.Minor, version.Minor, version.Patch,
 		)
 	}
@@ -459,7 +467,8 @@ func (cache *overlaycache) GetNodes(ctx context.Context, nodeIDs storj.NodeIDLis
 }
 
 // GetParticipatingNodes returns all known participating nodes (this includes all known nodes
-// excluding nodes that have been disqualified or gracefully exited).
+// excluding nodes that have been disqualified or gracefully exited). Nodes in a planned maintenance
+// window are considered online, so their pieces are not treated as unhealthy until the window ends.
 func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, onlineWindow, asOfSystemInterval time.Duration) (records []nodeselection.SelectedNode, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -467,7 +476,11 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, onlineWind
 
 	err = withRows(cache.db.Query(ctx, `
 		SELECT id, address, email, wallet, last_net, last_ip_port, country_code,
-			last_contact_success > $1 AS online,
+			(last_contact_success > $1 OR EXISTS (
+				SELECT 1 FROM node_maintenance_windows
+				WHERE node_maintenance_windows.node_id = nodes.id
+					AND start_at <= $2 AND end_at > $2
+			)) AS online,
 			(offline_suspended IS NOT NULL OR unknown_audit_suspended IS NOT NULL) AS suspended,
 			false AS disqualified,
 			exit_initiated_at IS NOT NULL AS exiting,
@@ -476,7 +489,7 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, onlineWind
 			`+cache.db.impl.AsOfSystemInterval(asOfSystemInterval)+`
 		WHERE disqualified IS NULL
 			AND exit_finished_at IS NULL
-	`, time.Now().Add(-onlineWindow),
+	`, time.Now().Add(-onlineWindow), time.Now(),
 	))(func(rows tagsql.Rows) error {
 		for rows.Next() {
 			node, err := scanSelectedNode(rows)
@@ -1689,6 +1702,130 @@ func (cache *overlaycache) UpdateNodeTags(ctx context.Context, tags nodeselectio
 	return nil
 }
 
+func (cache *overlaycache) ReplaceNodeTags(ctx context.Context, id storj.NodeID, tags nodeselection.NodeTags) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(cache.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `DELETE FROM node_tags WHERE node_id = $1`, id)
+		if err != nil {
+			return err
+		}
+		for _, t := range tags {
+			err := tx.ReplaceNoReturn_NodeTags(ctx,
+				dbx.NodeTags_NodeId(id.Bytes()),
+				dbx.NodeTags_Name(t.Name),
+				dbx.NodeTags_Value(t.Value),
+				dbx.NodeTags_SignedAt(t.SignedAt),
+				dbx.NodeTags_Signer(t.Signer.Bytes()),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func (cache *overlaycache) ListNodeTags(ctx context.Context, name string, signer storj.NodeID) (_ nodeselection.NodeTags, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var signerBytes []byte
+	if !signer.IsZero() {
+		signerBytes = signer.Bytes()
+	}
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT node_id, name, value, signed_at, signer
+		FROM node_tags
+		WHERE ($1 = '' OR name = $1)
+			AND ($2::BYTEA IS NULL OR signer = $2)
+		ORDER BY node_id, name, signer
+	`, name, signerBytes)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var tags nodeselection.NodeTags
+	for rows.Next() {
+		var tag nodeselection.NodeTag
+		err := rows.Scan(&tag.NodeID, &tag.Name, &tag.Value, &tag.SignedAt, &tag.Signer)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, Error.Wrap(rows.Err())
+}
+
+func (cache *overlaycache) InsertMaintenanceWindow(ctx context.Context, window overlay.MaintenanceWindow) (inserted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, `
+		INSERT INTO node_maintenance_windows (node_id, start_at, end_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (node_id, start_at) DO NOTHING
+	`, window.NodeID, window.Start, window.End)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return affected > 0, nil
+}
+
+func (cache *overlaycache) GetMaintenanceWindows(ctx context.Context, id storj.NodeID, endAfter time.Time) (_ []overlay.MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT start_at, end_at, created_at
+		FROM node_maintenance_windows
+		WHERE node_id = $1 AND end_at > $2
+		ORDER BY start_at
+	`, id, endAfter)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var windows []overlay.MaintenanceWindow
+	for rows.Next() {
+		window := overlay.MaintenanceWindow{NodeID: id}
+		if err := rows.Scan(&window.Start, &window.End, &window.CreatedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		windows = append(windows, window)
+	}
+	return windows, Error.Wrap(rows.Err())
+}
+
+func (cache *overlaycache) GetNodesInMaintenance(ctx context.Context, ids storj.NodeIDList, at time.Time) (_ storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT DISTINCT node_id
+		FROM node_maintenance_windows
+		WHERE node_id = ANY($1::bytea[])
+			AND start_at <= $2 AND end_at > $2
+	`, pgutil.NodeIDArray(ids), at)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var nodes storj.NodeIDList
+	for rows.Next() {
+		var id storj.NodeID
+		if err := rows.Scan(&id); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodes = append(nodes, id)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
 func (cache *overlaycache) GetNodeTags(ctx context.Context, id storj.NodeID) (nodeselection.NodeTags, error) {
 	rows, err := cache.db.All_NodeTags_By_NodeId(ctx, dbx.NodeTags_NodeId(id.Bytes()))
 	if err != nil {
@@ -823,3 +823,56 @@ func TestOverlayCache_KnownReliableTagHandling(t *testing.T) {
 		}
 	})
 }
+
+func TestOverlayCache_ReplaceAndListNodeTags(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		cache := db.OverlayCache()
+
+		signer1, signer2 := testrand.NodeID(), testrand.NodeID()
+		node1, node2 := testrand.NodeID(), testrand.NodeID()
+		tag := func(node, signer storj.NodeID, name, value string) nodeselection.NodeTag {
+			return nodeselection.NodeTag{
+				NodeID:   node,
+				SignedAt: time.Now().Truncate(time.Second),
+				Signer:   signer,
+				Name:     name,
+				Value:    []byte(value),
+			}
+		}
+
+		require.NoError(t, cache.ReplaceNodeTags(ctx, node1, nodeselection.NodeTags{
+			tag(node1, signer1, "soc", "true"),
+			tag(node1, signer2, "owner", "foo"),
+		}))
+		require.NoError(t, cache.ReplaceNodeTags(ctx, node2, nodeselection.NodeTags{
+			tag(node2, signer1, "soc", "false"),
+		}))
+
+		tags, err := cache.ListNodeTags(ctx, "soc", storj.NodeID{})
+		require.NoError(t, err)
+		require.Len(t, tags, 2)
+
+		tags, err = cache.ListNodeTags(ctx, "", signer2)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, node1, tags[0].NodeID)
+		require.Equal(t, "owner", tags[0].Name)
+		require.Equal(t, "foo", string(tags[0].Value))
+
+		// tags which are not in the new list are removed.
+		require.NoError(t, cache.ReplaceNodeTags(ctx, node1, nodeselection.NodeTags{
+			tag(node1, signer1, "soc", "false"),
+		}))
+
+		tags, err = cache.GetNodeTags(ctx, node1)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, "false", string(tags[0].Value))
+
+		require.NoError(t, cache.ReplaceNodeTags(ctx, node2, nil))
+		tags, err = cache.ListNodeTags(ctx, "soc", signer1)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, node1, tags[0].NodeID)
+	})
+}
@@ -6,8 +6,10 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	pgxerrcode "github.com/jackc/pgerrcode"
@@ -16,6 +18,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/useragent"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
@@ -223,6 +226,41 @@ func (db *ProjectAccounting) GetProjectBandwidth(ctx context.Context, projectID
 	return *egress, err
 }
 
+// GetBucketBandwidth returns bucket allocated egress for the specified year and month.
+func (db *ProjectAccounting) GetBucketBandwidth(ctx context.Context, projectID uuid.UUID, bucketName []byte, year int, month time.Month, asOfSystemInterval time.Duration) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var egress *int64
+
+	startOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := `SELECT sum(allocated + inline) FROM bucket_bandwidth_rollups` +
+		db.db.impl.AsOfSystemInterval(asOfSystemInterval) +
+		` WHERE project_id = ? AND bucket_name = ? AND action = ? AND interval_start >= ? AND interval_start < ?`
+	err = db.db.QueryRow(ctx, db.db.Rebind(query), projectID[:], bucketName, pb.PieceAction_GET, startOfMonth, periodEnd).Scan(&egress)
+	if errors.Is(err, sql.ErrNoRows) || egress == nil {
+		return 0, nil
+	}
+
+	return *egress, err
+}
+
+// GetBucketLatestTally returns the storage and segments of the bucket's most
+// recent tally. It returns zero usage when the bucket hasn't been tallied yet.
+func (db *ProjectAccounting) GetBucketLatestTally(ctx context.Context, projectID uuid.UUID, bucketName []byte, asOfSystemInterval time.Duration) (usage accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT total_bytes, total_segments_count FROM bucket_storage_tallies` +
+		db.db.impl.AsOfSystemInterval(asOfSystemInterval) +
+		` WHERE project_id = ? AND bucket_name = ? ORDER BY interval_start DESC LIMIT 1`
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(query), projectID[:], bucketName).Scan(&usage.Storage, &usage.Segments)
+	if errors.Is(err, sql.ErrNoRows) {
+		return accounting.Usage{}, nil
+	}
+
+	return usage, err
+}
+
 // GetProjectSettledBandwidth returns the used settled bandwidth for the specified year and month.
 func (db *ProjectAccounting) GetProjectSettledBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, asOfSystemInterval time.Duration) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -547,8 +585,34 @@ func (db *ProjectAccounting) GetProjectTotal(ctx context.Context, projectID uuid
 // Unpartnered usage or usage for a partner not present in partnerNames is mapped to the empty string.
 func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, projectID uuid.UUID, partnerNames []string, since, before time.Time) (usages map[string]accounting.ProjectUsage, err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	placementUsages, err := db.GetProjectTotalByPartnerAndPlacement(ctx, projectID, partnerNames, since, before)
+	if err != nil {
+		return nil, err
+	}
+
+	usages = make(map[string]accounting.ProjectUsage)
+	for partner, byPlacement := range placementUsages {
+		usage := accounting.ProjectUsage{Since: timeTruncateDown(since), Before: before}
+		for _, placementUsage := range byPlacement {
+			usage.Storage += placementUsage.Storage
+			usage.Egress += placementUsage.Egress
+			usage.SegmentCount += placementUsage.SegmentCount
+			usage.ObjectCount += placementUsage.ObjectCount
+		}
+		usages[partner] = usage
+	}
+
+	return usages, nil
+}
+
+// GetProjectTotalByPartnerAndPlacement retrieves project usage for a given period categorized by partner name
+// and bucket placement. Unpartnered usage or usage for a partner not present in partnerNames is mapped to the
+// empty string.
+func (db *ProjectAccounting) GetProjectTotalByPartnerAndPlacement(ctx context.Context, projectID uuid.UUID, partnerNames []string, since, before time.Time) (usages map[string]map[storj.PlacementConstraint]accounting.ProjectUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
 	since = timeTruncateDown(since)
-	bucketNames, err := db.getBucketsSinceAndBefore(ctx, projectID, since, before)
+	buckets, err := db.getBucketPlacementsSinceAndBefore(ctx, projectID, since, before)
 	if err != nil {
 		return nil, err
 	}
@@ -584,19 +648,14 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 			action = ?;
 	`)
 
-	usages = make(map[string]accounting.ProjectUsage)
+	usages = make(map[string]map[storj.PlacementConstraint]accounting.ProjectUsage)
 
-	for _, bucket := range bucketNames {
-		userAgentRow, err := db.db.Get_BucketMetainfo_UserAgent_By_ProjectId_And_Name(ctx,
-			dbx.BucketMetainfo_ProjectId(projectID[:]),
-			dbx.BucketMetainfo_Name([]byte(bucket)))
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
-			return nil, err
-		}
+	for _, bucket := range buckets {
+		placement := bucket.placement
 
 		var partner string
-		if userAgentRow != nil && userAgentRow.UserAgent != nil {
-			entries, err := useragent.ParseEntries(userAgentRow.UserAgent)
+		if bucket.userAgent != nil {
+			entries, err := useragent.ParseEntries(bucket.userAgent)
 			if err != nil {
 				return nil, err
 			}
@@ -611,11 +670,14 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 			}
 		}
 		if _, ok := usages[partner]; !ok {
-			usages[partner] = accounting.ProjectUsage{Since: since, Before: before}
+			usages[partner] = make(map[storj.PlacementConstraint]accounting.ProjectUsage)
+		}
+		if _, ok := usages[partner][placement]; !ok {
+			usages[partner][placement] = accounting.ProjectUsage{Since: since, Before: before}
 		}
-		usage := usages[partner]
+		usage := usages[partner][placement]
 
-		storageTalliesRows, err := db.db.QueryContext(ctx, storageQuery, projectID[:], []byte(bucket), since, before)
+		storageTalliesRows, err := db.db.QueryContext(ctx, storageQuery, projectID[:], []byte(bucket.name), since, before)
 		if err != nil {
 			return nil, err
 		}
@@ -651,7 +713,7 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 			return nil, err
 		}
 
-		totalEgressRow := db.db.QueryRowContext(ctx, totalEgressQuery, projectID[:], []byte(bucket), since, before, pb.PieceAction_GET)
+		totalEgressRow := db.db.QueryRowContext(ctx, totalEgressQuery, projectID[:], []byte(bucket.name), since, before, pb.PieceAction_GET)
 		if err != nil {
 			return nil, err
 		}
@@ -662,7 +724,7 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 		}
 		usage.Egress += egress
 
-		usages[partner] = usage
+		usages[partner][placement] = usage
 	}
 
 	return usages, nil
@@ -789,6 +851,219 @@ func (db *ProjectAccounting) getSingleBucketRollup(ctx context.Context, projectI
 	return bucketRollup, nil
 }
 
+// bucketDailyUsageBatchSize is the number of buckets whose daily usage is queried at once.
+const bucketDailyUsageBatchSize = 100
+
+// IterateBucketDailyUsage calls fn for the usage of the buckets selected by the cursor on every day of the specified
+// period and returns the number of buckets with usage in that period. Days without any usage are skipped.
+//
+// The usage is queried for batches of buckets, so only the usage of a single batch is kept in memory.
+func (db *ProjectAccounting) IterateBucketDailyUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time, cursor accounting.BucketDailyUsageCursor, fn func(context.Context, accounting.BucketDailyUsage) error) (bucketCount uint64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	since = timeTruncateDown(since.UTC())
+	before = before.UTC()
+
+	buckets, err := db.getBucketsSinceAndBefore(ctx, projectID, since, before)
+	if err != nil {
+		return 0, err
+	}
+	sort.Strings(buckets)
+	bucketCount = uint64(len(buckets))
+
+	if cursor.Offset >= bucketCount {
+		return bucketCount, nil
+	}
+	buckets = buckets[cursor.Offset:]
+	if cursor.Limit > 0 && uint64(cursor.Limit) < uint64(len(buckets)) {
+		buckets = buckets[:cursor.Limit]
+	}
+
+	for len(buckets) > 0 {
+		batch := buckets
+		if len(batch) > bucketDailyUsageBatchSize {
+			batch = batch[:bucketDailyUsageBatchSize]
+		}
+		buckets = buckets[len(batch):]
+
+		days, err := db.getBucketsDailyUsage(ctx, projectID, batch[0], batch[len(batch)-1], since, before)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, day := range days {
+			if err := fn(ctx, day); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return bucketCount, nil
+}
+
+// getBucketsDailyUsage returns the daily usage of the buckets with names in [firstBucket, lastBucket],
+// ordered by bucket name and day.
+func (db *ProjectAccounting) getBucketsDailyUsage(ctx context.Context, projectID uuid.UUID, firstBucket, lastBucket string, since, before time.Time) (_ []accounting.BucketDailyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the buckets may have been deleted since, in which case the defaults are used.
+	templates := make(map[string]accounting.BucketDailyUsage)
+	bucketRows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT name, placement, user_agent
+		FROM bucket_metainfos
+		WHERE project_id = ? AND name >= ? AND name <= ?
+	`), projectID[:], []byte(firstBucket), []byte(lastBucket))
+	if err != nil {
+		return nil, err
+	}
+	for bucketRows.Next() {
+		var name []byte
+		var placement *int64
+		var userAgent []byte
+		if err := bucketRows.Scan(&name, &placement, &userAgent); err != nil {
+			return nil, errs.Combine(err, bucketRows.Close())
+		}
+
+		template := accounting.BucketDailyUsage{
+			UserAgent: userAgent,
+		}
+		if placement != nil {
+			template.Placement = storj.PlacementConstraint(*placement)
+		}
+		templates[string(name)] = template
+	}
+	if err := errs.Combine(bucketRows.Err(), bucketRows.Close()); err != nil {
+		return nil, err
+	}
+
+	type usageKey struct {
+		bucket string
+		day    time.Time
+	}
+	usages := make(map[usageKey]*accounting.BucketDailyUsage)
+	usageOn := func(bucket string, t time.Time) *accounting.BucketDailyUsage {
+		key := usageKey{bucket: bucket, day: truncateToDay(t)}
+		usage, ok := usages[key]
+		if !ok {
+			usage = new(accounting.BucketDailyUsage)
+			*usage = templates[bucket]
+			usage.ProjectID = projectID
+			usage.BucketName = bucket
+			usage.Day = key.day
+			usages[key] = usage
+		}
+		return usage
+	}
+
+	rollupRows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT
+			bucket_name, date_trunc('day', interval_start AT TIME ZONE 'UTC') AS day,
+			COALESCE(SUM(CASE WHEN action = ? THEN settled + inline ELSE 0 END), 0)::INT8,
+			COALESCE(SUM(CASE WHEN action = ? THEN settled + inline ELSE 0 END), 0)::INT8,
+			COALESCE(SUM(CASE WHEN action = ? THEN settled + inline ELSE 0 END), 0)::INT8
+		FROM bucket_bandwidth_rollups
+		WHERE project_id = ? AND bucket_name >= ? AND bucket_name <= ? AND interval_start >= ? AND interval_start < ?
+			AND action IN (?, ?, ?)
+		GROUP BY bucket_name, day
+	`), pb.PieceAction_GET, pb.PieceAction_GET_REPAIR, pb.PieceAction_GET_AUDIT,
+		projectID[:], []byte(firstBucket), []byte(lastBucket), since, before,
+		pb.PieceAction_GET, pb.PieceAction_GET_REPAIR, pb.PieceAction_GET_AUDIT)
+	if err != nil {
+		return nil, err
+	}
+
+	for rollupRows.Next() {
+		var bucket []byte
+		var day time.Time
+		var get, repair, audit int64
+		if err := rollupRows.Scan(&bucket, &day, &get, &repair, &audit); err != nil {
+			return nil, errs.Combine(err, rollupRows.Close())
+		}
+
+		usage := usageOn(string(bucket), day)
+		usage.GetEgress += get
+		usage.RepairEgress += repair
+		usage.AuditEgress += audit
+	}
+	if err := errs.Combine(rollupRows.Err(), rollupRows.Close()); err != nil {
+		return nil, err
+	}
+
+	// a tally accounts for the time until the next tally, so the most recent one is skipped.
+	tallyRows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT bucket_name, interval_start, next_interval_start,
+			total_bytes, inline, remote,
+			total_segments_count, remote_segments_count, inline_segments_count, object_count
+		FROM (
+			SELECT *, LEAD(interval_start) OVER (PARTITION BY bucket_name ORDER BY interval_start) AS next_interval_start
+			FROM bucket_storage_tallies
+			WHERE project_id = ? AND bucket_name >= ? AND bucket_name <= ? AND interval_start >= ? AND interval_start < ?
+		) AS tallies
+		WHERE next_interval_start IS NOT NULL
+	`), projectID[:], []byte(firstBucket), []byte(lastBucket), since, before)
+	if err != nil {
+		return nil, err
+	}
+
+	for tallyRows.Next() {
+		var bucket []byte
+		var intervalStart, nextIntervalStart time.Time
+		var tally dbx.BucketStorageTally
+		err := tallyRows.Scan(&bucket, &intervalStart, &nextIntervalStart, &tally.TotalBytes, &tally.Inline, &tally.Remote,
+			&tally.TotalSegmentsCount, &tally.RemoteSegmentsCount, &tally.InlineSegmentsCount, &tally.ObjectCount)
+		if err != nil {
+			return nil, errs.Combine(err, tallyRows.Close())
+		}
+
+		bytes := tally.TotalBytes
+		if bytes == 0 {
+			bytes = tally.Inline + tally.Remote
+		}
+		segments := tally.TotalSegmentsCount
+		if segments == 0 {
+			segments = tally.RemoteSegmentsCount + tally.InlineSegmentsCount
+		}
+
+		// split the interval between the tallies at day boundaries.
+		nextIntervalStart = nextIntervalStart.UTC()
+		for start := intervalStart.UTC(); start.Before(nextIntervalStart); {
+			end := truncateToDay(start).AddDate(0, 0, 1)
+			if end.After(nextIntervalStart) {
+				end = nextIntervalStart
+			}
+			hours := end.Sub(start).Hours()
+
+			usage := usageOn(string(bucket), start)
+			usage.StorageByteHours += float64(bytes) * hours
+			usage.SegmentHours += float64(segments) * hours
+			usage.ObjectHours += float64(tally.ObjectCount) * hours
+
+			start = end
+		}
+	}
+	if err := errs.Combine(tallyRows.Err(), tallyRows.Close()); err != nil {
+		return nil, err
+	}
+
+	days := make([]accounting.BucketDailyUsage, 0, len(usages))
+	for _, usage := range usages {
+		days = append(days, *usage)
+	}
+	sort.Slice(days, func(i, k int) bool {
+		if days[i].BucketName != days[k].BucketName {
+			return days[i].BucketName < days[k].BucketName
+		}
+		return days[i].Day.Before(days[k].Day)
+	})
+
+	return days, nil
+}
+
+// truncateToDay truncates down to the start of the UTC day.
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
 // prefixIncrement returns the lexicographically lowest byte string which is
 // greater than origPrefix and does not have origPrefix as a prefix. If no such
 // byte string exists (origPrefix is empty, or origPrefix contains only 0xff
@@ -1108,6 +1383,52 @@ func (db *ProjectAccounting) getBucketsSinceAndBefore(ctx context.Context, proje
 	return buckets, nil
 }
 
+// bucketPlacement contains the placement and user agent of a bucket.
+type bucketPlacement struct {
+	name      string
+	placement storj.PlacementConstraint
+	userAgent []byte
+}
+
+// getBucketPlacementsSinceAndBefore lists distinct buckets with their placement and user agent
+// for a project within a specific timeframe. Buckets, which were already deleted, have the
+// default placement and no user agent.
+func (db *ProjectAccounting) getBucketPlacementsSinceAndBefore(ctx context.Context, projectID uuid.UUID, since, before time.Time) (buckets []bucketPlacement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT used.bucket_name, bucket_metainfos.placement, bucket_metainfos.user_agent
+		FROM (
+			SELECT bucket_name FROM bucket_storage_tallies
+			WHERE project_id = ? AND interval_start >= ? AND interval_start < ?
+			UNION
+			SELECT bucket_name FROM bucket_bandwidth_rollups
+			WHERE project_id = ? AND interval_start >= ? AND interval_start < ?
+		) AS used
+		LEFT JOIN bucket_metainfos ON bucket_metainfos.project_id = ? AND bucket_metainfos.name = used.bucket_name
+	`), projectID[:], since, before, projectID[:], since, before, projectID[:])
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Err(), rows.Close()) }()
+
+	for rows.Next() {
+		var bucket bucketPlacement
+		var name []byte
+		var placement *int64
+		if err := rows.Scan(&name, &placement, &bucket.userAgent); err != nil {
+			return nil, err
+		}
+		bucket.name = string(name)
+		if placement != nil {
+			bucket.placement = storj.PlacementConstraint(*placement)
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, nil
+}
+
 // timeTruncateDown truncates down to the hour before to be in sync with orders endpoint.
 func timeTruncateDown(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
@@ -1209,3 +1530,173 @@ func (db *ProjectAccounting) GetArchivedRollupsSince(ctx context.Context, since
 		}
 	}
 }
+
+// bucketObjectStatsBatchSize is the number of buckets, whose object statistics are stored in one transaction.
+const bucketObjectStatsBatchSize = 1000
+
+// ReplaceBucketObjectStats stores the object statistics collected at collectedAt and removes the
+// statistics of the buckets, which were collected before. The statistics are stored in batches,
+// so the buckets are updated in small transactions.
+func (db *ProjectAccounting) ReplaceBucketObjectStats(ctx context.Context, collectedAt time.Time, stats []accounting.BucketObjectStats) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for len(stats) > 0 {
+		batch := stats
+		if len(batch) > bucketObjectStatsBatchSize {
+			batch = batch[:bucketObjectStatsBatchSize]
+		}
+		stats = stats[len(batch):]
+
+		if err := db.upsertBucketObjectStats(ctx, batch); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `
+			DELETE FROM bucket_prefix_stats
+			WHERE (project_id, bucket_name) IN (
+				SELECT project_id, bucket_name FROM bucket_object_stats WHERE collected_at < $1
+			)
+		`, collectedAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `DELETE FROM bucket_object_stats WHERE collected_at < $1`, collectedAt)
+		return err
+	}))
+}
+
+// upsertBucketObjectStats stores the object statistics of the buckets and replaces their prefix statistics.
+func (db *ProjectAccounting) upsertBucketObjectStats(ctx context.Context, stats []accounting.BucketObjectStats) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectIDs := make([]uuid.UUID, len(stats))
+	bucketNames := make([][]byte, len(stats))
+	collectedAt := make([]time.Time, len(stats))
+	objectCounts := make([]int64, len(stats))
+	pendingObjectCounts := make([]int64, len(stats))
+	totalBytes := make([]int64, len(stats))
+	histograms := make([][]byte, len(stats))
+
+	var prefixProjectIDs []uuid.UUID
+	var prefixBucketNames, prefixes [][]byte
+	var prefixObjectCounts, prefixTotalBytes []int64
+
+	for i, bucket := range stats {
+		projectIDs[i] = bucket.ProjectID
+		bucketNames[i] = []byte(bucket.BucketName)
+		collectedAt[i] = bucket.CollectedAt
+		objectCounts[i] = bucket.ObjectCount
+		pendingObjectCounts[i] = bucket.PendingObjectCount
+		totalBytes[i] = bucket.TotalBytes
+
+		histograms[i], err = json.Marshal(bucket.SizeHistogram)
+		if err != nil {
+			return err
+		}
+
+		for _, prefix := range bucket.Prefixes {
+			prefixProjectIDs = append(prefixProjectIDs, bucket.ProjectID)
+			prefixBucketNames = append(prefixBucketNames, []byte(bucket.BucketName))
+			// the key can't be NULL, hence the prefix of the objects without
+			// a path separator is stored as an empty value.
+			prefixes = append(prefixes, append([]byte{}, prefix.Prefix...))
+			prefixObjectCounts = append(prefixObjectCounts, prefix.ObjectCount)
+			prefixTotalBytes = append(prefixTotalBytes, prefix.TotalBytes)
+		}
+	}
+
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `
+			INSERT INTO bucket_object_stats (
+				project_id, bucket_name, collected_at,
+				object_count, pending_object_count, total_bytes, size_histogram
+			)
+			SELECT * FROM unnest(
+				$1::bytea[], $2::bytea[], $3::timestamptz[],
+				$4::int8[], $5::int8[], $6::int8[], $7::bytea[]
+			)
+			ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+				collected_at = EXCLUDED.collected_at,
+				object_count = EXCLUDED.object_count,
+				pending_object_count = EXCLUDED.pending_object_count,
+				total_bytes = EXCLUDED.total_bytes,
+				size_histogram = EXCLUDED.size_histogram
+		`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.TimestampTZArray(collectedAt),
+			pgutil.Int8Array(objectCounts), pgutil.Int8Array(pendingObjectCounts), pgutil.Int8Array(totalBytes), pgutil.ByteaArray(histograms))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			DELETE FROM bucket_prefix_stats
+			WHERE (project_id, bucket_name) IN (
+				SELECT * FROM unnest($1::bytea[], $2::bytea[])
+			)
+		`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames))
+		if err != nil {
+			return err
+		}
+
+		if len(prefixes) == 0 {
+			return nil
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO bucket_prefix_stats (project_id, bucket_name, prefix, object_count, total_bytes)
+			SELECT * FROM unnest($1::bytea[], $2::bytea[], $3::bytea[], $4::int8[], $5::int8[])
+		`, pgutil.UUIDArray(prefixProjectIDs), pgutil.ByteaArray(prefixBucketNames), pgutil.ByteaArray(prefixes),
+			pgutil.Int8Array(prefixObjectCounts), pgutil.Int8Array(prefixTotalBytes))
+		return err
+	})
+}
+
+// GetBucketObjectStats returns the latest object statistics of a bucket.
+func (db *ProjectAccounting) GetBucketObjectStats(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ accounting.BucketObjectStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats := accounting.BucketObjectStats{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+	}
+
+	var histogram []byte
+	err = db.db.QueryRowContext(ctx, `
+		SELECT collected_at, object_count, pending_object_count, total_bytes, size_histogram
+		FROM bucket_object_stats
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, bucketName).Scan(&stats.CollectedAt, &stats.ObjectCount, &stats.PendingObjectCount, &stats.TotalBytes, &histogram)
+	if errors.Is(err, sql.ErrNoRows) {
+		return accounting.BucketObjectStats{}, accounting.ErrObjectStatsNotFound.New("%s/%s", projectID, bucketName)
+	}
+	if err != nil {
+		return accounting.BucketObjectStats{}, Error.Wrap(err)
+	}
+
+	if err := json.Unmarshal(histogram, &stats.SizeHistogram); err != nil {
+		return accounting.BucketObjectStats{}, Error.Wrap(err)
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT prefix, object_count, total_bytes
+		FROM bucket_prefix_stats
+		WHERE project_id = $1 AND bucket_name = $2
+		ORDER BY total_bytes DESC, prefix
+	`, projectID, bucketName)
+	if err != nil {
+		return accounting.BucketObjectStats{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var prefix accounting.PrefixStats
+		if err := rows.Scan(&prefix.Prefix, &prefix.ObjectCount, &prefix.TotalBytes); err != nil {
+			return accounting.BucketObjectStats{}, Error.Wrap(err)
+		}
+		stats.Prefixes = append(stats.Prefixes, prefix)
+	}
+
+	return stats, Error.Wrap(rows.Err())
+}
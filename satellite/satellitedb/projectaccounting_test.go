@@ -4,6 +4,7 @@
 package satellitedb_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
@@ -261,6 +263,70 @@ func Test_GetProjectTotal(t *testing.T) {
 	)
 }
 
+func Test_IterateBucketDailyUsage(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projectID := testrand.UUID()
+		firstBucket, secondBucket := "bucket-a", "bucket-b"
+
+		day := time.Date(2023, time.May, 10, 0, 0, 0, 0, time.UTC)
+		nextDay := day.AddDate(0, 0, 1)
+
+		// the first tally spans midnight, so its usage is split between both days.
+		var tallies []accounting.BucketStorageTally
+		for _, intervalStart := range []time.Time{day.Add(22 * time.Hour), nextDay.Add(2 * time.Hour), nextDay.Add(4 * time.Hour)} {
+			tally := randTally(firstBucket, projectID, intervalStart)
+			tallies = append(tallies, tally)
+			require.NoError(t, db.ProjectAccounting().CreateStorageTally(ctx, tally))
+		}
+
+		getRollup := randRollup(firstBucket, projectID, day.Add(23*time.Hour))
+		repairRollup := randRollup(firstBucket, projectID, nextDay.Add(time.Hour))
+		repairRollup.Action = pb.PieceAction_GET_REPAIR
+		otherRollup := randRollup(secondBucket, projectID, nextDay.Add(time.Hour))
+		require.NoError(t, db.Orders().UpdateBandwidthBatch(ctx, []orders.BucketBandwidthRollup{getRollup, repairRollup, otherRollup}))
+
+		iterate := func(cursor accounting.BucketDailyUsageCursor) (usages []accounting.BucketDailyUsage) {
+			bucketCount, err := db.ProjectAccounting().IterateBucketDailyUsage(ctx, projectID, day, nextDay.AddDate(0, 0, 1), cursor, func(ctx context.Context, usage accounting.BucketDailyUsage) error {
+				usages = append(usages, usage)
+				return nil
+			})
+			require.NoError(t, err)
+			require.EqualValues(t, 2, bucketCount)
+			return usages
+		}
+
+		usages := iterate(accounting.BucketDailyUsageCursor{})
+		require.Len(t, usages, 3)
+
+		const epsilon = 1e-8
+
+		require.Equal(t, firstBucket, usages[0].BucketName)
+		require.Equal(t, day, usages[0].Day.UTC())
+		require.InDelta(t, float64(tallies[0].Bytes())*2, usages[0].StorageByteHours, epsilon)
+		require.InDelta(t, float64(tallies[0].TotalSegmentCount)*2, usages[0].SegmentHours, epsilon)
+		require.InDelta(t, float64(tallies[0].ObjectCount)*2, usages[0].ObjectHours, epsilon)
+		require.Equal(t, getRollup.Inline+getRollup.Settled, usages[0].GetEgress)
+		require.Zero(t, usages[0].RepairEgress)
+
+		require.Equal(t, firstBucket, usages[1].BucketName)
+		require.Equal(t, nextDay, usages[1].Day.UTC())
+		require.InDelta(t, float64(tallies[0].Bytes()+tallies[1].Bytes())*2, usages[1].StorageByteHours, epsilon)
+		require.Zero(t, usages[1].GetEgress)
+		require.Equal(t, repairRollup.Inline+repairRollup.Settled, usages[1].RepairEgress)
+
+		require.Equal(t, secondBucket, usages[2].BucketName)
+		require.Equal(t, nextDay, usages[2].Day.UTC())
+		require.Zero(t, usages[2].StorageByteHours)
+		require.Equal(t, otherRollup.Inline+otherRollup.Settled, usages[2].GetEgress)
+
+		// the cursor selects the buckets, whose usage is iterated.
+		require.Equal(t, usages[:2], iterate(accounting.BucketDailyUsageCursor{Limit: 1}))
+		require.Equal(t, usages[2:], iterate(accounting.BucketDailyUsageCursor{Offset: 1}))
+		require.Equal(t, usages[2:], iterate(accounting.BucketDailyUsageCursor{Offset: 1, Limit: 1}))
+		require.Empty(t, iterate(accounting.BucketDailyUsageCursor{Offset: 2}))
+	})
+}
+
 func Test_GetProjectTotalByPartner(t *testing.T) {
 	const (
 		epsilon          = 1e-8
@@ -418,6 +484,64 @@ func Test_GetProjectTotalByPartner(t *testing.T) {
 	)
 }
 
+func Test_GetProjectTotalByPartnerAndPlacement(t *testing.T) {
+	since := time.Time{}
+	before := since.Add(2 * time.Hour)
+
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			sat := planet.Satellites[0]
+			projectID := testrand.UUID()
+
+			type bucketSetup struct {
+				partner   string
+				placement storj.PlacementConstraint
+				deleted   bool
+			}
+			expected := make(map[string]map[storj.PlacementConstraint]int64)
+			for _, setup := range []bucketSetup{
+				{partner: "", placement: storj.DefaultPlacement},
+				{partner: "", placement: storj.EU},
+				{partner: "partner1", placement: storj.EU},
+				{partner: "partner1", placement: storj.EU},
+				{partner: "", placement: storj.DefaultPlacement, deleted: true},
+			} {
+				bucket := buckets.Bucket{
+					ID:        testrand.UUID(),
+					Name:      testrand.BucketName(),
+					ProjectID: projectID,
+					Placement: setup.placement,
+				}
+				if setup.partner != "" {
+					bucket.UserAgent = []byte(setup.partner)
+				}
+				if !setup.deleted {
+					_, err := sat.DB.Buckets().CreateBucket(ctx, bucket)
+					require.NoError(t, err)
+				}
+
+				rollup := randRollup(bucket.Name, projectID, since)
+				require.NoError(t, sat.DB.Orders().UpdateBandwidthBatch(ctx, []orders.BucketBandwidthRollup{rollup}))
+
+				if expected[setup.partner] == nil {
+					expected[setup.partner] = make(map[storj.PlacementConstraint]int64)
+				}
+				expected[setup.partner][setup.placement] += rollup.Inline + rollup.Settled
+			}
+
+			usages, err := sat.DB.ProjectAccounting().GetProjectTotalByPartnerAndPlacement(ctx, projectID, []string{"partner1"}, since, before)
+			require.NoError(t, err)
+			require.Len(t, usages, len(expected))
+			for partner, placements := range expected {
+				require.Len(t, usages[partner], len(placements))
+				for placement, egress := range placements {
+					require.Equal(t, egress, usages[partner][placement].Egress, "partner %q placement %d", partner, placement)
+				}
+			}
+		},
+	)
+}
+
 func randTally(bucketName string, projectID uuid.UUID, intervalStart time.Time) accounting.BucketStorageTally {
 	return accounting.BucketStorageTally{
 		BucketName:        bucketName,
@@ -570,6 +694,33 @@ func TestProjectUsageGap(t *testing.T) {
 	})
 }
 
+func TestProjectaccounting_GetBucketLatestTally(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projectAccounting := db.ProjectAccounting()
+		bucketName := testrand.BucketName()
+		projectID := testrand.UUID()
+
+		usage, err := projectAccounting.GetBucketLatestTally(ctx, projectID, []byte(bucketName), 0)
+		require.NoError(t, err)
+		require.Zero(t, usage)
+
+		var tallies []accounting.BucketStorageTally
+		for i := 0; i < 3; i++ {
+			tally := randTally(bucketName, projectID, time.Time{}.Add(time.Duration(i)*time.Hour))
+			tallies = append(tallies, tally)
+			require.NoError(t, projectAccounting.CreateStorageTally(ctx, tally))
+		}
+		require.NoError(t, projectAccounting.CreateStorageTally(ctx, randTally(testrand.BucketName(), projectID, time.Time{}.Add(3*time.Hour))))
+
+		usage, err = projectAccounting.GetBucketLatestTally(ctx, projectID, []byte(bucketName), 0)
+		require.NoError(t, err)
+		require.Equal(t, accounting.Usage{
+			Storage:  tallies[2].TotalBytes,
+			Segments: tallies[2].TotalSegmentCount,
+		}, usage)
+	})
+}
+
 func TestProjectaccounting_GetNonEmptyTallyBucketsInRange(t *testing.T) {
 	// test if invalid bucket name will be handled correctly
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
@@ -583,3 +734,56 @@ func TestProjectaccounting_GetNonEmptyTallyBucketsInRange(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestProjectaccounting_BucketObjectStats(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projectAccounting := db.ProjectAccounting()
+		projectID := testrand.UUID()
+
+		_, err := projectAccounting.GetBucketObjectStats(ctx, projectID, []byte("testbucket"))
+		require.True(t, accounting.ErrObjectStatsNotFound.Has(err))
+
+		collectedAt := time.Now().UTC().Truncate(time.Microsecond)
+		expected := accounting.BucketObjectStats{
+			ProjectID:          projectID,
+			BucketName:         "testbucket",
+			CollectedAt:        collectedAt,
+			ObjectCount:        3,
+			PendingObjectCount: 1,
+			TotalBytes:         3 * memory.KiB.Int64(),
+			SizeHistogram: []accounting.ObjectSizeClass{
+				{MinSize: 0, MaxSize: 4 * memory.KiB.Int64(), ObjectCount: 3},
+				{MinSize: 4 * memory.KiB.Int64(), ObjectCount: 0},
+			},
+			Prefixes: []accounting.PrefixStats{
+				{Prefix: []byte("b"), ObjectCount: 2, TotalBytes: 2 * memory.KiB.Int64()},
+				{Prefix: []byte{}, ObjectCount: 1, TotalBytes: memory.KiB.Int64()},
+			},
+		}
+		other := accounting.BucketObjectStats{
+			ProjectID:   projectID,
+			BucketName:  "otherbucket",
+			CollectedAt: collectedAt,
+		}
+
+		require.NoError(t, projectAccounting.ReplaceBucketObjectStats(ctx, collectedAt, []accounting.BucketObjectStats{expected, other}))
+
+		stats, err := projectAccounting.GetBucketObjectStats(ctx, projectID, []byte("testbucket"))
+		require.NoError(t, err)
+		require.WithinDuration(t, expected.CollectedAt, stats.CollectedAt, time.Second)
+		stats.CollectedAt = expected.CollectedAt
+		require.Equal(t, expected, stats)
+
+		// replacing the stats removes the stats of the buckets, which are missing.
+		other.CollectedAt = collectedAt.Add(time.Hour)
+		require.NoError(t, projectAccounting.ReplaceBucketObjectStats(ctx, other.CollectedAt, []accounting.BucketObjectStats{other}))
+
+		_, err = projectAccounting.GetBucketObjectStats(ctx, projectID, []byte("testbucket"))
+		require.True(t, accounting.ErrObjectStatsNotFound.Has(err))
+
+		stats, err = projectAccounting.GetBucketObjectStats(ctx, projectID, []byte("otherbucket"))
+		require.NoError(t, err)
+		require.Zero(t, stats.ObjectCount)
+		require.Empty(t, stats.Prefixes)
+	})
+}
@@ -165,6 +165,38 @@ func (users *users) GetByEmail(ctx context.Context, email string) (_ *console.Us
 	return userFromDBX(ctx, user)
 }
 
+// GetNamesByIDs gets the ID, email, full name and short name of the users with the given IDs.
+// The IDs of users which don't exist are ignored.
+func (users *users) GetNamesByIDs(ctx context.Context, ids []uuid.UUID) (_ []*console.User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows, err := users.db.Query(ctx, `
+		SELECT id, email, full_name, short_name
+		FROM users
+		WHERE id = ANY($1::bytea[])
+	`, pgutil.UUIDArray(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var found []*console.User
+	for rows.Next() {
+		var user console.User
+		err = rows.Scan(&user.ID, &user.Email, &user.FullName, &user.ShortName)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, &user)
+	}
+
+	return found, rows.Err()
+}
+
 // GetUnverifiedNeedingReminder returns users in need of a reminder to verify their email.
 func (users *users) GetUnverifiedNeedingReminder(ctx context.Context, firstReminder, secondReminder, cutoff time.Time) (usersNeedingReminder []*console.User, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,236 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ErrIrreversibleStep is returned when a rollback would need to revert a step
+// without a Revert action.
+var ErrIrreversibleStep = errs.Class("irreversible migration step")
+
+// Rollback reverts the applied steps, which are newer than version, in the
+// reverse order. Afterwards the database is in the same state as after running
+// the migration returned by TargetVersion(version).
+//
+// Nothing is reverted when any of the steps cannot be reverted.
+func (migration *Migration) Rollback(ctx context.Context, log *zap.Logger, version int) error {
+	steps, err := migration.rollbackSteps(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		db := *step.DB
+
+		stepLog := log.Named(strconv.Itoa(step.Version))
+		stepLog.Info("Reverting", zap.String("description", step.Description))
+
+		err := txutil.WithTx(ctx, db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+			err := step.Revert.Run(ctx, stepLog, db, tx)
+			if err != nil {
+				return err
+			}
+			return migration.removeVersion(ctx, tx, db, step.Version)
+		})
+		if err != nil {
+			return Error.New("revert v%d: %w", step.Version, err)
+		}
+	}
+
+	if len(steps) > 0 {
+		log.Info("Database Version", zap.Int("version", version))
+	} else {
+		log.Info("Nothing to revert")
+	}
+
+	return nil
+}
+
+// DryRun writes the statements, which Run would execute, to w without changing
+// the database. Steps, which are not plain SQL, are only described.
+func (migration *Migration) DryRun(ctx context.Context, w io.Writer) error {
+	if err := migration.ValidateSteps(); err != nil {
+		return err
+	}
+
+	versions := make(map[tagsql.DB]int)
+	for _, step := range migration.Steps {
+		if step.DB == nil || *step.DB == nil {
+			return Error.New("step.DB is nil for step %d", step.Version)
+		}
+		db := *step.DB
+
+		version, ok := versions[db]
+		if !ok {
+			var err error
+			version, err = migration.readVersion(ctx, db)
+			if err != nil {
+				return err
+			}
+			versions[db] = version
+		}
+
+		if step.Version <= version {
+			continue
+		}
+
+		err := writeAction(w, db, fmt.Sprintf("v%d: %s", step.Version, step.Description), step.Action)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// DryRunRollback writes the statements, which Rollback would execute, to w
+// without changing the database.
+func (migration *Migration) DryRunRollback(ctx context.Context, w io.Writer, version int) error {
+	steps, err := migration.rollbackSteps(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		err := writeAction(w, *step.DB, fmt.Sprintf("revert v%d: %s", step.Version, step.Description), step.Revert)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// rollbackSteps returns the applied steps newer than version in the order they
+// need to be reverted.
+func (migration *Migration) rollbackSteps(ctx context.Context, version int) ([]*Step, error) {
+	if err := migration.ValidateSteps(); err != nil {
+		return nil, err
+	}
+
+	versions := make(map[tagsql.DB]int)
+	lastVersions := make(map[tagsql.DB]int)
+
+	var steps []*Step
+	for _, step := range migration.Steps {
+		if step.DB == nil || *step.DB == nil {
+			return nil, Error.New("step.DB is nil for step %d", step.Version)
+		}
+		db := *step.DB
+
+		current, ok := versions[db]
+		if !ok {
+			var err error
+			current, err = migration.readVersion(ctx, db)
+			if err != nil {
+				return nil, err
+			}
+			versions[db] = current
+		}
+		lastVersions[db] = step.Version
+
+		if step.Version <= version || step.Version > current {
+			continue
+		}
+		if step.Revert == nil {
+			return nil, ErrIrreversibleStep.New("v%d: %s", step.Version, step.Description)
+		}
+		steps = append(steps, step)
+	}
+
+	// the steps, which have been applied by a newer release, are unknown and
+	// hence can't be reverted.
+	for db, current := range versions {
+		if current > lastVersions[db] {
+			return nil, ErrValidateVersionMismatch.New("database version %d is newer than the latest known step %d", current, lastVersions[db])
+		}
+	}
+
+	for i, k := 0, len(steps)-1; i < k; i, k = i+1, k-1 {
+		steps[i], steps[k] = steps[k], steps[i]
+	}
+	return steps, nil
+}
+
+// readVersion finds the latest version in migration.Table without leaving any
+// changes behind. The version table is created only within a transaction,
+// which is always rolled back. It returns -1 if there aren't any versions.
+func (migration *Migration) readVersion(ctx context.Context, db tagsql.DB) (_ int, err error) {
+	err = migration.ValidTableName()
+	if err != nil {
+		return -1, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return -1, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(tx.Rollback())) }()
+
+	_, err = tx.Exec(ctx, rebind(db, `CREATE TABLE IF NOT EXISTS `+migration.Table+` (version int, commited_at text)`)) //nolint:misspell
+	if err != nil {
+		return -1, Error.Wrap(err)
+	}
+
+	var version sql.NullInt64
+	/* #nosec G202 */ // Table name is white listed by the ValidTableName method
+	// executed at the beginning of the function
+	err = tx.QueryRow(ctx, rebind(db, `SELECT MAX(version) FROM `+migration.Table)).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !version.Valid) {
+		return -1, nil
+	}
+	if err != nil {
+		return -1, Error.Wrap(err)
+	}
+	return int(version.Int64), nil
+}
+
+// removeVersion removes the information about an applied migration.
+func (migration *Migration) removeVersion(ctx context.Context, tx tagsql.Tx, db tagsql.DB, version int) error {
+	err := migration.ValidTableName()
+	if err != nil {
+		return err
+	}
+
+	/* #nosec G202 */ // Table name is white listed by the ValidTableName method
+	// executed at the beginning of the function
+	_, err = tx.Exec(ctx, rebind(db, `DELETE FROM `+migration.Table+` WHERE version = ?`), version)
+	return err
+}
+
+// writeAction writes the statements of the action to w.
+func writeAction(w io.Writer, db tagsql.DB, header string, action Action) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "-- %s\n", header)
+
+	switch action := action.(type) {
+	case SQL:
+		for _, query := range action {
+			query = strings.TrimSuffix(strings.TrimSpace(rebind(db, query)), ";")
+			fmt.Fprintf(&b, "%s;\n", query)
+		}
+	case nil:
+		fmt.Fprintf(&b, "-- no changes\n")
+	default:
+		fmt.Fprintf(&b, "-- runs %T, the changes can't be shown in advance\n", action)
+	}
+	b.WriteString("\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
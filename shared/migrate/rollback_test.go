@@ -0,0 +1,140 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrate_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/shared/dbutil/pgtest"
+	"storj.io/storj/shared/migrate"
+	"storj.io/storj/shared/tagsql"
+)
+
+func TestRollbackMigrationSqlite(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := tagsql.Open(ctx, "sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	rollbackMigration(ctx, t, db, &sqliteDB{DB: db})
+}
+
+func TestRollbackMigrationPostgres(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	connstr := pgtest.PickPostgres(t)
+
+	db, err := tagsql.Open(ctx, "pgx", connstr)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	rollbackMigration(ctx, t, db, &postgresDB{DB: db})
+}
+
+func rollbackMigration(ctx context.Context, t *testing.T, db tagsql.DB, testDB tagsql.DB) {
+	dbName := strings.ToLower(`versions_` + t.Name())
+	defer func() { assert.NoError(t, dropTables(ctx, db, dbName, "users")) }()
+
+	m := migrate.Migration{
+		Table: dbName,
+		Steps: []*migrate.Step{
+			{
+				DB:          &testDB,
+				Description: "Initialize Table",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE users (id int)`,
+				},
+			},
+			{
+				DB:          &testDB,
+				Description: "Add name column",
+				Version:     2,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD COLUMN name text`,
+				},
+				Revert: migrate.SQL{
+					`ALTER TABLE users DROP COLUMN name`,
+				},
+			},
+			{
+				DB:          &testDB,
+				Description: "Add email column",
+				Version:     3,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD COLUMN email text`,
+				},
+				Revert: migrate.SQL{
+					`ALTER TABLE users DROP COLUMN email`,
+				},
+			},
+		},
+	}
+
+	// dry run doesn't change the database.
+	var plan strings.Builder
+	require.NoError(t, m.DryRun(ctx, &plan))
+	require.Equal(t, ""+
+		"-- v1: Initialize Table\nCREATE TABLE users (id int);\n\n"+
+		"-- v2: Add name column\nALTER TABLE users ADD COLUMN name text;\n\n"+
+		"-- v3: Add email column\nALTER TABLE users ADD COLUMN email text;\n\n",
+		plan.String())
+
+	version, err := m.CurrentVersion(ctx, zap.NewNop(), testDB)
+	require.NoError(t, err)
+	require.Equal(t, -1, version)
+
+	require.NoError(t, m.Run(ctx, zap.NewNop()))
+
+	plan.Reset()
+	require.NoError(t, m.DryRun(ctx, &plan))
+	require.Empty(t, plan.String())
+
+	plan.Reset()
+	require.NoError(t, m.DryRunRollback(ctx, &plan, 1))
+	require.Equal(t, ""+
+		"-- revert v3: Add email column\nALTER TABLE users DROP COLUMN email;\n\n"+
+		"-- revert v2: Add name column\nALTER TABLE users DROP COLUMN name;\n\n",
+		plan.String())
+
+	// the first step can't be reverted, hence nothing is reverted.
+	err = m.Rollback(ctx, zap.NewNop(), 0)
+	require.True(t, migrate.ErrIrreversibleStep.Has(err))
+
+	version, err = m.CurrentVersion(ctx, zap.NewNop(), testDB)
+	require.NoError(t, err)
+	require.Equal(t, 3, version)
+
+	require.NoError(t, m.Rollback(ctx, zap.NewNop(), 1))
+
+	version, err = m.CurrentVersion(ctx, zap.NewNop(), testDB)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+
+	_, err = db.Exec(ctx, `INSERT INTO users (id) VALUES (1)`)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `INSERT INTO users (id, name) VALUES (2, 'name')`)
+	require.Error(t, err)
+
+	// the reverted steps can be applied again.
+	require.NoError(t, m.Run(ctx, zap.NewNop()))
+
+	version, err = m.CurrentVersion(ctx, zap.NewNop(), testDB)
+	require.NoError(t, err)
+	require.Equal(t, 3, version)
+
+	// steps applied by a newer release are unknown and can't be reverted.
+	err = m.TargetVersion(2).Rollback(ctx, zap.NewNop(), 1)
+	require.True(t, migrate.ErrValidateVersionMismatch.Has(err))
+}
@@ -44,7 +44,8 @@ Scenarios it doesn't handle properly.
 
 2. Undoing migrations.
 
-	Intentionally left out, because we do not gain that much from currently.
+	Only steps, which specify a Revert action, can be rolled back. Reverting
+	a step that moved or deleted data can't restore the original state.
 
 3. Snapshotting the whole state.
 
@@ -70,6 +71,10 @@ type Step struct {
 	Action      Action
 	CreateDB    CreateDB
 
+	// Revert optionally undoes the changes made by Action. Steps without it
+	// cannot be rolled back.
+	Revert Action
+
 	// SeparateTx marks a step as it should not be merged together for optimization.
 	// Cockroach cannot add a column and update the value in the same transaction.
 	SeparateTx bool
@@ -226,6 +231,10 @@ func (migration *Migration) Run(ctx context.Context, log *zap.Logger) error {
 
 // ensureVersionTable creates migration.Table table if not exists.
 func (migration *Migration) ensureVersionTable(ctx context.Context, log *zap.Logger, db tagsql.DB) error {
+	if err := migration.ValidTableName(); err != nil {
+		return Error.Wrap(err)
+	}
+
 	err := txutil.WithTx(ctx, db, nil, func(ctx context.Context, tx tagsql.Tx) error {
 		_, err := tx.Exec(ctx, rebind(db, `CREATE TABLE IF NOT EXISTS `+migration.Table+` (version int, commited_at text)`)) //nolint:misspell
 		return err
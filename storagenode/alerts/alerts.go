@@ -0,0 +1,130 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package alerts pushes alerts about the problems of the storage node to the operator,
+// through a webhook, email or a script.
+package alerts
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for alerts.
+	Error = errs.Class("alerts")
+)
+
+// Event is the kind of problem an alert is about.
+type Event string
+
+const (
+	// EventSuspension means that the node was suspended by a satellite.
+	EventSuspension Event = "suspension"
+	// EventDisqualification means that the node was disqualified by a satellite.
+	EventDisqualification Event = "disqualification"
+	// EventAuditFailure means that the node failed audits, which puts it at risk of disqualification.
+	EventAuditFailure Event = "audit-failure"
+	// EventOffline means that a satellite couldn't reach the node.
+	EventOffline Event = "offline"
+	// EventLowDiskSpace means that the disk has less free space than the remaining allocated space.
+	EventLowDiskSpace Event = "low-disk-space"
+	// EventStorageDir means that the storage directory couldn't be verified.
+	EventStorageDir Event = "storage-dir"
+	// EventVersion means that the node is running an outdated version.
+	EventVersion Event = "version"
+	// EventNotification means that the node received a notification.
+	EventNotification Event = "notification"
+)
+
+// Events contains all the alert events.
+var Events = []Event{
+	EventSuspension,
+	EventDisqualification,
+	EventAuditFailure,
+	EventOffline,
+	EventLowDiskSpace,
+	EventStorageDir,
+	EventVersion,
+	EventNotification,
+}
+
+// Alert is a problem of the storage node which is reported to the operator.
+type Alert struct {
+	Event Event        `json:"event"`
+	Node  storj.NodeID `json:"node"`
+	// Satellite is the satellite the alert is about, nil when it isn't about a satellite.
+	Satellite *storj.NodeID `json:"satellite,omitempty"`
+	Title     string        `json:"title"`
+	Message   string        `json:"message"`
+	Time      time.Time     `json:"time"`
+}
+
+// Config defines the configuration of the alerts.
+type Config struct {
+	RateLimit time.Duration `help:"minimum time between two alerts with the same event, satellite and title" default:"1h0m0s"`
+	QueueSize int           `help:"maximum number of alerts waiting to be sent, further alerts are dropped" default:"100" hidden:"true"`
+
+	OnlineScoreThreshold float64 `help:"online score which an alert is sent for when the score of a satellite drops below it" default:"0.95"`
+
+	Webhook WebhookConfig
+	Email   EmailConfig
+	Script  ScriptConfig
+}
+
+// WebhookConfig defines the configuration of the webhook alerts.
+type WebhookConfig struct {
+	URL     string        `help:"URL the alerts are posted to as JSON, disabled when empty" default:""`
+	Events  string        `help:"comma separated list of the events which are posted to the webhook, all when empty" default:""`
+	Timeout time.Duration `help:"timeout for posting an alert" default:"30s"`
+}
+
+// EmailConfig defines the configuration of the email alerts.
+type EmailConfig struct {
+	SMTPServerAddress string `help:"address of the SMTP server the alerts are sent through, disabled when empty" default:""`
+	From              string `help:"email address the alerts are sent from" default:""`
+	To                string `help:"comma separated list of the email addresses the alerts are sent to" default:""`
+	Login             string `help:"login for the SMTP server, no authentication when empty" default:""`
+	Password          string `help:"password for the SMTP server" default:""`
+	Events            string `help:"comma separated list of the events which are sent by email, all when empty" default:""`
+}
+
+// ScriptConfig defines the configuration of the script alerts.
+type ScriptConfig struct {
+	Path    string        `help:"path of an executable which is run for each alert with the alert as JSON on stdin, disabled when empty" default:""`
+	Events  string        `help:"comma separated list of the events which the script is run for, all when empty" default:""`
+	Timeout time.Duration `help:"timeout for running the script" default:"1m0s"`
+}
+
+// parseEvents parses a comma separated list of events. It returns nil, which matches all the
+// events, when the list is empty.
+func parseEvents(list string) (map[Event]bool, error) {
+	var events map[Event]bool
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		known := false
+		for _, event := range Events {
+			known = known || Event(name) == event
+		}
+		if !known {
+			return nil, Error.New("unknown event %q", name)
+		}
+
+		if events == nil {
+			events = map[Event]bool{}
+		}
+		events[Event(name)] = true
+	}
+	return events, nil
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+)
+
+// Channel delivers alerts to the operator.
+type Channel interface {
+	Send(ctx context.Context, alert Alert) error
+}
+
+// Webhook posts the alerts as JSON to a URL.
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook creates a channel which posts the alerts to the url.
+func NewWebhook(url string, timeout time.Duration) *Webhook {
+	return &Webhook{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send posts the alert to the webhook.
+func (webhook *Webhook) Send(ctx context.Context, alert Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhook.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with status %q", resp.Status)
+	}
+	return nil
+}
+
+// Email sends the alerts by email.
+type Email struct {
+	sender *post.SMTPSender
+	to     []post.Address
+}
+
+// NewEmail creates a channel which sends the alerts by email.
+func NewEmail(config EmailConfig) (*Email, error) {
+	host, _, err := net.SplitHostPort(config.SMTPServerAddress)
+	if err != nil {
+		return nil, Error.New("invalid SMTP server address %q: %v", config.SMTPServerAddress, err)
+	}
+
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, Error.New("invalid from address %q: %v", config.From, err)
+	}
+
+	to, err := mail.ParseAddressList(config.To)
+	if err != nil {
+		return nil, Error.New("invalid to addresses %q: %v", config.To, err)
+	}
+
+	sender := &post.SMTPSender{
+		ServerAddress: config.SMTPServerAddress,
+		From:          *from,
+	}
+	if config.Login != "" {
+		sender.Auth = smtp.PlainAuth("", config.Login, config.Password, host)
+	}
+
+	email := &Email{sender: sender}
+	for _, address := range to {
+		email.to = append(email.to, *address)
+	}
+	return email, nil
+}
+
+// Send sends the alert by email.
+func (email *Email) Send(ctx context.Context, alert Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var text strings.Builder
+	_, _ = fmt.Fprintf(&text, "%s\n\n", alert.Message)
+	_, _ = fmt.Fprintf(&text, "Event: %s\n", alert.Event)
+	_, _ = fmt.Fprintf(&text, "Node: %s\n", alert.Node)
+	if alert.Satellite != nil {
+		_, _ = fmt.Fprintf(&text, "Satellite: %s\n", alert.Satellite)
+	}
+	_, _ = fmt.Fprintf(&text, "Time: %s\n", alert.Time.Format(time.RFC3339))
+
+	return Error.Wrap(email.sender.SendEmail(ctx, &post.Message{
+		From:      email.sender.From,
+		To:        email.to,
+		Subject:   "Storage node alert: " + alert.Title,
+		Date:      alert.Time,
+		PlainText: text.String(),
+	}))
+}
+
+// Script runs an executable for each alert.
+//
+// The alert is written as JSON to the standard input of the executable, and its fields are
+// passed in the STORJ_ALERT_* environment variables.
+type Script struct {
+	path    string
+	timeout time.Duration
+}
+
+// NewScript creates a channel which runs the executable at path for each alert.
+func NewScript(path string, timeout time.Duration) *Script {
+	return &Script{
+		path:    path,
+		timeout: timeout,
+	}
+}
+
+// Send runs the script for the alert.
+func (script *Script) Send(ctx context.Context, alert Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	input, err := json.Marshal(alert)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if script.timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, script.timeout)
+		defer cancel()
+	}
+
+	satellite := ""
+	if alert.Satellite != nil {
+		satellite = alert.Satellite.String()
+	}
+
+	cmd := exec.CommandContext(ctx, script.path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Env = append(os.Environ(),
+		"STORJ_ALERT_EVENT="+string(alert.Event),
+		"STORJ_ALERT_NODE="+alert.Node.String(),
+		"STORJ_ALERT_SATELLITE="+satellite,
+		"STORJ_ALERT_TITLE="+alert.Title,
+		"STORJ_ALERT_MESSAGE="+alert.Message,
+		"STORJ_ALERT_TIME="+alert.Time.Format(time.RFC3339),
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return Error.New("script failed: %v: %s", err, bytes.TrimSpace(output))
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+)
+
+// drainTimeout is how long the alerts which are still queued are sent for after the service
+// is stopped, so the alerts about the problems which stop the node are not lost.
+const drainTimeout = 10 * time.Second
+
+type channel struct {
+	name    string
+	events  map[Event]bool
+	channel Channel
+}
+
+// matches returns whether the alerts of the event are sent through the channel.
+func (channel *channel) matches(event Event) bool {
+	return channel.events == nil || channel.events[event]
+}
+
+type rateLimitKey struct {
+	event     Event
+	satellite storj.NodeID
+	title     string
+}
+
+// Service sends the alerts through the configured channels.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	nodeID storj.NodeID
+	config Config
+
+	channels []*channel
+	queue    chan Alert
+
+	mu       sync.Mutex
+	lastSent map[rateLimitKey]time.Time
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new alerts service with the channels which are enabled in the config.
+func NewService(log *zap.Logger, nodeID storj.NodeID, config Config) (*Service, error) {
+	service := &Service{
+		log:      log,
+		nodeID:   nodeID,
+		config:   config,
+		lastSent: map[rateLimitKey]time.Time{},
+		nowFn:    time.Now,
+	}
+
+	if config.Webhook.URL != "" {
+		if err := service.AddChannel("webhook", config.Webhook.Events, NewWebhook(config.Webhook.URL, config.Webhook.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+	if config.Email.SMTPServerAddress != "" {
+		email, err := NewEmail(config.Email)
+		if err != nil {
+			return nil, err
+		}
+		if err := service.AddChannel("email", config.Email.Events, email); err != nil {
+			return nil, err
+		}
+	}
+	if config.Script.Path != "" {
+		if err := service.AddChannel("script", config.Script.Events, NewScript(config.Script.Path, config.Script.Timeout)); err != nil {
+			return nil, err
+		}
+	}
+
+	queueSize := config.QueueSize
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+	service.queue = make(chan Alert, queueSize)
+
+	return service, nil
+}
+
+// AddChannel adds a channel which the alerts of the comma separated list of events are sent
+// through, all of them when the list is empty. It must be called before Run.
+func (service *Service) AddChannel(name, events string, ch Channel) error {
+	filter, err := parseEvents(events)
+	if err != nil {
+		return Error.New("%s: %v", name, err)
+	}
+	service.channels = append(service.channels, &channel{
+		name:    name,
+		events:  filter,
+		channel: ch,
+	})
+	return nil
+}
+
+// Send queues the alert for the channels which match its event.
+//
+// The alerts with the same event, satellite and title are sent at most once per rate limit
+// interval. When the queue is full the alert is dropped. It's safe to call Send on a nil
+// service, which doesn't send anything.
+func (service *Service) Send(ctx context.Context, alert Alert) {
+	defer mon.Task()(&ctx)(nil)
+
+	if service == nil {
+		return
+	}
+
+	matched := false
+	for _, channel := range service.channels {
+		matched = matched || channel.matches(alert.Event)
+	}
+	if !matched {
+		return
+	}
+
+	alert.Node = service.nodeID
+	if alert.Time.IsZero() {
+		alert.Time = service.nowFn()
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	key := newRateLimitKey(alert)
+	if !service.allow(key, alert.Time) {
+		mon.Counter("alerts_rate_limited").Inc(1)
+		service.log.Debug("alert rate limited", zap.String("Event", string(alert.Event)), zap.String("Title", alert.Title))
+		return
+	}
+
+	select {
+	case service.queue <- alert:
+		// the alert is rate limited only once it's queued, so a dropped alert can be sent again.
+		service.lastSent[key] = alert.Time
+	default:
+		mon.Counter("alerts_dropped").Inc(1)
+		service.log.Warn("alert queue is full, alert dropped", zap.String("Event", string(alert.Event)), zap.String("Title", alert.Title))
+	}
+}
+
+func newRateLimitKey(alert Alert) rateLimitKey {
+	key := rateLimitKey{
+		event: alert.Event,
+		title: alert.Title,
+	}
+	if alert.Satellite != nil {
+		key.satellite = *alert.Satellite
+	}
+	return key
+}
+
+// allow returns whether the alert isn't rate limited. It must be called with mu held.
+func (service *Service) allow(key rateLimitKey, now time.Time) bool {
+	if last, ok := service.lastSent[key]; ok && now.Sub(last) < service.config.RateLimit {
+		return false
+	}
+
+	// forget the alerts which don't limit anything anymore.
+	for key, last := range service.lastSent {
+		if now.Sub(last) >= service.config.RateLimit {
+			delete(service.lastSent, key)
+		}
+	}
+
+	return true
+}
+
+// Run sends the queued alerts until the context is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		select {
+		case alert := <-service.queue:
+			if ctx.Err() != nil {
+				// the alert was queued while the service was stopped.
+				service.drain(alert)
+				return nil
+			}
+			service.deliver(ctx, alert)
+		case <-ctx.Done():
+			service.drain()
+			return nil
+		}
+	}
+}
+
+// drain sends the pending alerts and the alerts which are still queued.
+func (service *Service) drain(pending ...Alert) {
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	for _, alert := range pending {
+		service.deliver(ctx, alert)
+	}
+	for {
+		select {
+		case alert := <-service.queue:
+			service.deliver(ctx, alert)
+		default:
+			return
+		}
+	}
+}
+
+// deliver sends the alert through the channels which match its event.
+func (service *Service) deliver(ctx context.Context, alert Alert) {
+	for _, channel := range service.channels {
+		if !channel.matches(alert.Event) {
+			continue
+		}
+
+		if err := channel.channel.Send(ctx, alert); err != nil {
+			mon.Counter("alerts_failed").Inc(1)
+			service.log.Warn("failed to send alert",
+				zap.String("Channel", channel.name),
+				zap.String("Event", string(alert.Event)),
+				zap.String("Title", alert.Title),
+				zap.Error(err))
+			continue
+		}
+		mon.Counter("alerts_sent").Inc(1)
+	}
+}
+
+// TestSetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) TestSetNow(now func() time.Time) {
+	service.nowFn = now
+}
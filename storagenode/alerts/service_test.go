@@ -0,0 +1,210 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/alerts"
+)
+
+func TestWebhook(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	received := make(chan alerts.Alert, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert alerts.Alert
+		if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- alert
+	}))
+	defer server.Close()
+
+	nodeID := testrand.NodeID()
+	satelliteID := testrand.NodeID()
+	service, err := alerts.NewService(zaptest.NewLogger(t), nodeID, alerts.Config{
+		RateLimit: time.Hour,
+		QueueSize: 10,
+		Webhook: alerts.WebhookConfig{
+			URL:     server.URL,
+			Events:  "suspension, low-disk-space",
+			Timeout: time.Minute,
+		},
+	})
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	service.TestSetNow(func() time.Time { return now })
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx.Go(func() error { return service.Run(runCtx) })
+
+	suspension := alerts.Alert{
+		Event:     alerts.EventSuspension,
+		Satellite: &satelliteID,
+		Title:     "suspended",
+		Message:   "the node is suspended",
+	}
+	service.Send(ctx, suspension)
+
+	alert := <-received
+	require.Equal(t, alerts.EventSuspension, alert.Event)
+	require.Equal(t, nodeID, alert.Node)
+	require.Equal(t, &satelliteID, alert.Satellite)
+	require.Equal(t, "suspended", alert.Title)
+	require.Equal(t, "the node is suspended", alert.Message)
+	require.True(t, now.Equal(alert.Time))
+
+	// the same alert is rate limited and the version alerts are filtered.
+	service.Send(ctx, suspension)
+	service.Send(ctx, alerts.Alert{Event: alerts.EventVersion, Title: "outdated"})
+
+	// the alerts with a different title aren't rate limited.
+	service.Send(ctx, alerts.Alert{Event: alerts.EventLowDiskSpace, Title: "low disk space"})
+	alert = <-received
+	require.Equal(t, alerts.EventLowDiskSpace, alert.Event)
+	require.Nil(t, alert.Satellite)
+
+	// the same alert is sent again after the rate limit.
+	now = now.Add(time.Hour)
+	service.Send(ctx, suspension)
+	alert = <-received
+	require.Equal(t, alerts.EventSuspension, alert.Event)
+	require.True(t, now.Equal(alert.Time))
+
+	require.Empty(t, received)
+}
+
+type alertsChannel chan alerts.Alert
+
+func (ch alertsChannel) Send(ctx context.Context, alert alerts.Alert) error {
+	ch <- alert
+	return nil
+}
+
+func TestSend_QueueFull(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	service, err := alerts.NewService(zaptest.NewLogger(t), testrand.NodeID(), alerts.Config{
+		RateLimit: time.Hour,
+		QueueSize: 1,
+	})
+	require.NoError(t, err)
+
+	received := make(alertsChannel, 10)
+	require.NoError(t, service.AddChannel("test", "", received))
+
+	drain := func() {
+		runCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		require.NoError(t, service.Run(runCtx))
+	}
+
+	queued := alerts.Alert{Event: alerts.EventStorageDir, Title: "queued"}
+	dropped := alerts.Alert{Event: alerts.EventStorageDir, Title: "dropped"}
+
+	service.Send(ctx, queued)
+	service.Send(ctx, dropped)
+	drain()
+
+	require.Len(t, received, 1)
+	require.Equal(t, "queued", (<-received).Title)
+
+	// the dropped alert isn't rate limited, while the sent one is.
+	service.Send(ctx, queued)
+	service.Send(ctx, dropped)
+	drain()
+
+	require.Len(t, received, 1)
+	require.Equal(t, "dropped", (<-received).Title)
+}
+
+func TestScript(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("script uses a shell")
+	}
+
+	ctx := testcontext.New(t)
+
+	output := ctx.File("output")
+	script := ctx.File("alert.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho \"$STORJ_ALERT_EVENT\" > "+output+"\ncat >> "+output+"\n"), 0755))
+
+	nodeID := testrand.NodeID()
+	service, err := alerts.NewService(zaptest.NewLogger(t), nodeID, alerts.Config{
+		Script: alerts.ScriptConfig{
+			Path:    script,
+			Timeout: time.Minute,
+		},
+	})
+	require.NoError(t, err)
+
+	service.Send(ctx, alerts.Alert{
+		Event: alerts.EventStorageDir,
+		Title: "storage directory",
+	})
+
+	// the queued alerts are sent when the service is stopped.
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, service.Run(runCtx))
+
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+
+	event, input, ok := strings.Cut(string(data), "\n")
+	require.True(t, ok)
+	require.Equal(t, string(alerts.EventStorageDir), event)
+
+	var alert alerts.Alert
+	require.NoError(t, json.Unmarshal([]byte(input), &alert))
+	require.Equal(t, alerts.EventStorageDir, alert.Event)
+	require.Equal(t, nodeID, alert.Node)
+	require.Equal(t, "storage directory", alert.Title)
+}
+
+func TestNewService_InvalidConfig(t *testing.T) {
+	log := zaptest.NewLogger(t)
+
+	_, err := alerts.NewService(log, testrand.NodeID(), alerts.Config{
+		Webhook: alerts.WebhookConfig{
+			URL:    "http://localhost",
+			Events: "suspension,unknown",
+		},
+	})
+	require.Error(t, err)
+
+	_, err = alerts.NewService(log, testrand.NodeID(), alerts.Config{
+		Email: alerts.EmailConfig{
+			SMTPServerAddress: "localhost:25",
+			From:              "node@example.test",
+			To:                "not an address",
+		},
+	})
+	require.Error(t, err)
+
+	_, err = alerts.NewService(log, testrand.NodeID(), alerts.Config{
+		Script: alerts.ScriptConfig{
+			Path: filepath.Join("path", "to", "script"),
+		},
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/orders"
+)
+
+// ErrOrdersAPI - console orders api error type.
+var ErrOrdersAPI = errs.Class("consoleapi orders")
+
+// Orders is an api controller that exposes orders related api.
+type Orders struct {
+	service *orders.Service
+
+	log *zap.Logger
+}
+
+// NewOrders is a constructor for orders controller.
+func NewOrders(log *zap.Logger, service *orders.Service) *Orders {
+	return &Orders{
+		log:     log,
+		service: service,
+	}
+}
+
+// Reconciliation returns the submitted, accepted, rejected and settled amounts of the orders by
+// satellite and hour window, for all satellites or for the satellite specified by query parameter id.
+func (controller *Orders) Reconciliation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	var satelliteID *storj.NodeID
+	if id := r.URL.Query().Get("id"); id != "" {
+		nodeID, err := storj.NodeIDFromString(id)
+		if err != nil {
+			controller.serveJSONError(w, http.StatusBadRequest, ErrOrdersAPI.Wrap(err))
+			return
+		}
+		satelliteID = &nodeID
+	}
+
+	report, err := controller.service.Reconcile(ctx, time.Now(), satelliteID)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrOrdersAPI.Wrap(err))
+		return
+	}
+	if report == nil {
+		report = []*orders.ReconciliationWindow{}
+	}
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrOrdersAPI.Wrap(err)))
+		return
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *Orders) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrOrdersAPI.Wrap(err)))
+		return
+	}
+}
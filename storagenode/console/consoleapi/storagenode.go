@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
+	"storj.io/storj/private/nodeoperator"
 	"storj.io/storj/storagenode/console"
 )
 
@@ -187,6 +188,92 @@ func (dashboard *StorageNode) Pricing(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Maintenance returns the requested maintenance window or null, if there is none.
+func (dashboard *StorageNode) Maintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	window, err := dashboard.service.GetMaintenanceWindow(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(window); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// RequestMaintenance sends a planned maintenance window to all satellites.
+// The window starts at the optional start time (default now) and lasts for the duration.
+func (dashboard *StorageNode) RequestMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	var request struct {
+		Start    time.Time `json:"start"`
+		Duration string    `json:"duration"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	duration, err := time.ParseDuration(request.Duration)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+	if request.Start.IsZero() {
+		request.Start = time.Now()
+	}
+
+	window := nodeoperator.MaintenanceWindow{
+		Start: request.Start,
+		End:   request.Start.Add(duration),
+	}
+	if err = dashboard.service.RequestMaintenance(ctx, window); err != nil {
+		status := http.StatusInternalServerError
+		if nodeoperator.MaintenanceError.Has(err) {
+			status = http.StatusBadRequest
+		}
+		dashboard.serveJSONError(w, status, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(window); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// ScrubStats returns the results of the last finished piece scrub or null, if there are none.
+func (dashboard *StorageNode) ScrubStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	stats, err := dashboard.service.GetScrubStats(ctx)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (dashboard *StorageNode) serveJSONError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
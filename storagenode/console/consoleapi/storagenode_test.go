@@ -137,6 +137,25 @@ func TestStorageNodeApi(t *testing.T) {
 				}
 				require.EqualValues(t, expectedPayout, bodyPayout)
 			})
+
+			t.Run("ScrubStats", func(t *testing.T) {
+				// the scrubber is disabled by default, so there are no results.
+				req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/scrubber", baseURL), nil)
+				require.NoError(t, err)
+
+				res, err := http.DefaultClient.Do(req)
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, http.StatusOK, res.StatusCode)
+
+				defer func() {
+					err = res.Body.Close()
+					require.NoError(t, err)
+				}()
+				body, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+				require.Equal(t, "null\n", string(body))
+			})
 		},
 	)
 }
@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleapi"
 	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/payouts"
 )
 
@@ -46,6 +47,7 @@ type Server struct {
 	service       *console.Service
 	notifications *notifications.Service
 	payout        *payouts.Service
+	orders        *orders.Service
 	listener      net.Listener
 	assets        fs.FS
 
@@ -53,7 +55,7 @@ type Server struct {
 }
 
 // NewServer creates new instance of storagenode console web server.
-func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, listener net.Listener) *Server {
+func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, orders *orders.Service, listener net.Listener) *Server {
 	server := Server{
 		log:           logger,
 		service:       service,
@@ -61,6 +63,7 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 		assets:        assets,
 		notifications: notifications,
 		payout:        payout,
+		orders:        orders,
 	}
 
 	router := mux.NewRouter()
@@ -74,6 +77,9 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	storageNodeRouter.HandleFunc("/satellite/{id}", storageNodeController.Satellite).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/satellites/{id}/pricing", storageNodeController.Pricing).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/estimated-payout", storageNodeController.EstimatedPayout).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/maintenance", storageNodeController.Maintenance).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/maintenance", storageNodeController.RequestMaintenance).Methods(http.MethodPost)
+	storageNodeRouter.HandleFunc("/scrubber", storageNodeController.ScrubStats).Methods(http.MethodGet)
 
 	notificationController := consoleapi.NewNotifications(server.log, server.notifications)
 	notificationRouter := router.PathPrefix("/api/notifications").Subrouter()
@@ -91,6 +97,11 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	payoutRouter.HandleFunc("/periods", payoutController.HeldAmountPeriods).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/payout-history/{period}", payoutController.PayoutHistory).Methods(http.MethodGet)
 
+	ordersController := consoleapi.NewOrders(server.log, server.orders)
+	ordersRouter := router.PathPrefix("/api/orders").Subrouter()
+	ordersRouter.StrictSlash(true)
+	ordersRouter.HandleFunc("/reconciliation", ordersController.Reconciliation).Methods(http.MethodGet)
+
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static/").Handler(web.CacheHandler(staticServer))
 	router.PathPrefix("/").HandlerFunc(server.appHandler)
@@ -46,6 +46,13 @@ func TestConsole(t *testing.T) {
 			require.NoError(t, err)
 			_ = res.Body.Close()
 			require.Equal(t, http.StatusOK, res.StatusCode)
+
+			req, err = http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/api/orders/reconciliation?id=%s", addr, satellite.ID()), nil)
+			require.NoError(t, err)
+			res, err = http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			_ = res.Body.Close()
+			require.Equal(t, http.StatusOK, res.StatusCode)
 		},
 	)
 }
@@ -15,6 +15,7 @@ import (
 	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/storj/private/date"
+	"storj.io/storj/private/nodeoperator"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/shared/version"
 	"storj.io/storj/storagenode/bandwidth"
@@ -49,6 +50,7 @@ type Service struct {
 	pricingDB      pricing.DB
 	satelliteDB    satellites.DB
 	pieceStore     *pieces.Store
+	scrubber       *pieces.Scrubber
 	contact        *contact.Service
 
 	estimation *estimatedpayouts.Service
@@ -71,7 +73,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 	allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info, trust *trust.Pool,
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
 	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache,
-	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats) (*Service, error) {
+	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats, scrubber *pieces.Scrubber) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -114,6 +116,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		pricingDB:          pricingDB,
 		satelliteDB:        satelliteDB,
 		pieceStore:         pieceStore,
+		scrubber:           scrubber,
 		version:            version,
 		pingStats:          pingStats,
 		allocatedDiskSpace: allocatedDiskSpace,
@@ -494,3 +497,62 @@ func (s *Service) GetSatellitePricingModel(ctx context.Context, satelliteID stor
 
 	return pricingModel, nil
 }
+
+// RequestMaintenance sends a planned maintenance window to all trusted satellites.
+func (s *Service) RequestMaintenance(ctx context.Context, window nodeoperator.MaintenanceWindow) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.contact.RequestMaintenance(ctx, window)
+	if err != nil {
+		return SNOServiceErr.Wrap(err)
+	}
+	return nil
+}
+
+// GetMaintenanceWindow returns the requested maintenance window, if it's not over yet.
+func (s *Service) GetMaintenanceWindow(ctx context.Context) (window *nodeoperator.MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	current, ok := s.contact.MaintenanceWindow()
+	if !ok {
+		return nil, nil
+	}
+	return &current, nil
+}
+
+// ScrubStats contains the results of the last finished piece scrub.
+type ScrubStats struct {
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+
+	Verified    int64 `json:"verified"`
+	Corrupted   int64 `json:"corrupted"`
+	Unreadable  int64 `json:"unreadable"`
+	Quarantined int64 `json:"quarantined"`
+	BytesRead   int64 `json:"bytesRead"`
+}
+
+// GetScrubStats returns the results of the last finished piece scrub, or nil
+// if the scrubber is disabled or no scrub has finished yet.
+func (s *Service) GetScrubStats(ctx context.Context) (stats *ScrubStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.scrubber == nil {
+		return nil, nil
+	}
+
+	last := s.scrubber.LastStats()
+	if last.Finished.IsZero() {
+		return nil, nil
+	}
+
+	return &ScrubStats{
+		Started:     last.Started,
+		Finished:    last.Finished,
+		Verified:    last.Verified,
+		Corrupted:   last.Corrupted,
+		Unreadable:  last.Unreadable,
+		Quarantined: last.Quarantined,
+		BytesRead:   last.BytesRead,
+	}, nil
+}
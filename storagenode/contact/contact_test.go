@@ -6,17 +6,20 @@ package contact_test
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/identity/testidentity"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcpeer"
 	"storj.io/common/testcontext"
+	"storj.io/storj/private/nodeoperator"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/storagenode/contact"
@@ -174,3 +177,52 @@ func TestServiceRequestPingMeQUIC(t *testing.T) {
 		require.Equal(t, contact.NetworkStatusOk, quicStats.Status())
 	})
 }
+
+func TestServiceRequestMaintenance(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Maintenance.MaxDuration = 4 * time.Hour
+				if index == 1 {
+					config.Overlay.Maintenance.MaxDuration = time.Hour
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		node.Contact.Chore.Pause(ctx)
+
+		now := time.Now().Truncate(time.Second)
+		window := nodeoperator.MaintenanceWindow{Start: now, End: now.Add(2 * time.Hour)}
+
+		// the second satellite rejects the window, because it's too long.
+		err := node.Contact.Service.RequestMaintenance(ctx, window)
+		require.True(t, nodeoperator.MaintenanceError.Has(err))
+		require.Contains(t, err.Error(), planet.Satellites[1].ID().String())
+
+		windows, err := planet.Satellites[0].Overlay.Service.GetMaintenanceWindows(ctx, node.ID())
+		require.NoError(t, err)
+		require.Len(t, windows, 1)
+		require.True(t, windows[0].Start.Equal(window.Start))
+
+		windows, err = planet.Satellites[1].Overlay.Service.GetMaintenanceWindows(ctx, node.ID())
+		require.NoError(t, err)
+		require.Empty(t, windows)
+
+		// the window isn't sent again after it was accepted or rejected.
+		require.NoError(t, node.Contact.Service.PingSatellites(ctx, 10*time.Second))
+
+		// the window and the rejection are stored.
+		data, err := os.ReadFile(node.Config.Contact.MaintenancePath)
+		require.NoError(t, err)
+		require.Contains(t, string(data), planet.Satellites[1].ID().String())
+
+		restarted := contact.NewService(zaptest.NewLogger(t), node.Dialer, node.Contact.Service.Local(), node.Storage2.Trust,
+			node.Contact.QUICStats, nil, node.Config.Contact.MaintenancePath)
+		stored, ok := restarted.MaintenanceWindow()
+		require.True(t, ok)
+		require.True(t, stored.Start.Equal(window.Start))
+		require.True(t, stored.End.Equal(window.End))
+	})
+}
@@ -6,7 +6,11 @@ package contact
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/fs"
 	"math/rand"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -18,10 +22,15 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"storj.io/common/errs2"
+	"storj.io/common/fpath"
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/maintenancepb"
+	"storj.io/storj/private/nodeoperator"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -44,6 +53,8 @@ type Config struct {
 	Interval time.Duration `help:"how frequently the node contact chore should run" releaseDefault:"1h" devDefault:"30s"`
 
 	Tags SignedTags `help:"protobuf serialized signed node tags in hex (base64) format"`
+
+	MaintenancePath string `help:"file path where the requested maintenance window is stored" default:"${CONFDIR}/maintenance.json"`
 }
 
 // SignedTags represents base64 encoded signed tags.
@@ -119,19 +130,40 @@ type Service struct {
 	initialized sync2.Fence
 
 	tags *pb.SignedNodeTagSets
+
+	maintenancePath string
+	maintenance     *maintenanceRequest
+}
+
+// maintenanceRequest is a planned maintenance window requested by the node, as it's stored on disk.
+type maintenanceRequest struct {
+	Window nodeoperator.MaintenanceWindow `json:"window"`
+	// Scheduled contains the satellites, which accepted the window.
+	Scheduled map[string]bool `json:"scheduled"`
+	// Rejections contains the reasons of the satellites, which rejected the window.
+	Rejections map[string]string `json:"rejections"`
 }
 
 // NewService creates a new contact service.
-func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool, quicStats *QUICStats, tags *pb.SignedNodeTagSets) *Service {
-	return &Service{
-		log:       log,
-		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
-		dialer:    dialer,
-		trust:     trust,
-		self:      self,
-		quicStats: quicStats,
-		tags:      tags,
+func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool, quicStats *QUICStats, tags *pb.SignedNodeTagSets, maintenancePath string) *Service {
+	service := &Service{
+		log:             log,
+		rand:            rand.New(rand.NewSource(time.Now().UnixNano())),
+		dialer:          dialer,
+		trust:           trust,
+		self:            self,
+		quicStats:       quicStats,
+		tags:            tags,
+		maintenancePath: maintenancePath,
+	}
+
+	maintenance, err := loadMaintenance(maintenancePath)
+	if err != nil {
+		log.Warn("failed to load the requested maintenance window", zap.Error(err))
 	}
+	service.maintenance = maintenance
+
+	return service
 }
 
 // PingSatellites attempts to ping all satellites in trusted list until backoff reaches maxInterval.
@@ -177,9 +209,21 @@ func (service *Service) pingSatellite(ctx context.Context, satellite storj.NodeI
 func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID) (err error) {
 	defer mon.Task()(&ctx, id)(&err)
 
+	rejected, err := service.checkIn(ctx, id)
+	if rejected != nil {
+		service.log.Warn("The satellite rejected the requested maintenance window.", zap.Stringer("Satellite ID", id), zap.Error(rejected))
+	}
+	return err
+}
+
+// checkIn sends the node information and the requested maintenance window to the satellite.
+// The returned rejection is not nil, when the satellite rejected the maintenance window.
+func (service *Service) checkIn(ctx context.Context, id storj.NodeID) (rejected error, err error) {
+	defer mon.Task()(&ctx, id)(&err)
+
 	conn, err := service.dialSatellite(ctx, id)
 	if err != nil {
-		return errPingSatellite.Wrap(err)
+		return nil, errPingSatellite.Wrap(err)
 	}
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
@@ -200,19 +244,25 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	})
 	service.quicStats.SetStatus(false)
 	if err != nil {
-		return errPingSatellite.Wrap(err)
+		return nil, errPingSatellite.Wrap(err)
 	}
+
+	rejected, err = service.scheduleMaintenance(ctx, conn, id)
+	if err != nil {
+		service.log.Warn("failed to send maintenance window, it will be sent with the next check-in", zap.Stringer("Satellite ID", id), zap.Error(err))
+	}
+
 	if resp != nil {
 		service.quicStats.SetStatus(resp.PingNodeSuccessQuic)
 
 		if !resp.PingNodeSuccess {
-			return errPingSatellite.New("%s", resp.PingErrorMessage)
+			return rejected, errPingSatellite.New("%s", resp.PingErrorMessage)
 		}
 	}
 	if resp.PingErrorMessage != "" {
 		service.log.Warn("Your node is still considered to be online but encountered an error.", zap.Stringer("Satellite ID", id), zap.String("Error", resp.GetPingErrorMessage()))
 	}
-	return nil
+	return rejected, nil
 }
 
 // RequestPingMeQUIC sends pings request to satellite for a pingBack via QUIC.
@@ -283,6 +333,196 @@ func (service *Service) dialSatellite(ctx context.Context, id storj.NodeID) (*rp
 	return service.dialer.DialNodeURL(ctx, nodeurl)
 }
 
+// RequestMaintenance sends a planned maintenance window to all trusted satellites. The window
+// is stored and sent with the next check-ins to the satellites, which couldn't be reached, until
+// it ends. It returns an error, when any satellite rejected it.
+func (service *Service) RequestMaintenance(ctx context.Context, window nodeoperator.MaintenanceWindow) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !window.End.After(window.Start) {
+		return nodeoperator.MaintenanceError.New("end %s is not after start %s", window.End, window.Start)
+	}
+	if !window.End.After(time.Now()) {
+		return nodeoperator.MaintenanceError.New("window already ended at %s", window.End)
+	}
+
+	service.mu.Lock()
+	service.maintenance = &maintenanceRequest{
+		Window:     window,
+		Scheduled:  map[string]bool{},
+		Rejections: map[string]string{},
+	}
+	service.saveMaintenance()
+	service.mu.Unlock()
+
+	var group errs.Group
+	for _, satellite := range service.trust.GetSatellites(ctx) {
+		rejected, err := service.sendMaintenance(ctx, satellite)
+		if rejected != nil {
+			group.Add(rejected)
+			continue
+		}
+		if err != nil {
+			service.log.Warn("failed to send maintenance window, it will be sent with the next check-in", zap.Stringer("Satellite ID", satellite), zap.Error(err))
+		}
+	}
+	return group.Err()
+}
+
+// sendMaintenance dials the satellite and sends the requested maintenance window to it.
+func (service *Service) sendMaintenance(ctx context.Context, id storj.NodeID) (rejected error, err error) {
+	defer mon.Task()(&ctx, id)(&err)
+
+	conn, err := service.dialSatellite(ctx, id)
+	if err != nil {
+		return nil, errPingSatellite.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	return service.scheduleMaintenance(ctx, conn, id)
+}
+
+// scheduleMaintenance sends the requested maintenance window to the satellite, unless it's over or
+// the satellite already accepted or rejected it. The returned rejection is not nil, when the
+// satellite rejected the window.
+func (service *Service) scheduleMaintenance(ctx context.Context, conn *rpc.Conn, id storj.NodeID) (rejected error, err error) {
+	defer mon.Task()(&ctx, id)(&err)
+
+	maintenance := service.pendingMaintenance(id)
+	if maintenance == nil {
+		return nil, nil
+	}
+
+	_, err = maintenancepb.NewDRPCMaintenanceClient(conn).ScheduleWindow(ctx, &maintenancepb.ScheduleWindowRequest{
+		Start: maintenance.Window.Start,
+		End:   maintenance.Window.End,
+	})
+	if err != nil {
+		if errs2.IsRPC(err, rpcstatus.InvalidArgument) {
+			reason := err.Error()
+			service.maintenanceRejected(maintenance, id, reason)
+			return nodeoperator.MaintenanceError.New("rejected by %s: %s", id, reason), nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	service.maintenanceScheduled(maintenance, id)
+	return nil, nil
+}
+
+// MaintenanceWindow returns the requested maintenance window, if it's not over yet.
+func (service *Service) MaintenanceWindow() (window nodeoperator.MaintenanceWindow, ok bool) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if service.maintenance == nil || !service.maintenance.Window.End.After(time.Now()) {
+		return nodeoperator.MaintenanceWindow{}, false
+	}
+	return service.maintenance.Window, true
+}
+
+// pendingMaintenance returns the requested maintenance window, if it's not over yet and the
+// satellite hasn't accepted or rejected it yet. It returns nil, when there's nothing to send.
+func (service *Service) pendingMaintenance(satellite storj.NodeID) *maintenanceRequest {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	maintenance := service.maintenance
+	if maintenance == nil {
+		return nil
+	}
+	if !maintenance.Window.End.After(time.Now()) {
+		service.maintenance = nil
+		service.saveMaintenance()
+		return nil
+	}
+	if _, rejected := maintenance.Rejections[satellite.String()]; rejected {
+		return nil
+	}
+	if maintenance.Scheduled[satellite.String()] {
+		return nil
+	}
+	return maintenance
+}
+
+// maintenanceScheduled records that the satellite accepted the maintenance window, so it isn't sent again.
+func (service *Service) maintenanceScheduled(maintenance *maintenanceRequest, satellite storj.NodeID) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	// the window may have been replaced in the meantime.
+	if service.maintenance != maintenance {
+		return
+	}
+	maintenance.Scheduled[satellite.String()] = true
+	service.saveMaintenance()
+}
+
+// maintenanceRejected records that the satellite rejected the maintenance window, so it isn't sent again.
+func (service *Service) maintenanceRejected(maintenance *maintenanceRequest, satellite storj.NodeID, reason string) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	// the window may have been replaced in the meantime.
+	if service.maintenance != maintenance {
+		return
+	}
+	maintenance.Rejections[satellite.String()] = reason
+	service.saveMaintenance()
+}
+
+// saveMaintenance stores the requested maintenance window, so it survives restarts.
+// It must be called with the mutex held.
+func (service *Service) saveMaintenance() {
+	if service.maintenancePath == "" {
+		return
+	}
+
+	var err error
+	if service.maintenance == nil {
+		err = os.Remove(service.maintenancePath)
+		if errors.Is(err, fs.ErrNotExist) {
+			err = nil
+		}
+	} else {
+		var data []byte
+		data, err = json.Marshal(service.maintenance)
+		if err == nil {
+			err = fpath.AtomicWriteFile(service.maintenancePath, data, 0644)
+		}
+	}
+	if err != nil {
+		service.log.Warn("failed to store the requested maintenance window", zap.Error(err))
+	}
+}
+
+// loadMaintenance loads the stored maintenance window. It returns nil, when there is none.
+func loadMaintenance(path string) (*maintenanceRequest, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var maintenance maintenanceRequest
+	if err := json.Unmarshal(data, &maintenance); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if maintenance.Scheduled == nil {
+		maintenance.Scheduled = map[string]bool{}
+	}
+	if maintenance.Rejections == nil {
+		maintenance.Rejections = map[string]string{}
+	}
+	return &maintenance, nil
+}
+
 // Local returns the storagenode info.
 func (service *Service) Local() NodeInfo {
 	service.mu.Lock()
@@ -0,0 +1,130 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package forgetsatellite
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/trust"
+)
+
+// Chore removes the data of the satellites which are being forgotten, while the node is running.
+//
+// The satellites are marked as forgetting in the satellites DB, so the cleanup which is
+// interrupted by a restart is resumed when the node starts.
+//
+// architecture: Chore
+type Chore struct {
+	log          *zap.Logger
+	trust        *trust.Pool
+	satellites   satellites.DB
+	cleaner      *Cleaner
+	cacheService *pieces.CacheService
+
+	trigger chan struct{}
+}
+
+// NewChore creates a new forget satellite chore.
+func NewChore(log *zap.Logger, trust *trust.Pool, satellites satellites.DB, cleaner *Cleaner, cacheService *pieces.CacheService) *Chore {
+	return &Chore{
+		log:          log,
+		trust:        trust,
+		satellites:   satellites,
+		cleaner:      cleaner,
+		cacheService: cacheService,
+		trigger:      make(chan struct{}, 1),
+	}
+}
+
+// Run cleans up the satellites which are being forgotten, when it starts and every time a
+// satellite is forgotten.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		if err := chore.cleanup(ctx); err != nil {
+			chore.log.Error("error cleaning up forgotten satellites", zap.Error(err))
+		}
+
+		select {
+		case <-chore.trigger:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Forget marks the untrusted satellite as forgetting and triggers the removal of its data. It
+// returns ErrTrusted when the satellite is trusted and ErrNotFound when the node doesn't know it.
+func (chore *Chore) Forget(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if chore.trust.IsTrusted(ctx, satelliteID) {
+		return ErrTrusted.New("%s", satelliteID)
+	}
+
+	satellite, err := chore.satellites.GetSatellite(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if satellite.SatelliteID.IsZero() {
+		return ErrNotFound.New("%s", satelliteID)
+	}
+
+	if satellite.Status != satellites.Forgetting {
+		if err := chore.satellites.UpdateSatelliteStatus(ctx, satelliteID, satellites.Forgetting); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	select {
+	case chore.trigger <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// cleanup removes the data of all the satellites which are being forgotten.
+func (chore *Chore) cleanup(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sats, err := chore.satellites.GetSatellites(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	cleaned := false
+	for _, satellite := range sats {
+		if satellite.Status != satellites.Forgetting {
+			continue
+		}
+
+		// the satellite could have been trusted again after it was forgotten.
+		if chore.trust.IsTrusted(ctx, satellite.SatelliteID) {
+			chore.log.Warn("Satellite is trusted again, its data is kept.", zap.Stringer("satelliteID", satellite.SatelliteID))
+			group.Add(chore.satellites.UpdateSatelliteStatus(ctx, satellite.SatelliteID, satellites.Normal))
+			continue
+		}
+
+		if err := chore.cleaner.Cleanup(ctx, satellite.SatelliteID); err != nil {
+			group.Add(err)
+			continue
+		}
+		cleaned = true
+	}
+
+	// the space used cache doesn't know about the removed pieces and trash.
+	if cleaned {
+		chore.cacheService.TriggerRecalculation()
+	}
+
+	return group.Err()
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package forgetsatellite removes the data of the untrusted satellites from the storage node.
+package forgetsatellite
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/satellites"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for forget satellite errors.
+	Error = errs.Class("forget satellite")
+	// ErrTrusted is the error class for forgetting a satellite which is still trusted.
+	ErrTrusted = errs.Class("satellite is trusted")
+	// ErrNotFound is the error class for forgetting a satellite which is unknown to the node.
+	ErrNotFound = errs.Class("satellite not found")
+)
+
+// Cleaner removes the data of a satellite from the storage node.
+type Cleaner struct {
+	log         *zap.Logger
+	blobs       blobstore.Blobs
+	v0PieceInfo pieces.V0PieceInfoDB
+	reputation  reputation.DB
+	satellites  satellites.DB
+}
+
+// NewCleaner creates a new cleaner.
+func NewCleaner(log *zap.Logger, blobs blobstore.Blobs, v0PieceInfo pieces.V0PieceInfoDB, reputation reputation.DB, satellites satellites.DB) *Cleaner {
+	return &Cleaner{
+		log:         log,
+		blobs:       blobs,
+		v0PieceInfo: v0PieceInfo,
+		reputation:  reputation,
+		satellites:  satellites,
+	}
+}
+
+// Cleanup removes the pieces, the trash, the reputation and the v0 pieces of the satellite, and
+// finally the satellite itself from the satellites DB.
+func (cleaner *Cleaner) Cleanup(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log := cleaner.log.With(zap.Stringer("satelliteID", satelliteID))
+
+	log.Info("Cleaning up satellite data.")
+	if err := cleaner.blobs.DeleteNamespace(ctx, satelliteID.Bytes()); err != nil {
+		return Error.Wrap(err)
+	}
+
+	log.Info("Cleaning up the trash.")
+	if err := cleaner.blobs.DeleteTrashNamespace(ctx, satelliteID.Bytes()); err != nil {
+		return Error.Wrap(err)
+	}
+
+	log.Info("Removing satellite info from reputation DB.")
+	if err := cleaner.reputation.Delete(ctx, satelliteID); err != nil {
+		return Error.Wrap(err)
+	}
+
+	// delete v0 pieces for the satellite, if any.
+	log.Info("Removing satellite v0 pieces if any.")
+	err = cleaner.v0PieceInfo.WalkSatelliteV0Pieces(ctx, cleaner.blobs, satelliteID, func(access pieces.StoredPieceAccess) error {
+		return cleaner.blobs.Delete(ctx, access.BlobRef())
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	log.Info("Removing satellite from satellites DB.")
+	if err := cleaner.satellites.DeleteSatellite(ctx, satelliteID); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
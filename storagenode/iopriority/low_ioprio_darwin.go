@@ -14,3 +14,15 @@ func SetLowIOPriority() error {
 	}
 	return nil
 }
+
+// SetLowIOPriorityThread lowers the I/O priority of the calling OS thread.
+//
+// The caller must lock the goroutine to its OS thread with runtime.LockOSThread
+// before calling this, otherwise the priority will leak to other goroutines.
+func SetLowIOPriorityThread() error {
+	r1, err := C.setiopolicy_np(C.IOPOL_TYPE_DISK, C.IOPOL_SCOPE_THREAD, C.IOPOL_THROTTLE)
+	if r1 != 0 {
+		return err
+	}
+	return nil
+}
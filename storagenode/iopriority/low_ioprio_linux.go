@@ -30,6 +30,19 @@ func SetLowIOPriority() error {
 	return nil
 }
 
+// SetLowIOPriorityThread lowers the I/O priority of the calling OS thread.
+//
+// The caller must lock the goroutine to its OS thread with runtime.LockOSThread
+// before calling this, otherwise the priority will leak to other goroutines.
+func SetLowIOPriorityThread() error {
+	ioprioPrioValue := ioprioPrioClassValue(ioprioClassBE, 7)
+	_, _, err := syscall.Syscall(syscall.SYS_IOPRIO_SET, uintptr(ioprioWhoProcess), uintptr(syscall.Gettid()), uintptr(ioprioPrioValue))
+	if err != 0 {
+		return err
+	}
+	return nil
+}
+
 // ioprioPrioClassValue returns the class value based on the definition for the IOPRIO_PRIO_VALUE
 // macro in Linux's ioprio.h
 // See https://github.com/torvalds/linux/blob/61d325dcbc05d8fef88110d35ef7776f3ac3f68b/include/uapi/linux/ioprio.h#L15-L17
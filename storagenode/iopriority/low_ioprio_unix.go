@@ -15,3 +15,11 @@ func SetLowIOPriority() error {
 	// the process I/O priority.
 	return syscall.Setpriority(syscall.PRIO_PROCESS, 0, 9)
 }
+
+// SetLowIOPriorityThread lowers the I/O priority of the calling OS thread.
+//
+// POSIX has no portable way to change the priority of a single thread, so
+// this is a no-op on these platforms.
+func SetLowIOPriorityThread() error {
+	return nil
+}
@@ -7,7 +7,24 @@ import (
 	"golang.org/x/sys/windows"
 )
 
+// threadModeBackgroundBegin comes from the THREAD_MODE_BACKGROUND_BEGIN definition in processthreadsapi.h.
+const threadModeBackgroundBegin = 0x00010000
+
+var procSetThreadPriority = windows.NewLazySystemDLL("kernel32.dll").NewProc("SetThreadPriority")
+
 // SetLowIOPriority lowers the process I/O priority.
 func SetLowIOPriority() (err error) {
 	return windows.SetPriorityClass(windows.CurrentProcess(), windows.PROCESS_MODE_BACKGROUND_BEGIN)
 }
+
+// SetLowIOPriorityThread lowers the I/O priority of the calling OS thread.
+//
+// The caller must lock the goroutine to its OS thread with runtime.LockOSThread
+// before calling this, otherwise the priority will leak to other goroutines.
+func SetLowIOPriorityThread() error {
+	r1, _, err := procSetThreadPriority.Call(uintptr(windows.CurrentThread()), threadModeBackgroundBegin)
+	if r1 == 0 {
+		return err
+	}
+	return nil
+}
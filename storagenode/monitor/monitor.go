@@ -7,6 +7,8 @@ package monitor
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -18,6 +20,7 @@ import (
 	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/alerts"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/pieces"
@@ -28,6 +31,8 @@ var (
 
 	// Error is the default error class for piecestore monitor errors.
 	Error = errs.Class("piecestore monitor")
+	// ErrInvalidAllocation is the error class for an allocated disk space which doesn't meet the requirements.
+	ErrInvalidAllocation = errs.Class("invalid allocated disk space")
 )
 
 // DiskSpace consolidates monitored disk space statistics.
@@ -66,21 +71,25 @@ type Service struct {
 	log                   *zap.Logger
 	store                 *pieces.Store
 	contact               *contact.Service
+	alerts                *alerts.Service
 	usageDB               bandwidth.DB
-	allocatedDiskSpace    int64
 	cooldown              *sync2.Cooldown
 	Loop                  *sync2.Cycle
 	VerifyDirReadableLoop *sync2.Cycle
 	VerifyDirWritableLoop *sync2.Cycle
 	Config                Config
+
+	mu                 sync.Mutex
+	allocatedDiskSpace int64
 }
 
 // NewService creates a new storage node monitoring service.
-func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, usageDB bandwidth.DB, allocatedDiskSpace int64, interval time.Duration, reportCapacity func(context.Context), config Config) *Service {
+func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, alerts *alerts.Service, usageDB bandwidth.DB, allocatedDiskSpace int64, interval time.Duration, reportCapacity func(context.Context), config Config) *Service {
 	return &Service{
 		log:                   log,
 		store:                 store,
 		contact:               contact,
+		alerts:                alerts,
 		usageDB:               usageDB,
 		allocatedDiskSpace:    allocatedDiskSpace,
 		cooldown:              sync2.NewCooldown(config.NotifyLowDiskCooldown),
@@ -108,33 +117,39 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		return Error.Wrap(err)
 	}
 
+	allocatedDiskSpace := service.allocated()
+
 	// check your hard drive is big enough
 	// first time setup as a piece node server
-	if totalUsed == 0 && freeDiskSpace < service.allocatedDiskSpace {
-		service.allocatedDiskSpace = freeDiskSpace
-		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
+	if totalUsed == 0 && freeDiskSpace < allocatedDiskSpace {
+		allocatedDiskSpace = freeDiskSpace
+		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
 	// on restarting the Piece node server, assuming already been working as a node
 	// used above the alloacated space, user changed the allocation space setting
 	// before restarting
-	if totalUsed >= service.allocatedDiskSpace {
-		service.log.Warn("Used more space than allocated. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
+	if totalUsed >= allocatedDiskSpace {
+		service.log.Warn("Used more space than allocated. Allocated space is", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
 	// the available disk space is less than remaining allocated space,
 	// due to change of setting before restarting
-	if freeDiskSpace < service.allocatedDiskSpace-totalUsed {
-		service.allocatedDiskSpace = freeDiskSpace + totalUsed
-		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
+	if freeDiskSpace < allocatedDiskSpace-totalUsed {
+		allocatedDiskSpace = freeDiskSpace + totalUsed
+		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
 	// Ensure the disk is at least 500GB in size, which is our current minimum required to be an operator
-	if service.allocatedDiskSpace < service.Config.MinimumDiskSpace.Int64() {
+	if allocatedDiskSpace < service.Config.MinimumDiskSpace.Int64() {
 		service.log.Error("Total disk space is less than required minimum", zap.Int64("bytes", service.Config.MinimumDiskSpace.Int64()))
 		return Error.New("disk space requirement not met")
 	}
 
+	service.mu.Lock()
+	service.allocatedDiskSpace = allocatedDiskSpace
+	service.mu.Unlock()
+
 	group, ctx := errgroup.WithContext(ctx)
 	group.Go(func() error {
 		timeout := service.Config.VerifyDirReadableTimeout
@@ -144,6 +159,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 				if errs2.IsCanceled(err) {
 					return nil
 				}
+				service.alertStorageDir(ctx, "readability", err)
 				if errs.Is(err, context.DeadlineExceeded) {
 					if service.Config.VerifyDirWarnOnly {
 						service.log.Error("timed out while verifying readability of storage directory", zap.Duration("timeout", timeout))
@@ -168,6 +184,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 				if errs2.IsCanceled(err) {
 					return nil
 				}
+				service.alertStorageDir(ctx, "writability", err)
 				if errs.Is(err, context.DeadlineExceeded) {
 					if service.Config.VerifyDirWarnOnly {
 						service.log.Error("timed out while verifying writability of storage directory", zap.Duration("timeout", timeout))
@@ -190,6 +207,10 @@ func (service *Service) Run(ctx context.Context) (err error) {
 			if err != nil {
 				service.log.Error("error during updating node information: ", zap.Error(err))
 			}
+			err = service.checkDiskSpace(ctx)
+			if err != nil {
+				service.log.Error("error during checking disk space: ", zap.Error(err))
+			}
 			return nil
 		})
 	})
@@ -236,6 +257,36 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 	return nil
 }
 
+// checkDiskSpace sends an alert when the disk has less free space than the remaining allocated space.
+func (service *Service) checkDiskSpace(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	diskSpace, err := service.DiskSpace(ctx)
+	if err != nil {
+		return err
+	}
+
+	remaining := diskSpace.Allocated - diskSpace.UsedForPieces - diskSpace.UsedForTrash
+	if diskSpace.Free < remaining {
+		service.alerts.Send(ctx, alerts.Alert{
+			Event: alerts.EventLowDiskSpace,
+			Title: "Your Node is running out of disk space",
+			Message: fmt.Sprintf("The disk has %s of free space, but %s of the allocated space is still unused.",
+				memory.Size(diskSpace.Free), memory.Size(remaining)),
+		})
+	}
+	return nil
+}
+
+// alertStorageDir sends an alert about the failed verification of the storage directory.
+func (service *Service) alertStorageDir(ctx context.Context, check string, err error) {
+	service.alerts.Send(ctx, alerts.Alert{
+		Event:   alerts.EventStorageDir,
+		Title:   "Your Node failed to verify the " + check + " of the storage directory",
+		Message: err.Error(),
+	})
+}
+
 // AvailableSpace returns available disk space for upload.
 func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -245,7 +296,8 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		return 0, err
 	}
 
-	freeSpaceForStorj := service.allocatedDiskSpace - usedSpace
+	allocatedDiskSpace := service.allocated()
+	freeSpaceForStorj := allocatedDiskSpace - usedSpace
 
 	diskStatus, err := service.store.StorageStatus(ctx)
 	if err != nil {
@@ -255,13 +307,54 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		freeSpaceForStorj = diskStatus.DiskFree
 	}
 
-	mon.IntVal("allocated_space").Observe(service.allocatedDiskSpace)
+	mon.IntVal("allocated_space").Observe(allocatedDiskSpace)
 	mon.IntVal("used_space").Observe(usedSpace)
 	mon.IntVal("available_space").Observe(freeSpaceForStorj)
 
 	return freeSpaceForStorj, nil
 }
 
+// SetAllocatedDiskSpace changes the disk space allocated to the storage node until it's restarted
+// and reports the new capacity to the satellites. The allocated space is limited to the space used
+// by the node and the free space of the disk, and it can't be less than the minimum disk space.
+// It returns the disk space which is allocated.
+func (service *Service) SetAllocatedDiskSpace(ctx context.Context, allocated int64) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	totalUsed, err := service.store.SpaceUsedForPiecesAndTrash(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	if storageStatus.DiskFree < allocated-totalUsed {
+		allocated = storageStatus.DiskFree + totalUsed
+		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", allocated))
+	}
+	if allocated < service.Config.MinimumDiskSpace.Int64() {
+		return 0, ErrInvalidAllocation.New("%s is less than the required minimum %s", memory.Size(allocated), service.Config.MinimumDiskSpace)
+	}
+
+	service.mu.Lock()
+	service.allocatedDiskSpace = allocated
+	service.mu.Unlock()
+
+	service.log.Info("Allocated disk space changed", zap.Int64("bytes", allocated))
+	service.NotifyLowDisk()
+
+	return allocated, nil
+}
+
+// allocated returns the disk space allocated to the storage node.
+func (service *Service) allocated() int64 {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.allocatedDiskSpace
+}
+
 // DiskSpace returns consolidated disk space state info.
 func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -280,9 +373,10 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 		return DiskSpace{}, Error.Wrap(err)
 	}
 
+	allocatedDiskSpace := service.allocated()
 	overused := int64(0)
 
-	available := service.allocatedDiskSpace - (usedForPieces + usedForTrash)
+	available := allocatedDiskSpace - (usedForPieces + usedForTrash)
 	if available < 0 {
 		overused = -available
 	}
@@ -291,7 +385,7 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 	}
 
 	return DiskSpace{
-		Allocated:     service.allocatedDiskSpace,
+		Allocated:     allocatedDiskSpace,
 		UsedForPieces: usedForPieces,
 		UsedForTrash:  usedForTrash,
 		Free:          storageStatus.DiskFree,
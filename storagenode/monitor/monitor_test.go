@@ -5,15 +5,21 @@ package monitor_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/memory"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 )
 
 func TestMonitor(t *testing.T) {
@@ -43,3 +49,44 @@ func TestMonitor(t *testing.T) {
 		assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 	})
 }
+
+func TestSetAllocatedDiskSpace(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		blobsCache := pieces.NewBlobsUsageCache(log, db.Pieces())
+		store := pieces.NewStore(log, pieces.NewFileWalker(log, blobsCache, db.V0PieceInfo()), nil, blobsCache, db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		service := monitor.NewService(log, store, nil, nil, db.Bandwidth(), 2*memory.MB.Int64(), time.Hour, nil, monitor.Config{
+			MinimumDiskSpace: memory.MB,
+		})
+		defer ctx.Check(service.Close)
+
+		allocated, err := service.SetAllocatedDiskSpace(ctx, 10*memory.MB.Int64())
+		require.NoError(t, err)
+		require.Equal(t, 10*memory.MB.Int64(), allocated)
+
+		diskSpace, err := service.DiskSpace(ctx)
+		require.NoError(t, err)
+		require.Equal(t, allocated, diskSpace.Allocated)
+
+		// the allocated space is limited to the free space of the disk.
+		requested := int64(1 << 60)
+		allocated, err = service.SetAllocatedDiskSpace(ctx, requested)
+		require.NoError(t, err)
+		require.Less(t, allocated, requested)
+		require.GreaterOrEqual(t, allocated, 10*memory.MB.Int64())
+
+		diskSpace, err = service.DiskSpace(ctx)
+		require.NoError(t, err)
+		require.Equal(t, allocated, diskSpace.Allocated)
+
+		// the allocated space can't be less than the minimum, the previous allocation is kept.
+		_, err = service.SetAllocatedDiskSpace(ctx, memory.KB.Int64())
+		require.Error(t, err)
+		require.True(t, monitor.ErrInvalidAllocation.Has(err))
+
+		diskSpace, err = service.DiskSpace(ctx)
+		require.NoError(t, err)
+		require.Equal(t, allocated, diskSpace.Allocated)
+	})
+}
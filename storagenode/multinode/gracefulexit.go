@@ -0,0 +1,111 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/internalpb"
+)
+
+var _ multinodepb.DRPCGracefulExitServer = (*GracefulExitEndpoint)(nil)
+
+// GracefulExitEndpoint implements multinode graceful exit endpoint.
+//
+// architecture: Endpoint
+type GracefulExitEndpoint struct {
+	multinodepb.DRPCGracefulExitUnimplementedServer
+
+	log          *zap.Logger
+	apiKeys      *apikeys.Service
+	gracefulExit *gracefulexit.Endpoint
+}
+
+// NewGracefulExitEndpoint creates new multinode graceful exit endpoint.
+func NewGracefulExitEndpoint(log *zap.Logger, apiKeys *apikeys.Service, gracefulExit *gracefulexit.Endpoint) *GracefulExitEndpoint {
+	return &GracefulExitEndpoint{
+		log:          log,
+		apiKeys:      apiKeys,
+		gracefulExit: gracefulExit,
+	}
+}
+
+// InitiateGracefulExit starts the graceful exit from a trusted satellite which the node isn't exiting yet.
+func (endpoint *GracefulExitEndpoint) InitiateGracefulExit(ctx context.Context, req *multinodepb.InitiateGracefulExitRequest) (_ *multinodepb.InitiateGracefulExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, endpoint.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if req.SatelliteId.IsZero() {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, errs.New("satellite id is not provided"))
+	}
+
+	nonExiting, err := endpoint.gracefulExit.GetNonExitingSatellites(ctx, &internalpb.GetNonExitingSatellitesRequest{})
+	if err != nil {
+		endpoint.log.Error("list non exiting satellites internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	found := false
+	for _, satellite := range nonExiting.GetSatellites() {
+		found = found || satellite.NodeId == req.SatelliteId
+	}
+	if !found {
+		return nil, rpcstatus.Wrap(rpcstatus.FailedPrecondition, errs.New("satellite %s is not trusted or the node is already exiting it", req.SatelliteId))
+	}
+
+	progress, err := endpoint.gracefulExit.InitiateGracefulExit(ctx, &internalpb.InitiateGracefulExitRequest{
+		NodeId: req.SatelliteId,
+	})
+	if err != nil {
+		endpoint.log.Error("initiate graceful exit internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	return &multinodepb.InitiateGracefulExitResponse{
+		Progress: exitProgress(progress),
+	}, nil
+}
+
+// GracefulExitProgress returns the progress of the graceful exits of the node.
+func (endpoint *GracefulExitEndpoint) GracefulExitProgress(ctx context.Context, req *multinodepb.GracefulExitProgressRequest) (_ *multinodepb.GracefulExitProgressResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, endpoint.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	progress, err := endpoint.gracefulExit.GetExitProgress(ctx, &internalpb.GetExitProgressRequest{})
+	if err != nil {
+		endpoint.log.Error("graceful exit progress internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	response := new(multinodepb.GracefulExitProgressResponse)
+	for _, satellite := range progress.GetProgress() {
+		response.Progress = append(response.Progress, exitProgress(satellite))
+	}
+
+	return response, nil
+}
+
+// exitProgress converts the graceful exit progress of a satellite.
+func exitProgress(progress *internalpb.ExitProgress) *multinodepb.ExitProgress {
+	return &multinodepb.ExitProgress{
+		SatelliteId:       progress.NodeId,
+		DomainName:        progress.DomainName,
+		PercentComplete:   progress.PercentComplete,
+		Successful:        progress.Successful,
+		CompletionReceipt: progress.CompletionReceipt,
+	}
+}
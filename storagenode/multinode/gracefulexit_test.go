@@ -0,0 +1,83 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/multinode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestGracefulExitEndpoint(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		apiKeys := apikeys.NewService(db.APIKeys())
+
+		satelliteURL := trust.SatelliteURL{ID: testrand.NodeID(), Host: "satellite.test", Port: 7777}
+		poolConfig := trust.Config{
+			CachePath: ctx.File("trust-cache.json"),
+		}
+		poolConfig.Sources = append(poolConfig.Sources, &trust.StaticURLSource{URL: satelliteURL})
+
+		trustPool, err := trust.NewPool(log, trust.Dialer(rpc.Dialer{}), poolConfig, db.Satellites())
+		require.NoError(t, err)
+		require.NoError(t, trustPool.Refresh(ctx))
+
+		blobsCache := pieces.NewBlobsUsageCache(log, db.Pieces())
+		gracefulExit := gracefulexit.NewEndpoint(log, trustPool, db.Satellites(), rpc.Dialer{}, blobsCache)
+
+		endpoint := multinode.NewGracefulExitEndpoint(log, apiKeys, gracefulExit)
+
+		key, err := apiKeys.Issue(ctx)
+		require.NoError(t, err)
+		header := &multinodepb.RequestHeader{ApiKey: key.Secret[:]}
+
+		_, err = endpoint.InitiateGracefulExit(ctx, &multinodepb.InitiateGracefulExitRequest{SatelliteId: satelliteURL.ID})
+		require.Equal(t, rpcstatus.Unauthenticated, rpcstatus.Code(err))
+
+		_, err = endpoint.GracefulExitProgress(ctx, &multinodepb.GracefulExitProgressRequest{})
+		require.Equal(t, rpcstatus.Unauthenticated, rpcstatus.Code(err))
+
+		_, err = endpoint.InitiateGracefulExit(ctx, &multinodepb.InitiateGracefulExitRequest{Header: header})
+		require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+		// the node can exit only trusted satellites.
+		_, err = endpoint.InitiateGracefulExit(ctx, &multinodepb.InitiateGracefulExitRequest{Header: header, SatelliteId: testrand.NodeID()})
+		require.Equal(t, rpcstatus.FailedPrecondition, rpcstatus.Code(err))
+
+		progress, err := endpoint.GracefulExitProgress(ctx, &multinodepb.GracefulExitProgressRequest{Header: header})
+		require.NoError(t, err)
+		require.Empty(t, progress.Progress)
+
+		initiated, err := endpoint.InitiateGracefulExit(ctx, &multinodepb.InitiateGracefulExitRequest{Header: header, SatelliteId: satelliteURL.ID})
+		require.NoError(t, err)
+		require.Equal(t, satelliteURL.ID, initiated.Progress.SatelliteId)
+		require.Equal(t, satelliteURL.Address(), initiated.Progress.DomainName)
+		require.Zero(t, initiated.Progress.PercentComplete)
+
+		// the node is already exiting the satellite.
+		_, err = endpoint.InitiateGracefulExit(ctx, &multinodepb.InitiateGracefulExitRequest{Header: header, SatelliteId: satelliteURL.ID})
+		require.Equal(t, rpcstatus.FailedPrecondition, rpcstatus.Code(err))
+
+		progress, err = endpoint.GracefulExitProgress(ctx, &multinodepb.GracefulExitProgressRequest{Header: header})
+		require.NoError(t, err)
+		require.Len(t, progress.Progress, 1)
+		require.Equal(t, initiated.Progress.SatelliteId, progress.Progress[0].SatelliteId)
+		require.False(t, progress.Progress[0].Successful)
+	})
+}
@@ -6,6 +6,7 @@ package multinode
 import (
 	"context"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/rpc/rpcstatus"
@@ -13,6 +14,7 @@ import (
 	"storj.io/storj/shared/version"
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/operator"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/trust"
@@ -35,10 +37,11 @@ type NodeEndpoint struct {
 	contact    *contact.PingStats
 	reputation reputation.DB
 	trust      *trust.Pool
+	forget     *forgetsatellite.Chore
 }
 
 // NewNodeEndpoint creates new multinode node endpoint.
-func NewNodeEndpoint(log *zap.Logger, config operator.Config, apiKeys *apikeys.Service, version version.Info, contact *contact.PingStats, reputation reputation.DB, trust *trust.Pool) *NodeEndpoint {
+func NewNodeEndpoint(log *zap.Logger, config operator.Config, apiKeys *apikeys.Service, version version.Info, contact *contact.PingStats, reputation reputation.DB, trust *trust.Pool, forget *forgetsatellite.Chore) *NodeEndpoint {
 	return &NodeEndpoint{
 		log:        log,
 		config:     config,
@@ -47,6 +50,7 @@ func NewNodeEndpoint(log *zap.Logger, config operator.Config, apiKeys *apikeys.S
 		contact:    contact,
 		reputation: reputation,
 		trust:      trust,
+		forget:     forget,
 	}
 }
 
@@ -166,3 +170,31 @@ func (node *NodeEndpoint) Operator(ctx context.Context, req *multinodepb.Operato
 		WalletFeatures: node.config.WalletFeatures,
 	}, nil
 }
+
+// ForgetSatellite starts removing the data of an untrusted satellite.
+func (node *NodeEndpoint) ForgetSatellite(ctx context.Context, req *multinodepb.ForgetSatelliteRequest) (_ *multinodepb.ForgetSatelliteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, node.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if req.SatelliteId.IsZero() {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, errs.New("satellite id is not provided"))
+	}
+
+	if err = node.forget.Forget(ctx, req.SatelliteId); err != nil {
+		switch {
+		case forgetsatellite.ErrTrusted.Has(err):
+			return nil, rpcstatus.Wrap(rpcstatus.FailedPrecondition, err)
+		case forgetsatellite.ErrNotFound.Has(err):
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		node.log.Error("forget satellite internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	return &multinodepb.ForgetSatelliteResponse{
+		InProgress: true,
+	}, nil
+}
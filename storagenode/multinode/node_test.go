@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/shared/version"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/forgetsatellite"
+	"storj.io/storj/storagenode/multinode"
+	"storj.io/storj/storagenode/operator"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestNodeEndpointForgetSatellite(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		apiKeys := apikeys.NewService(db.APIKeys())
+
+		trustedID := testrand.NodeID()
+		untrustedID := testrand.NodeID()
+
+		poolConfig := trust.Config{
+			CachePath: ctx.File("trust-cache.json"),
+		}
+		poolConfig.Sources = append(poolConfig.Sources, &trust.StaticURLSource{URL: trust.SatelliteURL{ID: trustedID}})
+
+		trustPool, err := trust.NewPool(log, trust.Dialer(rpc.Dialer{}), poolConfig, db.Satellites())
+		require.NoError(t, err)
+		require.NoError(t, trustPool.Refresh(ctx))
+
+		require.NoError(t, db.Satellites().SetAddressAndStatus(ctx, untrustedID, "", satellites.Untrusted))
+		require.NoError(t, db.Reputation().Store(ctx, reputation.Stats{
+			SatelliteID: untrustedID,
+			OnlineScore: 1,
+		}))
+
+		blobsCache := pieces.NewBlobsUsageCache(log, db.Pieces())
+		createBlob := func(satelliteID storj.NodeID) blobstore.BlobRef {
+			ref := blobstore.BlobRef{
+				Namespace: satelliteID.Bytes(),
+				Key:       testrand.PieceID().Bytes(),
+			}
+			w, err := blobsCache.Create(ctx, ref, -1)
+			require.NoError(t, err)
+			_, err = w.Write(testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, w.Commit(ctx))
+			return ref
+		}
+		trustedBlob := createBlob(trustedID)
+		untrustedBlob := createBlob(untrustedID)
+
+		store := pieces.NewStore(log, pieces.NewFileWalker(log, blobsCache, db.V0PieceInfo()), nil, blobsCache, db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		cacheService := pieces.NewService(log, blobsCache, store, time.Hour, false)
+
+		chore := forgetsatellite.NewChore(log, trustPool, db.Satellites(),
+			forgetsatellite.NewCleaner(log, blobsCache, db.V0PieceInfo(), db.Reputation(), db.Satellites()),
+			cacheService)
+
+		endpoint := multinode.NewNodeEndpoint(log, operator.Config{}, apiKeys, version.Info{}, nil, db.Reputation(), trustPool, chore)
+
+		key, err := apiKeys.Issue(ctx)
+		require.NoError(t, err)
+		header := &multinodepb.RequestHeader{ApiKey: key.Secret[:]}
+
+		_, err = endpoint.ForgetSatellite(ctx, &multinodepb.ForgetSatelliteRequest{SatelliteId: untrustedID})
+		require.Equal(t, rpcstatus.Unauthenticated, rpcstatus.Code(err))
+
+		_, err = endpoint.ForgetSatellite(ctx, &multinodepb.ForgetSatelliteRequest{Header: header, SatelliteId: trustedID})
+		require.Equal(t, rpcstatus.FailedPrecondition, rpcstatus.Code(err))
+
+		_, err = endpoint.ForgetSatellite(ctx, &multinodepb.ForgetSatelliteRequest{Header: header, SatelliteId: testrand.NodeID()})
+		require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+
+		resp, err := endpoint.ForgetSatellite(ctx, &multinodepb.ForgetSatelliteRequest{Header: header, SatelliteId: untrustedID})
+		require.NoError(t, err)
+		require.True(t, resp.InProgress)
+
+		satellite, err := db.Satellites().GetSatellite(ctx, untrustedID)
+		require.NoError(t, err)
+		require.Equal(t, satellites.Forgetting, satellite.Status)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		ctx.Go(func() error {
+			return chore.Run(runCtx)
+		})
+		defer cancel()
+
+		require.Eventually(t, func() bool {
+			satellite, err := db.Satellites().GetSatellite(ctx, untrustedID)
+			require.NoError(t, err)
+			return satellite.SatelliteID.IsZero()
+		}, 10*time.Second, 10*time.Millisecond)
+
+		_, err = blobsCache.Stat(ctx, untrustedBlob)
+		require.Error(t, err)
+		_, err = blobsCache.Stat(ctx, trustedBlob)
+		require.NoError(t, err)
+
+		stats, err := db.Reputation().Get(ctx, untrustedID)
+		require.NoError(t, err)
+		require.Equal(t, &reputation.Stats{SatelliteID: untrustedID}, stats)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"storj.io/storj/private/multinodepb"
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/storageusage"
 )
 
@@ -24,19 +25,21 @@ var _ multinodepb.DRPCStorageServer = (*StorageEndpoint)(nil)
 type StorageEndpoint struct {
 	multinodepb.DRPCStorageUnimplementedServer
 
-	log     *zap.Logger
-	apiKeys *apikeys.Service
-	monitor *monitor.Service
-	usage   storageusage.DB
+	log          *zap.Logger
+	apiKeys      *apikeys.Service
+	monitor      *monitor.Service
+	cacheService *pieces.CacheService
+	usage        storageusage.DB
 }
 
 // NewStorageEndpoint creates new multinode storage endpoint.
-func NewStorageEndpoint(log *zap.Logger, apiKeys *apikeys.Service, monitor *monitor.Service, usage storageusage.DB) *StorageEndpoint {
+func NewStorageEndpoint(log *zap.Logger, apiKeys *apikeys.Service, monitor *monitor.Service, cacheService *pieces.CacheService, usage storageusage.DB) *StorageEndpoint {
 	return &StorageEndpoint{
-		log:     log,
-		apiKeys: apiKeys,
-		monitor: monitor,
-		usage:   usage,
+		log:          log,
+		apiKeys:      apiKeys,
+		monitor:      monitor,
+		cacheService: cacheService,
+		usage:        usage,
 	}
 }
 
@@ -151,3 +154,42 @@ func (storage *StorageEndpoint) UsageSatellite(ctx context.Context, req *multino
 		AverageUsageBytes: averageUsageInBytes,
 	}, nil
 }
+
+// UpdateAllocatedDiskSpace changes the allocated disk space until the node is restarted.
+func (storage *StorageEndpoint) UpdateAllocatedDiskSpace(ctx context.Context, req *multinodepb.UpdateAllocatedDiskSpaceRequest) (_ *multinodepb.UpdateAllocatedDiskSpaceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, storage.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if req.GetAllocated() <= 0 {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, errs.New("allocated disk space is not provided"))
+	}
+
+	allocated, err := storage.monitor.SetAllocatedDiskSpace(ctx, req.GetAllocated())
+	if err != nil {
+		if monitor.ErrInvalidAllocation.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+		}
+		storage.log.Error("update allocated disk space internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	return &multinodepb.UpdateAllocatedDiskSpaceResponse{
+		Allocated: allocated,
+	}, nil
+}
+
+// RecalculateUsedSpace triggers the recalculation of the used space by walking all the pieces.
+func (storage *StorageEndpoint) RecalculateUsedSpace(ctx context.Context, req *multinodepb.RecalculateUsedSpaceRequest) (_ *multinodepb.RecalculateUsedSpaceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, storage.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	return &multinodepb.RecalculateUsedSpaceResponse{
+		Started: storage.cacheService.TriggerRecalculation(),
+	}, nil
+}
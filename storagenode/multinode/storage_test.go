@@ -0,0 +1,77 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/multinode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestStorageEndpointManagement(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		apiKeys := apikeys.NewService(db.APIKeys())
+
+		blobsCache := pieces.NewBlobsUsageCache(log, db.Pieces())
+		store := pieces.NewStore(log, pieces.NewFileWalker(log, blobsCache, db.V0PieceInfo()), nil, blobsCache, db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		cacheService := pieces.NewService(log, blobsCache, store, time.Hour, false)
+		monitorService := monitor.NewService(log, store, nil, nil, db.Bandwidth(), memory.MB.Int64(), time.Hour, nil, monitor.Config{
+			MinimumDiskSpace: memory.MB,
+		})
+
+		endpoint := multinode.NewStorageEndpoint(log, apiKeys, monitorService, cacheService, db.StorageUsage())
+
+		key, err := apiKeys.Issue(ctx)
+		require.NoError(t, err)
+		header := &multinodepb.RequestHeader{ApiKey: key.Secret[:]}
+
+		t.Run("UpdateAllocatedDiskSpace", func(t *testing.T) {
+			_, err := endpoint.UpdateAllocatedDiskSpace(ctx, &multinodepb.UpdateAllocatedDiskSpaceRequest{Allocated: 10 * memory.MB.Int64()})
+			require.Equal(t, rpcstatus.Unauthenticated, rpcstatus.Code(err))
+
+			_, err = endpoint.UpdateAllocatedDiskSpace(ctx, &multinodepb.UpdateAllocatedDiskSpaceRequest{Header: header})
+			require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+			// the allocated space can't be less than the minimum.
+			_, err = endpoint.UpdateAllocatedDiskSpace(ctx, &multinodepb.UpdateAllocatedDiskSpaceRequest{Header: header, Allocated: memory.KB.Int64()})
+			require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+			resp, err := endpoint.UpdateAllocatedDiskSpace(ctx, &multinodepb.UpdateAllocatedDiskSpaceRequest{Header: header, Allocated: 10 * memory.MB.Int64()})
+			require.NoError(t, err)
+			require.Equal(t, 10*memory.MB.Int64(), resp.Allocated)
+
+			diskSpace, err := endpoint.DiskSpace(ctx, &multinodepb.DiskSpaceRequest{Header: header})
+			require.NoError(t, err)
+			require.Equal(t, 10*memory.MB.Int64(), diskSpace.Allocated)
+		})
+
+		t.Run("RecalculateUsedSpace", func(t *testing.T) {
+			_, err := endpoint.RecalculateUsedSpace(ctx, &multinodepb.RecalculateUsedSpaceRequest{})
+			require.Equal(t, rpcstatus.Unauthenticated, rpcstatus.Code(err))
+
+			resp, err := endpoint.RecalculateUsedSpace(ctx, &multinodepb.RecalculateUsedSpaceRequest{Header: header})
+			require.NoError(t, err)
+			require.True(t, resp.Started)
+
+			// the cache service isn't running, so the first recalculation is still pending.
+			resp, err = endpoint.RecalculateUsedSpace(ctx, &multinodepb.RecalculateUsedSpaceRequest{Header: header})
+			require.NoError(t, err)
+			require.False(t, resp.Started)
+		})
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/uuid"
+	"storj.io/storj/storagenode/alerts"
 )
 
 var (
@@ -33,28 +34,50 @@ const (
 // Service is the notification service between storage nodes and satellites.
 // architecture: Service
 type Service struct {
-	log *zap.Logger
-	db  DB
+	log    *zap.Logger
+	db     DB
+	alerts *alerts.Service
 }
 
 // NewService creates a new notification service.
-func NewService(log *zap.Logger, db DB) *Service {
+func NewService(log *zap.Logger, db DB, alerts *alerts.Service) *Service {
 	return &Service{
-		log: log,
-		db:  db,
+		log:    log,
+		db:     db,
+		alerts: alerts,
 	}
 }
 
-// Receive - receives notifications from satellite and Insert them into DB.
+// Receive - receives notifications from satellite, Insert them into DB and sends them as alerts.
 func (service *Service) Receive(ctx context.Context, newNotification NewNotification) (Notification, error) {
 	notification, err := service.db.Insert(ctx, newNotification)
 	if err != nil {
 		return Notification{}, err
 	}
 
+	service.alerts.Send(ctx, alerts.Alert{
+		Event:   alertEvent(newNotification.Type),
+		Title:   newNotification.Title,
+		Message: newNotification.Message,
+	})
+
 	return notification, nil
 }
 
+// alertEvent returns the alert event of the notification type.
+func alertEvent(notificationType Type) alerts.Event {
+	switch notificationType {
+	case TypeAuditCheckFailure:
+		return alerts.EventAuditFailure
+	case TypeDisqualification:
+		return alerts.EventDisqualification
+	case TypeSuspension:
+		return alerts.EventSuspension
+	default:
+		return alerts.EventNotification
+	}
+}
+
 // Read - change notification status to Read by ID.
 func (service *Service) Read(ctx context.Context, notificationID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
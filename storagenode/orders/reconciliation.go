@@ -0,0 +1,242 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/private/settlementpb"
+)
+
+// ReconciliationAmounts contains the amounts of the orders of a window.
+type ReconciliationAmounts struct {
+	// Submitted is the amount of all the archived orders.
+	Submitted int64 `json:"submitted"`
+	// Accepted is the amount of the orders accepted by the satellite.
+	Accepted int64 `json:"accepted"`
+	// Rejected is the amount of the orders rejected by the satellite.
+	Rejected int64 `json:"rejected"`
+	// Settled is the amount settled by the satellite.
+	Settled int64 `json:"settled"`
+}
+
+// ReconciliationWindow compares the orders created in an hour window for a satellite with the
+// amounts settled by the satellite.
+type ReconciliationWindow struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+	Window      time.Time    `json:"window"`
+
+	ReconciliationAmounts
+	// Actions contains the amounts by piece action.
+	Actions map[string]ReconciliationAmounts `json:"actions"`
+
+	// SettledAvailable is false when the settled amounts couldn't be retrieved from the satellite.
+	SettledAvailable bool `json:"settledAvailable"`
+	// Reasons explains the differences between the submitted and the settled amounts.
+	Reasons []string `json:"reasons"`
+}
+
+// Reconcile compares the orders archived during the archive TTL with the amounts settled by the
+// satellites, by satellite and hour window. When satelliteID isn't nil, only the orders of that
+// satellite are reconciled.
+//
+// When the settled amounts of a satellite can't be retrieved, its windows are reported with the
+// reason and without the settled amounts.
+func (service *Service) Reconcile(ctx context.Context, now time.Time, satelliteID *storj.NodeID) (_ []*ReconciliationWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	since := now.Add(-service.config.ArchiveTTL).Truncate(time.Hour)
+
+	archived, err := service.ordersStore.ListArchived()
+	if err != nil {
+		// the orders which could be read are still reconciled.
+		service.log.Warn("listing archived orders", zap.Error(err))
+	}
+
+	type windowKey struct {
+		satelliteID storj.NodeID
+		window      time.Time
+	}
+	windows := map[windowKey]*ReconciliationWindow{}
+	getWindow := func(satelliteID storj.NodeID, window time.Time) *ReconciliationWindow {
+		key := windowKey{satelliteID: satelliteID, window: window}
+		if w, ok := windows[key]; ok {
+			return w
+		}
+		w := &ReconciliationWindow{
+			SatelliteID: satelliteID,
+			Window:      window,
+			Actions:     map[string]ReconciliationAmounts{},
+		}
+		windows[key] = w
+		return w
+	}
+
+	satellites := map[storj.NodeID]struct{}{}
+	for _, info := range archived {
+		window := info.Limit.OrderCreation.UTC().Truncate(time.Hour)
+		if window.Before(since) || (satelliteID != nil && info.Limit.SatelliteId != *satelliteID) {
+			continue
+		}
+		satellites[info.Limit.SatelliteId] = struct{}{}
+
+		w := getWindow(info.Limit.SatelliteId, window)
+		action := w.Actions[info.Limit.Action.String()]
+		for _, amounts := range []*ReconciliationAmounts{&w.ReconciliationAmounts, &action} {
+			amounts.Submitted += info.Order.Amount
+			switch info.Status {
+			case StatusAccepted:
+				amounts.Accepted += info.Order.Amount
+			case StatusRejected:
+				amounts.Rejected += info.Order.Amount
+			}
+		}
+		w.Actions[info.Limit.Action.String()] = action
+	}
+
+	to := now.Truncate(time.Hour).Add(time.Hour)
+	for satelliteID := range satellites {
+		settled, err := service.settledWindows(ctx, satelliteID, since, to)
+		if err != nil {
+			service.log.Warn("unable to get settled amounts", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+			for _, w := range windows {
+				if w.SatelliteID == satelliteID {
+					w.Reasons = append(w.Reasons, "settled amounts are not available: "+err.Error())
+				}
+			}
+			continue
+		}
+
+		for _, settledWindow := range settled {
+			w := getWindow(satelliteID, settledWindow.Window.UTC())
+			for action, amount := range settledWindow.ActionSettled {
+				actionAmounts := w.Actions[pb.PieceAction(action).String()]
+				actionAmounts.Settled += amount
+				w.Actions[pb.PieceAction(action).String()] = actionAmounts
+				w.Settled += amount
+			}
+		}
+		for _, w := range windows {
+			if w.SatelliteID == satelliteID {
+				w.SettledAvailable = true
+			}
+		}
+	}
+
+	var report []*ReconciliationWindow
+	for _, w := range windows {
+		w.explain()
+		report = append(report, w)
+	}
+	sort.Slice(report, func(i, k int) bool {
+		if report[i].SatelliteID != report[k].SatelliteID {
+			return report[i].SatelliteID.Less(report[k].SatelliteID)
+		}
+		return report[i].Window.Before(report[k].Window)
+	})
+
+	return report, nil
+}
+
+// explain adds the reasons of the differences between the submitted and the settled amounts.
+func (w *ReconciliationWindow) explain() {
+	if w.Submitted > w.Accepted+w.Rejected {
+		w.Reasons = append(w.Reasons, "the settlement status of some orders is unknown")
+	}
+	if !w.SettledAvailable {
+		return
+	}
+
+	switch {
+	case w.Submitted == 0:
+		w.Reasons = append(w.Reasons, "the orders of the window are not in the archive")
+	case w.Rejected > 0 && w.Settled > 0:
+		w.Reasons = append(w.Reasons, "the satellite rejected the orders because the window was already settled with different amounts")
+	case w.Rejected > 0:
+		w.Reasons = append(w.Reasons, "the satellite rejected the orders, e.g. because none of them were valid or the satellite was untrusted")
+	case w.Settled < w.Accepted:
+		w.Reasons = append(w.Reasons, fmt.Sprintf("the satellite settled %s less than accepted, e.g. because some orders were expired, invalid or duplicated", memory.Size(w.Accepted-w.Settled)))
+	case w.Settled > w.Accepted:
+		w.Reasons = append(w.Reasons, fmt.Sprintf("the satellite settled %s more than accepted, e.g. because some archived orders are corrupted", memory.Size(w.Settled-w.Accepted)))
+	}
+}
+
+// settledCacheEntry contains the amounts settled by a satellite for the windows starting in [from, to).
+type settledCacheEntry struct {
+	from, to  time.Time
+	fetchedAt time.Time
+	windows   []*settlementpb.SettledWindow
+}
+
+// settledWindows returns the amounts settled by the satellite for the windows starting in [from, to).
+//
+// The amounts are reused for the settled cache TTL, or until orders are settled with the satellite.
+func (service *Service) settledWindows(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []*settlementpb.SettledWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeURL, err := service.trust.GetNodeURL(ctx, satelliteID)
+	if err != nil {
+		return nil, OrderError.Wrap(err)
+	}
+
+	service.settledMu.Lock()
+	cached, ok := service.settledCache[satelliteID]
+	service.settledMu.Unlock()
+	if ok && cached.from.Equal(from) && cached.to.Equal(to) && time.Since(cached.fetchedAt) < service.config.SettledCacheTTL {
+		return cached.windows, nil
+	}
+
+	windows, err := service.fetchSettledWindows(ctx, nodeURL, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	service.settledMu.Lock()
+	service.settledCache[satelliteID] = settledCacheEntry{
+		from:      from,
+		to:        to,
+		fetchedAt: time.Now(),
+		windows:   windows,
+	}
+	service.settledMu.Unlock()
+
+	return windows, nil
+}
+
+// forgetSettled removes the cached settled amounts of the satellite.
+func (service *Service) forgetSettled(satelliteID storj.NodeID) {
+	service.settledMu.Lock()
+	defer service.settledMu.Unlock()
+
+	delete(service.settledCache, satelliteID)
+}
+
+// fetchSettledWindows retrieves the amounts settled by the satellite for the windows starting in [from, to).
+func (service *Service) fetchSettledWindows(ctx context.Context, nodeURL storj.NodeURL, from, to time.Time) (_ []*settlementpb.SettledWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.dialer.DialNodeURL(ctx, nodeURL)
+	if err != nil {
+		return nil, OrderError.New("unable to connect to the satellite: %w", err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	response, err := settlementpb.NewDRPCSettlementClient(conn).SettledWindows(ctx, &settlementpb.SettledWindowsRequest{
+		From: from,
+		To:   to,
+	})
+	if err != nil {
+		return nil, OrderError.Wrap(err)
+	}
+	return response.Windows, nil
+}
@@ -85,6 +85,8 @@ type Config struct {
 	CleanupInterval   time.Duration `help:"duration between archive cleanups" default:"5m0s"`
 	ArchiveTTL        time.Duration `help:"length of time to archive orders before deletion" default:"168h0m0s"` // 7 days
 	Path              string        `help:"path to store order limit files in" default:"$CONFDIR/orders"`
+
+	SettledCacheTTL time.Duration `help:"how long the amounts settled by a satellite are reused for the orders reconciliation" default:"10m0s"`
 }
 
 // Service sends every interval unsent orders to the satellite.
@@ -99,6 +101,9 @@ type Service struct {
 	orders      DB
 	trust       *trust.Pool
 
+	settledMu    sync.Mutex
+	settledCache map[storj.NodeID]settledCacheEntry
+
 	Sender  *sync2.Cycle
 	Cleanup *sync2.Cycle
 }
@@ -113,6 +118,8 @@ func NewService(log *zap.Logger, dialer rpc.Dialer, ordersStore *FileStore, orde
 		config:      config,
 		trust:       trust,
 
+		settledCache: map[storj.NodeID]settledCacheEntry{},
+
 		Sender:  sync2.NewCycle(config.SenderInterval),
 		Cleanup: sync2.NewCycle(config.CleanupInterval),
 	}
@@ -240,6 +247,9 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 					return nil
 				}
 
+				// the settled amounts changed with the settlement.
+				service.forgetSettled(satelliteID)
+
 				return nil
 			})
 
@@ -183,6 +183,65 @@ func TestOrderFileStoreSettle_UntrustedSatellite(t *testing.T) {
 	})
 }
 
+func TestReconcile(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplinkPeer := planet.Uplinks[0]
+		satellite.Audit.Worker.Loop.Pause()
+		node := planet.StorageNodes[0]
+		service := node.Storage2.Orders
+		service.Sender.Pause()
+		service.Cleanup.Pause()
+		tomorrow := time.Now().Add(24 * time.Hour)
+
+		// upload a file to generate an order on the storagenode
+		testData := testrand.Bytes(8 * memory.KiB)
+		require.NoError(t, uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testData))
+
+		require.NoError(t, planet.WaitForStorageNodeEndpoints(ctx))
+
+		// trigger order send
+		service.SendOrders(ctx, tomorrow)
+
+		report, err := service.Reconcile(ctx, time.Now(), nil)
+		require.NoError(t, err)
+		require.Len(t, report, 1)
+
+		window := report[0]
+		require.Equal(t, satellite.ID(), window.SatelliteID)
+		require.True(t, window.SettledAvailable)
+		require.Empty(t, window.Reasons)
+		require.NotZero(t, window.Submitted)
+		require.Equal(t, window.Submitted, window.Accepted)
+		require.Equal(t, window.Accepted, window.Settled)
+		require.Zero(t, window.Rejected)
+		require.Equal(t, window.ReconciliationAmounts, window.Actions[pb.PieceAction_PUT.String()])
+
+		// the orders of other satellites aren't reconciled.
+		otherSatellite := testrand.NodeID()
+		report, err = service.Reconcile(ctx, time.Now(), &otherSatellite)
+		require.NoError(t, err)
+		require.Empty(t, report)
+
+		report, err = service.Reconcile(ctx, time.Now(), &window.SatelliteID)
+		require.NoError(t, err)
+		require.Len(t, report, 1)
+		require.Equal(t, window.Settled, report[0].Settled)
+
+		// the settled amounts of an untrusted satellite aren't available.
+		require.NoError(t, node.Storage2.Trust.DeleteSatellite(ctx, satellite.ID()))
+
+		report, err = service.Reconcile(ctx, time.Now(), nil)
+		require.NoError(t, err)
+		require.Len(t, report, 1)
+		require.False(t, report[0].SettledAvailable)
+		require.Zero(t, report[0].Settled)
+		require.Len(t, report[0].Reasons, 1)
+	})
+}
+
 // TODO remove when db is removed.
 // TestOrderFileStoreAndDBSettle ensures that if orders exist in both DB and filestore, that the DB orders and filestore are both settled.
 func TestOrderFileStoreAndDBSettle(t *testing.T) {
@@ -30,6 +30,7 @@ import (
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/shared/debug"
 	"storj.io/storj/shared/version"
+	"storj.io/storj/storagenode/alerts"
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/blobstore"
@@ -38,6 +39,7 @@ import (
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/healthcheck"
 	"storj.io/storj/storagenode/inspector"
@@ -135,6 +137,8 @@ type Config struct {
 	Bandwidth bandwidth.Config
 
 	GracefulExit gracefulexit.Config
+
+	Alerts alerts.Config
 }
 
 // DatabaseConfig returns the storagenodedb.Config that should be used with this Config.
@@ -149,6 +153,8 @@ func (config *Config) DatabaseConfig() storagenodedb.Config {
 		Info2:     filepath.Join(dbdir, "info.db"),
 		Pieces:    config.Storage.Path,
 		Filestore: config.Filestore,
+
+		Consolidated: config.Storage2.DatabaseConsolidated,
 	}
 }
 
@@ -249,6 +255,7 @@ type Peer struct {
 		Trust          *trust.Pool
 		Store          *pieces.Store
 		TrashChore     *pieces.TrashChore
+		Scrubber       *pieces.Scrubber
 		BlobsCache     *pieces.BlobsUsageCache
 		CacheService   *pieces.CacheService
 		RetainService  *retain.Service
@@ -286,10 +293,18 @@ type Peer struct {
 		BlobsCleaner *gracefulexit.BlobsCleaner
 	}
 
+	ForgetSatellite struct {
+		Chore *forgetsatellite.Chore
+	}
+
 	Notifications struct {
 		Service *notifications.Service
 	}
 
+	Alerts struct {
+		Service *alerts.Service
+	}
+
 	Payout struct {
 		Service  *payouts.Service
 		Endpoint *payouts.Endpoint
@@ -300,10 +315,11 @@ type Peer struct {
 	Reputation *reputation.Service
 
 	Multinode struct {
-		Storage   *multinode.StorageEndpoint
-		Bandwidth *multinode.BandwidthEndpoint
-		Node      *multinode.NodeEndpoint
-		Payout    *multinode.PayoutEndpoint
+		Storage      *multinode.StorageEndpoint
+		Bandwidth    *multinode.BandwidthEndpoint
+		Node         *multinode.NodeEndpoint
+		Payout       *multinode.PayoutEndpoint
+		GracefulExit *multinode.GracefulExitEndpoint
 	}
 }
 
@@ -318,8 +334,20 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		Services: lifecycle.NewGroup(log.Named("services")),
 	}
 
+	{ // setup alerts service.
+		alertsService, err := alerts.NewService(peer.Log.Named("alerts"), peer.Identity.ID, config.Alerts)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Alerts.Service = alertsService
+		peer.Services.Add(lifecycle.Item{
+			Name: "alerts",
+			Run:  peer.Alerts.Service.Run,
+		})
+	}
+
 	{ // setup notification service.
-		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications())
+		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications(), peer.Alerts.Service)
 	}
 
 	{ // setup debug
@@ -355,7 +383,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 
 		peer.Version.Service = checker.NewService(log.Named("version"), config.Version, versionInfo, "Storagenode")
 		versionCheckInterval := 12 * time.Hour
-		peer.Version.Chore = version2.NewChore(peer.Log.Named("version:chore"), peer.Version.Service, peer.Notifications.Service, peer.Identity.ID, versionCheckInterval)
+		peer.Version.Chore = version2.NewChore(peer.Log.Named("version:chore"), peer.Version.Service, peer.Notifications.Service, peer.Alerts.Service, peer.Identity.ID, versionCheckInterval)
 		peer.Services.Add(lifecycle.Item{
 			Name: "version",
 			Run:  peer.Version.Chore.Run,
@@ -447,7 +475,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Contact.QUICStats = contact.NewQUICStats(peer.Server.IsQUICEnabled())
 
 		tags := pb.SignedNodeTagSets(config.Contact.Tags)
-		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust, peer.Contact.QUICStats, &tags)
+		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust, peer.Contact.QUICStats, &tags, config.Contact.MaintenancePath)
 
 		peer.Contact.Chore = contact.NewChore(peer.Log.Named("contact:chore"), config.Contact.Interval, peer.Contact.Service)
 		peer.Services.Add(lifecycle.Item{
@@ -505,6 +533,23 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Close: peer.Storage2.TrashChore.Close,
 		})
 
+		if config.Pieces.Scrubber.Enabled {
+			peer.Storage2.Scrubber = pieces.NewScrubber(
+				log.Named("pieces:scrubber"),
+				config.Pieces.Scrubber,
+				peer.Storage2.Store,
+				peer.Storage2.Trust,
+				filepath.Join(config.Storage.Path, "quarantine"),
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "pieces:scrubber",
+				Run:   peer.Storage2.Scrubber.Run,
+				Close: peer.Storage2.Scrubber.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Pieces Scrubber", peer.Storage2.Scrubber.Loop))
+		}
+
 		peer.Storage2.CacheService = pieces.NewService(
 			log.Named("piecestore:cache"),
 			peer.Storage2.BlobsCache,
@@ -524,6 +569,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			log.Named("piecestore:monitor"),
 			peer.Storage2.Store,
 			peer.Contact.Service,
+			peer.Alerts.Service,
 			peer.DB.Bandwidth(),
 			config.Storage.AllocatedDiskSpace.Int64(),
 			// TODO: use config.Storage.Monitor.Interval, but for some reason is not set
@@ -642,6 +688,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.Reputation(),
 			peer.Identity.ID,
 			peer.Notifications.Service,
+			peer.Alerts.Service,
+			config.Alerts.OnlineScoreThreshold,
 		)
 	}
 
@@ -710,6 +758,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			config.Operator.WalletFeatures,
 			port,
 			peer.Contact.QUICStats,
+			peer.Storage2.Scrubber,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -735,6 +784,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Notifications.Service,
 			peer.Console.Service,
 			peer.Payout.Service,
+			peer.Storage2.Orders,
 			peer.Console.Listener,
 		)
 
@@ -822,6 +872,26 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			debug.Cycle("Graceful Exit", peer.GracefulExit.Chore.Loop))
 	}
 
+	{ // setup forget satellite
+		peer.ForgetSatellite.Chore = forgetsatellite.NewChore(
+			peer.Log.Named("forgetsatellite:chore"),
+			peer.Storage2.Trust,
+			peer.DB.Satellites(),
+			forgetsatellite.NewCleaner(
+				peer.Log.Named("forgetsatellite:cleaner"),
+				peer.Storage2.BlobsCache,
+				peer.DB.V0PieceInfo(),
+				peer.DB.Reputation(),
+				peer.DB.Satellites(),
+			),
+			peer.Storage2.CacheService,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name: "forgetsatellite:chore",
+			Run:  peer.ForgetSatellite.Chore.Run,
+		})
+	}
+
 	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.UsedSerials, config.Collector)
 	peer.Services.Add(lifecycle.Item{
 		Name:  "collector",
@@ -848,6 +918,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Log.Named("multinode:storage-endpoint"),
 			apiKeys,
 			peer.Storage2.Monitor,
+			peer.Storage2.CacheService,
 			peer.DB.StorageUsage(),
 		)
 
@@ -865,6 +936,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Contact.PingStats,
 			peer.DB.Reputation(),
 			peer.Storage2.Trust,
+			peer.ForgetSatellite.Chore,
 		)
 
 		peer.Multinode.Payout = multinode.NewPayoutEndpoint(
@@ -875,6 +947,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Payout.Service,
 		)
 
+		peer.Multinode.GracefulExit = multinode.NewGracefulExitEndpoint(
+			peer.Log.Named("multinode:graceful-exit-endpoint"),
+			apiKeys,
+			peer.GracefulExit.Endpoint,
+		)
+
 		if err = multinodepb.DRPCRegisterStorage(peer.Server.DRPC(), peer.Multinode.Storage); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -890,6 +968,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err = multinodepb.DRPCRegisterPayouts(peer.Server.DRPC(), peer.Multinode.Payout); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err = multinodepb.DRPCRegisterGracefulExit(peer.Server.DRPC(), peer.Multinode.GracefulExit); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	return peer, nil
@@ -11,7 +11,9 @@ import (
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
+	"storj.io/common/errs2"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/storagenode/blobstore"
@@ -30,6 +32,8 @@ type CacheService struct {
 	// InitFence is released once the cache's Run method returns or when it has
 	// completed its first loop. This is useful for testing.
 	InitFence sync2.Fence
+
+	recalculate chan struct{}
 }
 
 // NewService creates a new cache service that updates the space usage cache on startup and syncs the cache values to
@@ -41,6 +45,7 @@ func NewService(log *zap.Logger, usageCache *BlobsUsageCache, pieces *Store, int
 		store:              pieces,
 		pieceScanOnStartup: pieceScanOnStartup,
 		Loop:               sync2.NewCycle(interval),
+		recalculate:        make(chan struct{}, 1),
 	}
 }
 
@@ -50,30 +55,11 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	defer service.InitFence.Release()
 
-	totalsAtStart := service.usageCache.copyCacheTotals()
-
 	// recalculate the cache once
 	if service.pieceScanOnStartup {
-		piecesTotal, piecesContentSize, totalsBySatellite, err := service.store.SpaceUsedTotalAndBySatellite(ctx)
-		if err != nil {
-			service.log.Error("error getting current used space: ", zap.Error(err))
-			return err
-		}
-		trashTotal, err := service.usageCache.Blobs.SpaceUsedForTrash(ctx)
-		if err != nil {
-			service.log.Error("error getting current used space for trash: ", zap.Error(err))
+		if err := service.recalculateCache(ctx, true); err != nil {
 			return err
 		}
-		service.usageCache.Recalculate(
-			piecesTotal,
-			totalsAtStart.piecesTotal,
-			piecesContentSize,
-			totalsAtStart.piecesContentSize,
-			trashTotal,
-			totalsAtStart.trashTotal,
-			totalsBySatellite,
-			totalsAtStart.spaceUsedBySatellite,
-		)
 	} else {
 		service.log.Info("Startup piece scan omitted by configuration")
 	}
@@ -83,17 +69,80 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 		return err
 	}
 
-	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
-		defer mon.Task()(&ctx)(&err)
-
-		// on a loop sync the cache values to the db so that we have the them saved
-		// in the case that the storagenode restarts
-		if err := service.PersistCacheTotals(ctx); err != nil {
-			service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
+	group, ctx := errgroup.WithContext(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	group.Go(func() error {
+		for {
+			select {
+			case <-service.recalculate:
+				// the running node isn't slowed down by walking the pieces in its process,
+				// when the lazy filewalker fails.
+				if err := service.recalculateCache(ctx, false); err != nil && !errs2.IsCanceled(err) {
+					service.log.Error("error recalculating used space: ", zap.Error(err))
+				}
+			case <-ctx.Done():
+				return nil
+			}
 		}
-		service.InitFence.Release()
-		return err
 	})
+	group.Go(func() error {
+		defer cancel()
+
+		return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
+			defer mon.Task()(&ctx)(&err)
+
+			// on a loop sync the cache values to the db so that we have the them saved
+			// in the case that the storagenode restarts
+			if err := service.PersistCacheTotals(ctx); err != nil {
+				service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
+			}
+			service.InitFence.Release()
+			return err
+		})
+	})
+	return group.Wait()
+}
+
+// TriggerRecalculation requests the space used cache to be recalculated by walking all the
+// pieces, which can take a long time. The pieces are walked by the lazy filewalker, when it's
+// enabled. It returns false when a recalculation is already pending.
+func (service *CacheService) TriggerRecalculation() bool {
+	select {
+	case service.recalculate <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// recalculateCache walks all the pieces and updates the space used cache with the totals.
+// With failover the pieces are walked in the storage node process, when the lazy filewalker fails.
+func (service *CacheService) recalculateCache(ctx context.Context, failover bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	totalsAtStart := service.usageCache.copyCacheTotals()
+
+	piecesTotal, piecesContentSize, totalsBySatellite, err := service.store.spaceUsedTotalAndBySatellite(ctx, failover)
+	if err != nil {
+		service.log.Error("error getting current used space: ", zap.Error(err))
+		return err
+	}
+	trashTotal, err := service.usageCache.Blobs.SpaceUsedForTrash(ctx)
+	if err != nil {
+		service.log.Error("error getting current used space for trash: ", zap.Error(err))
+		return err
+	}
+	service.usageCache.Recalculate(
+		piecesTotal,
+		totalsAtStart.piecesTotal,
+		piecesContentSize,
+		totalsAtStart.piecesContentSize,
+		trashTotal,
+		totalsAtStart.trashTotal,
+		totalsBySatellite,
+		totalsAtStart.spaceUsedBySatellite,
+	)
+	return nil
 }
 
 // PersistCacheTotals saves the current totals of the space used cache to the database
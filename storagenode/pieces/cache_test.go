@@ -266,6 +266,74 @@ func TestCacheServiceRun(t *testing.T) {
 		assert.Equal(t, int64(expBlobSize-pieces.V1PieceHeaderReservedArea), piecesContentSize)
 		assert.True(t, trashTotal >= int64(expTrashSize))
 
+		// a triggered recalculation is walked by the lazy filewalker as well,
+		// the store doesn't have a filewalker to fall back to.
+		w, err = store.Create(ctx, blobstore.BlobRef{
+			Namespace: testrand.NodeID().Bytes(),
+			Key:       testrand.PieceID().Bytes(),
+		}, -1)
+		require.NoError(t, err)
+		_, err = w.Write(testrand.Bytes(expBlobSize))
+		require.NoError(t, err)
+		require.NoError(t, w.Commit(ctx))
+
+		require.True(t, cacheService.TriggerRecalculation())
+		require.Eventually(t, func() bool {
+			piecesTotal, _, err := cache.SpaceUsedForPieces(ctx)
+			require.NoError(t, err)
+			return piecesTotal == 2*expBlobSize.Int64()
+		}, 10*time.Second, 10*time.Millisecond)
+
+		require.NoError(t, cacheService.Close())
+		require.NoError(t, eg.Wait())
+	})
+}
+
+func TestCacheServiceRun_TriggerRecalculation(t *testing.T) {
+	log := zaptest.NewLogger(t)
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		store, err := filestore.NewAt(log, db.Config().Pieces, filestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(store.Close)
+
+		cache := pieces.NewBlobsUsageCache(log, store)
+		cacheService := pieces.NewService(log,
+			cache,
+			pieces.NewStore(log, pieces.NewFileWalker(log, cache, nil), nil, cache, nil, nil, db.PieceSpaceUsedDB(), pieces.DefaultConfig),
+			1*time.Hour,
+			false,
+		)
+		require.NoError(t, cacheService.Init(ctx))
+
+		var eg errgroup.Group
+		eg.Go(func() error {
+			return cacheService.Run(ctx)
+		})
+		cacheService.InitFence.Wait(ctx)
+
+		// write a blob without the cache, so only the recalculation counts it.
+		expBlobSize := memory.KB
+		w, err := store.Create(ctx, blobstore.BlobRef{
+			Namespace: testrand.NodeID().Bytes(),
+			Key:       testrand.PieceID().Bytes(),
+		}, -1)
+		require.NoError(t, err)
+		_, err = w.Write(testrand.Bytes(expBlobSize))
+		require.NoError(t, err)
+		require.NoError(t, w.Commit(ctx))
+
+		piecesTotal, _, err := cache.SpaceUsedForPieces(ctx)
+		require.NoError(t, err)
+		require.Zero(t, piecesTotal)
+
+		require.True(t, cacheService.TriggerRecalculation())
+
+		require.Eventually(t, func() bool {
+			piecesTotal, _, err := cache.SpaceUsedForPieces(ctx)
+			require.NoError(t, err)
+			return piecesTotal == expBlobSize.Int64()
+		}, 10*time.Second, 10*time.Millisecond)
+
 		require.NoError(t, cacheService.Close())
 		require.NoError(t, eg.Wait())
 	})
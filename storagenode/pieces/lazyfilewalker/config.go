@@ -19,6 +19,7 @@ type Config struct {
 	Pieces    string `help:"path to store pieces in"`
 	Filestore filestore.Config
 
+	Consolidated    bool `help:"whether the databases are consolidated in a single file" default:"false"`
 	LowerIOPriority bool `help:"if true, the process will run with lower IO priority" default:"true"`
 }
 
@@ -32,6 +33,7 @@ func (config *Config) Args() []string {
 		"--pieces", config.Pieces,
 		"--driver", config.Driver,
 		"--filestore.write-buffer-size", config.Filestore.WriteBufferSize.String(),
+		fmt.Sprintf("--consolidated=%v", config.Consolidated),
 		// set log output to stderr, so it doesn't interfere with the output of the command
 		"--log.output", "stderr",
 		// use the json formatter in the subprocess, so we could read lines and re-log them in the main process
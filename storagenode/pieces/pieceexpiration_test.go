@@ -4,12 +4,15 @@
 package pieces_test
 
 import (
+	"bytes"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/storagenode"
@@ -18,7 +21,7 @@ import (
 )
 
 func TestPieceExpirationDB(t *testing.T) {
-	// test GetExpired, SetExpiration, DeleteExpiration, DeleteFailed
+	// test GetExpired, GetExpirations, SetExpiration, DeleteExpiration, DeleteFailed
 	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
 		expireDB := db.PieceExpirationDB()
 
@@ -54,6 +57,34 @@ func TestPieceExpirationDB(t *testing.T) {
 		err = expireDB.SetExpiration(ctx, satelliteID, pieceID, expireAt.Add(time.Hour))
 		require.Error(t, err)
 
+		// GetExpiration normal usage
+		expiration, found, err := expireDB.GetExpiration(ctx, satelliteID, pieceID)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.WithinDuration(t, expireAt, expiration, time.Microsecond)
+
+		// GetExpiration with no matches
+		_, found, err = expireDB.GetExpiration(ctx, satelliteID, testrand.PieceID())
+		require.NoError(t, err)
+		require.False(t, found)
+
+		// GetExpirations normal usage
+		expirations, err := expireDB.GetExpirations(ctx, satelliteID, storj.PieceID{}, 10)
+		require.NoError(t, err)
+		require.Len(t, expirations, 1)
+		require.Equal(t, pieceID, expirations[0].PieceID)
+		assert.WithinDuration(t, expireAt, expirations[0].Expiration, time.Microsecond)
+
+		// GetExpirations after the last piece
+		expirations, err = expireDB.GetExpirations(ctx, satelliteID, pieceID, 10)
+		require.NoError(t, err)
+		require.Len(t, expirations, 0)
+
+		// GetExpirations with no matches
+		expirations, err = expireDB.GetExpirations(ctx, testrand.NodeID(), storj.PieceID{}, 10)
+		require.NoError(t, err)
+		require.Len(t, expirations, 0)
+
 		// GetExpired normal usage
 		expiredPieceIDs, err = expireDB.GetExpired(ctx, expireAt.Add(time.Microsecond), 1000)
 		require.NoError(t, err)
@@ -86,3 +117,39 @@ func TestPieceExpirationDB(t *testing.T) {
 		require.Len(t, expiredPieceIDs, 0)
 	})
 }
+
+func TestPieceExpirationDB_GetExpirationsPages(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		expireDB := db.PieceExpirationDB()
+
+		satelliteID := testrand.NodeID()
+		pieceIDs := make([]storj.PieceID, 5)
+		for i := range pieceIDs {
+			pieceIDs[i] = testrand.PieceID()
+			require.NoError(t, expireDB.SetExpiration(ctx, satelliteID, pieceIDs[i], time.Now().Add(time.Hour)))
+		}
+		require.NoError(t, expireDB.SetExpiration(ctx, testrand.NodeID(), testrand.PieceID(), time.Now()))
+
+		// the trashed pieces are skipped.
+		trashed := testrand.PieceID()
+		require.NoError(t, expireDB.SetExpiration(ctx, satelliteID, trashed, time.Now()))
+		require.NoError(t, expireDB.Trash(ctx, satelliteID, trashed))
+
+		var paged []storj.PieceID
+		var cursor storj.PieceID
+		for {
+			expirations, err := expireDB.GetExpirations(ctx, satelliteID, cursor, 2)
+			require.NoError(t, err)
+			for _, expiration := range expirations {
+				paged = append(paged, expiration.PieceID)
+			}
+			if len(expirations) < 2 {
+				break
+			}
+			cursor = expirations[len(expirations)-1].PieceID
+		}
+
+		sort.Slice(pieceIDs, func(i, k int) bool { return bytes.Compare(pieceIDs[i][:], pieceIDs[k][:]) < 0 })
+		require.Equal(t, pieceIDs, paged)
+	})
+}
@@ -0,0 +1,320 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/iopriority"
+	"storj.io/storj/storagenode/trust"
+)
+
+var errScrubber = errs.Class("scrubber")
+
+// ScrubberConfig contains the configuration for the piece scrubber.
+type ScrubberConfig struct {
+	Enabled        bool          `help:"periodically verify the content of stored pieces against the hash in their piece header" default:"false"`
+	Interval       time.Duration `help:"how frequently a full scrub of all stored pieces is started" default:"168h0m0s"`
+	BytesPerSecond memory.Size   `help:"maximum read throughput of the scrubber, 0 means unlimited" default:"4MiB"`
+	QuarantineDir  string        `help:"directory corrupted pieces are moved to. defaults to a quarantine directory inside the storage path" default:""`
+}
+
+// ScrubStats contains the results of a scrub.
+type ScrubStats struct {
+	Started  time.Time
+	Finished time.Time
+
+	Verified    int64
+	Corrupted   int64
+	Unreadable  int64
+	Quarantined int64
+	BytesRead   int64
+}
+
+// Scrubber is the chore that periodically reads stored pieces and verifies their content
+// against the hash stored in the piece header, to detect bit rot before audits do.
+//
+// architecture: Chore
+type Scrubber struct {
+	log    *zap.Logger
+	config ScrubberConfig
+	store  *Store
+	trust  *trust.Pool
+
+	Loop *sync2.Cycle
+
+	mu   sync.Mutex
+	last ScrubStats
+}
+
+// NewScrubber creates a new piece scrubber. quarantineDir is used when
+// config.QuarantineDir is not set.
+func NewScrubber(log *zap.Logger, config ScrubberConfig, store *Store, trust *trust.Pool, quarantineDir string) *Scrubber {
+	if config.QuarantineDir == "" {
+		config.QuarantineDir = quarantineDir
+	}
+	return &Scrubber{
+		log:    log,
+		config: config,
+		store:  store,
+		trust:  trust,
+		Loop:   sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the scrubber until the context is canceled.
+func (scrubber *Scrubber) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return scrubber.Loop.Run(ctx, func(ctx context.Context) error {
+		stats, err := scrubber.Scrub(ctx)
+		if err != nil {
+			scrubber.log.Error("scrub failed", zap.Error(err))
+		}
+		scrubber.log.Info("scrub finished",
+			zap.Int64("verified", stats.Verified),
+			zap.Int64("corrupted", stats.Corrupted),
+			zap.Int64("unreadable", stats.Unreadable),
+			zap.Int64("quarantined", stats.Quarantined),
+			zap.Duration("duration", stats.Finished.Sub(stats.Started)))
+		return nil
+	})
+}
+
+// Close stops the scrubber.
+func (scrubber *Scrubber) Close() error {
+	scrubber.Loop.Close()
+	return nil
+}
+
+// LastStats returns the results of the last finished scrub.
+func (scrubber *Scrubber) LastStats() ScrubStats {
+	scrubber.mu.Lock()
+	defer scrubber.mu.Unlock()
+	return scrubber.last
+}
+
+// Scrub verifies all pieces of all trusted satellites once.
+func (scrubber *Scrubber) Scrub(ctx context.Context) (stats ScrubStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats.Started = time.Now()
+	defer func() {
+		stats.Finished = time.Now()
+
+		mon.IntVal("scrubber_pieces_verified").Observe(stats.Verified)
+		mon.IntVal("scrubber_pieces_corrupted").Observe(stats.Corrupted)
+		mon.IntVal("scrubber_pieces_unreadable").Observe(stats.Unreadable)
+		mon.IntVal("scrubber_pieces_quarantined").Observe(stats.Quarantined)
+		mon.IntVal("scrubber_duration_seconds").Observe(int64(stats.Finished.Sub(stats.Started).Seconds()))
+
+		scrubber.mu.Lock()
+		scrubber.last = stats
+		scrubber.mu.Unlock()
+	}()
+
+	// The scrubber competes with uploads and downloads for disk time, so it runs
+	// on its own OS thread with lowered I/O priority. The thread is never
+	// unlocked, so it is destroyed together with the goroutine instead of
+	// returning to the scheduler with the lowered priority.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runtime.LockOSThread()
+		if err := iopriority.SetLowIOPriorityThread(); err != nil {
+			scrubber.log.Warn("failed to lower I/O priority of scrubber", zap.Error(err))
+		}
+		err = scrubber.scrub(ctx, &stats)
+	}()
+	<-done
+
+	return stats, err
+}
+
+// scrub walks the pieces of all trusted satellites and verifies them.
+func (scrubber *Scrubber) scrub(ctx context.Context, stats *ScrubStats) error {
+	limiter := newByteRateLimiter(scrubber.config.BytesPerSecond.Int64())
+	for _, satellite := range scrubber.trust.GetSatellites(ctx) {
+		err := scrubber.store.WalkSatellitePieces(ctx, satellite, func(access StoredPieceAccess) error {
+			return scrubber.verifyPiece(ctx, satellite, access.PieceID(), limiter, stats)
+		})
+		if err != nil {
+			return errScrubber.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// verifyPiece verifies a single piece and quarantines it when the content does not
+// match the hash in the piece header.
+func (scrubber *Scrubber) verifyPiece(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, limiter *byteRateLimiter, stats *ScrubStats) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	ok, n, err := scrubber.checkHash(ctx, satellite, pieceID, limiter)
+	stats.BytesRead += n
+	switch {
+	case errs.IsFunc(err, os.IsNotExist):
+		// piece was deleted while we were scanning.
+		return nil
+	case errors.Is(err, context.Canceled):
+		return err
+	case err != nil:
+		stats.Unreadable++
+		scrubber.log.Warn("unable to verify piece",
+			zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID), zap.Error(err))
+		return nil
+	case ok:
+		stats.Verified++
+		return nil
+	}
+
+	stats.Corrupted++
+	scrubber.log.Error("piece content does not match piece hash",
+		zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID))
+
+	if err := scrubber.quarantine(ctx, satellite, pieceID); err != nil {
+		scrubber.log.Error("failed to quarantine corrupted piece",
+			zap.Stringer("Satellite ID", satellite), zap.Stringer("Piece ID", pieceID), zap.Error(err))
+		return nil
+	}
+	stats.Quarantined++
+	return nil
+}
+
+// checkHash reads the piece content and compares its hash with the one stored
+// in the piece header. It returns how many bytes of content were read.
+func (scrubber *Scrubber) checkHash(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, limiter *byteRateLimiter) (ok bool, n int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := scrubber.store.Reader(ctx, satellite, pieceID)
+	if err != nil {
+		return false, 0, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	pieceHash, _, err := scrubber.store.GetHashAndLimit(ctx, satellite, pieceID, reader)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return checkHash(ctx, reader, pieceHash, limiter)
+}
+
+// CheckHash reads the piece content and compares its hash with pieceHash. It
+// returns how many bytes of content were read.
+func CheckHash(ctx context.Context, reader *Reader, pieceHash pb.PieceHash) (ok bool, n int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return checkHash(ctx, reader, pieceHash, newByteRateLimiter(0))
+}
+
+func checkHash(ctx context.Context, reader *Reader, pieceHash pb.PieceHash, limiter *byteRateLimiter) (ok bool, n int64, err error) {
+	hash := pb.NewHashFromAlgorithm(pieceHash.HashAlgorithm)
+	buf := make([]byte, 256*memory.KiB.Int())
+	for {
+		read, readErr := reader.Read(buf)
+		n += int64(read)
+		_, _ = hash.Write(buf[:read])
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return false, n, readErr
+		}
+		if err := limiter.Wait(ctx, read); err != nil {
+			return false, n, err
+		}
+	}
+
+	return bytes.Equal(hash.Sum(nil), pieceHash.Hash), n, nil
+}
+
+// quarantine moves a corrupted piece out of the blob store into the quarantine
+// directory, so it is no longer served but can still be inspected.
+func (scrubber *Scrubber) quarantine(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := scrubber.store.Stat(ctx, satellite, pieceID)
+	if err != nil {
+		return err
+	}
+	source, err := info.FullPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(scrubber.config.QuarantineDir, satellite.String())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	if err := copyFile(source, filepath.Join(dir, pieceID.String()+filepath.Ext(source))); err != nil {
+		return err
+	}
+
+	// delete through the store, so that space usage and expiration info are updated.
+	return scrubber.store.Delete(ctx, satellite, pieceID)
+}
+
+func copyFile(source, destination string) (err error) {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, in.Close()) }()
+
+	out, err := os.OpenFile(destination, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, out.Close()) }()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// byteRateLimiter limits the throughput of reads to a fixed number of bytes per second.
+type byteRateLimiter struct {
+	bytesPerSecond int64
+	start          time.Time
+	total          int64
+}
+
+func newByteRateLimiter(bytesPerSecond int64) *byteRateLimiter {
+	return &byteRateLimiter{
+		bytesPerSecond: bytesPerSecond,
+		start:          time.Now(),
+	}
+}
+
+// Wait accounts n bytes and sleeps until reading them fits into the budget.
+func (limiter *byteRateLimiter) Wait(ctx context.Context, n int) error {
+	if limiter.bytesPerSecond <= 0 {
+		return nil
+	}
+	limiter.total += int64(n)
+	expected := time.Duration(float64(limiter.total) / float64(limiter.bytesPerSecond) * float64(time.Second))
+	if delay := expected - time.Since(limiter.start); delay > 0 {
+		if !sync2.Sleep(ctx, delay) {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
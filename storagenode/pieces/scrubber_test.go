@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestScrubber(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		dir, err := filestore.NewDir(log, ctx.Dir("store"))
+		require.NoError(t, err)
+
+		blobs := filestore.New(log, dir, filestore.DefaultConfig)
+		defer ctx.Check(blobs.Close)
+
+		fw := pieces.NewFileWalker(log, blobs, nil)
+		store := pieces.NewStore(log, fw, nil, blobs, nil, db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+
+		satelliteID := testrand.NodeID()
+		writePiece := func(pieceID storj.PieceID) {
+			w, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = w.Write(testrand.Bytes(10 * memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, w.Commit(ctx, &pb.PieceHeader{
+				Hash:          w.Hash(),
+				HashAlgorithm: pb.PieceHashAlgorithm_SHA256,
+			}))
+		}
+
+		healthyID, corruptedID := testrand.PieceID(), testrand.PieceID()
+		writePiece(healthyID)
+		writePiece(corruptedID)
+
+		{ // flip a byte in the content of the second piece
+			info, err := store.Stat(ctx, satelliteID, corruptedID)
+			require.NoError(t, err)
+			path, err := info.FullPath(ctx)
+			require.NoError(t, err)
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			data[pieces.V1PieceHeaderReservedArea+10] ^= 0xFF
+			require.NoError(t, os.WriteFile(path, data, 0600))
+		}
+
+		pool, err := trust.NewPool(log, trust.Dialer(rpc.Dialer{}), trust.Config{
+			Sources: []trust.Source{
+				&trust.StaticURLSource{URL: trust.SatelliteURL{ID: satelliteID, Host: "localhost", Port: 7777}},
+			},
+			CachePath: ctx.File("trust-cache.json"),
+		}, db.Satellites())
+		require.NoError(t, err)
+		require.NoError(t, pool.Refresh(ctx))
+
+		quarantineDir := ctx.Dir("quarantine")
+		scrubber := pieces.NewScrubber(log, pieces.ScrubberConfig{}, store, pool, quarantineDir)
+
+		stats, err := scrubber.Scrub(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, stats.Verified)
+		require.EqualValues(t, 1, stats.Corrupted)
+		require.EqualValues(t, 1, stats.Quarantined)
+		require.EqualValues(t, 0, stats.Unreadable)
+		require.Equal(t, stats, scrubber.LastStats())
+
+		_, err = store.Stat(ctx, satelliteID, healthyID)
+		require.NoError(t, err)
+
+		_, err = store.Stat(ctx, satelliteID, corruptedID)
+		require.True(t, errs.IsFunc(err, os.IsNotExist))
+
+		_, err = os.Stat(filepath.Join(quarantineDir, satelliteID.String(), corruptedID.String()+".sj1"))
+		require.NoError(t, err)
+	})
+}
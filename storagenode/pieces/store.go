@@ -53,12 +53,23 @@ type ExpiredInfo struct {
 	InPieceInfo bool
 }
 
+// PieceExpiration is the expiration time of a piece.
+type PieceExpiration struct {
+	PieceID    storj.PieceID
+	Expiration time.Time
+}
+
 // PieceExpirationDB stores information about pieces with expiration dates.
 //
 // architecture: Database
 type PieceExpirationDB interface {
 	// GetExpired gets piece IDs that expire or have expired before the given time
 	GetExpired(ctx context.Context, expiresBefore time.Time, limit int64) ([]ExpiredInfo, error)
+	// GetExpiration gets the expiration time of the piece, when it has one and it isn't in the trash
+	GetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (expiresAt time.Time, found bool, err error)
+	// GetExpirations gets up to limit expiration times of the pieces of the given satellite, which
+	// aren't in the trash and whose IDs are after the cursor, ordered by piece ID
+	GetExpirations(ctx context.Context, satellite storj.NodeID, cursor storj.PieceID, limit int) ([]PieceExpiration, error)
 	// SetExpiration sets an expiration time for the given piece ID on the given satellite
 	SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time) error
 	// DeleteExpiration removes an expiration record for the given piece ID on the given satellite
@@ -160,6 +171,8 @@ type Config struct {
 	WritePreallocSize    memory.Size `help:"file preallocated for uploading" default:"4MiB"`
 	DeleteToTrash        bool        `help:"move pieces to trash upon deletion. Warning: if set to false, you risk disqualification for failed audits if a satellite database is restored from backup." default:"true"`
 	EnableLazyFilewalker bool        `help:"run garbage collection and used-space calculation filewalkers as a separate subprocess with lower IO priority" default:"true"`
+
+	Scrubber ScrubberConfig
 }
 
 // DefaultConfig is the default value for the Config.
@@ -700,6 +713,15 @@ func (store *Store) SpaceUsedBySatellite(ctx context.Context, satelliteID storj.
 func (store *Store) SpaceUsedTotalAndBySatellite(ctx context.Context) (piecesTotal, piecesContentSize int64, totalBySatellite map[storj.NodeID]SatelliteUsage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return store.spaceUsedTotalAndBySatellite(ctx, true)
+}
+
+// spaceUsedTotalAndBySatellite adds up the space used by and for all satellites for blob storage.
+// The pieces are walked by the lazy filewalker, when it's enabled. Without failover the pieces
+// aren't walked in the storage node process, when the lazy filewalker fails.
+func (store *Store) spaceUsedTotalAndBySatellite(ctx context.Context, failover bool) (piecesTotal, piecesContentSize int64, totalBySatellite map[storj.NodeID]SatelliteUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	satelliteIDs, err := store.getAllStoringSatellites(ctx)
 	if err != nil {
 		return 0, 0, nil, Error.New("failed to enumerate satellites: %w", err)
@@ -712,17 +734,16 @@ func (store *Store) SpaceUsedTotalAndBySatellite(ctx context.Context) (piecesTot
 		var satPiecesTotal int64
 		var satPiecesContentSize int64
 
-		failover := true
+		walk := true
 		if store.config.EnableLazyFilewalker && store.lazyFilewalker != nil {
 			satPiecesTotal, satPiecesContentSize, err = store.lazyFilewalker.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
 			if err != nil {
 				store.log.Error("failed to lazywalk space used by satellite", zap.Error(err), zap.Stringer("Satellite ID", satelliteID))
-			} else {
-				failover = false
 			}
+			walk = err != nil && failover
 		}
 
-		if failover {
+		if walk {
 			satPiecesTotal, satPiecesContentSize, err = store.Filewalker.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
 		}
 
@@ -0,0 +1,212 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+// verifyExpirationsPageSize is the number of expirations loaded at once, when looking for missing pieces.
+const verifyExpirationsPageSize = 1000
+
+// VerifyProblem is the kind of problem found with a piece by the verification.
+type VerifyProblem string
+
+const (
+	// ProblemCorrupted means that the piece content doesn't match the hash in its header.
+	ProblemCorrupted VerifyProblem = "corrupted"
+	// ProblemMissing means that the piece has an expiration, but it isn't stored.
+	ProblemMissing VerifyProblem = "missing"
+	// ProblemUnreadable means that the piece or its header can't be read.
+	ProblemUnreadable VerifyProblem = "unreadable"
+	// ProblemExpiration means that the expiration of the piece doesn't match its order limit.
+	ProblemExpiration VerifyProblem = "expiration"
+)
+
+// VerifyOptions contains the options of a piece verification.
+type VerifyOptions struct {
+	// Sample is the fraction, between 0 and 1, of the pieces which are verified.
+	// The same pieces are sampled by each verification.
+	Sample float64
+	// BytesPerSecond is the maximum read throughput, 0 means unlimited.
+	BytesPerSecond memory.Size
+}
+
+// VerifyFailure is a piece which failed the verification.
+type VerifyFailure struct {
+	Satellite storj.NodeID
+	PieceID   storj.PieceID
+	Problem   VerifyProblem
+	Details   string
+}
+
+// VerifyStats contains the results of a piece verification.
+type VerifyStats struct {
+	Verified           int64
+	Corrupted          int64
+	Missing            int64
+	Unreadable         int64
+	ExpirationMismatch int64
+	BytesRead          int64
+}
+
+// Failures returns the number of pieces which failed the verification.
+func (stats VerifyStats) Failures() int64 {
+	return stats.Corrupted + stats.Missing + stats.Unreadable + stats.ExpirationMismatch
+}
+
+// VerifySatellitePieces walks the pieces of the satellite, verifies their content against the
+// hash in their piece header and checks that their expiration is consistent with the expiration
+// database. It calls fn for each piece which fails the verification.
+//
+// The pieces whose expiration is in the database but which aren't stored are reported as missing.
+func (store *Store) VerifySatellitePieces(ctx context.Context, satellite storj.NodeID, opts VerifyOptions, fn func(VerifyFailure) error) (stats VerifyStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limiter := newByteRateLimiter(opts.BytesPerSecond.Int64())
+	report := func(pieceID storj.PieceID, problem VerifyProblem, details string) error {
+		switch problem {
+		case ProblemCorrupted:
+			stats.Corrupted++
+		case ProblemMissing:
+			stats.Missing++
+		case ProblemUnreadable:
+			stats.Unreadable++
+		case ProblemExpiration:
+			stats.ExpirationMismatch++
+		}
+		return fn(VerifyFailure{
+			Satellite: satellite,
+			PieceID:   pieceID,
+			Problem:   problem,
+			Details:   details,
+		})
+	}
+
+	err = store.WalkSatellitePieces(ctx, satellite, func(access StoredPieceAccess) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		pieceID := access.PieceID()
+		if !sampled(pieceID, opts.Sample) {
+			return nil
+		}
+
+		orderLimitExpiration, ok, n, err := store.verifyPiece(ctx, satellite, pieceID, limiter)
+		stats.BytesRead += n
+		switch {
+		case errs.IsFunc(err, os.IsNotExist):
+			// piece was deleted while we were walking.
+			return nil
+		case errors.Is(err, context.Canceled):
+			return err
+		case err != nil:
+			return report(pieceID, ProblemUnreadable, err.Error())
+		case !ok:
+			return report(pieceID, ProblemCorrupted, "content does not match piece hash")
+		}
+		stats.Verified++
+
+		// the expiration of the pieces with storage format V0 is stored with their info.
+		if access.StorageFormatVersion() < filestore.FormatV1 || store.expirationInfo == nil {
+			return nil
+		}
+
+		expiration, hasExpiration, err := store.expirationInfo.GetExpiration(ctx, satellite, pieceID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		switch {
+		case orderLimitExpiration.IsZero() && hasExpiration:
+			return report(pieceID, ProblemExpiration, "piece doesn't expire, but it has an expiration "+expiration.UTC().Format(time.RFC3339))
+		case !orderLimitExpiration.IsZero() && !hasExpiration:
+			return report(pieceID, ProblemExpiration, "piece expires at "+orderLimitExpiration.UTC().Format(time.RFC3339)+", but it has no expiration")
+		case hasExpiration && !orderLimitExpiration.Equal(expiration):
+			return report(pieceID, ProblemExpiration, "piece expires at "+orderLimitExpiration.UTC().Format(time.RFC3339)+", but its expiration is "+expiration.UTC().Format(time.RFC3339))
+		}
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	if store.expirationInfo == nil {
+		return stats, nil
+	}
+
+	// the expirations are paged, so that they aren't all loaded in memory.
+	var cursor storj.PieceID
+	for {
+		expirations, err := store.expirationInfo.GetExpirations(ctx, satellite, cursor, verifyExpirationsPageSize)
+		if err != nil {
+			return stats, Error.Wrap(err)
+		}
+
+		for _, expiration := range expirations {
+			if !sampled(expiration.PieceID, opts.Sample) {
+				continue
+			}
+
+			_, err := store.Stat(ctx, satellite, expiration.PieceID)
+			switch {
+			case err == nil:
+				// piece was either walked or stored while we were walking.
+				continue
+			case !errs.IsFunc(err, os.IsNotExist):
+				return stats, err
+			}
+
+			if err := report(expiration.PieceID, ProblemMissing, "piece has an expiration, but it isn't stored"); err != nil {
+				return stats, err
+			}
+		}
+
+		if len(expirations) < verifyExpirationsPageSize {
+			return stats, nil
+		}
+		cursor = expirations[len(expirations)-1].PieceID
+	}
+}
+
+// verifyPiece reads the piece and compares its content with the hash in its header. It returns
+// the expiration in the order limit of the piece and how many bytes of content were read.
+func (store *Store) verifyPiece(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, limiter *byteRateLimiter) (expiration time.Time, ok bool, n int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := store.Reader(ctx, satellite, pieceID)
+	if err != nil {
+		return time.Time{}, false, 0, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	pieceHash, limit, err := store.GetHashAndLimit(ctx, satellite, pieceID, reader)
+	if err != nil {
+		return time.Time{}, false, 0, err
+	}
+
+	ok, n, err = checkHash(ctx, reader, pieceHash, limiter)
+	return limit.PieceExpiration, ok, n, err
+}
+
+// sampled returns whether the piece is part of the sample. Since the piece IDs are random, their
+// first bytes are used for sampling the same pieces each time.
+func sampled(pieceID storj.PieceID, sample float64) bool {
+	if sample >= 1 {
+		return true
+	}
+	return float64(binary.BigEndian.Uint64(pieceID[:8])) < sample*math.MaxUint64
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestVerifySatellitePieces(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		dir, err := filestore.NewDir(log, ctx.Dir("store"))
+		require.NoError(t, err)
+
+		blobs := filestore.New(log, dir, filestore.DefaultConfig)
+		defer ctx.Check(blobs.Close)
+
+		fw := pieces.NewFileWalker(log, blobs, nil)
+		store := pieces.NewStore(log, fw, nil, blobs, nil, db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+
+		satelliteID := testrand.NodeID()
+		expiration := time.Now().Add(24 * time.Hour).UTC()
+		writePiece := func(pieceID storj.PieceID, expiration time.Time) {
+			w, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = w.Write(testrand.Bytes(10 * memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, w.Commit(ctx, &pb.PieceHeader{
+				Hash:          w.Hash(),
+				HashAlgorithm: pb.PieceHashAlgorithm_SHA256,
+				OrderLimit:    pb.OrderLimit{PieceExpiration: expiration},
+			}))
+		}
+		piecePath := func(pieceID storj.PieceID) string {
+			info, err := store.Stat(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			path, err := info.FullPath(ctx)
+			require.NoError(t, err)
+			return path
+		}
+
+		healthyID, expiringID := testrand.PieceID(), testrand.PieceID()
+		corruptedID, unreadableID := testrand.PieceID(), testrand.PieceID()
+		noExpirationID, missingID := testrand.PieceID(), testrand.PieceID()
+
+		writePiece(healthyID, time.Time{})
+		writePiece(expiringID, expiration)
+		require.NoError(t, store.SetExpiration(ctx, satelliteID, expiringID, expiration))
+		writePiece(corruptedID, time.Time{})
+		writePiece(unreadableID, time.Time{})
+		writePiece(noExpirationID, expiration)
+		require.NoError(t, store.SetExpiration(ctx, satelliteID, missingID, expiration))
+
+		{ // flip a byte in the content of the corrupted piece
+			path := piecePath(corruptedID)
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			data[len(data)-1]++
+			require.NoError(t, os.WriteFile(path, data, 0600))
+		}
+		// truncate the unreadable piece within its header
+		require.NoError(t, os.Truncate(piecePath(unreadableID), 100))
+
+		problems := map[storj.PieceID]pieces.VerifyProblem{}
+		stats, err := store.VerifySatellitePieces(ctx, satelliteID, pieces.VerifyOptions{Sample: 1}, func(failure pieces.VerifyFailure) error {
+			require.Equal(t, satelliteID, failure.Satellite)
+			require.NotEmpty(t, failure.Details)
+			problems[failure.PieceID] = failure.Problem
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, map[storj.PieceID]pieces.VerifyProblem{
+			corruptedID:    pieces.ProblemCorrupted,
+			unreadableID:   pieces.ProblemUnreadable,
+			noExpirationID: pieces.ProblemExpiration,
+			missingID:      pieces.ProblemMissing,
+		}, problems)
+		require.Equal(t, pieces.VerifyStats{
+			Verified:           3,
+			Corrupted:          1,
+			Missing:            1,
+			Unreadable:         1,
+			ExpirationMismatch: 1,
+			BytesRead:          4 * 10 * memory.KiB.Int64(),
+		}, stats)
+		require.EqualValues(t, 4, stats.Failures())
+
+		// no piece is sampled.
+		stats, err = store.VerifySatellitePieces(ctx, satelliteID, pieces.VerifyOptions{Sample: 0}, func(failure pieces.VerifyFailure) error {
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, pieces.VerifyStats{}, stats)
+	})
+}
@@ -13,4 +13,5 @@ var mon = monkit.Package()
 type Config struct {
 	LocalTimeCheck bool `help:"whether or not preflight check for local system clock is enabled on the satellite side. When disabling this feature, your storagenode may not setup correctly." default:"true"`
 	DatabaseCheck  bool `help:"whether or not preflight check for database is enabled." default:"true"`
+	DatabaseRepair bool `help:"whether or not damaged databases are recreated at startup. Their data is lost, except what can be derived again from the pieces." default:"false"`
 }
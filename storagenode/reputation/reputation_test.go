@@ -4,6 +4,7 @@
 package reputation_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/alerts"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
@@ -184,8 +186,8 @@ func TestServiceStore(t *testing.T) {
 		reputationDB := db.Reputation()
 		notificationsDB := db.Notifications()
 		log := zaptest.NewLogger(t)
-		notificationService := notifications.NewService(log, notificationsDB)
-		reputationService := reputation.NewService(log, reputationDB, storj.NodeID{}, notificationService)
+		notificationService := notifications.NewService(log, notificationsDB, nil)
+		reputationService := reputation.NewService(log, reputationDB, storj.NodeID{}, notificationService, nil, 0.95)
 
 		id := testrand.NodeID()
 		now := time.Now().AddDate(0, 0, -2)
@@ -294,3 +296,76 @@ func TestServiceStore(t *testing.T) {
 		require.Equal(t, amount, 5)
 	})
 }
+
+type alertsChannel chan alerts.Alert
+
+func (ch alertsChannel) Send(ctx context.Context, alert alerts.Alert) error {
+	ch <- alert
+	return nil
+}
+
+func TestServiceStoreAlerts(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		alertsService, err := alerts.NewService(log, testrand.NodeID(), alerts.Config{QueueSize: 10})
+		require.NoError(t, err)
+		received := make(alertsChannel, 10)
+		require.NoError(t, alertsService.AddChannel("test", "", received))
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ctx.Go(func() error { return alertsService.Run(runCtx) })
+
+		notificationService := notifications.NewService(log, db.Notifications(), alertsService)
+		reputationService := reputation.NewService(log, db.Reputation(), testrand.NodeID(), notificationService, alertsService, 0.95)
+
+		satelliteID := testrand.NodeID()
+		now := time.Now()
+
+		// the first stats aren't compared with anything.
+		require.NoError(t, reputationService.Store(ctx, reputation.Stats{
+			SatelliteID: satelliteID,
+			Audit:       reputation.Metric{TotalCount: 10, SuccessCount: 9, Score: 0.99},
+			OnlineScore: 1,
+			UpdatedAt:   now,
+		}, satelliteID))
+
+		require.NoError(t, reputationService.Store(ctx, reputation.Stats{
+			SatelliteID:        satelliteID,
+			Audit:              reputation.Metric{TotalCount: 20, SuccessCount: 17, Score: 0.97},
+			OnlineScore:        0.9,
+			OfflineSuspendedAt: &now,
+			DisqualifiedAt:     &now,
+			UpdatedAt:          now,
+		}, satelliteID))
+
+		var events []alerts.Event
+		for i := 0; i < 3; i++ {
+			alert := <-received
+			require.Equal(t, &satelliteID, alert.Satellite)
+			events = append(events, alert.Event)
+		}
+		require.ElementsMatch(t, []alerts.Event{
+			alerts.EventDisqualification,
+			alerts.EventAuditFailure,
+			alerts.EventOffline,
+		}, events)
+		require.Empty(t, received)
+
+		// the offline alert is sent only when the online score drops below the threshold.
+		otherSatelliteID := testrand.NodeID()
+		for _, onlineScore := range []float64{1, 0.97, 0.94, 0.9} {
+			require.NoError(t, reputationService.Store(ctx, reputation.Stats{
+				SatelliteID: otherSatelliteID,
+				OnlineScore: onlineScore,
+				UpdatedAt:   now,
+			}, otherSatelliteID))
+		}
+
+		alert := <-received
+		require.Equal(t, &otherSatelliteID, alert.Satellite)
+		require.Equal(t, alerts.EventOffline, alert.Event)
+		require.Contains(t, alert.Message, "94.00%")
+	})
+}
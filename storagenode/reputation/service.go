@@ -5,11 +5,13 @@ package reputation
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
+	"storj.io/storj/storagenode/alerts"
 	"storj.io/storj/storagenode/notifications"
 )
 
@@ -22,19 +24,27 @@ type Service struct {
 	db            DB
 	nodeID        storj.NodeID
 	notifications *notifications.Service
+	alerts        *alerts.Service
+
+	onlineScoreThreshold float64
 }
 
-// NewService creates new instance of service.
-func NewService(log *zap.Logger, db DB, nodeID storj.NodeID, notifications *notifications.Service) *Service {
+// NewService creates new instance of service. The offline alert is sent when the online score
+// drops below onlineScoreThreshold.
+func NewService(log *zap.Logger, db DB, nodeID storj.NodeID, notifications *notifications.Service, alerts *alerts.Service, onlineScoreThreshold float64) *Service {
 	return &Service{
 		log:           log,
 		db:            db,
 		nodeID:        nodeID,
 		notifications: notifications,
+		alerts:        alerts,
+
+		onlineScoreThreshold: onlineScoreThreshold,
 	}
 }
 
-// Store stores reputation stats into db, and notify's in case of offline suspension.
+// Store stores reputation stats into db, notify's in case of offline suspension and sends
+// alerts about the worsened reputation.
 func (s *Service) Store(ctx context.Context, stats Stats, satelliteID storj.NodeID) error {
 	rep, err := s.db.Get(ctx, satelliteID)
 	if err != nil {
@@ -73,9 +83,63 @@ func (s *Service) Store(ctx context.Context, stats Stats, satelliteID storj.Node
 		}
 	}
 
+	s.sendAlerts(ctx, satelliteID, stats, *rep)
+
 	return nil
 }
 
+// sendAlerts sends the alerts about the changes of the reputation which need the attention
+// of the operator.
+func (s *Service) sendAlerts(ctx context.Context, satelliteID storj.NodeID, new, old Stats) {
+	if new.DisqualifiedAt != nil && old.DisqualifiedAt == nil {
+		s.alerts.Send(ctx, alerts.Alert{
+			Event:     alerts.EventDisqualification,
+			Satellite: &satelliteID,
+			Title:     "Your Node is disqualified",
+			Message:   "Your StorageNode was disqualified on Satellite " + satelliteID.String() + " at " + new.DisqualifiedAt.String(),
+		})
+	}
+
+	if new.SuspendedAt != nil && old.SuspendedAt == nil {
+		s.alerts.Send(ctx, alerts.Alert{
+			Event:     alerts.EventSuspension,
+			Satellite: &satelliteID,
+			Title:     "Your Node is suspended",
+			Message:   "Your StorageNode was suspended for unknown audit errors on Satellite " + satelliteID.String() + " at " + new.SuspendedAt.String(),
+		})
+	}
+
+	// the changes can't be compared with the first stats of the satellite.
+	if old.UpdatedAt.IsZero() {
+		return
+	}
+
+	failed := new.Audit.TotalCount - new.Audit.SuccessCount
+	oldFailed := old.Audit.TotalCount - old.Audit.SuccessCount
+	if failed > oldFailed {
+		s.alerts.Send(ctx, alerts.Alert{
+			Event:     alerts.EventAuditFailure,
+			Satellite: &satelliteID,
+			Title:     "Your Node failed audits",
+			Message: fmt.Sprintf("Your StorageNode failed %d audits on Satellite %s, its audit score is %.2f%%. "+
+				"A low audit score puts the Node at risk of disqualification.", failed-oldFailed, satelliteID, new.Audit.Score*100),
+		})
+	}
+
+	underReview := new.OfflineUnderReviewAt != nil && old.OfflineUnderReviewAt == nil
+	suspended := new.OfflineSuspendedAt != nil && old.OfflineSuspendedAt == nil
+	crossed := new.OnlineScore < s.onlineScoreThreshold && old.OnlineScore >= s.onlineScoreThreshold
+	if underReview || suspended || crossed {
+		s.alerts.Send(ctx, alerts.Alert{
+			Event:     alerts.EventOffline,
+			Satellite: &satelliteID,
+			Title:     "Your Node is offline",
+			Message: fmt.Sprintf("Satellite %s couldn't reach your StorageNode, its online score is %.2f%%.",
+				satelliteID, new.OnlineScore*100),
+		})
+	}
+}
+
 // isSuspended returns if there's new downtime suspension.
 func isSuspended(new, old Stats) bool {
 	if new.OfflineSuspendedAt == nil {
@@ -28,6 +28,8 @@ const (
 	ExitFailed Status = 4
 	// Untrusted reflects a satellite that is not trusted.
 	Untrusted Status = 5
+	// Forgetting reflects an untrusted satellite whose data is being removed.
+	Forgetting Status = 6
 )
 
 // ExitProgress contains the status of a graceful exit.
@@ -0,0 +1,328 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package storagemigration moves the data of a storage node to a new storage
+// directory, e.g. a bigger disk, while the node keeps running.
+//
+// The data is copied with several passes, each of them only copies the files
+// which were created or modified, and deletes the files which were deleted,
+// since the previous one. Once the passes are done, the migration is finished
+// when the node restarts: the last changes and the databases are copied while
+// the node isn't running, and the node is switched to the new directory.
+package storagemigration
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/common/memory"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the error class for the storage migration.
+	Error = errs.Class("storage migration")
+)
+
+// StateFilename is the name of the file, in the configuration directory, where
+// the state of the migration is stored.
+const StateFilename = "storage-migration.json"
+
+// progressInterval is how often the progress of a pass is logged.
+const progressInterval = time.Minute
+
+// Config contains the configuration of a storage migration.
+type Config struct {
+	// From is the current storage directory.
+	From string
+	// To is the new storage directory.
+	To string
+	// Passes is the maximum number of passes done while the node runs.
+	Passes int
+}
+
+// Stats contains the counters of a migration.
+type Stats struct {
+	Copied      int64 `json:"copied"`
+	BytesCopied int64 `json:"bytesCopied"`
+	Deleted     int64 `json:"deleted"`
+	// Verified are the copied pieces whose hash was verified.
+	Verified int64 `json:"verified"`
+	// Corrupted are the copied pieces which were already corrupted in the
+	// current storage directory.
+	Corrupted int64 `json:"corrupted"`
+}
+
+// Changes returns the number of files changed in the new directory.
+func (stats Stats) Changes() int64 {
+	return stats.Copied + stats.Deleted
+}
+
+func (stats *Stats) add(other Stats) {
+	stats.Copied += other.Copied
+	stats.BytesCopied += other.BytesCopied
+	stats.Deleted += other.Deleted
+	stats.Verified += other.Verified
+	stats.Corrupted += other.Corrupted
+}
+
+// State is the persisted state of a migration, which allows resuming it.
+type State struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Passes is the number of completed passes.
+	Passes int `json:"passes"`
+	// Done are the directories already synchronized by the current pass.
+	Done []string `json:"done,omitempty"`
+	// Ready is set when the migration can be finished by restarting the node.
+	Ready bool `json:"ready"`
+
+	Stats Stats `json:"stats"`
+}
+
+// LoadState loads the state of the migration from path. It returns nil when
+// there isn't any migration.
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, Error.New("invalid state file %q: %w", path, err)
+	}
+	return &state, nil
+}
+
+// save stores the state atomically at path.
+func (state *State) save(path string) error {
+	data, err := json.MarshalIndent(state, "", "\t")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(fpath.AtomicWriteFile(path, data, 0600))
+}
+
+// Migration migrates the data of a storage node to a new storage directory.
+type Migration struct {
+	log       *zap.Logger
+	config    Config
+	statePath string
+}
+
+// New creates a new migration, whose state is stored at statePath.
+func New(log *zap.Logger, config Config, statePath string) (*Migration, error) {
+	from, err := filepath.Abs(config.From)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	to, err := filepath.Abs(config.To)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if from == to {
+		return nil, Error.New("the new storage directory is the current one")
+	}
+	if strings.HasPrefix(to, from+string(filepath.Separator)) {
+		return nil, Error.New("the new storage directory %q is inside the current one %q", to, from)
+	}
+	if config.Passes < 1 {
+		config.Passes = 1
+	}
+
+	config.From, config.To = from, to
+	return &Migration{
+		log:       log,
+		config:    config,
+		statePath: statePath,
+	}, nil
+}
+
+// Run copies the data to the new storage directory while the node runs. It
+// resumes the migration when its state exists. Once it returns, the migration
+// is finished by restarting the node.
+func (migration *Migration) Run(ctx context.Context) (_ *State, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state, err := migration.loadState()
+	if err != nil {
+		return nil, err
+	}
+	state.Ready = false
+
+	for state.Passes < migration.config.Passes {
+		stats, err := migration.runPass(ctx, state)
+		if err != nil {
+			return state, err
+		}
+
+		migration.log.Info("storage migration pass finished",
+			zap.Int("pass", state.Passes),
+			zap.Int64("copied", stats.Copied),
+			zap.Stringer("bytes copied", memory.Size(stats.BytesCopied)),
+			zap.Int64("deleted", stats.Deleted),
+			zap.Int64("corrupted", stats.Corrupted))
+
+		if stats.Changes() == 0 {
+			break
+		}
+	}
+
+	state.Ready = true
+	return state, state.save(migration.statePath)
+}
+
+// loadState loads the state of the migration or creates a new one.
+func (migration *Migration) loadState() (*State, error) {
+	state, err := LoadState(migration.statePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if state == nil {
+		state = &State{
+			From: migration.config.From,
+			To:   migration.config.To,
+		}
+	}
+
+	if state.From != migration.config.From || state.To != migration.config.To {
+		return nil, Error.New("a migration from %q to %q is in progress, remove %q for starting a different one", state.From, state.To, migration.statePath)
+	}
+
+	// passes are run again when a finished migration is run again, because
+	// the node kept running.
+	if state.Ready {
+		state.Passes = 0
+		state.Done = nil
+	}
+
+	return state, nil
+}
+
+// runPass synchronizes the new storage directory once and returns what it
+// changed. The state is saved after each synchronized directory.
+func (migration *Migration) runPass(ctx context.Context, state *State) (stats Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dirs, err := listDirs(migration.config.From, migration.config.To)
+	if err != nil {
+		return stats, err
+	}
+
+	done := make(map[string]bool, len(state.Done))
+	for _, dir := range state.Done {
+		done[dir] = true
+	}
+
+	lastProgress := time.Now()
+	for i, dir := range dirs {
+		if done[dir] {
+			continue
+		}
+
+		dirStats, err := syncDir(ctx, migration.log, migration.config.From, migration.config.To, dir)
+		if err != nil {
+			return stats, err
+		}
+		stats.add(dirStats)
+		state.Stats.add(dirStats)
+
+		state.Done = append(state.Done, dir)
+		if err := state.save(migration.statePath); err != nil {
+			return stats, err
+		}
+
+		if time.Since(lastProgress) >= progressInterval {
+			lastProgress = time.Now()
+			migration.log.Info("storage migration progress",
+				zap.Int("pass", state.Passes+1),
+				zap.String("directories", progress(i+1, len(dirs))),
+				zap.Int64("copied", stats.Copied),
+				zap.Stringer("bytes copied", memory.Size(stats.BytesCopied)))
+		}
+	}
+
+	state.Passes++
+	state.Done = nil
+	return stats, state.save(migration.statePath)
+}
+
+// Finish finishes the migration whose state is stored at statePath, when the
+// migration is ready, by copying the files changed since its last pass and the
+// rest of the files of the storage directory, e.g. the databases. It must be
+// called while the node isn't running.
+//
+// It returns the new storage directory, or an empty string when there isn't
+// any migration ready to finish. The state is removed once finished and the
+// files of the old storage directory are left untouched.
+func Finish(ctx context.Context, log *zap.Logger, statePath, from string) (to string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state, err := LoadState(statePath)
+	if err != nil || state == nil {
+		return "", err
+	}
+	if !state.Ready {
+		log.Info("storage migration isn't ready to be finished", zap.String("to", state.To))
+		return "", nil
+	}
+
+	from, err = filepath.Abs(from)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	if state.From != from {
+		return "", Error.New("storage migration is from %q, but the storage directory is %q", state.From, from)
+	}
+
+	dirs, err := listDirs(state.From, state.To)
+	if err != nil {
+		return "", err
+	}
+
+	var stats Stats
+	for _, dir := range dirs {
+		dirStats, err := syncDir(ctx, log, state.From, state.To, dir)
+		if err != nil {
+			return "", err
+		}
+		stats.add(dirStats)
+	}
+
+	otherStats, err := syncOthers(ctx, log, state.From, state.To)
+	if err != nil {
+		return "", err
+	}
+	stats.add(otherStats)
+	state.Stats.add(stats)
+
+	if err := createStorageDirs(state.To); err != nil {
+		return "", err
+	}
+
+	log.Info("storage migration finished",
+		zap.String("from", state.From),
+		zap.String("to", state.To),
+		zap.Int64("copied", state.Stats.Copied),
+		zap.Stringer("bytes copied", memory.Size(state.Stats.BytesCopied)),
+		zap.Int64("verified", state.Stats.Verified),
+		zap.Int64("corrupted", state.Stats.Corrupted))
+
+	return state.To, Error.Wrap(os.Remove(statePath))
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagemigration_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagemigration"
+)
+
+func TestMigration(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	from := ctx.Dir("from")
+	to := ctx.Dir("to")
+	statePath := filepath.Join(ctx.Dir("config"), storagemigration.StateFilename)
+
+	dir, err := filestore.NewDir(log, from)
+	require.NoError(t, err)
+	blobs := filestore.New(log, dir, filestore.DefaultConfig)
+	defer ctx.Check(blobs.Close)
+	store := pieces.NewStore(log, pieces.NewFileWalker(log, blobs, nil), nil, blobs, nil, nil, nil, pieces.DefaultConfig)
+
+	satelliteID := testrand.NodeID()
+	writePiece := func(t *testing.T) storj.PieceID {
+		pieceID := testrand.PieceID()
+		writer, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.BytesInt(4096))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{
+			Hash:          writer.Hash(),
+			HashAlgorithm: pb.PieceHashAlgorithm_SHA256,
+		}))
+		return pieceID
+	}
+	piecePath := func(t *testing.T, root string, pieceID storj.PieceID) string {
+		info, err := blobs.Stat(ctx, blobstore.BlobRef{Namespace: satelliteID.Bytes(), Key: pieceID.Bytes()})
+		require.NoError(t, err)
+		path, err := info.FullPath(ctx)
+		require.NoError(t, err)
+		rel, err := filepath.Rel(from, path)
+		require.NoError(t, err)
+		return filepath.Join(root, rel)
+	}
+
+	pieceIDs := []storj.PieceID{writePiece(t), writePiece(t), writePiece(t)}
+	require.NoError(t, os.WriteFile(filepath.Join(from, "bandwidth.db"), []byte("database"), 0644))
+
+	_, err = storagemigration.New(log, storagemigration.Config{From: from, To: from}, statePath)
+	require.Error(t, err)
+	_, err = storagemigration.New(log, storagemigration.Config{From: from, To: filepath.Join(from, "new")}, statePath)
+	require.Error(t, err)
+
+	migration, err := storagemigration.New(log, storagemigration.Config{From: from, To: to, Passes: 3}, statePath)
+	require.NoError(t, err)
+
+	state, err := migration.Run(ctx)
+	require.NoError(t, err)
+	require.True(t, state.Ready)
+	require.Equal(t, 2, state.Passes, "the second pass has nothing to copy")
+	require.EqualValues(t, 3, state.Stats.Copied)
+	require.EqualValues(t, 3, state.Stats.Verified)
+	for _, pieceID := range pieceIDs {
+		require.FileExists(t, piecePath(t, to, pieceID))
+	}
+	// the databases are copied when the migration finishes.
+	require.NoFileExists(t, filepath.Join(to, "bandwidth.db"))
+
+	// the node keeps running while the migration is ready.
+	deletedPath := piecePath(t, to, pieceIDs[0])
+	require.NoError(t, store.Delete(ctx, satelliteID, pieceIDs[0]))
+	newPieceID := writePiece(t)
+
+	state, err = migration.Run(ctx)
+	require.NoError(t, err)
+	require.True(t, state.Ready)
+	require.NoFileExists(t, deletedPath)
+	require.FileExists(t, piecePath(t, to, newPieceID))
+
+	// a piece which is corrupted in the current directory is copied as is.
+	corruptedPath := piecePath(t, from, pieceIDs[1])
+	content, err := os.ReadFile(corruptedPath)
+	require.NoError(t, err)
+	content[len(content)-1]++
+	require.NoError(t, os.WriteFile(corruptedPath, content, 0644))
+
+	state, err = migration.Run(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, state.Stats.Corrupted)
+
+	loaded, err := storagemigration.LoadState(statePath)
+	require.NoError(t, err)
+	require.Equal(t, state, loaded)
+
+	newTo, err := storagemigration.Finish(ctx, log, statePath, from)
+	require.NoError(t, err)
+	require.Equal(t, to, newTo)
+	require.FileExists(t, filepath.Join(to, "bandwidth.db"))
+	require.DirExists(t, filepath.Join(to, "temp"))
+	require.NoFileExists(t, statePath)
+	// the old directory is left untouched.
+	require.FileExists(t, piecePath(t, from, pieceIDs[2]))
+
+	newTo, err = storagemigration.Finish(ctx, log, statePath, from)
+	require.NoError(t, err)
+	require.Empty(t, newTo)
+}
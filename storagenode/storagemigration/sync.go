@@ -0,0 +1,392 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagemigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+)
+
+const (
+	blobsDir = "blobs"
+	trashDir = "trash"
+
+	// v1PieceFileSuffix is the extension of the piece files stored with the
+	// storage format V1, which have a piece header.
+	v1PieceFileSuffix = ".sj1"
+
+	// tmpSuffix is the extension of the files while they are copied.
+	tmpSuffix = ".migrating"
+)
+
+// skippedDirs are the directories of the storage directory which aren't
+// copied, because their content is only needed while the node runs.
+var skippedDirs = map[string]bool{
+	"temp":    true,
+	"garbage": true,
+}
+
+// listDirs returns the directories, relative to the storage directory, which
+// are synchronized one at a time: each prefix directory of the blobs and each
+// namespace of the trash. The directories of both the current and the new
+// storage directory are returned, so the deleted ones are synchronized too.
+func listDirs(from, to string) ([]string, error) {
+	unique := map[string]bool{}
+	for _, root := range []string{from, to} {
+		namespaces, err := readDirNames(filepath.Join(root, blobsDir))
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range namespaces {
+			prefixes, err := readDirNames(filepath.Join(root, blobsDir, namespace))
+			if err != nil {
+				return nil, err
+			}
+			for _, prefix := range prefixes {
+				unique[filepath.Join(blobsDir, namespace, prefix)] = true
+			}
+		}
+
+		namespaces, err = readDirNames(filepath.Join(root, trashDir))
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range namespaces {
+			unique[filepath.Join(trashDir, namespace)] = true
+		}
+	}
+
+	dirs := make([]string, 0, len(unique))
+	for dir := range unique {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	return dirs, nil
+}
+
+// readDirNames returns the names of the directories in dir. It returns none
+// when dir doesn't exist.
+func readDirNames(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// syncDir makes the directory dir, relative to the storage directories, of to
+// equal to the one of from. The files which are new or changed are copied and
+// the ones which don't exist in from anymore are deleted.
+func syncDir(ctx context.Context, log *zap.Logger, from, to, dir string) (stats Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := ctx.Err(); err != nil {
+		return stats, err
+	}
+
+	sources, err := listFiles(filepath.Join(from, dir))
+	if err != nil {
+		return stats, err
+	}
+	destinations, err := listFiles(filepath.Join(to, dir))
+	if err != nil {
+		return stats, err
+	}
+
+	for _, name := range sortedNames(sources) {
+		source := sources[name]
+		if destination, ok := destinations[name]; ok && sameFile(source, destination) {
+			continue
+		}
+
+		fileStats, err := syncFile(ctx, log, filepath.Join(from, dir, name), filepath.Join(to, dir, name))
+		if err != nil {
+			return stats, err
+		}
+		stats.add(fileStats)
+	}
+
+	for _, name := range sortedNames(destinations) {
+		if _, ok := sources[name]; ok {
+			continue
+		}
+
+		err := os.Remove(filepath.Join(to, dir, name))
+		if err != nil && !os.IsNotExist(err) {
+			return stats, Error.Wrap(err)
+		}
+		stats.Deleted++
+	}
+
+	return stats, nil
+}
+
+// syncOthers synchronizes the files and directories of the storage directory
+// which aren't synchronized by the passes, e.g. the databases.
+func syncOthers(ctx context.Context, log *zap.Logger, from, to string) (stats Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	entries, err := os.ReadDir(from)
+	if err != nil {
+		return stats, Error.Wrap(err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if name == blobsDir || name == trashDir || skippedDirs[name] {
+			continue
+		}
+
+		if !entry.IsDir() {
+			fileStats, err := syncFile(ctx, log, filepath.Join(from, name), filepath.Join(to, name))
+			if err != nil {
+				return stats, err
+			}
+			stats.add(fileStats)
+			continue
+		}
+
+		dirStats, err := syncDir(ctx, log, from, to, name)
+		if err != nil {
+			return stats, err
+		}
+		stats.add(dirStats)
+	}
+
+	return stats, nil
+}
+
+// createStorageDirs creates the directories of the piece store in dir.
+func createStorageDirs(dir string) error {
+	_, err := filestore.NewDir(zap.NewNop(), dir)
+	return Error.Wrap(err)
+}
+
+// listFiles returns the files in dir and in its subdirectories, by their path
+// relative to dir. It returns none when dir doesn't exist.
+func listFiles(dir string) (map[string]fs.FileInfo, error) {
+	files := map[string]fs.FileInfo{}
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// files and directories can be deleted while the node runs.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasSuffix(path, tmpSuffix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[name] = info
+		return nil
+	})
+	return files, Error.Wrap(err)
+}
+
+func sortedNames(files map[string]fs.FileInfo) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sameFile returns whether the copy is up to date with the source, i.e. it has
+// the same size and modification time.
+func sameFile(source, copy fs.FileInfo) bool {
+	return source.Size() == copy.Size() && source.ModTime().Equal(copy.ModTime())
+}
+
+// syncFile copies the file at source to destination and, when it's a piece,
+// verifies the copy.
+func syncFile(ctx context.Context, log *zap.Logger, source, destination string) (stats Stats, err error) {
+	n, err := copyFile(source, destination)
+	if err != nil {
+		// the piece was deleted while the node runs.
+		if errors.Is(err, fs.ErrNotExist) {
+			return stats, nil
+		}
+		return stats, Error.New("copying %q: %w", source, err)
+	}
+	stats.Copied++
+	stats.BytesCopied += n
+
+	if !strings.HasSuffix(destination, v1PieceFileSuffix) {
+		return stats, nil
+	}
+
+	ok, err := verifyPiece(ctx, destination)
+	if err != nil {
+		return stats, Error.New("verifying %q: %w", destination, err)
+	}
+	if ok {
+		stats.Verified++
+		return stats, nil
+	}
+
+	// the copy is kept when the piece is already corrupted, because it's the
+	// same as the piece which the node has.
+	sourceOK, err := verifyPiece(ctx, source)
+	if errors.Is(err, fs.ErrNotExist) {
+		// the piece was deleted while the node runs.
+		return stats, Error.Wrap(os.Remove(destination))
+	}
+	if err != nil {
+		return stats, Error.New("verifying %q: %w", source, err)
+	}
+	if !sourceOK {
+		stats.Corrupted++
+		log.Warn("piece is corrupted in the current storage directory", zap.String("path", source))
+		return stats, nil
+	}
+
+	return stats, errs.Combine(
+		Error.New("copy %q of piece %q is corrupted", destination, source),
+		os.Remove(destination),
+	)
+}
+
+// copyFile copies the file at source to destination, with a temporary name
+// until it's complete, and keeps its modification time. It returns the number
+// of bytes copied.
+func copyFile(source, destination string) (n int64, err error) {
+	in, err := os.Open(source)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, in.Close()) }()
+
+	info, err := in.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destination), 0700); err != nil {
+		return 0, err
+	}
+
+	tmpPath := destination + tmpSuffix
+	out, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return 0, err
+	}
+
+	n, err = io.Copy(out, in)
+	if err == nil {
+		err = out.Sync()
+	}
+	err = errs.Combine(err, out.Close())
+	if err == nil {
+		err = os.Chtimes(tmpPath, time.Now(), info.ModTime())
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, destination)
+	}
+	if err != nil {
+		return n, errs.Combine(err, os.Remove(tmpPath))
+	}
+
+	return n, nil
+}
+
+// verifyPiece returns whether the content of the piece at path matches the
+// hash in its header.
+func verifyPiece(ctx context.Context, path string) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+
+	reader, err := pieces.NewReader(&blobFile{File: file, format: filestore.FormatV1})
+	if err != nil {
+		return false, errs.Combine(err, file.Close())
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	header, err := reader.GetPieceHeader()
+	if err != nil {
+		// a piece whose header can't be read is corrupted.
+		if pieces.Error.Has(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	ok, _, err := pieces.CheckHash(ctx, reader, pb.PieceHash{
+		Hash:          header.Hash,
+		HashAlgorithm: header.HashAlgorithm,
+	})
+	return ok, err
+}
+
+// blobFile is a piece file opened for reading it as a blob.
+type blobFile struct {
+	*os.File
+	format blobstore.FormatVersion
+}
+
+// Size returns the size of the file.
+func (file *blobFile) Size() (int64, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// StorageFormatVersion returns the storage format of the piece.
+func (file *blobFile) StorageFormatVersion() blobstore.FormatVersion {
+	return file.format
+}
+
+// progress formats the progress of done out of total.
+func progress(done, total int) string {
+	if total == 0 {
+		return "0/0"
+	}
+	return fmt.Sprintf("%d/%d (%.1f%%)", done, total, float64(done)*100/float64(total))
+}
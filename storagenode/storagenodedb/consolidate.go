@@ -0,0 +1,421 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/shared/dbutil/dbschema"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ConsolidatedDBName represents the database name of the single file where all
+// the databases are stored when they are consolidated.
+const ConsolidatedDBName = "storagenode"
+
+// copySource is a database file, which is copied into another one.
+type copySource struct {
+	path string
+	// tables are the tables to copy. All of them are copied when nil.
+	tables []string
+}
+
+// Consolidate moves the databases stored in separate files into a single file.
+// The databases are migrated to the latest version before being moved, and
+// their files are removed once the single file is created.
+func Consolidate(ctx context.Context, log *zap.Logger, config Config) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config.Consolidated = false
+	split, err := OpenExisting(ctx, log, config)
+	if err != nil {
+		return err
+	}
+
+	err = split.MigrateToLatest(ctx)
+	if err != nil {
+		return errs.Combine(err, split.Close())
+	}
+
+	migration := split.Migration(ctx)
+	version := migration.Steps[len(migration.Steps)-1].Version
+
+	if err := split.Close(); err != nil {
+		return ErrDatabase.Wrap(err)
+	}
+
+	var sources []copySource
+	var paths []string
+	for _, dbName := range split.dbNames() {
+		path := split.filepathFromDBName(dbName)
+		if _, err := os.Stat(path); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return ErrDatabase.Wrap(err)
+		}
+
+		sources = append(sources, copySource{path: path})
+		paths = append(paths, path)
+	}
+
+	path := split.filepathFromDBName(ConsolidatedDBName)
+	err = split.buildDatabase(ctx, ConsolidatedDBName, path, version, sources)
+	if err != nil {
+		return err
+	}
+
+	log.Info("databases consolidated", zap.String("path", path))
+
+	return removeDatabaseFiles(paths...)
+}
+
+// Split moves the databases stored in a single file into separate files. The
+// databases are migrated to the latest version before being moved, and the
+// single file is removed once the separate files are created.
+//
+// Only the tables of the current schema of each database are moved.
+func Split(ctx context.Context, log *zap.Logger, config Config) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config.Consolidated = true
+	consolidated, err := OpenExisting(ctx, log, config)
+	if err != nil {
+		return err
+	}
+
+	path := consolidated.filepathFromDBName(ConsolidatedDBName)
+	if consolidated.consolidatedDB == nil {
+		return errs.Combine(
+			ErrDatabase.New("databases aren't consolidated, %q doesn't exist", path),
+			consolidated.Close(),
+		)
+	}
+
+	err = consolidated.MigrateToLatest(ctx)
+	if err := errs.Combine(err, consolidated.Close()); err != nil {
+		return err
+	}
+
+	config.Consolidated = false
+	target, err := OpenNew(ctx, log, config)
+	if err != nil {
+		return err
+	}
+
+	// the migration steps refer to the database connections, so they have to
+	// be open for finding the version of each database.
+	var tmpPaths []string
+	for _, dbName := range target.dbNames() {
+		tmpPath := target.filepathFromDBName(dbName) + ".tmp"
+		sqlDB, err := target.openSQLite(ctx, dbName, tmpPath)
+		if err != nil {
+			return errs.Combine(err, target.Close(), removeDatabaseFiles(tmpPaths...))
+		}
+		target.SQLDBs[dbName].Configure(sqlDB)
+		tmpPaths = append(tmpPaths, tmpPath)
+	}
+
+	versions := make(map[tagsql.DB]int)
+	for _, step := range target.Migration(ctx).Steps {
+		versions[*step.DB] = step.Version
+	}
+
+	schemas := Schema()
+	for _, dbName := range target.dbNames() {
+		tables := []string{}
+		if schema := schemas[dbName]; schema != nil {
+			for _, table := range schema.Tables {
+				tables = append(tables, table.Name)
+			}
+		}
+
+		sqlDB := target.SQLDBs[dbName].GetDB()
+		err := copyIntoDatabase(ctx, sqlDB, versions[sqlDB], copySource{path: path, tables: tables})
+		if err != nil {
+			return errs.Combine(ErrDatabase.New("%s: %w", dbName, err), target.Close(), removeDatabaseFiles(tmpPaths...))
+		}
+	}
+
+	if err := target.Close(); err != nil {
+		return errs.Combine(ErrDatabase.Wrap(err), removeDatabaseFiles(tmpPaths...))
+	}
+
+	for _, dbName := range target.dbNames() {
+		dbPath := target.filepathFromDBName(dbName)
+		if err := os.Rename(dbPath+".tmp", dbPath); err != nil {
+			return ErrDatabase.Wrap(err)
+		}
+	}
+
+	log.Info("databases split", zap.String("path", consolidated.dbDirectory))
+
+	return removeDatabaseFiles(path)
+}
+
+// createConsolidated creates the single file of the consolidated databases
+// when it doesn't exist yet.
+func (db *DB) createConsolidated(ctx context.Context) (err error) {
+	if _, err := os.Stat(db.filepathFromDBName(ConsolidatedDBName)); !os.IsNotExist(err) {
+		return ErrDatabase.Wrap(err)
+	}
+
+	if err := db.closeDatabase(ConsolidatedDBName); err != nil {
+		return err
+	}
+
+	if err := Consolidate(ctx, db.log, db.config); err != nil {
+		return err
+	}
+
+	return db.openConsolidatedDatabase(ctx)
+}
+
+// checkLayout returns an error when the existing databases aren't stored as
+// the configuration specifies.
+func (db *DB) checkLayout() error {
+	consolidatedPath := db.filepathFromDBName(ConsolidatedDBName)
+	_, err := os.Stat(consolidatedPath)
+	consolidated := err == nil
+
+	if db.config.Consolidated && !consolidated {
+		infoPath := db.filepathFromDBName(DeprecatedInfoDBName)
+		if _, err := os.Stat(infoPath); err == nil {
+			return ErrDatabase.New("databases are stored in separate files, but they are configured to be consolidated in %q", consolidatedPath)
+		}
+	}
+
+	if !db.config.Consolidated && consolidated {
+		return ErrDatabase.New("databases are consolidated in %q, but they are configured to be stored in separate files", consolidatedPath)
+	}
+
+	return nil
+}
+
+// buildDatabase creates the database file at path by copying the sources into
+// it. The file is created with a temporary name and renamed when complete.
+func (db *DB) buildDatabase(ctx context.Context, dbName, path string, version int, sources []copySource) (err error) {
+	tmpPath := path + ".tmp"
+	sqlDB, err := db.openSQLite(ctx, dbName, tmpPath)
+	if err != nil {
+		return err
+	}
+
+	for _, source := range sources {
+		err = copyIntoDatabase(ctx, sqlDB, version, source)
+		if err != nil {
+			break
+		}
+	}
+
+	err = errs.Combine(err, sqlDB.Close())
+	if err != nil {
+		return errs.Combine(ErrDatabase.New("%s: %w", dbName, err), removeDatabaseFiles(tmpPath))
+	}
+
+	return ErrDatabase.Wrap(os.Rename(tmpPath, path))
+}
+
+// copyIntoDatabase copies the tables, with their indexes and rows, of the
+// source database into sqlDB and sets its version.
+func copyIntoDatabase(ctx context.Context, sqlDB tagsql.DB, version int, source copySource) (err error) {
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	// attached databases are per connection, hence all the statements have to
+	// be executed with the same connection.
+	_, err = conn.ExecContext(ctx, "ATTACH DATABASE ? AS source", source.path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, detachErr := conn.ExecContext(ctx, "DETACH DATABASE source")
+		err = errs.Combine(err, detachErr)
+	}()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = copyTables(ctx, tx, source.tables)
+	if err == nil {
+		err = setVersion(ctx, tx, version)
+	}
+	if err != nil {
+		return errs.Combine(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+// copyTables copies the tables, with their indexes and rows, of the attached
+// source database into the main one. All the tables are copied when tables is
+// nil, except the versions table.
+func copyTables(ctx context.Context, tx tagsql.Tx, tables []string) (err error) {
+	var include map[string]bool
+	if tables != nil {
+		include = make(map[string]bool, len(tables))
+		for _, table := range tables {
+			include[table] = true
+		}
+	}
+
+	// indexes are created after their tables; the internal indexes, e.g. the
+	// ones of the primary keys, don't have SQL and are created with the tables.
+	rows, err := tx.QueryContext(ctx, `
+		SELECT type, name, tbl_name, sql FROM source.sqlite_master
+		WHERE type IN ('table', 'index') AND sql IS NOT NULL AND name NOT LIKE 'sqlite_%'
+		ORDER BY type = 'index', name
+	`)
+	if err != nil {
+		return err
+	}
+
+	type object struct {
+		kind, name, table, sql string
+	}
+	var objects []object
+	for rows.Next() {
+		var obj object
+		if err := rows.Scan(&obj.kind, &obj.name, &obj.table, &obj.sql); err != nil {
+			return errs.Combine(err, rows.Close())
+		}
+
+		// the test table is left behind when the preflight check fails.
+		if obj.table == VersionTable || obj.table == "test_table" {
+			continue
+		}
+		if include != nil && !include[obj.table] {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		if _, err := tx.ExecContext(ctx, obj.sql); err != nil {
+			return err
+		}
+
+		if obj.kind == "table" {
+			/* #nosec G202 */ // the table name comes from the schema of the source database.
+			_, err := tx.ExecContext(ctx, `INSERT INTO main."`+obj.name+`" SELECT * FROM source."`+obj.name+`"`)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// setVersion sets version as the migration version of the main database.
+func setVersion(ctx context.Context, tx tagsql.Tx, version int) error {
+	_, err := tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+VersionTable+` (version int, commited_at text)`) //nolint:misspell
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM `+VersionTable)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO `+VersionTable+` (version, commited_at) VALUES (?, ?)`, version, time.Now().String()) //nolint:misspell
+	return err
+}
+
+// preflightConsolidated conducts the pre-flight check of the consolidated
+// databases. The schema of each database is checked the same way as when the
+// databases are stored in separate files.
+func (db *DB) preflightConsolidated(ctx context.Context) error {
+	schema, err := queryPreflightSchema(ctx, ConsolidatedDBName, db.consolidatedDB)
+	if err != nil {
+		return err
+	}
+
+	expectedSchemas := Schema()
+	schemas, rest := splitConsolidatedSchema(schema, expectedSchemas)
+	if len(rest.Tables) > 0 || len(rest.Indexes) > 0 {
+		return ErrPreflight.New("database %q: schema contains unexpected tables or indices: %s", ConsolidatedDBName, rest)
+	}
+
+	for _, dbName := range db.dbNames() {
+		if err := db.checkPreflightSchema(dbName, schemas[dbName], expectedSchemas[dbName]); err != nil {
+			return err
+		}
+	}
+
+	return preflightReadWrite(ctx, ConsolidatedDBName, db.consolidatedDB)
+}
+
+// splitConsolidatedSchema splits the schema of the consolidated databases into
+// the schemas of the databases, which the tables belong to. The tables, which
+// don't belong to any database, and their indexes are returned in rest.
+func splitConsolidatedSchema(schema *dbschema.Schema, expectedSchemas map[string]*dbschema.Schema) (schemas map[string]*dbschema.Schema, rest *dbschema.Schema) {
+	schemas = make(map[string]*dbschema.Schema, len(expectedSchemas))
+	tableDBs := map[string]string{}
+	for dbName, expectedSchema := range expectedSchemas {
+		schemas[dbName] = &dbschema.Schema{}
+		for _, table := range expectedSchema.Tables {
+			tableDBs[table.Name] = dbName
+		}
+	}
+
+	rest = &dbschema.Schema{}
+	for _, table := range schema.Tables {
+		if dbName, ok := tableDBs[table.Name]; ok {
+			schemas[dbName].Tables = append(schemas[dbName].Tables, table)
+		} else {
+			rest.Tables = append(rest.Tables, table)
+		}
+	}
+	for _, index := range schema.Indexes {
+		if dbName, ok := tableDBs[index.Table]; ok {
+			schemas[dbName].Indexes = append(schemas[dbName].Indexes, index)
+		} else {
+			rest.Indexes = append(rest.Indexes, index)
+		}
+	}
+
+	return schemas, rest
+}
+
+// dbNames returns the names of the databases in order.
+func (db *DB) dbNames() []string {
+	names := make([]string, 0, len(db.SQLDBs))
+	for name := range db.SQLDBs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// removeDatabaseFiles removes the database files at paths, including their
+// journal files.
+func removeDatabaseFiles(paths ...string) error {
+	var group errs.Group
+	for _, path := range paths {
+		for _, ext := range []string{"", "-wal", "-shm"} {
+			if err := os.Remove(path + ext); err != nil && !os.IsNotExist(err) {
+				group.Add(err)
+			}
+		}
+	}
+
+	return ErrDatabase.Wrap(group.Err())
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb_test
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func TestConsolidateAndSplit(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	storageDir := ctx.Dir("storage")
+	cfg := storagenodedb.Config{
+		Storage: storageDir,
+		Info:    filepath.Join(storageDir, "piecestore.db"),
+		Info2:   filepath.Join(storageDir, "info.db"),
+		Pieces:  storageDir,
+	}
+	consolidatedCfg := cfg
+	consolidatedCfg.Consolidated = true
+	consolidatedPath := filepath.Join(storageDir, storagenodedb.ConsolidatedDBName+".db")
+
+	satelliteID := testrand.NodeID()
+	pieceID := testrand.PieceID()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	db, err := storagenodedb.OpenNew(ctx, log, cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.MigrateToLatest(ctx))
+	require.NoError(t, db.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_PUT, 1024, now))
+	require.NoError(t, db.PieceExpirationDB().SetExpiration(ctx, satelliteID, pieceID, now))
+	require.NoError(t, db.Close())
+
+	requireData := func(t *testing.T, config storagenodedb.Config) {
+		db, err := storagenodedb.OpenExisting(ctx, log, config)
+		require.NoError(t, err)
+		defer ctx.Check(db.Close)
+
+		require.NoError(t, db.MigrateToLatest(ctx))
+		require.NoError(t, db.CheckVersion(ctx))
+		require.NoError(t, db.Preflight(ctx))
+
+		usage, err := db.Bandwidth().Summary(ctx, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.EqualValues(t, 1024, usage.Put)
+
+		expired, err := db.PieceExpirationDB().GetExpired(ctx, now.Add(time.Second), 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		require.Equal(t, pieceID, expired[0].PieceID)
+	}
+
+	_, err = storagenodedb.OpenExisting(ctx, log, consolidatedCfg)
+	require.Error(t, err)
+	require.Error(t, storagenodedb.Split(ctx, log, cfg))
+
+	require.NoError(t, storagenodedb.Consolidate(ctx, log, cfg))
+	require.FileExists(t, consolidatedPath)
+	require.NoFileExists(t, filepath.Join(storageDir, storagenodedb.BandwidthDBName+".db"))
+	require.NoFileExists(t, filepath.Join(storageDir, storagenodedb.PieceExpirationDBName+".db"))
+
+	_, err = storagenodedb.OpenExisting(ctx, log, cfg)
+	require.Error(t, err)
+	require.Error(t, storagenodedb.Consolidate(ctx, log, cfg))
+
+	requireData(t, consolidatedCfg)
+
+	require.NoError(t, storagenodedb.Split(ctx, log, cfg))
+	require.NoFileExists(t, consolidatedPath)
+	require.FileExists(t, filepath.Join(storageDir, storagenodedb.BandwidthDBName+".db"))
+
+	requireData(t, cfg)
+}
+
+// BenchmarkUploadWrites measures the database writes of the upload hot path,
+// i.e. the bandwidth usage and the piece expiration, with each database
+// layout. The write amplification is reported as the bytes written to the
+// files per upload.
+func BenchmarkUploadWrites(b *testing.B) {
+	for _, layout := range []struct {
+		name         string
+		consolidated bool
+	}{
+		{"Split", false},
+		{"Consolidated", true},
+	} {
+		b.Run(layout.name, func(b *testing.B) {
+			ctx := testcontext.New(b)
+			defer ctx.Cleanup()
+
+			storageDir := ctx.Dir("storage")
+			db, err := storagenodedb.OpenNew(ctx, zap.NewNop(), storagenodedb.Config{
+				Storage:      storageDir,
+				Info:         filepath.Join(storageDir, "piecestore.db"),
+				Info2:        filepath.Join(storageDir, "info.db"),
+				Pieces:       storageDir,
+				Consolidated: layout.consolidated,
+			})
+			require.NoError(b, err)
+			defer ctx.Check(db.Close)
+			require.NoError(b, db.MigrateToLatest(ctx))
+
+			satelliteID := testrand.NodeID()
+			expiration := time.Now().Add(24 * time.Hour)
+
+			written, ok := bytesWritten()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				err := db.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_PUT, 1024, time.Now())
+				if err != nil {
+					b.Fatal(err)
+				}
+				err = db.PieceExpirationDB().SetExpiration(ctx, satelliteID, testrand.PieceID(), expiration)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.StopTimer()
+
+			if after, afterOK := bytesWritten(); ok && afterOK {
+				b.ReportMetric(float64(after-written)/float64(b.N), "written-B/op")
+			}
+		})
+	}
+}
+
+// bytesWritten returns the bytes that the process passed to write system
+// calls. It's only available on Linux.
+func bytesWritten() (int64, bool) {
+	file, err := os.Open("/proc/self/io")
+	if err != nil {
+		return 0, false
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		value, ok := strings.CutPrefix(scanner.Text(), "wchar: ")
+		if !ok {
+			continue
+		}
+
+		written, err := strconv.ParseInt(value, 10, 64)
+		return written, err == nil
+	}
+
+	return 0, false
+}
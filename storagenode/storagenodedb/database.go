@@ -8,6 +8,7 @@ package storagenodedb
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -18,10 +19,10 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
-	"storj.io/storj/private/migrate"
 	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/dbschema"
 	"storj.io/storj/shared/dbutil/sqliteutil"
+	"storj.io/storj/shared/migrate"
 	"storj.io/storj/shared/tagsql"
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/bandwidth"
@@ -85,6 +86,10 @@ type Config struct {
 	Pieces    string
 	Filestore filestore.Config
 
+	// Consolidated stores all the databases in a single file instead of one
+	// file per database.
+	Consolidated bool
+
 	TestingDisableWAL bool
 }
 
@@ -99,6 +104,7 @@ func (config Config) LazyFilewalkerConfig() lazyfilewalker.Config {
 		Driver:          config.Driver,
 		Pieces:          config.Pieces,
 		Filestore:       config.Filestore,
+		Consolidated:    config.Consolidated,
 		LowerIOPriority: true,
 	}
 }
@@ -127,6 +133,10 @@ type DB struct {
 	pricingDB         *pricingDB
 	apiKeysDB         *apiKeysDB
 
+	// consolidatedDB is the connection shared by all the databases when they
+	// are consolidated in a single file.
+	consolidatedDB tagsql.DB
+
 	SQLDBs map[string]DBContainer
 }
 
@@ -263,6 +273,11 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 		},
 	}
 
+	err = db.checkLayout()
+	if err != nil {
+		return nil, err
+	}
+
 	err = db.openDatabases(ctx)
 	if err != nil {
 		return nil, err
@@ -306,11 +321,18 @@ func (db *DB) openDatabases(ctx context.Context) error {
 }
 
 func (db *DB) rawDatabaseFromName(dbName string) tagsql.DB {
+	if db.config.Consolidated {
+		return db.consolidatedDB
+	}
 	return db.SQLDBs[dbName].GetDB()
 }
 
 // openExistingDatabase opens existing database at the specified path.
 func (db *DB) openExistingDatabase(ctx context.Context, dbName string) error {
+	if db.config.Consolidated {
+		dbName = ConsolidatedDBName
+	}
+
 	path := db.filepathFromDBName(dbName)
 	if _, err := os.Stat(path); err != nil {
 		if os.IsNotExist(err) {
@@ -326,17 +348,53 @@ func (db *DB) openExistingDatabase(ctx context.Context, dbName string) error {
 
 // openDatabase opens or creates a database at the specified path.
 func (db *DB) openDatabase(ctx context.Context, dbName string) error {
+	if db.config.Consolidated {
+		return db.openConsolidatedDatabase(ctx)
+	}
+
 	path := db.filepathFromDBName(dbName)
 
+	if err := db.closeDatabase(dbName); err != nil {
+		return ErrDatabase.Wrap(err)
+	}
+
+	sqlDB, err := db.openSQLite(ctx, dbName, path)
+	if err != nil {
+		return err
+	}
+
+	mDB := db.SQLDBs[dbName]
+	mDB.Configure(sqlDB)
+
+	return nil
+}
+
+// openConsolidatedDatabase opens or creates the single file of the
+// consolidated databases, when it isn't open yet, and configures all the
+// databases for using it.
+func (db *DB) openConsolidatedDatabase(ctx context.Context) error {
+	if db.consolidatedDB == nil {
+		sqlDB, err := db.openSQLite(ctx, ConsolidatedDBName, db.filepathFromDBName(ConsolidatedDBName))
+		if err != nil {
+			return err
+		}
+		db.consolidatedDB = sqlDB
+	}
+
+	for _, mDB := range db.SQLDBs {
+		mDB.Configure(db.consolidatedDB)
+	}
+
+	return nil
+}
+
+// openSQLite opens or creates the SQLite database file at path.
+func (db *DB) openSQLite(ctx context.Context, dbName, path string) (tagsql.DB, error) {
 	driver := db.config.Driver
 	if driver == "" {
 		driver = "sqlite3"
 	}
 
-	if err := db.closeDatabase(dbName); err != nil {
-		return ErrDatabase.Wrap(err)
-	}
-
 	wal := "&_journal=WAL"
 	if db.config.TestingDisableWAL {
 		wal = "&_journal=MEMORY"
@@ -344,15 +402,12 @@ func (db *DB) openDatabase(ctx context.Context, dbName string) error {
 
 	sqlDB, err := tagsql.Open(ctx, driver, "file:"+path+"?_busy_timeout=10000"+wal)
 	if err != nil {
-		return ErrDatabase.New("%s opening file %q failed: %w", dbName, path, err)
+		return nil, ErrDatabase.New("%s opening file %q failed: %w", dbName, path, err)
 	}
 
-	mDB := db.SQLDBs[dbName]
-	mDB.Configure(sqlDB)
-
 	dbutil.Configure(ctx, sqlDB, dbName, mon)
 
-	return nil
+	return sqlDB, nil
 }
 
 // filenameFromDBName returns a constructed filename for the specified database name.
@@ -365,33 +420,92 @@ func (db *DB) filepathFromDBName(dbName string) string {
 }
 
 // MigrateToLatest creates any necessary tables.
+//
+// When the databases are consolidated and their file doesn't exist yet, they
+// are created with the separate files layout and consolidated afterwards,
+// because the migration steps can only be applied to that layout.
 func (db *DB) MigrateToLatest(ctx context.Context) error {
+	if db.config.Consolidated {
+		if err := db.createConsolidated(ctx); err != nil {
+			return err
+		}
+	}
+
 	migration := db.Migration(ctx)
 	return migration.Run(ctx, db.log.Named("migration"))
 }
 
+// MigrateToVersion migrates the databases forward or rolls them back to the
+// specified version.
+func (db *DB) MigrateToVersion(ctx context.Context, version int) error {
+	migration := db.Migration(ctx)
+	if err := migration.Rollback(ctx, db.log.Named("migration"), version); err != nil {
+		return err
+	}
+	return migration.TargetVersion(version).Run(ctx, db.log.Named("migration"))
+}
+
+// DryRunMigration writes the statements, which would migrate the databases to
+// the specified version, to w without running them. Negative version means the
+// latest version.
+func (db *DB) DryRunMigration(ctx context.Context, w io.Writer, version int) error {
+	migration := db.Migration(ctx)
+	if version < 0 {
+		return migration.DryRun(ctx, w)
+	}
+
+	// only one of them writes anything, depending on whether the version is
+	// older or newer than the current one.
+	if err := migration.DryRunRollback(ctx, w, version); err != nil {
+		return err
+	}
+	return migration.TargetVersion(version).DryRun(ctx, w)
+}
+
 // Preflight conducts a pre-flight check to ensure correct schemas and minimal read+write functionality of the database tables.
 func (db *DB) Preflight(ctx context.Context) (err error) {
+	if db.config.Consolidated {
+		return db.preflightConsolidated(ctx)
+	}
+
 	for dbName, dbContainer := range db.SQLDBs {
-		if err := db.preflight(ctx, dbName, dbContainer); err != nil {
+		if err := db.preflight(ctx, dbName, dbContainer.GetDB(), Schema()[dbName]); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContainer) error {
-	nextDB := dbContainer.GetDB()
+func (db *DB) preflight(ctx context.Context, dbName string, nextDB tagsql.DB, expectedSchema *dbschema.Schema) error {
 	// Preflight stage 1: test schema correctness
+	schema, err := queryPreflightSchema(ctx, dbName, nextDB)
+	if err != nil {
+		return err
+	}
+	if err := db.checkPreflightSchema(dbName, schema, expectedSchema); err != nil {
+		return err
+	}
+
+	// Preflight stage 2: test basic read/write access
+	return preflightReadWrite(ctx, dbName, nextDB)
+}
+
+// queryPreflightSchema returns the schema of the database without the tables,
+// which are ignored by the pre-flight check.
+func queryPreflightSchema(ctx context.Context, dbName string, nextDB tagsql.DB) (*dbschema.Schema, error) {
 	schema, err := sqliteutil.QuerySchema(ctx, nextDB)
 	if err != nil {
-		return ErrPreflight.New("database %q: schema check failed: %v", dbName, err)
+		return nil, ErrPreflight.New("database %q: schema check failed: %v", dbName, err)
 	}
 	// we don't care about changes in versions table
 	schema.DropTable("versions")
 	// if there was a previous pre-flight failure, test_table might still be in the schema
 	schema.DropTable("test_table")
+	return schema, nil
+}
 
+// checkPreflightSchema compares the schema of the database with the expected one.
+func (db *DB) checkPreflightSchema(dbName string, schema, expectedSchema *dbschema.Schema) error {
 	// If tables and indexes of the schema are empty, set to nil
 	// to help with comparison to the snapshot.
 	if len(schema.Tables) == 0 {
@@ -401,9 +515,6 @@ func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContai
 		schema.Indexes = nil
 	}
 
-	// get expected schema
-	expectedSchema := Schema()[dbName]
-
 	// find extra indexes
 	var extraIdxs []*dbschema.Index
 	for _, idx := range schema.Indexes {
@@ -428,12 +539,15 @@ func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContai
 	if diff := cmp.Diff(expectedSchema, schema); diff != "" {
 		return ErrPreflight.New("database %q: expected schema does not match actual: %s", dbName, diff)
 	}
+	return nil
+}
 
-	// Preflight stage 2: test basic read/write access
+// preflightReadWrite tests basic read/write access of the database.
+func preflightReadWrite(ctx context.Context, dbName string, nextDB tagsql.DB) error {
 	// for each database, create a new table, insert a row into that table, retrieve and validate that row, and drop the table.
 
 	// drop test table in case the last preflight check failed before table could be dropped
-	_, err = nextDB.ExecContext(ctx, "DROP TABLE IF EXISTS test_table")
+	_, err := nextDB.ExecContext(ctx, "DROP TABLE IF EXISTS test_table")
 	if err != nil {
 		return ErrPreflight.New("database %q: failed drop if test_table: %w", dbName, err)
 	}
@@ -495,6 +609,19 @@ func (db *DB) closeDatabases() error {
 
 // closeDatabase closes the specified SQLite database connections and removes them from the associated maps.
 func (db *DB) closeDatabase(dbName string) (err error) {
+	if db.config.Consolidated {
+		if db.consolidatedDB == nil {
+			return nil
+		}
+
+		err = db.consolidatedDB.Close()
+		db.consolidatedDB = nil
+		if err != nil {
+			return ErrDatabase.New("%s close failed: %w", ConsolidatedDBName, err)
+		}
+		return nil
+	}
+
 	mdb, ok := db.SQLDBs[dbName]
 	if !ok {
 		return ErrDatabase.New("no database with name %s found. database was never opened or already closed.", dbName)
@@ -2025,6 +2152,9 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 				Action: migrate.SQL{
 					`ALTER TABLE reputation ADD COLUMN vetted_at TIMESTAMP`,
 				},
+				Revert: migrate.SQL{
+					`ALTER TABLE reputation DROP COLUMN vetted_at`,
+				},
 			},
 			{
 				DB:          &db.satellitesDB.DB,
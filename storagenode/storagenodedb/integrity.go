@@ -0,0 +1,375 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// ErrRecovery represents an error during the recovery of the databases.
+var ErrRecovery = errs.Class("database recovery")
+
+// lostOnRecreate describes, for each database, the data which is lost when the
+// database is recreated and can't be re-derived from the pieces.
+var lostOnRecreate = map[string]string{
+	DeprecatedInfoDBName:  "nothing, the database isn't used anymore",
+	BandwidthDBName:       "bandwidth usage history",
+	OrdersDBName:          "archive of the sent orders",
+	PieceExpirationDBName: "expirations of the pieces whose header can't be read",
+	PieceInfoDBName:       "information of the pieces stored with the V0 format, which can't be read anymore",
+	PieceSpaceUsedDBName:  "nothing, the space used is recalculated",
+	ReputationDBName:      "cached reputation, which is refreshed from the satellites",
+	StorageUsageDBName:    "storage usage history, the recent one is refreshed from the satellites",
+	UsedSerialsDBName:     "nothing, the database isn't used anymore",
+	SatellitesDBName:      "satellites' cache and graceful exit progress",
+	NotificationsDBName:   "notifications",
+	HeldAmountDBName:      "payouts history, which is refreshed from the satellites",
+	PricingDBName:         "satellites' pricing, which is refreshed from the satellites",
+	APIKeysDBName:         "API key of the multinode dashboard, which has to be issued again",
+	ConsolidatedDBName:    "all the data of the databases, except the piece expirations and the space used",
+}
+
+// IntegrityCheck is the result of the integrity check of a database.
+type IntegrityCheck struct {
+	Name string
+	Path string
+	// Problems are the problems found in the database. It's empty when the
+	// database is healthy.
+	Problems []string
+}
+
+// OK returns true when no problem was found in the database.
+func (check IntegrityCheck) OK() bool {
+	return len(check.Problems) == 0
+}
+
+// RecoveryReport describes the recovery of a database.
+type RecoveryReport struct {
+	Name string
+	// BackupPath is the path where the damaged database was moved. It's empty
+	// when the database was missing.
+	BackupPath string
+	// Lost describes the data which couldn't be recovered.
+	Lost string
+	// Rederived describes the data which was derived again from the pieces.
+	Rederived string
+}
+
+// CheckIntegrity checks the integrity of every database and reports the
+// problems found. A missing database is only a problem when other databases
+// exist, because a new node doesn't have any database until the first
+// migration.
+//
+// A damaged database may fail to open, so db should be created with OpenNew,
+// which doesn't open them, for checking all of them.
+func (db *DB) CheckIntegrity(ctx context.Context) (checks []IntegrityCheck, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	names := db.dbNames()
+	if db.config.Consolidated {
+		names = []string{ConsolidatedDBName}
+	}
+
+	var missing []int
+	for _, name := range names {
+		check := IntegrityCheck{
+			Name: name,
+			Path: db.filepathFromDBName(name),
+		}
+
+		if _, err := os.Stat(check.Path); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, ErrDatabase.New("%s couldn't be read (%q): %w", name, check.Path, err)
+			}
+			missing = append(missing, len(checks))
+			checks = append(checks, check)
+			continue
+		}
+
+		check.Problems, err = db.integrityProblems(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+
+	if len(missing) < len(checks) {
+		for _, i := range missing {
+			checks[i].Problems = []string{"database file is missing"}
+		}
+	}
+
+	return checks, nil
+}
+
+// integrityProblems runs the SQLite integrity check on the database and
+// returns the problems found. Errors which prevent from checking the database,
+// e.g. a damaged file header, are reported as problems too.
+func (db *DB) integrityProblems(ctx context.Context, dbName string) (problems []string, err error) {
+	sqlDB := db.rawDatabaseFromName(dbName)
+	if sqlDB == nil {
+		if err := db.openDatabase(ctx, dbName); err != nil {
+			return []string{err.Error()}, nil
+		}
+		sqlDB = db.rawDatabaseFromName(dbName)
+	}
+
+	rows, err := sqlDB.QueryContext(ctx, "PRAGMA integrity_check")
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return []string{err.Error()}, nil
+		}
+		if result != "ok" {
+			problems = append(problems, result)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	return problems, nil
+}
+
+// Recover backs up and recreates, with the current schema, the databases whose
+// check found problems. Afterwards, it derives again from the stored pieces the
+// piece expirations and the space used when their databases are recreated.
+//
+// The returned reports describe what was lost for each recreated database.
+func (db *DB) Recover(ctx context.Context, checks []IntegrityCheck) (reports []RecoveryReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var damaged []IntegrityCheck
+	for _, check := range checks {
+		if !check.OK() {
+			damaged = append(damaged, check)
+		}
+	}
+	if len(damaged) == 0 {
+		return nil, nil
+	}
+
+	templateDir, err := os.MkdirTemp(db.dbDirectory, "recovery-")
+	if err != nil {
+		return nil, ErrRecovery.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrRecovery.Wrap(os.RemoveAll(templateDir))) }()
+
+	if err := db.createTemplate(ctx, templateDir); err != nil {
+		return nil, err
+	}
+
+	suffix := ".corrupted-" + time.Now().UTC().Format("20060102T150405Z")
+	for _, check := range damaged {
+		report := RecoveryReport{
+			Name: check.Name,
+			Lost: lostOnRecreate[check.Name],
+		}
+
+		if err := db.closeDatabase(check.Name); err != nil {
+			return reports, ErrRecovery.Wrap(err)
+		}
+
+		if _, err := os.Stat(check.Path); err == nil {
+			report.BackupPath = check.Path + suffix
+		}
+		for _, ext := range []string{"", "-wal", "-shm"} {
+			err := os.Rename(check.Path+ext, check.Path+suffix+ext)
+			if err != nil && !os.IsNotExist(err) {
+				return reports, ErrRecovery.New("backing up %s failed: %w", check.Name, err)
+			}
+		}
+
+		err := os.Rename(filepath.Join(templateDir, db.filenameFromDBName(check.Name)), check.Path)
+		if err != nil {
+			return reports, ErrRecovery.New("recreating %s failed: %w", check.Name, err)
+		}
+
+		if err := db.openDatabase(ctx, check.Name); err != nil {
+			return reports, ErrRecovery.Wrap(err)
+		}
+
+		db.log.Warn("database recreated",
+			zap.String("database", check.Name),
+			zap.String("backup", report.BackupPath),
+			zap.String("lost", report.Lost))
+
+		reports = append(reports, report)
+	}
+
+	for i := range reports {
+		var rederived []string
+
+		name := reports[i].Name
+		if name == PieceExpirationDBName || name == ConsolidatedDBName {
+			count, err := db.rederiveExpirations(ctx)
+			if err != nil {
+				return reports, ErrRecovery.Wrap(err)
+			}
+			rederived = append(rederived, fmt.Sprintf("piece expirations: %d", count))
+		}
+		if name == PieceSpaceUsedDBName || name == ConsolidatedDBName {
+			total, err := db.rederiveSpaceUsed(ctx)
+			if err != nil {
+				return reports, ErrRecovery.Wrap(err)
+			}
+			rederived = append(rederived, "space used by pieces: "+memory.Size(total).String())
+		}
+
+		reports[i].Rederived = strings.Join(rederived, ", ")
+	}
+
+	return reports, nil
+}
+
+// createTemplate creates, in dir, all the databases with the latest schema.
+func (db *DB) createTemplate(ctx context.Context, dir string) (err error) {
+	template, err := OpenNew(ctx, db.log.Named("recovery"), Config{
+		Storage: dir,
+		Info:    filepath.Join(dir, "piecestore.db"),
+		Info2:   filepath.Join(dir, "info.db"),
+		Driver:  db.config.Driver,
+		Pieces:  dir,
+
+		Consolidated:      db.config.Consolidated,
+		TestingDisableWAL: db.config.TestingDisableWAL,
+	})
+	if err != nil {
+		return ErrRecovery.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrRecovery.Wrap(template.Close())) }()
+
+	return ErrRecovery.Wrap(template.MigrateToLatest(ctx))
+}
+
+// rederiveExpirations stores the expirations of the pieces with an expiration
+// in their header and returns how many were stored.
+func (db *DB) rederiveExpirations(ctx context.Context) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	walker := pieces.NewFileWalker(db.log.Named("recovery"), db.pieces, nil)
+	err = db.walkSatellites(ctx, func(satelliteID storj.NodeID) error {
+		return walker.WalkSatellitePieces(ctx, satelliteID, func(access pieces.StoredPieceAccess) error {
+			expiration, err := db.pieceExpiration(ctx, access)
+			if err != nil {
+				db.log.Debug("couldn't read piece header",
+					zap.Stringer("satellite", satelliteID),
+					zap.Stringer("piece", access.PieceID()),
+					zap.Error(err))
+				return nil
+			}
+			if expiration.IsZero() {
+				return nil
+			}
+
+			count++
+			return db.pieceExpirationDB.SetExpiration(ctx, satelliteID, access.PieceID(), expiration)
+		})
+	})
+
+	return count, err
+}
+
+// pieceExpiration returns the expiration in the header of the piece.
+func (db *DB) pieceExpiration(ctx context.Context, access pieces.StoredPieceAccess) (_ time.Time, err error) {
+	blob, err := db.pieces.OpenWithStorageFormat(ctx, access.BlobRef(), access.StorageFormatVersion())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	reader, err := pieces.NewReader(blob)
+	if err != nil {
+		return time.Time{}, errs.Combine(err, blob.Close())
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	header, err := reader.GetPieceHeader()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return header.OrderLimit.PieceExpiration, nil
+}
+
+// rederiveSpaceUsed walks all the pieces for storing the space used by them and
+// by the trash, and returns the total space used by pieces.
+func (db *DB) rederiveSpaceUsed(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := db.pieceSpaceUsedDB.Init(ctx); err != nil {
+		return 0, err
+	}
+
+	walker := pieces.NewFileWalker(db.log.Named("recovery"), db.pieces, db.v0PieceInfoDB)
+
+	var contentSize int64
+	bySatellite := make(map[storj.NodeID]pieces.SatelliteUsage)
+	err = db.walkSatellites(ctx, func(satelliteID storj.NodeID) error {
+		satTotal, satContentSize, err := walker.WalkAndComputeSpaceUsedBySatellite(ctx, satelliteID)
+		if err != nil {
+			return err
+		}
+
+		total += satTotal
+		contentSize += satContentSize
+		bySatellite[satelliteID] = pieces.SatelliteUsage{
+			Total:       satTotal,
+			ContentSize: satContentSize,
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	trash, err := db.pieces.SpaceUsedForTrash(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return total, errs.Combine(
+		db.pieceSpaceUsedDB.UpdatePieceTotals(ctx, total, contentSize),
+		db.pieceSpaceUsedDB.UpdatePieceTotalsForAllSatellites(ctx, bySatellite),
+		db.pieceSpaceUsedDB.UpdateTrashTotal(ctx, trash),
+	)
+}
+
+// walkSatellites calls fn for every satellite with pieces in the blob store.
+func (db *DB) walkSatellites(ctx context.Context, fn func(satelliteID storj.NodeID) error) error {
+	namespaces, err := db.pieces.ListNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaces {
+		satelliteID, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			db.log.Warn("invalid namespace in the blob store", zap.Binary("namespace", namespace), zap.Error(err))
+			continue
+		}
+
+		if err := fn(satelliteID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
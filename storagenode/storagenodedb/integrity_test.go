@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func TestCheckIntegrityAndRecover(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	storageDir := ctx.Dir("storage")
+	cfg := storagenodedb.Config{
+		Storage: storageDir,
+		Info:    filepath.Join(storageDir, "piecestore.db"),
+		Info2:   filepath.Join(storageDir, "info.db"),
+		Pieces:  storageDir,
+	}
+
+	db, err := storagenodedb.OpenNew(ctx, log, cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.MigrateToLatest(ctx))
+
+	satelliteID := testrand.NodeID()
+	pieceID := testrand.PieceID()
+	expiration := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	store := pieces.NewStore(log, pieces.NewFileWalker(log, db.Pieces(), nil), nil, db.Pieces(), nil, db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+	writer, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.Bytes(1024))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{
+		OrderLimit: pb.OrderLimit{PieceExpiration: expiration},
+	}))
+	require.NoError(t, db.Close())
+
+	// damage one database and remove another one.
+	notificationsPath := filepath.Join(storageDir, storagenodedb.NotificationsDBName+".db")
+	require.NoError(t, os.WriteFile(notificationsPath, testrand.Bytes(8192), 0644))
+	require.NoError(t, os.Remove(filepath.Join(storageDir, storagenodedb.PieceExpirationDBName+".db")))
+
+	_, err = storagenodedb.OpenExisting(ctx, log, cfg)
+	require.Error(t, err)
+
+	db, err = storagenodedb.OpenNew(ctx, log, cfg)
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	checks, err := db.CheckIntegrity(ctx)
+	require.NoError(t, err)
+
+	var damaged []string
+	for _, check := range checks {
+		if !check.OK() {
+			damaged = append(damaged, check.Name)
+		}
+	}
+	require.ElementsMatch(t, []string{storagenodedb.NotificationsDBName, storagenodedb.PieceExpirationDBName}, damaged)
+
+	reports, err := db.Recover(ctx, checks)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	for _, report := range reports {
+		require.NotEmpty(t, report.Lost)
+		switch report.Name {
+		case storagenodedb.NotificationsDBName:
+			require.True(t, strings.HasPrefix(report.BackupPath, notificationsPath+".corrupted-"))
+			require.FileExists(t, report.BackupPath)
+		case storagenodedb.PieceExpirationDBName:
+			require.Empty(t, report.BackupPath)
+			require.Equal(t, "piece expirations: 1", report.Rederived)
+		}
+	}
+
+	checks, err = db.CheckIntegrity(ctx)
+	require.NoError(t, err)
+	for _, check := range checks {
+		require.True(t, check.OK(), check.Name, check.Problems)
+	}
+	require.NoError(t, db.MigrateToLatest(ctx))
+	require.NoError(t, db.Preflight(ctx))
+
+	expired, err := db.PieceExpirationDB().GetExpired(ctx, expiration.Add(time.Second), 10)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	require.Equal(t, satelliteID, expired[0].SatelliteID)
+	require.Equal(t, pieceID, expired[0].PieceID)
+}
@@ -142,10 +142,30 @@ func TestMigrate(t *testing.T) {
 		err = insertOldData(ctx, expected, rawDBs)
 		require.NoError(t, err, tag)
 
+		// load the schemas for verifying the rollback of the step
+		var previousSchemas map[string]*dbschema.Schema
+		if step.Revert != nil {
+			previousSchemas, err = getSchemas(ctx, rawDBs)
+			require.NoError(t, err, tag)
+		}
+
 		// run migration up to a specific version
 		err := migrations.TargetVersion(step.Version).Run(ctx, log.Named("migrate"))
 		require.NoError(t, err, tag)
 
+		// verify that the step can be reverted and applied again
+		if step.Revert != nil {
+			err = migrations.Rollback(ctx, log.Named("rollback"), migrations.Steps[i-1].Version)
+			require.NoError(t, err, tag)
+
+			revertedSchemas, err := getSchemas(ctx, rawDBs)
+			require.NoError(t, err, tag)
+			require.Equal(t, previousSchemas, revertedSchemas, tag)
+
+			err = migrations.TargetVersion(step.Version).Run(ctx, log.Named("migrate"))
+			require.NoError(t, err, tag)
+		}
+
 		// insert data for new tables
 		err = insertNewData(ctx, expected, rawDBs)
 		require.NoError(t, err, tag)
@@ -5,6 +5,8 @@ package storagenodedb
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -56,6 +58,56 @@ func (db *pieceExpirationDB) GetExpired(ctx context.Context, expiresBefore time.
 	return expiredPieceIDs, rows.Err()
 }
 
+// GetExpiration gets the expiration time of the piece, when it has one and it isn't in the trash.
+func (db *pieceExpirationDB) GetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (expiresAt time.Time, found bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.QueryRowContext(ctx, `
+		SELECT piece_expiration
+			FROM piece_expirations
+			WHERE satellite_id = ?
+				AND piece_id = ?
+				AND trash = 0
+	`, satellite, pieceID).Scan(&expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, ErrPieceExpiration.Wrap(err)
+	}
+	return expiresAt, true, nil
+}
+
+// GetExpirations gets up to limit expiration times of the pieces of the given satellite, which
+// aren't in the trash and whose IDs are after the cursor, ordered by piece ID.
+func (db *pieceExpirationDB) GetExpirations(ctx context.Context, satellite storj.NodeID, cursor storj.PieceID, limit int) (expirations []pieces.PieceExpiration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT piece_id, piece_expiration
+			FROM piece_expirations
+			WHERE satellite_id = ?
+				AND piece_id > ?
+				AND trash = 0
+			ORDER BY piece_id
+			LIMIT ?
+	`, satellite, cursor, limit)
+	if err != nil {
+		return nil, ErrPieceExpiration.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var expiration pieces.PieceExpiration
+		err = rows.Scan(&expiration.PieceID, &expiration.Expiration)
+		if err != nil {
+			return nil, ErrPieceExpiration.Wrap(err)
+		}
+		expirations = append(expirations, expiration)
+	}
+	return expirations, ErrPieceExpiration.Wrap(rows.Err())
+}
+
 // SetExpiration sets an expiration time for the given piece ID on the given satellite.
 func (db *pieceExpirationDB) SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -26,36 +26,50 @@ func init() {
 // Run method will iterate over all supported databases. Will establish
 // connection and will create tables for each DB.
 func Run(t *testing.T, test func(ctx *testcontext.Context, t *testing.T, db storagenode.DB)) {
-	t.Run("Sqlite", func(t *testing.T) {
-		t.Parallel()
-		ctx := testcontext.New(t)
-		defer ctx.Cleanup()
-
-		log := zaptest.NewLogger(t)
-
-		storageDir := ctx.Dir("storage")
-
-		cfg := storagenodedb.Config{
-			Storage: storageDir,
-			Info:    filepath.Join(storageDir, "piecestore.db"),
-			Info2:   filepath.Join(storageDir, "info.db"),
-			Driver:  "sqlite3+utccheck",
-			Pieces:  storageDir,
-
-			TestingDisableWAL: true,
-		}
-
-		db, err := OpenNew(ctx, log, cfg)
-		if err != nil {
-			t.Fatal(err)
-		}
-		defer ctx.Check(db.Close)
-
-		err = db.MigrateToLatest(ctx)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		test(ctx, t, db)
-	})
+	for _, layout := range []struct {
+		name         string
+		consolidated bool
+	}{
+		{"Sqlite", false},
+		{"SqliteConsolidated", true},
+	} {
+		layout := layout
+		t.Run(layout.name, func(t *testing.T) {
+			t.Parallel()
+			run(t, layout.consolidated, test)
+		})
+	}
+}
+
+func run(t *testing.T, consolidated bool, test func(ctx *testcontext.Context, t *testing.T, db storagenode.DB)) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+
+	storageDir := ctx.Dir("storage")
+
+	cfg := storagenodedb.Config{
+		Storage: storageDir,
+		Info:    filepath.Join(storageDir, "piecestore.db"),
+		Info2:   filepath.Join(storageDir, "info.db"),
+		Driver:  "sqlite3+utccheck",
+		Pieces:  storageDir,
+
+		Consolidated:      consolidated,
+		TestingDisableWAL: true,
+	}
+
+	db, err := OpenNew(ctx, log, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(db.Close)
+
+	err = db.MigrateToLatest(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test(ctx, t, db)
 }
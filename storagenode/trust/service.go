@@ -240,7 +240,7 @@ func (pool *Pool) Refresh(ctx context.Context) error {
 		status := satellites.Normal
 		dbSatellite, err := pool.satellitesDB.GetSatellite(ctx, info.url.ID)
 		if err == nil && !dbSatellite.SatelliteID.IsZero() {
-			if dbSatellite.Status != satellites.Untrusted {
+			if dbSatellite.Status != satellites.Untrusted && dbSatellite.Status != satellites.Forgetting {
 				status = dbSatellite.Status
 			}
 		}
@@ -14,6 +14,7 @@ import (
 	"storj.io/common/sync2"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/shared/version"
+	"storj.io/storj/storagenode/alerts"
 	"storj.io/storj/storagenode/notifications"
 )
 
@@ -29,6 +30,7 @@ type Chore struct {
 	Loop          *sync2.Cycle
 	nodeID        storj.NodeID
 	notifications *notifications.Service
+	alerts        *alerts.Service
 
 	version Relevance
 	// nowFn used to mock time is tests.
@@ -36,12 +38,13 @@ type Chore struct {
 }
 
 // NewChore creates a Version Check Client with default configuration for storagenode.
-func NewChore(log *zap.Logger, service *checker.Service, notifications *notifications.Service, nodeID storj.NodeID, checkInterval time.Duration) *Chore {
+func NewChore(log *zap.Logger, service *checker.Service, notifications *notifications.Service, alerts *alerts.Service, nodeID storj.NodeID, checkInterval time.Duration) *Chore {
 	return &Chore{
 		log:           log,
 		service:       service,
 		nodeID:        nodeID,
 		notifications: notifications,
+		alerts:        alerts,
 		Loop:          sync2.NewCycle(checkInterval),
 		nowFn:         time.Now().UTC,
 	}
@@ -127,6 +130,12 @@ func (chore *Chore) checkRelevance(ctx context.Context, suggested version.SemVer
 				chore.version.ExpectedVersion = suggested
 				chore.version.FirstTimeSpotted = time.Now().UTC()
 				chore.version.TimesNotified = notifications.TimesNotifiedZero
+
+				chore.alerts.Send(ctx, alerts.Alert{
+					Event:   alerts.EventVersion,
+					Title:   "Please update your Node to Version " + suggested.String(),
+					Message: "Your Node is running version " + current.String() + ", which is outdated.",
+				})
 			}
 		} else {
 			chore.version.IsOutdated = false
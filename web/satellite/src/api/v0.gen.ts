@@ -25,6 +25,22 @@ export class APIKeyPage {
     totalCount: number;
 }
 
+export class APIKeyRestrictions {
+    disallowReads: boolean;
+    disallowWrites: boolean;
+    disallowLists: boolean;
+    disallowDeletes: boolean;
+    buckets?: string[];
+    notBefore?: Time;
+    notAfter?: Time;
+}
+
+export class BucketInfo {
+    name: string;
+    placement: number;
+    createdAt: Time;
+}
+
 export class BucketUsageRollup {
     projectID: UUID;
     bucketName: string;
@@ -42,6 +58,7 @@ export class BucketUsageRollup {
 export class CreateAPIKeyRequest {
     projectID: string;
     name: string;
+    restrictions?: APIKeyRestrictions;
 }
 
 export class CreateAPIKeyResponse {
@@ -49,6 +66,15 @@ export class CreateAPIKeyResponse {
     keyInfo: APIKeyInfo | null;
 }
 
+export class CreateBucketRequest {
+    name: string;
+    placement?: number;
+}
+
+export class InviteProjectMemberRequest {
+    email: string;
+}
+
 export class Project {
     id: UUID;
     publicId: UUID;
@@ -69,6 +95,60 @@ export class Project {
     defaultPlacement: number;
 }
 
+export class ProjectInvitationInfo {
+    email: string;
+    createdAt: Time;
+    expired: boolean;
+}
+
+export class ProjectMemberInfo {
+    id: UUID;
+    fullName: string;
+    shortName: string;
+    email: string;
+    joinedAt: Time;
+}
+
+export class ProjectMembersAndInvitationsPage {
+    members: ProjectMemberInfo[] | null;
+    invitations: ProjectInvitationInfo[] | null;
+    search: string;
+    limit: number;
+    order: number;
+    orderDirection: number;
+    offset: number;
+    pageCount: number;
+    currentPage: number;
+    totalCount: number;
+}
+
+export class ProjectReportItem {
+    projectID: UUID;
+    projectName: string;
+    bucketName: string;
+    storage: number;
+    egress: number;
+    segmentCount: number;
+    objectCount: number;
+    since: Time;
+    before: Time;
+}
+
+export class ProjectUsageLimits {
+    storageLimit: number;
+    bandwidthLimit: number;
+    storageUsed: number;
+    bandwidthUsed: number;
+    objectCount: number;
+    segmentCount: number;
+    rateLimit: number;
+    segmentLimit: number;
+    rateUsed: number;
+    segmentUsed: number;
+    bucketsUsed: number;
+    bucketsLimit: number;
+}
+
 export class ResponseUser {
     id: UUID;
     fullName: string;
@@ -94,6 +174,32 @@ export class UpsertProjectInfo {
     createdAt: Time;
 }
 
+export class UsageExportItem {
+    projectID: UUID;
+    projectName: string;
+    bucketName: string;
+    day: Time;
+    storageByteHours: number;
+    segmentHours: number;
+    objectHours: number;
+    getEgress: number;
+    repairEgress: number;
+    auditEgress: number;
+    storageCents: number;
+    egressCents: number;
+    segmentCents: number;
+    totalCents: number;
+}
+
+export class UsageExportPage {
+    items: UsageExportItem[] | null;
+    limit: number;
+    offset: number;
+    pageCount: number;
+    currentPage: number;
+    totalCount: number;
+}
+
 class APIError extends Error {
     constructor(
         public readonly msg: string,
@@ -176,6 +282,22 @@ export class ProjectManagementHttpApiV0 {
         throw new APIError(err.error, response.status);
     }
 
+    public async getUsageExport(projectID: UUID, since: Time, before: Time, limit: number, page: number): Promise<UsageExportPage> {
+        const u = new URL(`${this.ROOT_PATH}/usage-export`, window.location.href);
+        u.searchParams.set('projectID', projectID);
+        u.searchParams.set('since', since);
+        u.searchParams.set('before', before);
+        u.searchParams.set('limit', limit);
+        u.searchParams.set('page', page);
+        const fullPath = u.toString();
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as UsageExportPage);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
     public async getAPIKeys(projectID: UUID, search: string, limit: number, page: number, order: number, orderDirection: number): Promise<APIKeyPage> {
         const u = new URL(`${this.ROOT_PATH}/apikeys/${projectID}`, window.location.href);
         u.searchParams.set('search', search);
@@ -191,6 +313,95 @@ export class ProjectManagementHttpApiV0 {
         const err = await response.json();
         throw new APIError(err.error, response.status);
     }
+
+    public async getMembersAndInvitations(projectID: UUID, search: string, limit: number, page: number): Promise<ProjectMembersAndInvitationsPage> {
+        const u = new URL(`${this.ROOT_PATH}/members/${projectID}`, window.location.href);
+        u.searchParams.set('search', search);
+        u.searchParams.set('limit', limit);
+        u.searchParams.set('page', page);
+        const fullPath = u.toString();
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as ProjectMembersAndInvitationsPage);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async inviteMember(request: InviteProjectMemberRequest, projectID: UUID): Promise<ProjectInvitationInfo> {
+        const fullPath = `${this.ROOT_PATH}/invite/${projectID}`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as ProjectInvitationInfo);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async deleteMembersAndInvitations(projectID: UUID, emails: string): Promise<void> {
+        const u = new URL(`${this.ROOT_PATH}/members/${projectID}`, window.location.href);
+        u.searchParams.set('emails', emails);
+        const fullPath = u.toString();
+        const response = await this.http.delete(fullPath);
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async getUsageLimits(projectID: UUID): Promise<ProjectUsageLimits> {
+        const fullPath = `${this.ROOT_PATH}/usage-limits/${projectID}`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as ProjectUsageLimits);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async getUsageReport(projectID: UUID, since: Time, before: Time): Promise<ProjectReportItem[]> {
+        const u = new URL(`${this.ROOT_PATH}/usage-report/${projectID}`, window.location.href);
+        u.searchParams.set('since', since);
+        u.searchParams.set('before', before);
+        const fullPath = u.toString();
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as ProjectReportItem[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async getBuckets(projectID: UUID): Promise<BucketInfo[]> {
+        const fullPath = `${this.ROOT_PATH}/buckets/${projectID}`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as BucketInfo[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async createBucket(request: CreateBucketRequest, projectID: UUID): Promise<BucketInfo> {
+        const fullPath = `${this.ROOT_PATH}/buckets/${projectID}`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as BucketInfo);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async deleteBucket(projectID: UUID, name: string): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/buckets/${projectID}/${name}`;
+        const response = await this.http.delete(fullPath);
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
 }
 
 export class APIKeyManagementHttpApiV0 {